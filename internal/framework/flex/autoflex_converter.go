@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ConverterFunc converts a source value of type F into the target value pointed to by to.
+// It is called for every source value matched to the converter, including null and unknown
+// Plugin Framework values when expanding.
+type ConverterFunc[F, T any] func(ctx context.Context, from F, to *T) diag.Diagnostics
+
+// converter is a type-erased ConverterFunc.
+type converter struct {
+	sourceType reflect.Type
+	targetType reflect.Type
+	fn         func(context.Context, reflect.Value, reflect.Value) diag.Diagnostics
+}
+
+type converterKey struct {
+	sourceType reflect.Type
+	targetType reflect.Type
+}
+
+// converterRegistry stores user-defined converters keyed by source and target type pair
+// or by source field path.
+type converterRegistry struct {
+	byType map[converterKey]converter
+	byPath map[string]converter
+}
+
+func newConverter[F, T any](fn ConverterFunc[F, T]) converter {
+	return converter{
+		sourceType: reflect.TypeFor[F](),
+		targetType: reflect.TypeFor[T](),
+		fn: func(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics {
+			from, _ := vFrom.Interface().(F) // A nil interface value converts to F's zero value.
+			return fn(ctx, from, vTo.Addr().Interface().(*T))
+		},
+	}
+}
+
+// lookup returns the converter for the specified source field path or, if there is none,
+// for the source and target type pair.
+func (r converterRegistry) lookup(sourcePath path.Path, sourceType, targetType reflect.Type) (converter, bool) {
+	if sourceType == nil || targetType == nil {
+		return converter{}, false
+	}
+
+	if c, ok := r.byPath[converterFieldPath(sourcePath)]; ok {
+		return c, true
+	}
+
+	c, ok := r.byType[converterKey{sourceType: sourceType, targetType: targetType}]
+	return c, ok
+}
+
+// convert runs the converter, checking that it applies to the source and target values.
+func (c converter) convert(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !vFrom.Type().AssignableTo(c.sourceType) || vTo.Type() != c.targetType || !vTo.CanAddr() {
+		diags.Append(diagConverterIncompatibleTypes(vFrom.Type(), vTo.Type(), c.sourceType, c.targetType))
+		return diags
+	}
+
+	diags.Append(c.fn(ctx, vFrom, vTo)...)
+
+	return diags
+}
+
+// converterFieldPath returns the source struct field names in the path, separated by '.'.
+// Collection element steps are omitted so that a field path matches every element.
+func converterFieldPath(p path.Path) string {
+	var names []string
+
+	for _, step := range p.Steps() {
+		if name, ok := step.(path.PathStepAttributeName); ok {
+			names = append(names, string(name))
+		}
+	}
+
+	return strings.Join(names, ".")
+}
+
+func diagConverterIncompatibleTypes(sourceType, targetType, converterSourceType, converterTargetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while converting configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Custom converter from %q to %q cannot convert %q to %q", fullTypeName(converterSourceType), fullTypeName(converterTargetType), fullTypeName(sourceType), fullTypeName(targetType)),
+	)
+}
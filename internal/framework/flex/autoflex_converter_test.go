@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+// Tests AutoFlex's user-defined converters.
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+type tfCommaSeparatedField struct {
+	Field1 fwtypes.ListOfString `tfsdk:"field1"`
+}
+
+func expandUpperString(_ context.Context, from types.String, to *string) diag.Diagnostics {
+	*to = strings.ToUpper(from.ValueString())
+	return nil
+}
+
+func expandCommaSeparated(ctx context.Context, from fwtypes.ListOfString, to **string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if from.IsNull() || from.IsUnknown() {
+		return diags
+	}
+
+	var elems []string
+	diags.Append(from.ElementsAs(ctx, &elems, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	*to = aws.String(strings.Join(elems, ","))
+
+	return diags
+}
+
+func flattenCommaSeparated(ctx context.Context, from *string, to *fwtypes.ListOfString) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if from == nil {
+		*to = fwtypes.NewListValueOfNull[types.String](ctx)
+		return diags
+	}
+
+	*to = FlattenFrameworkStringValueListOfString(ctx, strings.Split(aws.ToString(from), ","))
+
+	return diags
+}
+
+func TestExpandConverter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"type converter": {
+			Options: []AutoFlexOptionsFunc{WithConverter(expandUpperString)},
+			Source:  &tfSingleStringField{Field1: types.StringValue("a")},
+			Target:  &awsSingleStringValue{},
+			WantTarget: &awsSingleStringValue{
+				Field1: "A",
+			},
+		},
+		"type converter not matching target type": {
+			Options: []AutoFlexOptionsFunc{WithConverter(expandUpperString)},
+			Source:  &tfSingleStringField{Field1: types.StringValue("a")},
+			Target:  &awsSingleStringPointer{},
+			WantTarget: &awsSingleStringPointer{
+				Field1: aws.String("a"),
+			},
+		},
+		"field converter": {
+			Options: []AutoFlexOptionsFunc{WithFieldConverter("Field1", expandCommaSeparated)},
+			Source: &tfCommaSeparatedField{
+				Field1: FlattenFrameworkStringValueListOfString(ctx, []string{"a", "b"}),
+			},
+			Target: &awsSingleStringPointer{},
+			WantTarget: &awsSingleStringPointer{
+				Field1: aws.String("a,b"),
+			},
+		},
+		"field converter in nested collection": {
+			Options: []AutoFlexOptionsFunc{WithFieldConverter("Field1.Field1", expandUpperString)},
+			Source: &tfListOfNestedObject{
+				Field1: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*tfSingleStringField{
+					{Field1: types.StringValue("a")},
+					{Field1: types.StringValue("b")},
+				}),
+			},
+			Target: &awsSliceOfNestedObjectValues{},
+			WantTarget: &awsSliceOfNestedObjectValues{
+				Field1: []awsSingleStringValue{
+					{Field1: "A"},
+					{Field1: "B"},
+				},
+			},
+		},
+		"field converter takes precedence": {
+			Options: []AutoFlexOptionsFunc{
+				WithConverter(func(context.Context, types.String, *string) diag.Diagnostics {
+					panic("type converter called")
+				}),
+				WithFieldConverter("Field1", expandUpperString),
+			},
+			Source: &tfSingleStringField{Field1: types.StringValue("a")},
+			Target: &awsSingleStringValue{},
+			WantTarget: &awsSingleStringValue{
+				Field1: "A",
+			},
+		},
+		"field converter incompatible target": {
+			Options: []AutoFlexOptionsFunc{WithFieldConverter("Field1", expandUpperString)},
+			Source:  &tfSingleStringField{Field1: types.StringValue("a")},
+			Target:  &awsSingleStringPointer{},
+			ExpectedDiags: diag.Diagnostics{
+				diagConverterIncompatibleTypes(reflect.TypeFor[types.String](), reflect.TypeFor[*string](), reflect.TypeFor[types.String](), reflect.TypeFor[string]()),
+			},
+		},
+	}
+	runAutoExpandTestCases(t, testCases, runChecks{CompareDiags: true, CompareTarget: true})
+}
+
+func TestFlattenConverter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"field converter": {
+			Options: []AutoFlexOptionsFunc{WithFieldConverter("Field1", flattenCommaSeparated)},
+			Source:  &awsSingleStringPointer{Field1: aws.String("a,b")},
+			Target:  &tfCommaSeparatedField{},
+			WantTarget: &tfCommaSeparatedField{
+				Field1: FlattenFrameworkStringValueListOfString(ctx, []string{"a", "b"}),
+			},
+		},
+		"type converter nil source": {
+			Options: []AutoFlexOptionsFunc{WithConverter(flattenCommaSeparated)},
+			Source:  &awsSingleStringPointer{},
+			Target:  &tfCommaSeparatedField{},
+			WantTarget: &tfCommaSeparatedField{
+				Field1: fwtypes.NewListValueOfNull[types.String](ctx),
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases, runChecks{CompareDiags: true, CompareTarget: true})
+}
+
+func TestConverterFieldPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     path.Path
+		expected string
+	}{
+		"empty": {
+			path:     path.Empty(),
+			expected: "",
+		},
+		"attribute": {
+			path:     path.Root("Field1"),
+			expected: "Field1",
+		},
+		"nested": {
+			path:     path.Root("Field1").AtListIndex(0).AtName("Field2").AtMapKey("key").AtName("Field3"),
+			expected: "Field1.Field2.Field3",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := converterFieldPath(testCase.path), testCase.expected; got != want {
+				t.Errorf("converterFieldPath(%s) = %q, want %q", testCase.path, got, want)
+			}
+		})
+	}
+}
@@ -164,8 +164,17 @@ func (expander autoExpander) convert(ctx context.Context, sourcePath path.Path,
 		return diags
 	}
 
+	ctx = withTraceFrame(ctx, expander.Options.trace, sourcePath, valFrom, targetPath, vTo)
+
 	tflog.SubsystemInfo(ctx, subsystemName, "Converting")
 
+	if c, ok := expander.Options.converters.lookup(sourcePath, valFrom.Type(), vTo.Type()); ok {
+		tflog.SubsystemInfo(ctx, subsystemName, "Using custom converter")
+		expander.Options.trace.recordField(TraceEventConverted, sourcePath, valFrom.Type(), targetPath, vTo.Type(), "")
+		diags.Append(c.convert(ctx, valFrom, vTo)...)
+		return diags
+	}
+
 	if fromExpander, ok := valFrom.Interface().(Expander); ok {
 		tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.Expander")
 		diags.Append(expandExpander(ctx, fromExpander, vTo)...)
@@ -276,9 +285,13 @@ func (expander autoExpander) convert(ctx context.Context, sourcePath path.Path,
 	case basetypes.SetValuable:
 		diags.Append(expander.set(ctx, sourcePath, vFrom, targetPath, vTo, fieldOpts)...)
 		return diags
+
+	case basetypes.DynamicValuable:
+		diags.Append(expander.dynamic(ctx, vFrom, vTo)...)
+		return diags
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]any{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
 	})
@@ -321,7 +334,7 @@ func (expander autoExpander) bool(ctx context.Context, vFrom basetypes.BoolValua
 		}
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]any{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
 	})
@@ -378,7 +391,7 @@ func (expander autoExpander) float64(ctx context.Context, vFrom basetypes.Float6
 		}
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]any{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
 	})
@@ -421,7 +434,7 @@ func (expander autoExpander) float32(ctx context.Context, vFrom basetypes.Float3
 		}
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "Expanding incompatible types")
+	logIncompatibleTypes(ctx, "Expanding incompatible types", nil)
 	diags.Append(diagExpandingIncompatibleTypes(reflect.TypeOf(vFrom), vTo.Type()))
 	return diags
 }
@@ -475,7 +488,7 @@ func (expander autoExpander) int64(ctx context.Context, vFrom basetypes.Int64Val
 		}
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]any{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
 	})
@@ -518,7 +531,7 @@ func (expander autoExpander) int32(ctx context.Context, vFrom basetypes.Int32Val
 		}
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "Expanding incompatible types")
+	logIncompatibleTypes(ctx, "Expanding incompatible types", nil)
 	diags.Append(diagExpandingIncompatibleTypes(reflect.TypeOf(vFrom), vTo.Type()))
 	return diags
 }
@@ -609,7 +622,45 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 		}
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]any{
+		"from": vFrom.Type(ctx),
+		"to":   vTo.Kind(),
+	})
+
+	return diags
+}
+
+// dynamic copies a Plugin Framework Dynamic(ish) value to a compatible AWS API value.
+func (expander autoExpander) dynamic(ctx context.Context, vFrom basetypes.DynamicValuable, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vTo.Kind() == reflect.Interface {
+		//
+		// fwtypes.SmithyDocument -> document.Interface.
+		//
+		if s, ok := vFrom.(fwtypes.SmithyDocumentValue); ok {
+			v, d := s.ToSmithyObjectDocument(ctx)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			val := reflect.ValueOf(v)
+			if !val.IsValid() || val.IsZero() {
+				return diags
+			}
+
+			if !val.Type().Implements(vTo.Type()) {
+				diags.Append(diagExpandedTypeDoesNotImplement(val.Type(), vTo.Type()))
+				return diags
+			}
+
+			vTo.Set(val)
+			return diags
+		}
+	}
+
+	logIncompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]any{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
 	})
@@ -659,7 +710,7 @@ func (expander autoExpander) object(ctx context.Context, sourcePath path.Path, v
 		}
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]any{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
 	})
@@ -693,7 +744,7 @@ func (expander autoExpander) list(ctx context.Context, sourcePath path.Path, vFr
 		}
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]any{
 		"from list[%s]": v.ElementType(ctx),
 		"to":            vTo.Kind(),
 	})
@@ -787,7 +838,7 @@ func (expander autoExpander) listOrSetOfInt64(ctx context.Context, vFrom valueWi
 		}
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]any{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
 	})
@@ -867,7 +918,7 @@ func (expander autoExpander) listOrSetOfString(ctx context.Context, vFrom valueW
 		}
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]any{
 		"from": vFrom.Type(ctx),
 		"to":   vTo.Kind(),
 	})
@@ -941,7 +992,7 @@ func (expander autoExpander) listOrSetOfInt32(ctx context.Context, vFrom valueWi
 		}
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]any{
 		"from": "Set[Int32]",
 		"to":   vTo.Kind(),
 	})
@@ -1013,7 +1064,7 @@ func (expander autoExpander) map_(ctx context.Context, vFrom basetypes.MapValuab
 		}
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]any{
 		"from": fmt.Sprintf("map[string, %s]", v.ElementType(ctx)),
 		"to":   vTo.Kind(),
 	})
@@ -1068,7 +1119,7 @@ func (expander autoExpander) mapOfString(ctx context.Context, vFrom basetypes.Ma
 		}
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]any{
 		"from": fmt.Sprintf("map[string, %s]", vFrom.ElementType(ctx)),
 		"to":   vTo.Kind(),
 	})
@@ -1106,7 +1157,7 @@ func (expander autoExpander) set(ctx context.Context, sourcePath path.Path, vFro
 		}
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]any{
 		"from set[%s]": v.ElementType(ctx),
 		"to":           vTo.Kind(),
 	})
@@ -1391,7 +1442,13 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	}
 
 	if valTo.Kind() == reflect.Interface {
-		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
+		if fromUnion, ok := valFrom.Interface().(Union); ok {
+			tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.Union")
+			diags.Append(expandUnion(ctx, sourcePath, fromUnion, valFrom, targetPath, valTo, flexer)...)
+			return diags
+		}
+
+		logIncompatibleTypes(ctx, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
 		})
@@ -1409,13 +1466,15 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 		return diags
 	}
 
-	for fromField := range expandSourceFields(ctx, typeFrom, flexer.getOptions()) {
+	trace := flexer.getOptions().trace
+	for fromField := range expandSourceFields(ctx, sourcePath, typeFrom, flexer.getOptions()) {
 		fromFieldName := fromField.Name
 		_, fromFieldOpts := autoflexTags(fromField)
 		if fromFieldOpts.NoExpand() {
 			tflog.SubsystemTrace(ctx, subsystemName, "Skipping noexpand source field", map[string]any{
 				logAttrKeySourceFieldname: fromFieldName,
 			})
+			trace.recordField(TraceEventSkipped, sourcePath.AtName(fromFieldName), fromField.Type, targetPath, nil, "noexpand")
 			continue
 		}
 
@@ -1441,6 +1500,7 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
 				logAttrKeySourceFieldname: fromFieldName,
 			})
+			trace.recordField(TraceEventSkipped, sourcePath.AtName(fromFieldName), fromField.Type, targetPath, nil, "no corresponding field in "+fullTypeName(typeTo))
 			continue
 		}
 		toFieldName := toField.Name
@@ -1451,6 +1511,7 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 				logAttrKeySourceFieldname: fromFieldName,
 				logAttrKeyTargetFieldname: toFieldName,
 			})
+			trace.recordField(TraceEventSkipped, sourcePath.AtName(fromFieldName), fromField.Type, targetPath.AtName(toFieldName), toField.Type, "target field cannot be set")
 			continue
 		}
 
@@ -1458,6 +1519,7 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 			logAttrKeySourceFieldname: fromFieldName,
 			logAttrKeyTargetFieldname: toFieldName,
 		})
+		trace.recordField(TraceEventMapped, sourcePath.AtName(fromFieldName), fromField.Type, targetPath.AtName(toFieldName), toField.Type, "")
 
 		opts := fieldOpts{
 			legacy:          fromFieldOpts.Legacy(),
@@ -1475,7 +1537,7 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	return diags
 }
 
-func expandSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOptions) iter.Seq[reflect.StructField] {
+func expandSourceFields(ctx context.Context, sourcePath path.Path, typ reflect.Type, opts AutoFlexOptions) iter.Seq[reflect.StructField] {
 	return func(yield func(reflect.StructField) bool) {
 		for field := range tfreflect.ExportedStructFields(typ) {
 			fieldName := field.Name
@@ -1483,6 +1545,7 @@ func expandSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOpti
 				tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
 					logAttrKeySourceFieldname: fieldName,
 				})
+				opts.trace.recordField(TraceEventSkipped, sourcePath.AtName(fieldName), field.Type, path.Empty(), nil, "ignored field name")
 				continue
 			}
 
@@ -1491,6 +1554,7 @@ func expandSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOpti
 				tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
 					logAttrKeySourceFieldname: fieldName,
 				})
+				opts.trace.recordField(TraceEventSkipped, sourcePath.AtName(fieldName), field.Type, path.Empty(), nil, `autoflex:"-"`)
 				continue
 			}
 
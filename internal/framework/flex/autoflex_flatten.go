@@ -173,8 +173,17 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 		return diags
 	}
 
+	ctx = withTraceFrame(ctx, flattener.Options.trace, sourcePath, vFrom, targetPath, vTo)
+
 	tflog.SubsystemInfo(ctx, subsystemName, "Converting")
 
+	if c, ok := flattener.Options.converters.lookup(sourcePath, valueType(vFrom), vTo.Type()); ok {
+		tflog.SubsystemInfo(ctx, subsystemName, "Using custom converter")
+		flattener.Options.trace.recordField(TraceEventConverted, sourcePath, vFrom.Type(), targetPath, vTo.Type(), "")
+		diags.Append(c.convert(ctx, vFrom, vTo)...)
+		return diags
+	}
+
 	// main control flow
 	tTo := valTo.Type(ctx)
 	switch k := vFrom.Kind(); k {
@@ -220,11 +229,11 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo, fieldOpts)...)
+		diags.Append(flattener.interface_(ctx, sourcePath, vFrom, targetPath, tTo, vTo, fieldOpts)...)
 		return diags
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]any{
 		"from": vFrom.Kind(),
 		"to":   tTo,
 	})
@@ -265,7 +274,7 @@ func (flattener autoFlattener) bool(ctx context.Context, vFrom reflect.Value, is
 		return diags
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]any{
 		"from": vFrom.Kind(),
 		"to":   tTo,
 	})
@@ -308,12 +317,12 @@ func (flattener autoFlattener) float64(ctx context.Context, vFrom reflect.Value,
 
 	case basetypes.Float32Typable:
 		// Only returns an error when the target type is Float32Typable to prevent breaking existing resources
-		tflog.SubsystemError(ctx, subsystemName, "Flattening incompatible types")
+		logIncompatibleTypes(ctx, "Flattening incompatible types", nil)
 		diags.Append(DiagFlatteningIncompatibleTypes(sourceType, vTo.Type()))
 		return diags
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]any{
 		"from": vFrom.Kind(),
 		"to":   tTo,
 	})
@@ -391,7 +400,7 @@ func (flattener autoFlattener) float32(ctx context.Context, vFrom reflect.Value,
 		return diags
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]any{
 		"from": vFrom.Kind(),
 		"to":   tTo,
 	})
@@ -434,12 +443,12 @@ func (flattener autoFlattener) int64(ctx context.Context, vFrom reflect.Value, s
 
 	case basetypes.Int32Typable:
 		// Only returns an error when the target type is Int32Typeable to prevent breaking existing resources
-		tflog.SubsystemError(ctx, subsystemName, "Flattening incompatible types")
+		logIncompatibleTypes(ctx, "Flattening incompatible types", nil)
 		diags.Append(DiagFlatteningIncompatibleTypes(sourceType, vTo.Type()))
 		return diags
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]any{
 		"from": vFrom.Kind(),
 		"to":   tTo,
 	})
@@ -509,7 +518,7 @@ func (flattener autoFlattener) int32(ctx context.Context, vFrom reflect.Value, i
 		return diags
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]any{
 		"from": vFrom.Kind(),
 		"to":   tTo,
 	})
@@ -551,7 +560,7 @@ func (flattener autoFlattener) string(ctx context.Context, vFrom reflect.Value,
 		return diags
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]any{
 		"from": vFrom.Kind(),
 		"to":   tTo,
 	})
@@ -589,7 +598,7 @@ func (flattener autoFlattener) time(ctx context.Context, vFrom reflect.Value, is
 		return diags
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]any{
 		"from": vFrom.Kind(),
 		"to":   vTo,
 	})
@@ -631,7 +640,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 		return diags
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]any{
 		"from": vFrom.Kind(),
 		"to":   tTo,
 	})
@@ -639,7 +648,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 	return diags
 }
 
-func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value, _ fieldOpts) diag.Diagnostics {
+func (flattener autoFlattener) interface_(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value, _ fieldOpts) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -676,15 +685,48 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 			return diags
 		}
 
+	case basetypes.DynamicTypable:
+		//
+		// smithydocument.Marshaler -> types.Dynamic-ish.
+		//
+		if vFrom.Type().Implements(reflect.TypeFor[smithydocument.Marshaler]()) {
+			tflog.SubsystemInfo(ctx, subsystemName, "Source implements smithydocument.Marshaler")
+
+			dynamicValue := types.DynamicNull()
+
+			if vFrom.IsNil() {
+				tflog.SubsystemTrace(ctx, subsystemName, "Flattening null value")
+			} else {
+				doc := vFrom.Interface().(smithydocument.Marshaler)
+				v, err := fwtypes.DynamicValueFromSmithyDocument(ctx, doc)
+				if err != nil {
+					tflog.SubsystemError(ctx, subsystemName, "Marshalling JSON document", map[string]any{
+						logAttrKeyError: err.Error(),
+					})
+					diags.Append(diagFlatteningMarshalSmithyDocument(reflect.TypeOf(doc), err))
+					return diags
+				}
+				dynamicValue = v
+			}
+			v, d := tTo.ValueFromDynamic(ctx, dynamicValue)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(v))
+			return diags
+		}
+
 	case fwtypes.NestedObjectType:
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattener.interfaceToNestedObject(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
+		diags.Append(flattener.interfaceToNestedObject(ctx, sourcePath, vFrom, vFrom.IsNil(), targetPath, tTo, vTo)...)
 		return diags
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "Flattening incompatible types")
+	logIncompatibleTypes(ctx, "Flattening incompatible types", nil)
 
 	return diags
 }
@@ -713,7 +755,7 @@ func (flattener autoFlattener) struct_(ctx context.Context, sourcePath path.Path
 		return diags
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "Flattening incompatible types")
+	logIncompatibleTypes(ctx, "Flattening incompatible types", nil)
 
 	return diags
 }
@@ -907,7 +949,7 @@ func (flattener autoFlattener) slice(ctx context.Context, sourcePath path.Path,
 		}
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]any{
 		"from": vFrom.Kind(),
 		"to":   tTo,
 	})
@@ -1171,7 +1213,7 @@ func (flattener autoFlattener) map_(ctx context.Context, sourcePath path.Path, v
 		}
 	}
 
-	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Flatten; incompatible types", map[string]any{
+	logIncompatibleTypes(ctx, "AutoFlex Flatten; incompatible types", map[string]any{
 		"from": vFrom.Kind(),
 		"to":   tTo,
 	})
@@ -1373,7 +1415,7 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, sourceP
 }
 
 // interfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -1394,6 +1436,23 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 	}
 
 	toFlattener, ok := to.(Flattener)
+	if _, isUnion := to.(Union); !ok && isUnion {
+		tflog.SubsystemInfo(ctx, subsystemName, "Target implements flex.Union")
+
+		diags.Append(flattenUnion(ctx, sourcePath, vFrom, targetPath.AtListIndex(0), to, flattener)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		val, d := tTo.ValueFromObjectPtr(ctx, to)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
 	if !ok {
 		val, d := tTo.NullValue(ctx)
 		diags.Append(d...)
@@ -2232,7 +2291,8 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 		return diags
 	}
 
-	for fromField := range flattenSourceFields(ctx, typeFrom, flexer.getOptions()) {
+	trace := flexer.getOptions().trace
+	for fromField := range flattenSourceFields(ctx, sourcePath, typeFrom, flexer.getOptions()) {
 		fromFieldName := fromField.Name
 
 		// Skip fields that were already processed by XML wrapper split
@@ -2249,6 +2309,7 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
 				logAttrKeySourceFieldname: fromFieldName,
 			})
+			trace.recordField(TraceEventSkipped, sourcePath.AtName(fromFieldName), fromField.Type, targetPath, nil, "no corresponding field in "+fullTypeName(typeTo))
 			continue
 		}
 		toFieldName := toField.Name
@@ -2259,6 +2320,7 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 				logAttrKeySourceFieldname: fromFieldName,
 				logAttrKeyTargetFieldname: toFieldName,
 			})
+			trace.recordField(TraceEventSkipped, sourcePath.AtName(fromFieldName), fromField.Type, targetPath.AtName(toFieldName), toField.Type, `autoflex:"-"`)
 			continue
 		}
 		if toFieldOpts.NoFlatten() {
@@ -2266,6 +2328,7 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 				logAttrKeySourceFieldname: fromFieldName,
 				logAttrKeyTargetFieldname: toFieldName,
 			})
+			trace.recordField(TraceEventSkipped, sourcePath.AtName(fromFieldName), fromField.Type, targetPath.AtName(toFieldName), toField.Type, "noflatten")
 			continue
 		}
 		if !toFieldVal.CanSet() {
@@ -2274,6 +2337,7 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 				logAttrKeySourceFieldname: fromFieldName,
 				logAttrKeyTargetFieldname: toFieldName,
 			})
+			trace.recordField(TraceEventSkipped, sourcePath.AtName(fromFieldName), fromField.Type, targetPath.AtName(toFieldName), toField.Type, "target field cannot be set")
 			continue
 		}
 
@@ -2281,6 +2345,7 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 			logAttrKeySourceFieldname: fromFieldName,
 			logAttrKeyTargetFieldname: toFieldName,
 		})
+		trace.recordField(TraceEventMapped, sourcePath.AtName(fromFieldName), fromField.Type, targetPath.AtName(toFieldName), toField.Type, "")
 
 		// Check if target has wrapper tag and source is an XML wrapper struct
 		if wrapperField := toFieldOpts.XMLWrapperField(); wrapperField != "" {
@@ -2605,7 +2670,7 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 	return diags
 }
 
-func flattenSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOptions) iter.Seq[reflect.StructField] {
+func flattenSourceFields(ctx context.Context, sourcePath path.Path, typ reflect.Type, opts AutoFlexOptions) iter.Seq[reflect.StructField] {
 	return func(yield func(reflect.StructField) bool) {
 		for field := range tfreflect.ExportedStructFields(typ) {
 			fieldName := field.Name
@@ -2613,6 +2678,7 @@ func flattenSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOpt
 				tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
 					logAttrKeySourceFieldname: fieldName,
 				})
+				opts.trace.recordField(TraceEventSkipped, sourcePath.AtName(fieldName), field.Type, path.Empty(), nil, "ignored field name")
 				continue
 			}
 
@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// TraceEvent is the outcome recorded for a single field by an AutoFlex trace.
+type TraceEvent string
+
+const (
+	// TraceEventMapped means a source field was matched to a target field and converted.
+	TraceEventMapped TraceEvent = "mapped"
+	// TraceEventConverted means a value was converted by a custom converter.
+	TraceEventConverted TraceEvent = "converted"
+	// TraceEventSkipped means a source field was not copied to the target.
+	TraceEventSkipped TraceEvent = "skipped"
+	// TraceEventIncompatible means a value's type could not be converted to the target's type
+	// and the target was left unchanged.
+	TraceEventIncompatible TraceEvent = "incompatible"
+)
+
+// TraceEntry records what AutoFlex did with a single field or value.
+type TraceEntry struct {
+	Event      TraceEvent
+	SourcePath string
+	SourceType string
+	TargetPath string
+	TargetType string
+	Reason     string
+}
+
+func (e TraceEntry) String() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s: %s (%s)", e.Event, e.SourcePath, e.SourceType)
+	if e.TargetPath != "" || e.TargetType != "" {
+		fmt.Fprintf(&sb, " -> %s (%s)", e.TargetPath, e.TargetType)
+	}
+	if e.Reason != "" {
+		fmt.Fprintf(&sb, ": %s", e.Reason)
+	}
+
+	return sb.String()
+}
+
+// TraceReport is a structured, field-level report of an AutoFlex Expand or Flatten.
+// Pass a report to Expand or Flatten using WithTraceReport to find out why a field was not populated.
+type TraceReport struct {
+	mu      sync.Mutex
+	entries []TraceEntry
+}
+
+// Entries returns the report's entries in the order they were recorded.
+func (r *TraceReport) Entries() []TraceEntry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]TraceEntry(nil), r.entries...)
+}
+
+// Filter returns the report's entries for the specified event.
+func (r *TraceReport) Filter(event TraceEvent) []TraceEntry {
+	var entries []TraceEntry
+
+	for _, e := range r.Entries() {
+		if e.Event == event {
+			entries = append(entries, e)
+		}
+	}
+
+	return entries
+}
+
+// String returns the report with one entry per line.
+func (r *TraceReport) String() string {
+	var sb strings.Builder
+
+	for _, e := range r.Entries() {
+		sb.WriteString(e.String())
+		sb.WriteByte('\n')
+	}
+
+	return sb.String()
+}
+
+func (r *TraceReport) record(e TraceEntry) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = append(r.entries, e)
+}
+
+func (r *TraceReport) recordField(event TraceEvent, sourcePath path.Path, sourceType reflect.Type, targetPath path.Path, targetType reflect.Type, reason string) {
+	if r == nil {
+		return
+	}
+
+	e := TraceEntry{
+		Event:      event,
+		SourcePath: sourcePath.String(),
+		SourceType: fullTypeName(sourceType),
+		Reason:     reason,
+	}
+	if targetType != nil {
+		e.TargetPath = targetPath.String()
+		e.TargetType = fullTypeName(targetType)
+	}
+
+	r.record(e)
+}
+
+type traceFrameKey struct{}
+
+// traceFrame is the value being converted, used to attribute type mismatches.
+type traceFrame struct {
+	report     *TraceReport
+	sourcePath path.Path
+	sourceType reflect.Type
+	targetPath path.Path
+	targetType reflect.Type
+}
+
+// withTraceFrame returns a context recording the value being converted when tracing is enabled.
+func withTraceFrame(ctx context.Context, report *TraceReport, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, vTo reflect.Value) context.Context {
+	if report == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, traceFrameKey{}, &traceFrame{
+		report:     report,
+		sourcePath: sourcePath,
+		sourceType: valueType(vFrom),
+		targetPath: targetPath,
+		targetType: valueType(vTo),
+	})
+}
+
+// logIncompatibleTypes logs that the value being converted has no compatible target type
+// and records it in any trace report.
+func logIncompatibleTypes(ctx context.Context, msg string, additionalFields map[string]any) {
+	tflog.SubsystemError(ctx, subsystemName, msg, additionalFields)
+
+	if f, ok := ctx.Value(traceFrameKey{}).(*traceFrame); ok {
+		var reason []string
+		for _, k := range slices.Sorted(maps.Keys(additionalFields)) {
+			reason = append(reason, fmt.Sprintf("%s=%v", k, additionalFields[k]))
+		}
+		f.report.recordField(TraceEventIncompatible, f.sourcePath, f.sourceType, f.targetPath, f.targetType, strings.Join(reason, " "))
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+// Tests AutoFlex's field-level trace report.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type tfTraceFields struct {
+	Field1 types.String `tfsdk:"field1"`
+	Field2 types.Bool   `tfsdk:"field2"`
+	Field3 types.String `tfsdk:"field3"`
+	Field4 types.String `tfsdk:"field4" autoflex:"-"`
+	Tags   types.Map    `tfsdk:"tags"`
+}
+
+type awsTraceFields struct {
+	Field1 *string
+	Field2 string
+	Field4 *string
+}
+
+func TestExpandTraceReport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var report TraceReport
+
+	diags := Expand(ctx, &tfTraceFields{
+		Field1: types.StringValue("a"),
+		Field2: types.BoolValue(true),
+		Field3: types.StringValue("c"),
+		Field4: types.StringValue("d"),
+	}, &awsTraceFields{}, WithTraceReport(&report))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	want := []TraceEntry{
+		{Event: TraceEventMapped, SourcePath: "Field1", SourceType: "github.com/hashicorp/terraform-plugin-framework/types/basetypes.StringValue", TargetPath: "Field1", TargetType: "*string"},
+		{Event: TraceEventMapped, SourcePath: "Field2", SourceType: "github.com/hashicorp/terraform-plugin-framework/types/basetypes.BoolValue", TargetPath: "Field2", TargetType: "string"},
+		{Event: TraceEventIncompatible, SourcePath: "Field2", SourceType: "github.com/hashicorp/terraform-plugin-framework/types/basetypes.BoolValue", TargetPath: "Field2", TargetType: "string", Reason: "from=basetypes.BoolType to=string"},
+		{Event: TraceEventSkipped, SourcePath: "Field3", SourceType: "github.com/hashicorp/terraform-plugin-framework/types/basetypes.StringValue", Reason: "no corresponding field in github.com/hashicorp/terraform-provider-aws/internal/framework/flex.awsTraceFields"},
+		{Event: TraceEventSkipped, SourcePath: "Field4", SourceType: "github.com/hashicorp/terraform-plugin-framework/types/basetypes.StringValue", Reason: `autoflex:"-"`},
+		{Event: TraceEventSkipped, SourcePath: "Tags", SourceType: "github.com/hashicorp/terraform-plugin-framework/types/basetypes.MapValue", Reason: "ignored field name"},
+	}
+	if diff := cmp.Diff(report.Entries(), want); diff != "" {
+		t.Errorf("unexpected trace report difference: %s", diff)
+	}
+}
+
+func TestFlattenTraceReport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var report TraceReport
+
+	diags := Flatten(ctx, &awsTraceFields{
+		Field1: aws.String("a"),
+		Field2: "b",
+		Field4: aws.String("d"),
+	}, &tfTraceFields{}, WithTraceReport(&report))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got, want := len(report.Filter(TraceEventMapped)), 2; got != want {
+		t.Errorf("mapped entries = %d, want %d\n%s", got, want, report.String())
+	}
+	if got, want := len(report.Filter(TraceEventIncompatible)), 1; got != want {
+		t.Errorf("incompatible entries = %d, want %d\n%s", got, want, report.String())
+	}
+	if got, want := len(report.Filter(TraceEventSkipped)), 1; got != want {
+		t.Errorf("skipped entries = %d, want %d\n%s", got, want, report.String())
+	}
+}
+
+func TestTraceEntryString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		entry    TraceEntry
+		expected string
+	}{
+		"mapped": {
+			entry:    TraceEntry{Event: TraceEventMapped, SourcePath: "Field1", SourceType: "string", TargetPath: "Field1", TargetType: "*string"},
+			expected: "mapped: Field1 (string) -> Field1 (*string)",
+		},
+		"skipped": {
+			entry:    TraceEntry{Event: TraceEventSkipped, SourcePath: "Field1", SourceType: "string", Reason: "noexpand"},
+			expected: "skipped: Field1 (string): noexpand",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.entry.String(), testCase.expected; got != want {
+				t.Errorf("String() = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	unionMemberValueFieldName = "Value"
+)
+
+// Union is implemented by models of AWS API union types.
+//
+// A union type is an interface, such as `awstypes.Condition`, implemented by one
+// struct per member, such as `awstypes.ConditionMemberEquals`, each with a single
+// `Value` field. The model has one field per member, named as the member
+// (`Equals`), and at most one of these fields may be non-null.
+// AutoFlex expands the non-null field into the matching member's `Value` and
+// flattens a member's `Value` into the matching field, setting all others to null.
+type Union interface {
+	// UnionMembers returns a value of each member type of the union,
+	// for example `[]any{&awstypes.ConditionMemberEquals{}, &awstypes.ConditionMemberIn{}}`.
+	UnionMembers() []any
+}
+
+// unionMemberName returns the name of a union member type, e.g. "Equals" for `ConditionMemberEquals`.
+func unionMemberName(tUnion, tMember reflect.Type) string {
+	name := tMember.Name()
+
+	if v, ok := strings.CutPrefix(name, tUnion.Name()+"Member"); ok {
+		return v
+	}
+	if _, v, ok := strings.Cut(name, "Member"); ok {
+		return v
+	}
+
+	return name
+}
+
+// unionMemberStructType returns the struct type of a union member value.
+func unionMemberStructType(t reflect.Type) (reflect.Type, bool) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, false
+	}
+	if _, ok := t.FieldByName(unionMemberValueFieldName); !ok {
+		return nil, false
+	}
+
+	return t, true
+}
+
+// expandUnion expands a union model into a union member that implements the target interface.
+func expandUnion(ctx context.Context, sourcePath path.Path, from Union, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tUnion := valTo.Type()
+	typeFrom := valFrom.Type()
+	var setFieldName string
+
+	for _, member := range from.UnionMembers() {
+		tMember := reflect.TypeOf(member)
+		tStruct, ok := unionMemberStructType(tMember)
+		if !ok {
+			diags.Append(diagExpandingInvalidUnionMember(tMember, tUnion))
+			return diags
+		}
+
+		memberName := unionMemberName(tUnion, tStruct)
+		fromField, ok := (&fuzzyFieldFinder{}).findField(ctx, memberName, tStruct, typeFrom, flexer)
+		if !ok {
+			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field for union member", map[string]any{
+				"union_member": memberName,
+			})
+			continue
+		}
+
+		fromFieldVal := valFrom.FieldByIndex(fromField.Index)
+		if v, ok := fromFieldVal.Interface().(attr.Value); !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		if setFieldName != "" {
+			tflog.SubsystemError(ctx, subsystemName, "More than one union member is set", map[string]any{
+				logAttrKeySourceFieldname: fromField.Name,
+			})
+			diags.Append(diagExpandingMultipleUnionMembers(typeFrom, setFieldName, fromField.Name))
+			return diags
+		}
+		setFieldName = fromField.Name
+
+		tflog.SubsystemTrace(ctx, subsystemName, "Expanding union member", map[string]any{
+			logAttrKeySourceFieldname: fromField.Name,
+			"union_member":            memberName,
+		})
+
+		to := reflect.New(tStruct)
+		diags.Append(flexer.convert(ctx, sourcePath.AtName(fromField.Name), fromFieldVal, targetPath.AtName(memberName), to.Elem().FieldByName(unionMemberValueFieldName), fieldOpts{})...)
+		if diags.HasError() {
+			return diags
+		}
+
+		switch {
+		case to.Type().Implements(tUnion):
+			valTo.Set(to)
+		case tStruct.Implements(tUnion):
+			valTo.Set(to.Elem())
+		default:
+			diags.Append(diagExpandedTypeDoesNotImplement(to.Type(), tUnion))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// flattenUnion flattens a union member into the matching field of a union model.
+func flattenUnion(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, to any, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	valTo := reflect.ValueOf(to).Elem()
+	typeTo := valTo.Type()
+
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	tUnion := vFrom.Type()
+	vMember := vFrom.Elem()
+	tStruct, ok := unionMemberStructType(vMember.Type())
+	if !ok {
+		tflog.SubsystemError(ctx, subsystemName, "Source is not a union member")
+		diags.Append(DiagFlatteningIncompatibleTypes(vMember.Type(), typeTo))
+		return diags
+	}
+	if vMember.Kind() == reflect.Pointer {
+		if vMember.IsNil() {
+			tflog.SubsystemTrace(ctx, subsystemName, "Flattening null union member")
+			return diags
+		}
+		vMember = vMember.Elem()
+	}
+
+	memberName := unionMemberName(tUnion, tStruct)
+	toField, ok := (&fuzzyFieldFinder{}).findField(ctx, memberName, tStruct, typeTo, flexer)
+	if !ok {
+		// For example, an UnknownUnionMember returned by a newer API version.
+		tflog.SubsystemError(ctx, subsystemName, "No corresponding field for union member", map[string]any{
+			"union_member": memberName,
+		})
+		diags.Append(DiagFlatteningIncompatibleTypes(vMember.Type(), typeTo))
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Flattening union member", map[string]any{
+		logAttrKeyTargetFieldname: toField.Name,
+		"union_member":            memberName,
+	})
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(memberName), vMember.FieldByName(unionMemberValueFieldName), targetPath.AtName(toField.Name), valTo.FieldByIndex(toField.Index), fieldOpts{})...)
+
+	return diags
+}
+
+func diagExpandingInvalidUnionMember(memberType, unionType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Type %q is not a member of union %q.", fullTypeName(memberType), fullTypeName(unionType)),
+	)
+}
+
+func diagExpandingMultipleUnionMembers(sourceType reflect.Type, fieldName1, fieldName2 string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Combination",
+		"At most one of the union members may be set.\n\n"+
+			fmt.Sprintf("Both %q and %q are set in %q.", fieldName1, fieldName2, fullTypeName(sourceType)),
+	)
+}
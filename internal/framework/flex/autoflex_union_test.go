@@ -0,0 +1,259 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+// Tests AutoFlex's Expand/Flatten of Smithy unions and documents.
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfsmithy "github.com/hashicorp/terraform-provider-aws/internal/smithy"
+)
+
+type awsUnion interface {
+	isAWSUnion()
+}
+
+type awsUnionMemberString struct {
+	Value string
+}
+
+func (*awsUnionMemberString) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionMemberObject struct {
+	Value awsSingleStringValue
+}
+
+func (*awsUnionMemberObject) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionMemberUnknown struct {
+	Value []byte
+}
+
+func (*awsUnionMemberUnknown) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionField struct {
+	Field1 awsUnion
+}
+
+type tfUnion struct {
+	String types.String                                         `tfsdk:"string"`
+	Object fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"object"`
+}
+
+var _ Union = tfUnion{}
+
+func (tfUnion) UnionMembers() []any {
+	return []any{
+		&awsUnionMemberString{},
+		&awsUnionMemberObject{},
+	}
+}
+
+type tfUnionField struct {
+	Field1 fwtypes.ListNestedObjectValueOf[tfUnion] `tfsdk:"field1"`
+}
+
+type tfDocumentField struct {
+	Field1 fwtypes.SmithyDocument[tfsmithy.JSONStringer] `tfsdk:"field1"`
+}
+
+type awsDocumentField struct {
+	Field1 tfsmithy.JSONStringer
+}
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"string member": {
+			Source: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					String: types.StringValue("a"),
+					Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				}),
+			},
+			Target: &awsUnionField{},
+			WantTarget: &awsUnionField{
+				Field1: &awsUnionMemberString{Value: "a"},
+			},
+		},
+		"object member": {
+			Source: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					String: types.StringNull(),
+					Object: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("b"),
+					}),
+				}),
+			},
+			Target: &awsUnionField{},
+			WantTarget: &awsUnionField{
+				Field1: &awsUnionMemberObject{Value: awsSingleStringValue{Field1: "b"}},
+			},
+		},
+		"no member": {
+			Source: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					String: types.StringNull(),
+					Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				}),
+			},
+			Target:     &awsUnionField{},
+			WantTarget: &awsUnionField{},
+		},
+		"multiple members": {
+			Source: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					String: types.StringValue("a"),
+					Object: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("b"),
+					}),
+				}),
+			},
+			Target: &awsUnionField{},
+			ExpectedDiags: diag.Diagnostics{
+				diagExpandingMultipleUnionMembers(reflect.TypeFor[tfUnion](), "String", "Object"),
+			},
+		},
+	}
+	runAutoExpandTestCases(t, testCases, runChecks{CompareDiags: true, CompareTarget: true})
+}
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"string member": {
+			Source: &awsUnionField{
+				Field1: &awsUnionMemberString{Value: "a"},
+			},
+			Target: &tfUnionField{},
+			WantTarget: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					String: types.StringValue("a"),
+					Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				}),
+			},
+		},
+		"object member": {
+			Source: &awsUnionField{
+				Field1: &awsUnionMemberObject{Value: awsSingleStringValue{Field1: "b"}},
+			},
+			Target: &tfUnionField{},
+			WantTarget: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					String: types.StringNull(),
+					Object: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("b"),
+					}),
+				}),
+			},
+		},
+		"nil": {
+			Source: &awsUnionField{},
+			Target: &tfUnionField{},
+			WantTarget: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+		},
+		"unknown member": {
+			Source: &awsUnionField{
+				Field1: &awsUnionMemberUnknown{Value: []byte("c")},
+			},
+			Target: &tfUnionField{},
+			ExpectedDiags: diag.Diagnostics{
+				DiagFlatteningIncompatibleTypes(reflect.TypeFor[awsUnionMemberUnknown](), reflect.TypeFor[tfUnion]()),
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases, runChecks{CompareDiags: true, CompareTarget: true})
+}
+
+func TestExpandSmithyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"object": {
+			Source: &tfDocumentField{
+				Field1: fwtypes.NewSmithyDocumentValue(types.ObjectValueMust(
+					map[string]attr.Type{
+						"name":  types.StringType,
+						"count": types.NumberType,
+					},
+					map[string]attr.Value{
+						"name":  types.StringValue("a"),
+						"count": types.NumberValue(big.NewFloat(1)),
+					},
+				), newTestJSONDocument),
+			},
+			Target: &awsDocumentField{},
+			WantTarget: &awsDocumentField{
+				Field1: &testJSONDocument{
+					Value: map[string]any{
+						"name":  "a",
+						"count": int64(1),
+					},
+				},
+			},
+		},
+		"null": {
+			Source: &tfDocumentField{
+				Field1: fwtypes.NewSmithyDocumentNull[tfsmithy.JSONStringer](),
+			},
+			Target:     &awsDocumentField{},
+			WantTarget: &awsDocumentField{},
+		},
+	}
+	runAutoExpandTestCases(t, testCases, runChecks{CompareDiags: true, CompareTarget: true})
+}
+
+func TestFlattenSmithyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"object": {
+			Source: &awsDocumentField{
+				Field1: &testJSONDocument{
+					Value: map[string]any{
+						"name": "a",
+						"tags": []any{"b", true},
+					},
+				},
+			},
+			Target: &tfDocumentField{},
+			WantTarget: &tfDocumentField{
+				Field1: fwtypes.NewSmithyDocumentValue[tfsmithy.JSONStringer](types.ObjectValueMust(
+					map[string]attr.Type{
+						"name": types.StringType,
+						"tags": types.TupleType{ElemTypes: []attr.Type{types.StringType, types.BoolType}},
+					},
+					map[string]attr.Value{
+						"name": types.StringValue("a"),
+						"tags": types.TupleValueMust([]attr.Type{types.StringType, types.BoolType}, []attr.Value{types.StringValue("b"), types.BoolValue(true)}),
+					},
+				), nil),
+			},
+		},
+		"nil": {
+			Source: &awsDocumentField{},
+			Target: &tfDocumentField{},
+			WantTarget: &tfDocumentField{
+				Field1: fwtypes.NewSmithyDocumentNull[tfsmithy.JSONStringer](),
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases, runChecks{CompareDiags: true, CompareTarget: true})
+}
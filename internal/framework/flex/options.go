@@ -27,6 +27,13 @@ type AutoFlexOptions struct {
 	// ignoredFieldNames stores names which expanders and flatteners will
 	// not read from or write to
 	ignoredFieldNames []string
+
+	// converters stores user-defined converters which take precedence
+	// over the built-in conversions
+	converters converterRegistry
+
+	// trace receives a field-level report of the expand/flatten operation
+	trace *TraceReport
 }
 
 // WithFieldNamePrefix specifies a prefix to be accounted for when
@@ -77,6 +84,46 @@ func WithNoIgnoredFieldNames() AutoFlexOptionsFunc {
 	}
 }
 
+// WithConverter registers a converter from values of type F to values of type T
+//
+// Use this option for AWS data structures with shapes that AutoFlex does not
+// handle. The converter is used for every source value of exactly type F whose
+// target is of type T, in place of the built-in conversions.
+func WithConverter[F, T any](fn ConverterFunc[F, T]) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		c := newConverter(fn)
+		if o.converters.byType == nil {
+			o.converters.byType = make(map[converterKey]converter)
+		}
+		o.converters.byType[converterKey{sourceType: c.sourceType, targetType: c.targetType}] = c
+	}
+}
+
+// WithFieldConverter registers a converter for the source field at fieldPath
+//
+// fieldPath is the names of the source struct fields separated by '.', for
+// example "Rules.Action"; a field path matches every element of a collection.
+// A field converter takes precedence over a converter registered by type.
+func WithFieldConverter[F, T any](fieldPath string, fn ConverterFunc[F, T]) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		if o.converters.byPath == nil {
+			o.converters.byPath = make(map[string]converter)
+		}
+		o.converters.byPath[fieldPath] = newConverter(fn)
+	}
+}
+
+// WithTraceReport records a field-level report of every field mapping, skip
+// and type mismatch in r
+//
+// Use this option while developing a resource to find out why a field is not
+// populated.
+func WithTraceReport(r *TraceReport) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.trace = r
+	}
+}
+
 // isIgnoredField returns true if s is in the list of ignored field names
 func (o *AutoFlexOptions) isIgnoredField(s string) bool {
 	return slices.Contains(o.ignoredFieldNames, s)
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package listplanmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// UseStateForSemanticEquality returns a plan modifier that plans the prior state value of
+// an attribute whose custom type implements semantic equality when the configured value is
+// semantically equal to the prior state value, so that no difference is shown.
+// Semantic equality is otherwise only applied to the values returned by the provider.
+func UseStateForSemanticEquality() planmodifier.List {
+	return useStateForSemanticEqualityModifier{}
+}
+
+type useStateForSemanticEqualityModifier struct{}
+
+func (m useStateForSemanticEqualityModifier) Description(_ context.Context) string {
+	return ""
+}
+
+func (m useStateForSemanticEqualityModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useStateForSemanticEqualityModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	// Do nothing if there is no state or the value is not configured.
+	if req.StateValue.IsNull() || req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// Exit if another planmodifier has set the value or there is no difference.
+	if !req.PlanValue.Equal(req.ConfigValue) || req.PlanValue.Equal(req.StateValue) {
+		return
+	}
+
+	t, diags := req.Plan.Schema.TypeAtPath(ctx, req.Path)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listType, ok := t.(basetypes.ListTypable)
+	if !ok {
+		return
+	}
+
+	stateValue, diags := listType.ValueFromList(ctx, req.StateValue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planValue, diags := listType.ValueFromList(ctx, req.PlanValue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	v, ok := stateValue.(basetypes.ListValuableWithSemanticEquals)
+	if !ok {
+		return
+	}
+
+	equal, diags := v.ListSemanticEquals(ctx, planValue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if equal {
+		resp.PlanValue = req.StateValue
+	}
+}
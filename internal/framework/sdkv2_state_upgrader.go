@@ -0,0 +1,258 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SDKv2StateUpgradeFunc is the signature of a Plugin SDK v2 schema.StateUpgrader's Upgrade function.
+type SDKv2StateUpgradeFunc func(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error)
+
+// NewSDKv2StateUpgrader returns a state upgrader for state written by the Plugin SDK v2 implementation of a resource.
+// The raw state is passed through upgraders, in order, and is then normalized to the Plugin Framework schema s:
+// attributes not present in s are dropped and the zero values that the Plugin SDK v2 stores for unset Optional, non-Computed
+// attributes are replaced with null, so that migrating a resource to the Plugin Framework does not produce a plan difference.
+// State in the legacy flatmap format, which only Terraform v0.11 and earlier wrote, is first expanded into raw state whose
+// primitive values are strings, as the Plugin SDK v2 MigrateState functions saw them, and converted to the types in s by the normalization.
+func NewSDKv2StateUpgrader(s schema.Schema, meta any, upgraders ...SDKv2StateUpgradeFunc) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+			var rawState map[string]any
+
+			switch {
+			case request.RawState != nil && request.RawState.JSON != nil:
+				if err := json.Unmarshal(request.RawState.JSON, &rawState); err != nil {
+					response.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("reading Plugin SDK v2 state: %s", err))
+					return
+				}
+			case request.RawState != nil && request.RawState.Flatmap != nil:
+				rawState = expandSDKv2Flatmap(request.RawState.Flatmap, "")
+			default:
+				response.Diagnostics.AddError("Unable to Upgrade Resource State", "Plugin SDK v2 state is not available")
+				return
+			}
+
+			for _, f := range upgraders {
+				var err error
+				rawState, err = f(ctx, rawState, meta)
+
+				if err != nil {
+					response.Diagnostics.AddError("Unable to Upgrade Resource State", err.Error())
+					return
+				}
+			}
+
+			normalizeSDKv2State(ctx, s.Attributes, s.Blocks, rawState)
+
+			v, err := json.Marshal(rawState)
+			if err != nil {
+				response.Diagnostics.AddError("Unable to Upgrade Resource State", err.Error())
+				return
+			}
+
+			response.DynamicValue = &tfprotov6.DynamicValue{JSON: v}
+		},
+	}
+}
+
+// normalizeSDKv2State normalizes, in place, the raw Plugin SDK v2 state of a (nested) object to the specified Plugin Framework attributes and blocks.
+func normalizeSDKv2State(ctx context.Context, attributes map[string]schema.Attribute, blocks map[string]schema.Block, state map[string]any) {
+	for k := range state {
+		_, isAttribute := attributes[k]
+		_, isBlock := blocks[k]
+
+		if !isAttribute && !isBlock {
+			delete(state, k)
+		}
+	}
+
+	for k, attribute := range attributes {
+		v, ok := state[k]
+		if !ok {
+			continue
+		}
+
+		v = normalizeSDKv2Value(attribute.GetType().TerraformType(ctx), v)
+		if attribute.IsOptional() && !attribute.IsComputed() && isSDKv2ZeroValue(v) {
+			v = nil
+		}
+		state[k] = v
+	}
+
+	for k, block := range blocks {
+		var nestedObject schema.NestedBlockObject
+
+		switch block := block.(type) {
+		case schema.ListNestedBlock:
+			nestedObject = block.NestedObject
+		case schema.SetNestedBlock:
+			nestedObject = block.NestedObject
+		case schema.SingleNestedBlock:
+			nestedObject = schema.NestedBlockObject{Attributes: block.Attributes, Blocks: block.Blocks}
+		default:
+			continue
+		}
+
+		switch v := state[k].(type) {
+		case []any:
+			for _, v := range v {
+				if v, ok := v.(map[string]any); ok {
+					normalizeSDKv2State(ctx, nestedObject.Attributes, nestedObject.Blocks, v)
+				}
+			}
+		case map[string]any:
+			normalizeSDKv2State(ctx, nestedObject.Attributes, nestedObject.Blocks, v)
+		}
+	}
+}
+
+// isSDKv2ZeroValue returns whether the specified JSON value is the zero value stored by the Plugin SDK v2 for an unset attribute.
+func isSDKv2ZeroValue(v any) bool {
+	switch v := v.(type) {
+	case string:
+		return v == ""
+	case float64:
+		return v == 0
+	case json.Number:
+		f, err := v.Float64()
+		return err == nil && f == 0
+	case bool:
+		return !v
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+
+	return false
+}
+
+// expandSDKv2Flatmap expands the legacy flatmap state of a (nested) object, whose keys have the specified prefix, into raw state.
+// Lists and sets are expanded from their "#" count entries and maps from their "%" count entries. Primitive values are left as strings.
+func expandSDKv2Flatmap(flatmap map[string]string, prefix string) map[string]any {
+	state := make(map[string]any)
+
+	for k := range flatmap {
+		k, ok := strings.CutPrefix(k, prefix)
+		if !ok {
+			continue
+		}
+
+		name, _, _ := strings.Cut(k, ".")
+		if _, ok := state[name]; !ok {
+			state[name] = expandSDKv2FlatmapValue(flatmap, prefix+name)
+		}
+	}
+
+	return state
+}
+
+// expandSDKv2FlatmapValue expands the legacy flatmap state of the value with the specified key into raw state.
+func expandSDKv2FlatmapValue(flatmap map[string]string, key string) any {
+	if _, ok := flatmap[key+".#"]; ok {
+		var indices []string
+		for k := range flatmap {
+			if k, ok := strings.CutPrefix(k, key+"."); ok {
+				if index, _, _ := strings.Cut(k, "."); index != "#" && !slices.Contains(indices, index) {
+					indices = append(indices, index)
+				}
+			}
+		}
+
+		// List indices are sequential and set indices are hash codes, so sorting numerically preserves list order.
+		slices.SortFunc(indices, func(a, b string) int {
+			x, errX := strconv.Atoi(a)
+			y, errY := strconv.Atoi(b)
+			if errX != nil || errY != nil {
+				return strings.Compare(a, b)
+			}
+			return cmp.Compare(x, y)
+		})
+
+		elements := make([]any, 0, len(indices))
+		for _, index := range indices {
+			elements = append(elements, expandSDKv2FlatmapValue(flatmap, key+"."+index))
+		}
+
+		return elements
+	}
+
+	if _, ok := flatmap[key+".%"]; ok {
+		elements := make(map[string]any)
+		for k, v := range flatmap {
+			if k, ok := strings.CutPrefix(k, key+"."); ok && k != "%" {
+				elements[k] = v
+			}
+		}
+
+		return elements
+	}
+
+	if v, ok := flatmap[key]; ok {
+		return v
+	}
+
+	return expandSDKv2Flatmap(flatmap, key+".")
+}
+
+// normalizeSDKv2Value normalizes the raw Plugin SDK v2 state of an attribute value to the specified type.
+// The string primitive values of state expanded from legacy flatmap state are converted and object attributes not present in the type are dropped.
+func normalizeSDKv2Value(t tftypes.Type, v any) any {
+	switch v := v.(type) {
+	case string:
+		switch {
+		case t.Is(tftypes.Bool):
+			if b, err := strconv.ParseBool(v); err == nil {
+				return b
+			}
+		case t.Is(tftypes.Number):
+			if _, err := strconv.ParseFloat(v, 64); err == nil {
+				return json.Number(v)
+			}
+		}
+	case []any:
+		var elementType tftypes.Type
+		switch t := t.(type) {
+		case tftypes.List:
+			elementType = t.ElementType
+		case tftypes.Set:
+			elementType = t.ElementType
+		default:
+			return v
+		}
+
+		for i, e := range v {
+			v[i] = normalizeSDKv2Value(elementType, e)
+		}
+	case map[string]any:
+		switch t := t.(type) {
+		case tftypes.Map:
+			for k, e := range v {
+				v[k] = normalizeSDKv2Value(t.ElementType, e)
+			}
+		case tftypes.Object:
+			for k, e := range v {
+				if attributeType, ok := t.AttributeTypes[k]; ok {
+					v[k] = normalizeSDKv2Value(attributeType, e)
+				} else {
+					delete(v, k)
+				}
+			}
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestNewSDKv2StateUpgrader(t *testing.T) {
+	t.Parallel()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrPort: schema.Int64Attribute{
+				Optional: true,
+			},
+			names.AttrSubnetIDs: schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrRule: schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrEnabled: schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		rawState  string
+		flatmap   map[string]string
+		upgraders []SDKv2StateUpgradeFunc
+		want      map[string]any
+		wantErr   bool
+	}{
+		"zero values": {
+			rawState: `{"id":"abc","description":"","name":"","port":0,"subnet_ids":[],"rule":[{"enabled":false,"removed":"x"}],"timeouts":null}`,
+			want: map[string]any{
+				names.AttrID:          "abc",
+				names.AttrDescription: nil,
+				names.AttrName:        "",
+				names.AttrPort:        nil,
+				names.AttrSubnetIDs:   nil,
+				names.AttrRule:        []any{map[string]any{names.AttrEnabled: nil}},
+			},
+		},
+		"values": {
+			rawState: `{"id":"abc","description":"test","name":"n","port":443,"subnet_ids":["a"],"rule":[]}`,
+			want: map[string]any{
+				names.AttrID:          "abc",
+				names.AttrDescription: "test",
+				names.AttrName:        "n",
+				names.AttrPort:        float64(443),
+				names.AttrSubnetIDs:   []any{"a"},
+				names.AttrRule:        []any{},
+			},
+		},
+		"upgraders": {
+			rawState: `{"id":"abc","old_port":80}`,
+			upgraders: []SDKv2StateUpgradeFunc{
+				func(_ context.Context, rawState map[string]any, _ any) (map[string]any, error) {
+					rawState[names.AttrPort] = rawState["old_port"]
+					return rawState, nil
+				},
+				func(_ context.Context, rawState map[string]any, _ any) (map[string]any, error) {
+					rawState[names.AttrName] = "upgraded"
+					return rawState, nil
+				},
+			},
+			want: map[string]any{
+				names.AttrID:   "abc",
+				names.AttrName: "upgraded",
+				names.AttrPort: float64(80),
+			},
+		},
+		"upgrader error": {
+			rawState: `{"id":"abc"}`,
+			upgraders: []SDKv2StateUpgradeFunc{
+				func(context.Context, map[string]any, any) (map[string]any, error) {
+					return nil, errors.New("failed")
+				},
+			},
+			wantErr: true,
+		},
+		"flatmap": {
+			flatmap: map[string]string{
+				names.AttrID:          "abc",
+				names.AttrDescription: "",
+				names.AttrPort:        "443",
+				"subnet_ids.#":        "2",
+				"subnet_ids.1234":     "b",
+				"subnet_ids.99":       "a",
+				"rule.#":              "1",
+				"rule.0.enabled":      "true",
+				"rule.0.removed":      "x",
+			},
+			want: map[string]any{
+				names.AttrID:          "abc",
+				names.AttrDescription: nil,
+				names.AttrPort:        float64(443),
+				names.AttrSubnetIDs:   []any{"a", "b"},
+				names.AttrRule:        []any{map[string]any{names.AttrEnabled: true}},
+			},
+		},
+		"flatmap upgraders": {
+			flatmap: map[string]string{
+				names.AttrID: "abc",
+				"old_port":   "80",
+			},
+			upgraders: []SDKv2StateUpgradeFunc{
+				func(_ context.Context, rawState map[string]any, _ any) (map[string]any, error) {
+					rawState[names.AttrPort] = rawState["old_port"]
+					return rawState, nil
+				},
+			},
+			want: map[string]any{
+				names.AttrID:   "abc",
+				names.AttrPort: float64(80),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			upgrader := NewSDKv2StateUpgrader(s, nil, testCase.upgraders...)
+			request := resource.UpgradeStateRequest{
+				RawState: &tfprotov6.RawState{JSON: []byte(testCase.rawState)},
+			}
+			if testCase.flatmap != nil {
+				request.RawState = &tfprotov6.RawState{Flatmap: testCase.flatmap}
+			}
+			var response resource.UpgradeStateResponse
+			upgrader.StateUpgrader(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.wantErr; got != want {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+			if testCase.wantErr {
+				return
+			}
+
+			var got map[string]any
+			if err := json.Unmarshal(response.DynamicValue.JSON, &got); err != nil {
+				t.Fatalf("reading upgraded state: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.want, got); diff != "" {
+				t.Errorf("unexpected upgraded state (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
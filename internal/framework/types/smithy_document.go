@@ -0,0 +1,336 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	smithydocument "github.com/aws/smithy-go/document"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	tfsmithy "github.com/hashicorp/terraform-provider-aws/internal/smithy"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var (
+	_ basetypes.DynamicTypable = (*SmithyDocumentType[tfsmithy.JSONStringer])(nil)
+)
+
+// SmithyDocumentType is a dynamic type whose values represent Smithy documents.
+// Unlike SmithyJSONType, the document is configured as a Terraform value rather than a JSON string.
+type SmithyDocumentType[T tfsmithy.JSONStringer] struct {
+	basetypes.DynamicType
+	f func(any) T
+}
+
+func NewSmithyDocumentType[T tfsmithy.JSONStringer](_ context.Context, f func(any) T) SmithyDocumentType[T] {
+	return SmithyDocumentType[T]{
+		f: f,
+	}
+}
+
+// String returns a human readable string of the type name.
+func (t SmithyDocumentType[T]) String() string {
+	return "fwtypes.SmithyDocumentType"
+}
+
+// ValueType returns the Value type.
+func (t SmithyDocumentType[T]) ValueType(context.Context) attr.Value {
+	return SmithyDocument[T]{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t SmithyDocumentType[T]) Equal(o attr.Type) bool {
+	other, ok := o.(SmithyDocumentType[T])
+	if !ok {
+		return false
+	}
+
+	return t.DynamicType.Equal(other.DynamicType)
+}
+
+func (t SmithyDocumentType[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.DynamicType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicValue, ok := attrValue.(basetypes.DynamicValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	dynamicValuable, diags := t.ValueFromDynamic(ctx, dynamicValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting DynamicValue to DynamicValuable: %v", diags)
+	}
+
+	return dynamicValuable, nil
+}
+
+func (t SmithyDocumentType[T]) ValueFromDynamic(ctx context.Context, in basetypes.DynamicValue) (basetypes.DynamicValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return NewSmithyDocumentNull[T](), diags
+	}
+
+	if in.IsUnknown() {
+		return NewSmithyDocumentUnknown[T](), diags
+	}
+
+	return NewSmithyDocumentValue(in.UnderlyingValue(), t.f), diags
+}
+
+var (
+	_ basetypes.DynamicValuable = (*SmithyDocument[tfsmithy.JSONStringer])(nil)
+	_ SmithyDocumentValue       = (*SmithyDocument[tfsmithy.JSONStringer])(nil)
+)
+
+type SmithyDocument[T tfsmithy.JSONStringer] struct {
+	basetypes.DynamicValue
+	f func(any) T
+}
+
+func (v SmithyDocument[T]) Equal(o attr.Value) bool {
+	other, ok := o.(SmithyDocument[T])
+	if !ok {
+		return false
+	}
+
+	return v.DynamicValue.Equal(other.DynamicValue)
+}
+
+func (v SmithyDocument[T]) ToSmithyObjectDocument(ctx context.Context) (any, diag.Diagnostics) {
+	return v.ToSmithyDocument(ctx)
+}
+
+func (v SmithyDocument[T]) ToSmithyDocument(context.Context) (T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() || v.IsUnderlyingValueNull() || v.f == nil {
+		return inttypes.Zero[T](), diags
+	}
+
+	doc, err := attrValueToDocument(v.UnderlyingValue())
+	if err != nil {
+		diags.AddError(
+			"Smithy Document Conversion Error",
+			"An unexpected error occurred while converting a value to a Smithy document. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return inttypes.Zero[T](), diags
+	}
+
+	return v.f(doc), diags
+}
+
+func (v SmithyDocument[T]) Type(context.Context) attr.Type {
+	return SmithyDocumentType[T]{
+		f: v.f,
+	}
+}
+
+func (v SmithyDocument[T]) ToDynamicValue(context.Context) (basetypes.DynamicValue, diag.Diagnostics) {
+	return v.DynamicValue, nil
+}
+
+func NewSmithyDocumentValue[T tfsmithy.JSONStringer](value attr.Value, f func(any) T) SmithyDocument[T] {
+	return SmithyDocument[T]{
+		DynamicValue: basetypes.NewDynamicValue(value),
+		f:            f,
+	}
+}
+
+func NewSmithyDocumentNull[T tfsmithy.JSONStringer]() SmithyDocument[T] {
+	return SmithyDocument[T]{
+		DynamicValue: basetypes.NewDynamicNull(),
+	}
+}
+
+func NewSmithyDocumentUnknown[T tfsmithy.JSONStringer]() SmithyDocument[T] {
+	return SmithyDocument[T]{
+		DynamicValue: basetypes.NewDynamicUnknown(),
+	}
+}
+
+// DynamicValueFromSmithyDocument returns the Terraform value of a Smithy document.
+// JSON objects become object values, JSON arrays become tuple values and JSON numbers become number values.
+func DynamicValueFromSmithyDocument(ctx context.Context, document smithydocument.Marshaler) (basetypes.DynamicValue, error) {
+	s, err := tfsmithy.DocumentToJSONString(document)
+	if err != nil {
+		return basetypes.NewDynamicNull(), err
+	}
+
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return basetypes.NewDynamicNull(), err
+	}
+
+	value, err := documentToAttrValue(ctx, v)
+	if err != nil {
+		return basetypes.NewDynamicNull(), err
+	}
+
+	if value == nil {
+		return basetypes.NewDynamicNull(), nil
+	}
+
+	return basetypes.NewDynamicValue(value), nil
+}
+
+// documentToAttrValue converts a decoded JSON value to a Terraform value.
+// A JSON null returns nil.
+func documentToAttrValue(ctx context.Context, v any) (attr.Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+
+	case bool:
+		return basetypes.NewBoolValue(v), nil
+
+	case string:
+		return basetypes.NewStringValue(v), nil
+
+	case json.Number:
+		f, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, err
+		}
+		return basetypes.NewNumberValue(f), nil
+
+	case []any:
+		var (
+			elementTypes []attr.Type
+			elements     []attr.Value
+		)
+		for _, e := range v {
+			value, err := documentToAttrValue(ctx, e)
+			if err != nil {
+				return nil, err
+			}
+			if value == nil {
+				value = basetypes.NewDynamicNull()
+			}
+			elementTypes = append(elementTypes, value.Type(ctx))
+			elements = append(elements, value)
+		}
+		value, diags := basetypes.NewTupleValue(elementTypes, elements)
+		if diags.HasError() {
+			return nil, fwdiag.DiagnosticsError(diags)
+		}
+		return value, nil
+
+	case map[string]any:
+		attributeTypes := make(map[string]attr.Type, len(v))
+		attributes := make(map[string]attr.Value, len(v))
+		for k, e := range v {
+			value, err := documentToAttrValue(ctx, e)
+			if err != nil {
+				return nil, err
+			}
+			if value == nil {
+				value = basetypes.NewDynamicNull()
+			}
+			attributeTypes[k] = value.Type(ctx)
+			attributes[k] = value
+		}
+		value, diags := basetypes.NewObjectValue(attributeTypes, attributes)
+		if diags.HasError() {
+			return nil, fwdiag.DiagnosticsError(diags)
+		}
+		return value, nil
+	}
+
+	return nil, fmt.Errorf("unsupported document value type %T", v)
+}
+
+// attrValueToDocument converts a Terraform value to a value that can be marshalled as a Smithy document.
+func attrValueToDocument(v attr.Value) (any, error) {
+	if v == nil || v.IsNull() {
+		return nil, nil
+	}
+
+	if v.IsUnknown() {
+		return nil, errors.New("value is unknown")
+	}
+
+	switch v := v.(type) {
+	case basetypes.DynamicValue:
+		return attrValueToDocument(v.UnderlyingValue())
+
+	case basetypes.BoolValue:
+		return v.ValueBool(), nil
+
+	case basetypes.StringValue:
+		return v.ValueString(), nil
+
+	case basetypes.NumberValue:
+		f := v.ValueBigFloat()
+		if f.IsInt() {
+			if i, accuracy := f.Int64(); accuracy == big.Exact {
+				return i, nil
+			}
+		}
+		f64, _ := f.Float64()
+		return f64, nil
+
+	case basetypes.ListValue:
+		return attrValuesToDocument(v.Elements())
+
+	case basetypes.SetValue:
+		return attrValuesToDocument(v.Elements())
+
+	case basetypes.TupleValue:
+		return attrValuesToDocument(v.Elements())
+
+	case basetypes.MapValue:
+		return attrValueMapToDocument(v.Elements())
+
+	case basetypes.ObjectValue:
+		return attrValueMapToDocument(v.Attributes())
+	}
+
+	return nil, fmt.Errorf("unsupported value type %T", v)
+}
+
+func attrValuesToDocument(elements []attr.Value) ([]any, error) {
+	out := make([]any, 0, len(elements))
+
+	for _, e := range elements {
+		v, err := attrValueToDocument(e)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+
+	return out, nil
+}
+
+func attrValueMapToDocument(elements map[string]attr.Value) (map[string]any, error) {
+	out := make(map[string]any, len(elements))
+
+	for k, e := range elements {
+		v, err := attrValueToDocument(e)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		out[k] = v
+	}
+
+	return out, nil
+}
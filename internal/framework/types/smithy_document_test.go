@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfsmithy "github.com/hashicorp/terraform-provider-aws/internal/smithy"
+)
+
+func TestSmithyDocumentTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val      tftypes.Value
+		expected attr.Value
+	}{
+		"null value": {
+			val:      tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+			expected: fwtypes.NewSmithyDocumentNull[tfsmithy.JSONStringer](),
+		},
+		"unknown value": {
+			val:      tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue),
+			expected: fwtypes.NewSmithyDocumentUnknown[tfsmithy.JSONStringer](),
+		},
+		"string value": {
+			val:      tftypes.NewValue(tftypes.String, "value"),
+			expected: fwtypes.NewSmithyDocumentValue[tfsmithy.JSONStringer](types.StringValue("value"), nil),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			val, err := fwtypes.SmithyDocumentType[tfsmithy.JSONStringer]{}.ValueFromTerraform(ctx, test.val)
+
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if got, want := val, test.expected; !got.Equal(want) {
+				t.Errorf("got %T %v, want %T %v", got, got, want, want)
+			}
+		})
+	}
+}
+
+func TestSmithyDocumentValueInterface(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		val         fwtypes.SmithyDocument[tfsmithy.JSONStringer]
+		expected    tfsmithy.JSONStringer
+		expectError bool
+	}{
+		"null value": {
+			val: fwtypes.NewSmithyDocumentNull[tfsmithy.JSONStringer](),
+		},
+		"unknown value": {
+			val: fwtypes.NewSmithyDocumentUnknown[tfsmithy.JSONStringer](),
+		},
+		"object": {
+			val: fwtypes.NewSmithyDocumentValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"name":    types.StringType,
+					"count":   types.NumberType,
+					"ratio":   types.NumberType,
+					"enabled": types.BoolType,
+					"tags":    types.TupleType{ElemTypes: []attr.Type{types.StringType}},
+				},
+				map[string]attr.Value{
+					"name":    types.StringValue("value"),
+					"count":   types.NumberValue(big.NewFloat(42)),
+					"ratio":   types.NumberValue(big.NewFloat(0.5)),
+					"enabled": types.BoolValue(true),
+					"tags":    types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("a")}),
+				},
+			), newTestJSONDocument),
+			expected: &testJSONDocument{
+				Value: map[string]any{
+					"name":    "value",
+					"count":   int64(42),
+					"ratio":   0.5,
+					"enabled": true,
+					"tags":    []any{"a"},
+				},
+			},
+		},
+		"unknown element": {
+			val:         fwtypes.NewSmithyDocumentValue(types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown()}), newTestJSONDocument),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := test.val.ToSmithyDocument(ctx)
+			gotErr := err.HasError()
+
+			if gotErr != test.expectError {
+				t.Errorf("gotErr = %v, wantErr = %v", gotErr, test.expectError)
+			}
+
+			if !gotErr {
+				if diff := cmp.Diff(s, test.expected); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestDynamicValueFromSmithyDocument(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		document tfsmithy.JSONStringer
+		expected types.Dynamic
+	}{
+		"null": {
+			document: &testJSONDocument{},
+			expected: types.DynamicNull(),
+		},
+		"object": {
+			document: &testJSONDocument{
+				Value: map[string]any{
+					"name":  "value",
+					"count": 42,
+					"tags":  []any{"a", true},
+				},
+			},
+			expected: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"name":  types.StringType,
+					"count": types.NumberType,
+					"tags":  types.TupleType{ElemTypes: []attr.Type{types.StringType, types.BoolType}},
+				},
+				map[string]attr.Value{
+					"name":  types.StringValue("value"),
+					"count": types.NumberValue(big.NewFloat(42)),
+					"tags":  types.TupleValueMust([]attr.Type{types.StringType, types.BoolType}, []attr.Value{types.StringValue("a"), types.BoolValue(true)}),
+				},
+			)),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fwtypes.DynamicValueFromSmithyDocument(ctx, test.document)
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if !got.Equal(test.expected) {
+				t.Errorf("got %v, want %v", got, test.expected)
+			}
+		})
+	}
+}
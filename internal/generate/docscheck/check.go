@@ -0,0 +1,281 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+type findingKind string
+
+const (
+	findingMissing  findingKind = "missing"
+	findingExtra    findingKind = "extra"
+	findingRequired findingKind = "required"
+	findingDefault  findingKind = "default"
+)
+
+// finding is an inconsistency between a schema and its documentation.
+type finding struct {
+	Kind    findingKind
+	File    string
+	Line    int
+	Path    string // Attribute or block name, qualified by its documentation section.
+	Message string
+}
+
+func (f finding) String() string {
+	return fmt.Sprintf("%s:%d: %s: %s: %s", f.File, f.Line, f.Kind, f.Path, f.Message)
+}
+
+// ignoredNames are implementation details that need not be documented.
+var ignoredNames = []string{
+	"id",       // Implicit Plugin SDK v2 attribute.
+	"timeouts", // Documented in the Timeouts section.
+}
+
+// checkDocument compares a resource's or data source's schema with its documentation.
+func checkDocument(file string, s *schemaBlock, doc *document) []finding {
+	var findings []finding
+
+	documented := make(map[string]bool)
+	for _, section := range slices.Concat(doc.Arguments, doc.Attributes) {
+		if section.Name != "" {
+			continue
+		}
+		for _, arg := range section.Arguments {
+			documented[arg.Name] = true
+		}
+	}
+
+	for _, section := range mergeSections(doc.Arguments) {
+		block, ok := sectionBlock(s, section)
+		if !ok {
+			continue
+		}
+		findings = append(findings, checkArguments(file, section, block)...)
+	}
+
+	for _, section := range doc.Attributes {
+		block, ok := sectionBlock(s, section)
+		if !ok {
+			continue
+		}
+		for _, arg := range section.Arguments {
+			if !block.has(arg.Name) {
+				findings = append(findings, finding{
+					Kind:    findingExtra,
+					File:    file,
+					Line:    arg.Line,
+					Path:    qualifiedName(section, arg.Name),
+					Message: "documented attribute is not in the schema",
+				})
+			}
+		}
+	}
+
+	// Computed-only top-level attributes may be documented as arguments or attributes.
+	line := 0
+	if len(doc.Attributes) > 0 {
+		line = doc.Attributes[0].Line
+	}
+	for _, name := range slices.Sorted(maps.Keys(s.Attributes)) {
+		attr := s.Attributes[name]
+		if attr.isArgument() || attr.Deprecated || documented[name] || slices.Contains(ignoredNames, name) {
+			continue
+		}
+		findings = append(findings, finding{
+			Kind:    findingMissing,
+			File:    file,
+			Line:    line,
+			Path:    name,
+			Message: "attribute is not documented",
+		})
+	}
+
+	return findings
+}
+
+// checkArguments compares a block's arguments with a documentation section.
+func checkArguments(file string, section *docSection, block *schemaBlock) []finding {
+	var findings []finding
+
+	documented := make(map[string]*docArgument)
+	for _, arg := range section.Arguments {
+		documented[arg.Name] = arg
+
+		path := qualifiedName(section, arg.Name)
+		required, optional, def, ok := block.argument(arg.Name)
+		if !ok {
+			if !block.has(arg.Name) {
+				findings = append(findings, finding{
+					Kind:    findingExtra,
+					File:    file,
+					Line:    arg.Line,
+					Path:    path,
+					Message: "documented argument is not in the schema",
+				})
+			}
+			continue
+		}
+
+		switch {
+		case arg.Required && !required:
+			findings = append(findings, finding{
+				Kind:    findingRequired,
+				File:    file,
+				Line:    arg.Line,
+				Path:    path,
+				Message: "documented as Required but is Optional in the schema",
+			})
+		case arg.Optional && required:
+			findings = append(findings, finding{
+				Kind:    findingRequired,
+				File:    file,
+				Line:    arg.Line,
+				Path:    path,
+				Message: "documented as Optional but is Required in the schema",
+			})
+		case !arg.Required && !arg.Optional && (required || optional):
+			findings = append(findings, finding{
+				Kind:    findingRequired,
+				File:    file,
+				Line:    arg.Line,
+				Path:    path,
+				Message: "not documented as Required or Optional",
+			})
+		}
+
+		switch {
+		case def != nil && arg.Default != nil && *def != *arg.Default:
+			findings = append(findings, finding{
+				Kind:    findingDefault,
+				File:    file,
+				Line:    arg.Line,
+				Path:    path,
+				Message: fmt.Sprintf("documented default %q differs from schema default %q", *arg.Default, *def),
+			})
+		case def != nil && arg.Default == nil && !strings.Contains(strings.ToLower(arg.Description), "default"):
+			findings = append(findings, finding{
+				Kind:    findingDefault,
+				File:    file,
+				Line:    arg.Line,
+				Path:    path,
+				Message: fmt.Sprintf("schema default %q is not documented", *def),
+			})
+		}
+	}
+
+	for _, name := range block.argumentNames() {
+		if _, ok := documented[name]; ok || slices.Contains(ignoredNames, name) {
+			continue
+		}
+		findings = append(findings, finding{
+			Kind:    findingMissing,
+			File:    file,
+			Line:    section.Line,
+			Path:    qualifiedName(section, name),
+			Message: "argument is not documented",
+		})
+	}
+
+	return findings
+}
+
+// mergeSections merges sections with the same name, such as a block documented both by indented list items and by a heading.
+func mergeSections(sections []*docSection) []*docSection {
+	var merged []*docSection
+	byName := make(map[string]*docSection)
+
+	for _, section := range sections {
+		if v, ok := byName[section.Name]; ok {
+			v.Arguments = append(v.Arguments, section.Arguments...)
+			continue
+		}
+		v := &docSection{
+			Name:      section.Name,
+			Line:      section.Line,
+			Arguments: slices.Clone(section.Arguments),
+		}
+		byName[section.Name] = v
+		merged = append(merged, v)
+	}
+
+	return merged
+}
+
+// sectionBlock returns the schema block documented by a section.
+// A nested section is only checked if its name identifies exactly one block.
+func sectionBlock(s *schemaBlock, section *docSection) (*schemaBlock, bool) {
+	if section.Name == "" {
+		return s, true
+	}
+
+	if blocks := s.nestedBlocks(section.Name); len(blocks) == 1 {
+		return blocks[0], true
+	}
+
+	return nil, false
+}
+
+// argument returns whether the named attribute or block is a required or optional argument, and its default.
+func (b *schemaBlock) argument(name string) (required, optional bool, def *string, ok bool) {
+	if v, ok := b.Attributes[name]; ok {
+		if v.Deprecated || !v.isArgument() {
+			return false, false, nil, false
+		}
+		return v.Required, v.Optional, v.Default, true
+	}
+
+	if v, ok := b.Blocks[name]; ok {
+		if v.Deprecated {
+			return false, false, nil, false
+		}
+		return v.MinItems > 0, v.MinItems == 0, nil, true
+	}
+
+	return false, false, nil, false
+}
+
+// argumentNames returns the sorted names of the block's non-deprecated arguments.
+func (b *schemaBlock) argumentNames() []string {
+	var names []string
+
+	for name := range b.Attributes {
+		if _, _, _, ok := b.argument(name); ok {
+			names = append(names, name)
+		}
+	}
+	for name := range b.Blocks {
+		if _, _, _, ok := b.argument(name); ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// has returns whether the block has the named attribute or block.
+func (b *schemaBlock) has(name string) bool {
+	_, ok := b.Attributes[name]
+	if !ok {
+		_, ok = b.Blocks[name]
+	}
+
+	return ok
+}
+
+func qualifiedName(section *docSection, name string) string {
+	if section.Name == "" {
+		return name
+	}
+
+	return section.Name + "." + name
+}
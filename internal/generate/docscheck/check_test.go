@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testDocument = `---
+subcategory: "Example"
+---
+
+# Resource: aws_example
+
+## Example Usage
+
+` + "```terraform" + `
+resource "aws_example" "test" {
+  name = "test"
+}
+` + "```" + `
+
+## Argument Reference
+
+The following arguments are required:
+
+* ` + "`name`" + ` - (Required) Name.
+
+The following arguments are optional:
+
+* ` + "`enabled`" + ` - (Optional) Whether enabled. Defaults to ` + "`false`" + `.
+* ` + "`port`" + ` - (Required) Port. Defaults to ` + "`80`" + `.
+* ` + "`legacy`" + ` - (Optional) Not in the schema.
+* ` + "`rule`" + ` - (Optional) Rules. See below.
+    * ` + "`action`" + ` - (Required) Action.
+
+### ` + "`rule`" + ` Block
+
+* ` + "`priority`" + ` - (Optional) Priority.
+
+## Attribute Reference
+
+This resource exports the following attributes in addition to the arguments above:
+
+* ` + "`arn`" + ` - ARN.
+
+## Timeouts
+
+* ` + "`create`" + ` - (Default ` + "`60m`" + `)
+`
+
+func testSchema() *schemaBlock {
+	def80, defTrue, defAllow := "80", "true", "allow"
+
+	return &schemaBlock{
+		Attributes: map[string]*schemaAttribute{
+			"arn":      {Computed: true},
+			"enabled":  {Optional: true, Default: &defTrue},
+			"id":       {Optional: true, Computed: true},
+			"name":     {Required: true},
+			"port":     {Optional: true, Default: &def80},
+			"status":   {Computed: true},
+			"tags_all": {Computed: true, Deprecated: true},
+		},
+		Blocks: map[string]*schemaNestedBlock{
+			"rule": {
+				Block: &schemaBlock{
+					Attributes: map[string]*schemaAttribute{
+						"action":   {Required: true},
+						"effect":   {Optional: true, Default: &defAllow},
+						"priority": {Optional: true},
+					},
+					Blocks: map[string]*schemaNestedBlock{},
+				},
+			},
+			"timeouts": {Block: &schemaBlock{}},
+		},
+	}
+}
+
+func TestParseDocument(t *testing.T) {
+	t.Parallel()
+
+	doc, err := parseDocument(strings.NewReader(testDocument))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := func(sections []*docSection) map[string][]string {
+		m := make(map[string][]string)
+		for _, section := range sections {
+			for _, arg := range section.Arguments {
+				m[section.Name] = append(m[section.Name], arg.Name)
+			}
+		}
+		return m
+	}
+
+	if diff := cmp.Diff(names(doc.Arguments), map[string][]string{
+		"":     {"name", "enabled", "port", "legacy", "rule"},
+		"rule": {"action", "priority"},
+	}); diff != "" {
+		t.Errorf("unexpected arguments difference: %s", diff)
+	}
+	if diff := cmp.Diff(names(doc.Attributes), map[string][]string{
+		"": {"arn"},
+	}); diff != "" {
+		t.Errorf("unexpected attributes difference: %s", diff)
+	}
+
+	if got := doc.Arguments[0].Arguments[1]; got.Default == nil || *got.Default != "false" || !got.Optional {
+		t.Errorf("unexpected enabled argument: %+v", got)
+	}
+}
+
+func TestCheckDocument(t *testing.T) {
+	t.Parallel()
+
+	doc, err := parseDocument(strings.NewReader(testDocument))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, f := range checkDocument("example.html.markdown", testSchema(), doc) {
+		got = append(got, f.String())
+	}
+
+	want := []string{
+		`example.html.markdown:23: default: enabled: documented default "false" differs from schema default "true"`,
+		`example.html.markdown:24: required: port: documented as Required but is Optional in the schema`,
+		`example.html.markdown:25: extra: legacy: documented argument is not in the schema`,
+		`example.html.markdown:27: missing: rule.effect: argument is not documented`,
+		`example.html.markdown:33: missing: status: attribute is not documented`,
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected findings difference: %s", diff)
+	}
+}
+
+func TestCheckDocumentAmbiguousSection(t *testing.T) {
+	t.Parallel()
+
+	s := testSchema()
+	s.Blocks["other"] = &schemaNestedBlock{Block: &schemaBlock{Blocks: map[string]*schemaNestedBlock{"rule": {Block: &schemaBlock{}}}}}
+
+	doc, err := parseDocument(strings.NewReader(testDocument))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, f := range checkDocument("example.html.markdown", s, doc) {
+		if strings.HasPrefix(f.Path, "rule.") {
+			t.Errorf("unexpected finding in ambiguous section: %s", f)
+		}
+	}
+}
+
+func TestWriteSkeleton(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	writeSkeleton(&buf, "resource", testSchema())
+
+	want := "## Argument Reference\n\n" +
+		"The following arguments are required:\n\n" +
+		"* `name` - (Required) TODO.\n\n" +
+		"The following arguments are optional:\n\n" +
+		"* `enabled` - (Optional) TODO. Defaults to `true`.\n" +
+		"* `port` - (Optional) TODO. Defaults to `80`.\n" +
+		"* `rule` - (Optional) Configuration block. See [`rule` Block](#rule-block) below.\n\n" +
+		"### `rule` Block\n\n" +
+		"The `rule` configuration block supports the following arguments:\n\n" +
+		"* `action` - (Required) TODO.\n" +
+		"* `effect` - (Optional) TODO. Defaults to `allow`.\n" +
+		"* `priority` - (Optional) TODO.\n\n" +
+		"## Attribute Reference\n\n" +
+		"This resource exports the following attributes in addition to the arguments above:\n\n" +
+		"* `arn` - TODO.\n" +
+		"* `status` - TODO.\n"
+	if diff := cmp.Diff(buf.String(), want); diff != "" {
+		t.Errorf("unexpected skeleton difference: %s", diff)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+var (
+	dataSource = flag.String("data-source", "", "check only this data source, e.g. aws_vpc")
+	resource   = flag.String("resource", "", "check only this resource, e.g. aws_vpc")
+	skeleton   = flag.Bool("skeleton", false, "write argument and attribute reference sections for -resource or -data-source instead of checking")
+	websiteDir = flag.String("website-dir", "website/docs", "website documentation directory")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tdocscheck [-website-dir <dir>] [-resource <type> | -data-source <type>]\n")
+	fmt.Fprintf(os.Stderr, "\tdocscheck -skeleton -resource <type> | -data-source <type>\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if *resource != "" && *dataSource != "" || *skeleton && *resource == "" && *dataSource == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+
+	schemas, err := loadProviderSchemas(ctx)
+	if err != nil {
+		fatalf("loading provider schema: %s", err)
+	}
+
+	if *skeleton {
+		kind, typeName, s := "resource", *resource, schemas.Resources[*resource]
+		if *dataSource != "" {
+			kind, typeName, s = "data source", *dataSource, schemas.DataSources[*dataSource]
+		}
+		if s == nil {
+			fatalf("%s %s not found", kind, typeName)
+		}
+		writeSkeleton(os.Stdout, kind, s)
+		return
+	}
+
+	var findings []finding
+
+	if *dataSource == "" {
+		findings = append(findings, checkDocuments(filepath.Join(*websiteDir, "r"), schemas.Resources, *resource)...)
+	}
+	if *resource == "" {
+		findings = append(findings, checkDocuments(filepath.Join(*websiteDir, "d"), schemas.DataSources, *dataSource)...)
+	}
+
+	counts := make(map[findingKind]int)
+	for _, f := range findings {
+		fmt.Println(f)
+		counts[f.Kind]++
+	}
+
+	fmt.Printf("\n%d missing, %d extra, %d wrongly required, %d wrongly defaulted\n", counts[findingMissing], counts[findingExtra], counts[findingRequired], counts[findingDefault])
+
+	if len(findings) > 0 {
+		os.Exit(1)
+	}
+}
+
+// checkDocuments checks the documentation page in dir of each schema, or only of the named one.
+func checkDocuments(dir string, schemas map[string]*schemaBlock, only string) []finding {
+	var findings []finding
+
+	for _, typeName := range slices.Sorted(maps.Keys(schemas)) {
+		if only != "" && typeName != only {
+			continue
+		}
+
+		file := filepath.Join(dir, strings.TrimPrefix(typeName, providerTypeName+"_")+".html.markdown")
+		f, err := os.Open(file)
+		if errors.Is(err, fs.ErrNotExist) {
+			findings = append(findings, finding{
+				Kind:    findingMissing,
+				File:    file,
+				Path:    typeName,
+				Message: "documentation page does not exist",
+			})
+			continue
+		}
+		if err != nil {
+			fatalf("opening %s: %s", file, err)
+		}
+
+		doc, err := parseDocument(f)
+		f.Close()
+		if err != nil {
+			fatalf("parsing %s: %s", file, err)
+		}
+
+		findings = append(findings, checkDocument(file, schemas[typeName], doc)...)
+	}
+
+	return findings
+}
+
+func fatalf(format string, a ...any) {
+	fmt.Fprintf(os.Stderr, format+"\n", a...)
+	os.Exit(1)
+}
@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// docArgument is an argument or attribute documented in a list item such as
+// "* `name` - (Optional) Description. Defaults to `value`.".
+type docArgument struct {
+	Name        string
+	Line        int
+	Required    bool
+	Optional    bool
+	Description string
+	Default     *string // The documented default value, if any.
+}
+
+// docSection is a list of arguments or attributes, either at the top level or for a nested block.
+type docSection struct {
+	Name      string // Empty for the top level.
+	Line      int
+	Arguments []*docArgument
+}
+
+// document is the argument and attribute reference of a resource or data source documentation page.
+type document struct {
+	Arguments  []*docSection
+	Attributes []*docSection
+}
+
+type docPart int
+
+const (
+	docPartNone docPart = iota
+	docPartArguments
+	docPartAttributes
+)
+
+var (
+	headingRegexp      = regexp.MustCompile(`^(#{2,})\s+(.*?)\s*$`)
+	listItemRegexp     = regexp.MustCompile("^(\\s*)[*-]\\s+`([a-z0-9_]+)`\\s*-?\\s*(.*)$")
+	requiredRegexp     = regexp.MustCompile(`^\(\s*(Required|Optional)\b`)
+	defaultRegexp      = regexp.MustCompile("(?i)\\bdefault(?:s| value)?(?: (?:is|to|of))?:?\\s+`([^`]*)`")
+	headingNameRegexp  = regexp.MustCompile("`([a-z0-9_]+)`")
+	headingTokenRegexp = regexp.MustCompile(`^([a-z0-9]+(?:_[a-z0-9]+)*)\b`)
+)
+
+// parseDocument parses the argument and attribute reference sections of a documentation page.
+func parseDocument(r io.Reader) (*document, error) {
+	var (
+		doc     document
+		part    docPart
+		section *docSection
+		// The last top-level list item, for indented nested list items.
+		parent     *docArgument
+		nested     *docSection
+		inCodeSpan bool
+	)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	newSection := func(name string, line int) {
+		section = &docSection{Name: name, Line: line}
+		switch part {
+		case docPartArguments:
+			doc.Arguments = append(doc.Arguments, section)
+		case docPartAttributes:
+			doc.Attributes = append(doc.Attributes, section)
+		}
+		parent, nested = nil, nil
+	}
+
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := scanner.Text()
+
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCodeSpan = !inCodeSpan
+			continue
+		}
+		if inCodeSpan {
+			continue
+		}
+
+		if m := headingRegexp.FindStringSubmatch(line); m != nil {
+			level, text := len(m[1]), m[2]
+
+			if level == 2 {
+				switch {
+				case strings.HasPrefix(text, "Argument Reference"):
+					part = docPartArguments
+					newSection("", lineNum)
+				case strings.HasPrefix(text, "Attribute Reference"), strings.HasPrefix(text, "Attributes Reference"):
+					part = docPartAttributes
+					newSection("", lineNum)
+				default:
+					part, section = docPartNone, nil
+				}
+				continue
+			}
+
+			if part != docPartNone {
+				newSection(headingBlockName(text), lineNum)
+			}
+			continue
+		}
+
+		if section == nil {
+			continue
+		}
+
+		m := listItemRegexp.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+
+		indent, name, description := m[1], m[2], m[3]
+		arg := &docArgument{
+			Name:        name,
+			Line:        lineNum,
+			Description: description,
+		}
+		if m := requiredRegexp.FindStringSubmatch(description); m != nil {
+			arg.Required = m[1] == "Required"
+			arg.Optional = m[1] == "Optional"
+		}
+		if m := defaultRegexp.FindStringSubmatch(description); m != nil {
+			v := strings.Trim(m[1], `"`)
+			arg.Default = &v
+		}
+
+		if indent == "" || parent == nil {
+			section.Arguments = append(section.Arguments, arg)
+			parent, nested = arg, nil
+			continue
+		}
+
+		// An indented list item documents an argument of the parent item's block.
+		if nested == nil {
+			nested = &docSection{Name: parent.Name, Line: lineNum}
+			switch part {
+			case docPartArguments:
+				doc.Arguments = append(doc.Arguments, nested)
+			case docPartAttributes:
+				doc.Attributes = append(doc.Attributes, nested)
+			}
+		}
+		nested.Arguments = append(nested.Arguments, arg)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return &doc, nil
+}
+
+// headingBlockName returns the block name in a heading such as "### `rule` Block" or "### rule Configuration Block".
+func headingBlockName(text string) string {
+	if m := headingNameRegexp.FindStringSubmatch(text); m != nil {
+		return m[1]
+	}
+
+	if m := headingTokenRegexp.FindStringSubmatch(text); m != nil {
+		return m[1]
+	}
+
+	return ""
+}
@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework"
+)
+
+const (
+	providerTypeName = "aws"
+)
+
+// providerSchemas is the documented schema of each resource and data source, keyed by type name.
+type providerSchemas struct {
+	Resources   map[string]*schemaBlock
+	DataSources map[string]*schemaBlock
+}
+
+// loadProviderSchemas returns the schemas of the muxed SDKv2 and Framework provider.
+// Default values are not part of the protocol schema, so they are read from the providers' own schemas.
+func loadProviderSchemas(ctx context.Context) (*providerSchemas, error) {
+	factory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := factory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range response.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			return nil, fmt.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	schemas := &providerSchemas{
+		Resources:   make(map[string]*schemaBlock, len(response.ResourceSchemas)),
+		DataSources: make(map[string]*schemaBlock, len(response.DataSourceSchemas)),
+	}
+	for typeName, v := range response.ResourceSchemas {
+		schemas.Resources[typeName] = newSchemaBlock(v.Block)
+	}
+	for typeName, v := range response.DataSourceSchemas {
+		schemas.DataSources[typeName] = newSchemaBlock(v.Block)
+	}
+
+	// Plugin SDK v2 defaults.
+	for typeName, r := range primary.ResourcesMap {
+		if s, ok := schemas.Resources[typeName]; ok {
+			setSDKDefaults(s, nil, r.SchemaMap())
+		}
+	}
+	for typeName, r := range primary.DataSourcesMap {
+		if s, ok := schemas.DataSources[typeName]; ok {
+			setSDKDefaults(s, nil, r.SchemaMap())
+		}
+	}
+
+	// Plugin Framework defaults. Data sources don't have defaults.
+	secondary, err := framework.NewProvider(ctx, primary)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range secondary.Resources(ctx) {
+		r := f()
+
+		var metadataResponse resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerTypeName}, &metadataResponse)
+
+		s, ok := schemas.Resources[metadataResponse.TypeName]
+		if !ok {
+			continue
+		}
+
+		var schemaResponse resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+		if schemaResponse.Diagnostics.HasError() {
+			return nil, fmt.Errorf("%s: reading schema: %v", metadataResponse.TypeName, schemaResponse.Diagnostics)
+		}
+
+		setFrameworkDefaults(ctx, s, nil, reflect.ValueOf(schemaResponse.Schema.Attributes), reflect.ValueOf(schemaResponse.Schema.Blocks))
+	}
+
+	return schemas, nil
+}
+
+func setSDKDefaults(s *schemaBlock, path []string, schemaMap map[string]*sdkschema.Schema) {
+	for name, v := range schemaMap {
+		path := append(path[:len(path):len(path)], name)
+
+		if v.Default != nil {
+			if attr := s.attribute(path); attr != nil {
+				def := formatDefault(v.Default)
+				attr.Default = &def
+			}
+		}
+
+		if elem, ok := v.Elem.(*sdkschema.Resource); ok {
+			setSDKDefaults(s, path, elem.SchemaMap())
+		}
+	}
+}
+
+// setFrameworkDefaults sets defaults from Plugin Framework resource schema attributes and blocks.
+// Reflection is used as the nested object interfaces are internal to the Plugin Framework.
+func setFrameworkDefaults(ctx context.Context, s *schemaBlock, path []string, attributes, blocks reflect.Value) {
+	if attributes.IsValid() && attributes.Kind() == reflect.Map {
+		for iter := attributes.MapRange(); iter.Next(); {
+			name, v := iter.Key().String(), iter.Value()
+			if v.Kind() == reflect.Interface {
+				v = v.Elem()
+			}
+			path := append(path[:len(path):len(path)], name)
+
+			if v.Kind() == reflect.Struct {
+				if f := v.FieldByName("Default"); f.IsValid() && f.Kind() == reflect.Interface && !f.IsNil() {
+					if def, ok := frameworkDefault(ctx, f.Interface()); ok {
+						if attr := s.attribute(path); attr != nil {
+							attr.Default = &def
+						}
+					}
+				}
+			}
+
+			// Nested attributes are not supported by protocol version 5.
+		}
+	}
+
+	if blocks.IsValid() && blocks.Kind() == reflect.Map {
+		for iter := blocks.MapRange(); iter.Next(); {
+			name, v := iter.Key().String(), iter.Value()
+			path := append(path[:len(path):len(path)], name)
+
+			if nested := nestedObject(v); nested.IsValid() {
+				setFrameworkDefaults(ctx, s, path, call(nested, "GetAttributes"), call(nested, "GetBlocks"))
+			}
+		}
+	}
+}
+
+func nestedObject(v reflect.Value) reflect.Value {
+	return call(v, "GetNestedObject")
+}
+
+// call calls the named method with no arguments, returning its single result or the zero Value.
+func call(v reflect.Value, name string) reflect.Value {
+	if !v.IsValid() {
+		return reflect.Value{}
+	}
+
+	m := v.MethodByName(name)
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 {
+		return reflect.Value{}
+	}
+
+	out := m.Call(nil)[0]
+	if out.Kind() == reflect.Interface {
+		out = out.Elem()
+	}
+
+	return out
+}
+
+func frameworkDefault(ctx context.Context, v any) (string, bool) {
+	switch v := v.(type) {
+	case defaults.Bool:
+		var response defaults.BoolResponse
+		v.DefaultBool(ctx, defaults.BoolRequest{}, &response)
+		if response.PlanValue.IsNull() || response.PlanValue.IsUnknown() {
+			return "", false
+		}
+		return formatDefault(response.PlanValue.ValueBool()), true
+
+	case defaults.Float32:
+		var response defaults.Float32Response
+		v.DefaultFloat32(ctx, defaults.Float32Request{}, &response)
+		if response.PlanValue.IsNull() || response.PlanValue.IsUnknown() {
+			return "", false
+		}
+		return formatDefault(response.PlanValue.ValueFloat32()), true
+
+	case defaults.Float64:
+		var response defaults.Float64Response
+		v.DefaultFloat64(ctx, defaults.Float64Request{}, &response)
+		if response.PlanValue.IsNull() || response.PlanValue.IsUnknown() {
+			return "", false
+		}
+		return formatDefault(response.PlanValue.ValueFloat64()), true
+
+	case defaults.Int32:
+		var response defaults.Int32Response
+		v.DefaultInt32(ctx, defaults.Int32Request{}, &response)
+		if response.PlanValue.IsNull() || response.PlanValue.IsUnknown() {
+			return "", false
+		}
+		return formatDefault(response.PlanValue.ValueInt32()), true
+
+	case defaults.Int64:
+		var response defaults.Int64Response
+		v.DefaultInt64(ctx, defaults.Int64Request{}, &response)
+		if response.PlanValue.IsNull() || response.PlanValue.IsUnknown() {
+			return "", false
+		}
+		return formatDefault(response.PlanValue.ValueInt64()), true
+
+	case defaults.Number:
+		var response defaults.NumberResponse
+		v.DefaultNumber(ctx, defaults.NumberRequest{}, &response)
+		if response.PlanValue.IsNull() || response.PlanValue.IsUnknown() {
+			return "", false
+		}
+		return response.PlanValue.ValueBigFloat().Text('f', -1), true
+
+	case defaults.String:
+		var response defaults.StringResponse
+		v.DefaultString(ctx, defaults.StringRequest{}, &response)
+		if response.PlanValue.IsNull() || response.PlanValue.IsUnknown() {
+			return "", false
+		}
+		return formatDefault(response.PlanValue.ValueString()), true
+	}
+
+	return "", false
+}
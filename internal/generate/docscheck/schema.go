@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// schemaBlock is the subset of a resource's or data source's schema that is documented.
+type schemaBlock struct {
+	Attributes map[string]*schemaAttribute
+	Blocks     map[string]*schemaNestedBlock
+}
+
+type schemaAttribute struct {
+	Description string
+	Required    bool
+	Optional    bool
+	Computed    bool
+	Deprecated  bool
+	Default     *string // The default value, formatted as in documentation.
+}
+
+type schemaNestedBlock struct {
+	Description string
+	MinItems    int64
+	Deprecated  bool
+	Block       *schemaBlock
+}
+
+// isArgument returns whether the attribute can be configured.
+func (a *schemaAttribute) isArgument() bool {
+	return a.Required || a.Optional
+}
+
+func newSchemaBlock(in *tfprotov5.SchemaBlock) *schemaBlock {
+	out := &schemaBlock{
+		Attributes: make(map[string]*schemaAttribute),
+		Blocks:     make(map[string]*schemaNestedBlock),
+	}
+	if in == nil {
+		return out
+	}
+
+	for _, v := range in.Attributes {
+		out.Attributes[v.Name] = newSchemaAttribute(v)
+	}
+
+	for _, v := range in.BlockTypes {
+		out.Blocks[v.TypeName] = &schemaNestedBlock{
+			Description: v.Block.Description,
+			MinItems:    v.MinItems,
+			Deprecated:  v.Block.Deprecated,
+			Block:       newSchemaBlock(v.Block),
+		}
+	}
+
+	return out
+}
+
+func newSchemaAttribute(in *tfprotov5.SchemaAttribute) *schemaAttribute {
+	out := &schemaAttribute{
+		Description: in.Description,
+		Required:    in.Required,
+		Optional:    in.Optional,
+		Computed:    in.Computed,
+		Deprecated:  in.Deprecated,
+	}
+
+	return out
+}
+
+// attribute returns the attribute at the path of block and attribute names.
+func (b *schemaBlock) attribute(path []string) *schemaAttribute {
+	for i, name := range path {
+		if i == len(path)-1 {
+			return b.Attributes[name]
+		}
+
+		v, ok := b.Blocks[name]
+		if !ok {
+			return nil
+		}
+		b = v.Block
+	}
+
+	return nil
+}
+
+// nestedBlocks returns the blocks with the name anywhere in the schema.
+func (b *schemaBlock) nestedBlocks(name string) []*schemaBlock {
+	var blocks []*schemaBlock
+
+	for _, k := range slices.Sorted(maps.Keys(b.Blocks)) {
+		v := b.Blocks[k]
+		if k == name {
+			blocks = append(blocks, v.Block)
+		}
+		blocks = append(blocks, v.Block.nestedBlocks(name)...)
+	}
+
+	return blocks
+}
+
+// formatDefault formats a default value as it is written in documentation.
+func formatDefault(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	}
+
+	return fmt.Sprint(v)
+}
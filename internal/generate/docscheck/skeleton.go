@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// writeSkeleton writes argument and attribute reference sections for a resource or data source, following the skaff templates.
+func writeSkeleton(w io.Writer, kind string, s *schemaBlock) {
+	var required, optional, attributes []string
+
+	for _, name := range slices.Sorted(maps.Keys(s.Attributes)) {
+		attr := s.Attributes[name]
+		switch {
+		case slices.Contains(ignoredNames, name), attr.Deprecated:
+		case attr.Required:
+			required = append(required, name)
+		case attr.Optional:
+			optional = append(optional, name)
+		default:
+			attributes = append(attributes, name)
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(s.Blocks)) {
+		block := s.Blocks[name]
+		switch {
+		case slices.Contains(ignoredNames, name), block.Deprecated:
+		case block.MinItems > 0:
+			required = append(required, name)
+		default:
+			optional = append(optional, name)
+		}
+	}
+	slices.Sort(required)
+	slices.Sort(optional)
+
+	fmt.Fprintf(w, "## Argument Reference\n\n")
+	if len(required) > 0 {
+		fmt.Fprintf(w, "The following arguments are required:\n\n")
+		for _, name := range required {
+			fmt.Fprintln(w, skeletonItem(s, name))
+		}
+		fmt.Fprintln(w)
+	}
+	if len(optional) > 0 {
+		fmt.Fprintf(w, "The following arguments are optional:\n\n")
+		for _, name := range optional {
+			fmt.Fprintln(w, skeletonItem(s, name))
+		}
+		fmt.Fprintln(w)
+	}
+
+	writeSkeletonBlocks(w, s)
+
+	fmt.Fprintf(w, "## Attribute Reference\n\n")
+	fmt.Fprintf(w, "This %s exports the following attributes in addition to the arguments above:\n\n", kind)
+	for _, name := range attributes {
+		fmt.Fprintln(w, skeletonItem(s, name))
+	}
+}
+
+// writeSkeletonBlocks writes a section for each nested block, depth first.
+func writeSkeletonBlocks(w io.Writer, s *schemaBlock) {
+	for _, name := range slices.Sorted(maps.Keys(s.Blocks)) {
+		block := s.Blocks[name]
+		if slices.Contains(ignoredNames, name) || block.Deprecated {
+			continue
+		}
+		writeSkeletonBlock(w, name, block.Block)
+	}
+}
+
+func writeSkeletonBlock(w io.Writer, name string, s *schemaBlock) {
+	fmt.Fprintf(w, "### `%s` Block\n\n", name)
+	fmt.Fprintf(w, "The `%s` configuration block supports the following arguments:\n\n", name)
+	for _, name := range s.argumentNames() {
+		fmt.Fprintln(w, skeletonItem(s, name))
+	}
+	fmt.Fprintln(w)
+
+	writeSkeletonBlocks(w, s)
+}
+
+func skeletonItem(s *schemaBlock, name string) string {
+	var (
+		sb          strings.Builder
+		description string
+		def         *string
+	)
+
+	fmt.Fprintf(&sb, "* `%s` - ", name)
+
+	if attr, ok := s.Attributes[name]; ok {
+		switch {
+		case attr.Required:
+			sb.WriteString("(Required) ")
+		case attr.Optional:
+			sb.WriteString("(Optional) ")
+		}
+		description, def = attr.Description, attr.Default
+	} else if block, ok := s.Blocks[name]; ok {
+		if block.MinItems > 0 {
+			sb.WriteString("(Required) ")
+		} else {
+			sb.WriteString("(Optional) ")
+		}
+		description = block.Description
+		if description == "" {
+			description = fmt.Sprintf("Configuration block. See [`%s` Block](#%s-block) below", name, name)
+		}
+	}
+
+	if description == "" {
+		description = "TODO"
+	}
+	sb.WriteString(strings.TrimSuffix(strings.TrimSpace(description), "."))
+	sb.WriteString(".")
+
+	if def != nil {
+		fmt.Fprintf(&sb, " Defaults to `%s`.", *def)
+	}
+
+	return sb.String()
+}
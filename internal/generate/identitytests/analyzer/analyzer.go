@@ -0,0 +1,481 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package analyzer infers Resource Identity for resource types which do not yet declare it.
+//
+// For Plugin SDK resource types identity attributes are inferred from how the resource's ID is set in its Create
+// handler and how the ID is parsed, either by an import ID parser or in the Read handler.
+// For Plugin Framework resource types identity attributes are inferred from the resource's import support.
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+// IdentityKind is the form of Resource Identity inferred for a resource type.
+type IdentityKind int
+
+const (
+	IdentityKindNone IdentityKind = iota
+	IdentityKindARN
+	IdentityKindParameterized
+	IdentityKindSingleton
+)
+
+// Options configures analysis.
+type Options struct {
+	// AttrNames maps names.Attr... constant names to attribute names.
+	AttrNames map[string]string
+	// DocsDir is the resource registry documentation directory. If set, identity import documentation is added.
+	DocsDir string
+	// PreIdentityVersion is the last provider version released without Resource Identity, e.g. "v6.31.0".
+	PreIdentityVersion string
+	// GlobalResourcePrefixes are the resource type name prefixes of global services, e.g. "aws_iam_".
+	// The region template is not added to declarations of these resource types.
+	GlobalResourcePrefixes []string
+	// ProviderNameUpper returns the service name used in acceptance test names for a resource type, e.g. "ECR".
+	// If set, generated serialized identity tests are added to the existing serialized acceptance tests.
+	ProviderNameUpper func(typeName string) string
+}
+
+// Package is the result of analyzing a single service package directory.
+type Package struct {
+	Dir       string
+	Resources []*Resource
+
+	fset    *token.FileSet
+	files   map[string]*ast.File // Keyed by file name, excluding tests.
+	tests   map[string]*ast.File // Keyed by file name.
+	sources map[string][]byte    // Keyed by file name, including tests.
+	opts    Options
+}
+
+// Resource is the Resource Identity inferred for a single resource type.
+type Resource struct {
+	FileName       string
+	FactoryName    string
+	TypeName       string
+	Name           string
+	Implementation common.Implementation
+
+	Kind            IdentityKind
+	Attributes      []string // Parameterized Identity attributes.
+	IDAttrFormat    string
+	ImportIDHandler string
+	Testing         []string // @Testing annotation arguments.
+	Config          string   // Basic test configuration template, if it is to be written.
+
+	// Reason is set if Resource Identity cannot be added automatically.
+	Reason string
+
+	doc        *ast.CommentGroup
+	hasIDAttr  bool
+	hasTesting bool
+	tagged     bool
+	edits      []edit
+	handler    string // Generated Import ID Handler source.
+}
+
+// Applicable returns whether Resource Identity can be added to the resource type automatically.
+func (r *Resource) Applicable() bool {
+	return r.Kind != IdentityKindNone && r.Reason == ""
+}
+
+// Annotations returns the annotations to be added to the resource type's factory function.
+func (r *Resource) Annotations() []string {
+	var annotations []string
+
+	switch r.Kind {
+	case IdentityKindARN:
+		if r.Implementation == common.ImplementationFramework && r.hasIDAttr {
+			annotations = append(annotations, `@ArnIdentity(identityDuplicateAttributes="id")`)
+		} else {
+			annotations = append(annotations, "@ArnIdentity")
+		}
+
+	case IdentityKindSingleton:
+		annotations = append(annotations, "@SingletonIdentity")
+
+	case IdentityKindParameterized:
+		for _, attr := range r.Attributes {
+			annotations = append(annotations, fmt.Sprintf("@IdentityAttribute(%q)", attr))
+		}
+		if r.IDAttrFormat != "" {
+			annotations = append(annotations, fmt.Sprintf("@IdAttrFormat(%q)", r.IDAttrFormat))
+		}
+		if r.ImportIDHandler != "" {
+			annotations = append(annotations, fmt.Sprintf("@ImportIDHandler(%q)", r.ImportIDHandler))
+		}
+	}
+
+	for _, v := range r.Testing {
+		annotations = append(annotations, fmt.Sprintf("@Testing(%s)", v))
+	}
+
+	return annotations
+}
+
+// Analyze analyzes the Go source files in the specified service package directory.
+func Analyze(dir string, opts Options) (*Package, error) {
+	p := &Package{
+		Dir:     dir,
+		fset:    token.NewFileSet(),
+		files:   make(map[string]*ast.File),
+		tests:   make(map[string]*ast.File),
+		sources: make(map[string][]byte),
+		opts:    opts,
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") {
+			continue
+		}
+
+		src, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+
+		file, err := parser.ParseFile(p.fset, name, src, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("parsing (%s): %w", name, err)
+		}
+
+		if strings.HasSuffix(name, "_test.go") {
+			p.tests[name] = file
+		} else {
+			p.files[name] = file
+		}
+		p.sources[name] = src
+	}
+
+	fileNames := make([]string, 0, len(p.files))
+	for name := range p.files {
+		fileNames = append(fileNames, name)
+	}
+	slices.Sort(fileNames)
+
+	for _, name := range fileNames {
+		for _, decl := range p.files[name].Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Doc != nil {
+				if r := p.analyzeFactory(name, funcDecl); r != nil {
+					p.Resources = append(p.Resources, r)
+				}
+			}
+		}
+	}
+
+	return p, nil
+}
+
+var annotationRegexp = regexp.MustCompile(`^//\s*@([0-9A-Za-z]+)(\((.*)\))?\s*$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+
+// analyzeFactory analyzes a single resource type factory function.
+// nil is returned if the function is not a resource type factory or if the resource type already has Resource Identity.
+func (p *Package) analyzeFactory(fileName string, funcDecl *ast.FuncDecl) *Resource {
+	r := &Resource{
+		FileName:    fileName,
+		FactoryName: funcDecl.Name.Name,
+		doc:         funcDecl.Doc,
+	}
+
+	for _, line := range funcDecl.Doc.List {
+		m := annotationRegexp.FindStringSubmatch(line.Text)
+		if len(m) == 0 {
+			continue
+		}
+
+		switch annotationName, args := m[1], common.ParseArgs(m[3]); annotationName {
+		case "FrameworkResource", "SDKResource":
+			if annotationName == "FrameworkResource" {
+				r.Implementation = common.ImplementationFramework
+			} else {
+				r.Implementation = common.ImplementationSDK
+			}
+			if len(args.Positional) > 0 {
+				r.TypeName = args.Positional[0]
+			}
+			if v, ok := args.Keyword["name"]; ok {
+				r.Name = strings.ReplaceAll(strings.ReplaceAll(v, " ", ""), "-", "")
+			}
+
+		case "ArnIdentity", "CustomInherentRegionIdentity", "IdentityAttribute", "SingletonIdentity":
+			return nil
+
+		case "CustomImport", "ImportIDHandler", "NoImport":
+			r.Reason = fmt.Sprintf("has @%s annotation", annotationName)
+
+		case "Tags":
+			r.tagged = true
+
+		case "Testing":
+			r.hasTesting = true
+			if _, ok := args.Keyword["identityTest"]; ok {
+				r.Reason = "identity tests are disabled"
+			}
+		}
+	}
+
+	if r.TypeName == "" {
+		return nil
+	}
+
+	if r.Reason != "" {
+		return r
+	}
+
+	switch r.Implementation {
+	case common.ImplementationSDK:
+		p.analyzeSDKResource(r, funcDecl)
+
+	case common.ImplementationFramework:
+		p.analyzeFrameworkResource(r, funcDecl)
+	}
+
+	if r.Kind != IdentityKindNone && r.Reason == "" {
+		p.analyzeTests(r)
+	}
+
+	return r
+}
+
+// lookupFunc returns the named package-level function declared in the package's non-test files.
+func (p *Package) lookupFunc(name string) (*ast.FuncDecl, string) {
+	for fileName, file := range p.files {
+		for _, decl := range file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Name.Name == name {
+				return funcDecl, fileName
+			}
+		}
+	}
+
+	return nil, ""
+}
+
+// lookupConst returns the value of the named package-level string constant.
+func (p *Package) lookupConst(name string) (string, bool) {
+	for _, file := range p.files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.CONST {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				spec := spec.(*ast.ValueSpec)
+				for i, ident := range spec.Names {
+					if ident.Name != name || i >= len(spec.Values) {
+						continue
+					}
+					if lit, ok := spec.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+						if v, err := strconv.Unquote(lit.Value); err == nil {
+							return v, true
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return "", false
+}
+
+// isDeclared returns whether the specified identifier is declared at package level.
+func (p *Package) isDeclared(name string) bool {
+	for _, file := range p.files {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil && decl.Name.Name == name {
+					return true
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						if spec.Name.Name == name {
+							return true
+						}
+					case *ast.ValueSpec:
+						for _, ident := range spec.Names {
+							if ident.Name == name {
+								return true
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// attrName returns the attribute name for a string literal or names.Attr... constant.
+func (p *Package) attrName(expr ast.Expr) (string, bool) {
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		if expr.Kind == token.STRING {
+			if v, err := strconv.Unquote(expr.Value); err == nil {
+				return v, true
+			}
+		}
+
+	case *ast.SelectorExpr:
+		if x, ok := expr.X.(*ast.Ident); ok && x.Name == "names" {
+			v, ok := p.opts.AttrNames[expr.Sel.Name]
+			return v, ok
+		}
+
+	case *ast.Ident:
+		return p.lookupConst(expr.Name)
+	}
+
+	return "", false
+}
+
+// source returns the source text of the specified node.
+func (p *Package) source(fileName string, node ast.Node) string {
+	src := p.sources[fileName]
+	start, end := p.fset.Position(node.Pos()).Offset, p.fset.Position(node.End()).Offset
+
+	return string(src[start:end])
+}
+
+func isSelector(expr ast.Expr, x, sel string) bool {
+	if expr, ok := expr.(*ast.SelectorExpr); ok {
+		if ident, ok := expr.X.(*ast.Ident); ok {
+			return ident.Name == x && expr.Sel.Name == sel
+		}
+	}
+
+	return false
+}
+
+func isTrue(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == "true"
+}
+
+// compositeFields returns the keyed fields of a composite literal.
+func compositeFields(lit *ast.CompositeLit) map[string]ast.Expr {
+	fields := make(map[string]ast.Expr)
+
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			if key, ok := kv.Key.(*ast.Ident); ok {
+				fields[key.Name] = kv.Value
+			}
+		}
+	}
+
+	return fields
+}
+
+// camelWords splits a Go identifier into lower case words, keeping initialisms together.
+func camelWords(s string) []string {
+	var (
+		words []string
+		word  []rune
+	)
+	runes := []rune(s)
+
+	for i, r := range runes {
+		upper := r >= 'A' && r <= 'Z'
+		if upper && len(word) > 0 {
+			prevUpper := runes[i-1] >= 'A' && runes[i-1] <= 'Z'
+			nextLower := i+1 < len(runes) && runes[i+1] >= 'a' && runes[i+1] <= 'z'
+			if !prevUpper || nextLower {
+				words = append(words, strings.ToLower(string(word)))
+				word = nil
+			}
+		}
+		word = append(word, r)
+	}
+	if len(word) > 0 {
+		words = append(words, strings.ToLower(string(word)))
+	}
+
+	return words
+}
+
+// lowerFirstWord lower cases the first word of a Go identifier, e.g. "VPCEndpoint" -> "vpcEndpoint".
+func lowerFirstWord(s string) string {
+	words := camelWords(s)
+	if len(words) == 0 {
+		return s
+	}
+
+	return words[0] + s[len(words[0]):]
+}
+
+// goName returns a Go local variable name for an attribute name, e.g. "user_profile_name" -> "userProfileName".
+func goName(attr string) string {
+	var sb strings.Builder
+
+	for i, word := range strings.Split(attr, "_") {
+		switch {
+		case i == 0:
+			sb.WriteString(word)
+		case word == "arn" || word == "id":
+			sb.WriteString(strings.ToUpper(word))
+		case word != "":
+			sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+
+	if name := sb.String(); token.IsKeyword(name) {
+		return name + "Value"
+	} else {
+		return name
+	}
+}
+
+// LoadAttrNames returns the attribute names declared by the names.Attr... constants in the specified file.
+func LoadAttrNames(filename string) (map[string]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	attrNames := make(map[string]string)
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.CONST {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			spec := spec.(*ast.ValueSpec)
+			for i, ident := range spec.Names {
+				if i >= len(spec.Values) {
+					continue
+				}
+				if lit, ok := spec.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+					if v, err := strconv.Unquote(lit.Value); err == nil {
+						attrNames[ident.Name] = v
+					}
+				}
+			}
+		}
+	}
+
+	return attrNames, nil
+}
@@ -0,0 +1,1032 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var testAttrNames = map[string]string{
+	"AttrARN":    "arn",
+	"AttrID":     "id",
+	"AttrName":   "name",
+	"AttrPolicy": "policy",
+	"AttrVPCID":  "vpc_id",
+}
+
+const testWidgetTest = `package example_test
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/example"
+)
+
+func TestAccExampleWidget_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v example.DescribeWidgetOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_example_widget.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWidgetConfig_basic(rName),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
+
+func testAccCheckWidgetExists(ctx context.Context, n string, v *example.DescribeWidgetOutput) resource.TestCheckFunc {
+	return nil
+}
+
+func testAccCheckWidgetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return nil
+}
+
+func testAccWidgetConfig_base(rName string) string {
+	return fmt.Sprintf(` + "`" + `
+resource "aws_iam_role" "test" {
+  name = %[1]q
+}
+` + "`" + `, rName)
+}
+
+func testAccWidgetConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWidgetConfig_base(rName), fmt.Sprintf(` + "`" + `
+resource "aws_example_widget" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+}
+` + "`" + `, rName))
+}
+`
+
+const testWidgetTemplate = `resource "aws_example_widget" "test" {
+{{- template "region" }}
+  name = var.rName
+}
+`
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for fileName, src := range files {
+		fileName = filepath.Join(dir, fileName)
+		if err := os.MkdirAll(filepath.Dir(fileName), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(fileName, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		files           map[string]string
+		wantAnnotations []string
+		wantReason      string
+		wantConfig      string
+	}{
+		"SDK ARN": {
+			files: map[string]string{
+				"widget.go": `package example
+
+// @SDKResource("aws_example_widget", name="Widget")
+// @Tags(identifierAttribute="arn")
+func resourceWidget() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWidgetCreate,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceWidgetCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	output, err := conn.CreateWidget(ctx, &input)
+	d.SetId(aws.ToString(output.Widget.WidgetArn))
+	return nil
+}
+`,
+				"widget_test.go": testWidgetTest,
+			},
+			wantAnnotations: []string{
+				"@ArnIdentity",
+				`@Testing(existsType="github.com/aws/aws-sdk-go-v2/service/example;example.DescribeWidgetOutput")`,
+				`@Testing(preCheck="testAccPreCheck")`,
+				`@Testing(importIgnore="force_destroy")`,
+				`@Testing(preIdentityVersion="v6.0.0")`,
+				"@Testing(existsTakesT=false, destroyTakesT=false)",
+			},
+			wantConfig: `resource "aws_iam_role" "test" {
+  name = var.rName
+}
+
+resource "aws_example_widget" "test" {
+{{- template "region" }}
+  name     = var.rName
+  role_arn = aws_iam_role.test.arn
+{{- template "tags" . }}
+}
+`,
+		},
+		"SDK name": {
+			files: map[string]string{
+				"widget.go": `package example
+
+// @SDKResource("aws_example_widget", name="Widget")
+func resourceWidget() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWidgetCreate,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceWidgetCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	name := d.Get(names.AttrName).(string)
+	d.SetId(name)
+	return nil
+}
+`,
+				"widget_test.go": testWidgetTest,
+			},
+			wantAnnotations: []string{
+				`@IdentityAttribute("name")`,
+				`@Testing(existsType="github.com/aws/aws-sdk-go-v2/service/example;example.DescribeWidgetOutput")`,
+				`@Testing(preCheck="testAccPreCheck")`,
+				`@Testing(importIgnore="force_destroy")`,
+				`@Testing(preIdentityVersion="v6.0.0")`,
+				`@Testing(idAttrDuplicates="name")`,
+				"@Testing(existsTakesT=false, destroyTakesT=false)",
+			},
+		},
+		"SDK updatable name": {
+			files: map[string]string{
+				"widget.go": `package example
+
+// @SDKResource("aws_example_widget", name="Widget")
+func resourceWidget() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWidgetCreate,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceWidgetCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	d.SetId(d.Get(names.AttrName).(string))
+	return nil
+}
+`,
+			},
+			wantReason: `identity attribute "name" can be updated in place`,
+		},
+		"SDK composite": {
+			files: map[string]string{
+				"widget_attachment.go": `package example
+
+// @SDKResource("aws_example_widget_attachment", name="Widget Attachment")
+// @Testing(tagsTest=false)
+func resourceWidgetAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWidgetAttachmentCreate,
+		ReadWithoutTimeout:   resourceWidgetAttachmentRead,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"widget_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrVPCID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceWidgetAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	widgetID, vpcID := d.Get("widget_id").(string), d.Get(names.AttrVPCID).(string)
+	d.SetId(widgetAttachmentCreateResourceID(widgetID, vpcID))
+	return nil
+}
+
+func resourceWidgetAttachmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	widgetID, vpcID, err := widgetAttachmentParseResourceID(d.Id())
+	return nil
+}
+
+func widgetAttachmentCreateResourceID(widgetID, vpcID string) string {
+	return strings.Join([]string{widgetID, vpcID}, widgetAttachmentResourceIDSeparator)
+}
+
+const widgetAttachmentResourceIDSeparator = ","
+`,
+				"testdata/tmpl/widget_attachment_basic.gtpl": testWidgetTemplate,
+			},
+			wantAnnotations: []string{
+				`@IdentityAttribute("widget_id")`,
+				`@IdentityAttribute("vpc_id")`,
+				`@IdAttrFormat("{widget_id},{vpc_id}")`,
+				`@ImportIDHandler("widgetAttachmentImportID")`,
+				`@Testing(preIdentityVersion="v6.0.0")`,
+			},
+		},
+		"SDK generated ID": {
+			files: map[string]string{
+				"widget.go": `package example
+
+// @SDKResource("aws_example_widget", name="Widget")
+// @Testing(tagsTest=false)
+func resourceWidget() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWidgetCreate,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceWidgetCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	output, err := conn.CreateWidget(ctx, &input)
+	d.SetId(aws.ToString(output.WidgetId))
+	return nil
+}
+`,
+				"testdata/tmpl/widget_basic.gtpl": testWidgetTemplate,
+			},
+			wantAnnotations: []string{
+				`@IdentityAttribute("id")`,
+				`@Testing(preIdentityVersion="v6.0.0")`,
+			},
+		},
+		"SDK singleton": {
+			files: map[string]string{
+				"widget_policy.go": `package example
+
+// @SDKResource("aws_example_widget_policy", name="Widget Policy")
+// @Testing(tagsTest=false)
+func resourceWidgetPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWidgetPolicyPut,
+		ReadWithoutTimeout:   resourceWidgetPolicyRead,
+		DeleteWithoutTimeout: resourceWidgetPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrPolicy: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceWidgetPolicyPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	_, err := conn.PutWidgetPolicy(ctx, &input)
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
+	return resourceWidgetPolicyRead(ctx, d, meta)
+}
+
+func resourceWidgetPolicyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	output, err := findWidgetPolicy(ctx, conn)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Widget Policy (%s): %s", d.Id(), err)
+	}
+	d.Set(names.AttrPolicy, verify.PolicyToSet(d.Get(names.AttrPolicy).(string), aws.ToString(output.Policy)))
+	return nil
+}
+
+func resourceWidgetPolicyDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	log.Printf("[DEBUG] Deleting Widget Policy: %s", d.Id())
+	_, err := conn.DeleteWidgetPolicy(ctx, &input)
+	return nil
+}
+`,
+				"testdata/tmpl/widget_policy_basic.gtpl": testWidgetTemplate,
+			},
+			wantAnnotations: []string{
+				"@SingletonIdentity",
+				`@Testing(preIdentityVersion="v6.0.0")`,
+			},
+		},
+		"SDK singleton with AWS ID": {
+			files: map[string]string{
+				"widget_policy.go": `package example
+
+// @SDKResource("aws_example_widget_policy", name="Widget Policy")
+func resourceWidgetPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWidgetPolicyPut,
+		ReadWithoutTimeout:   resourceWidgetPolicyRead,
+		DeleteWithoutTimeout: resourceWidgetPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{},
+	}
+}
+
+func resourceWidgetPolicyPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	output, err := conn.PutWidgetPolicy(ctx, &input)
+	d.SetId(aws.ToString(output.RegistryId))
+	return nil
+}
+
+func resourceWidgetPolicyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return nil
+}
+
+func resourceWidgetPolicyDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return nil
+}
+`,
+			},
+			wantReason: "singleton ID must be set to the Region",
+		},
+		"SDK singleton with account ID": {
+			files: map[string]string{
+				"widget_policy.go": `package example
+
+// @SDKResource("aws_example_widget_policy", name="Widget Policy")
+func resourceWidgetPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWidgetPolicyPut,
+		ReadWithoutTimeout:   resourceWidgetPolicyRead,
+		DeleteWithoutTimeout: resourceWidgetPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{},
+	}
+}
+
+func resourceWidgetPolicyPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	_, err := conn.PutWidgetPolicy(ctx, &input)
+	d.SetId(meta.(*conns.AWSClient).AccountID(ctx))
+	return nil
+}
+
+func resourceWidgetPolicyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return nil
+}
+
+func resourceWidgetPolicyDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return nil
+}
+`,
+			},
+			wantReason: "singleton ID must be set to the Region",
+		},
+		"SDK Read uses ID": {
+			files: map[string]string{
+				"widget.go": `package example
+
+// @SDKResource("aws_example_widget", name="Widget")
+// @Testing(tagsTest=false)
+func resourceWidget() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWidgetCreate,
+		ReadWithoutTimeout:   resourceWidgetRead,
+		DeleteWithoutTimeout: resourceWidgetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{},
+	}
+}
+
+func resourceWidgetCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	output, err := conn.CreateWidget(ctx, &input)
+	d.SetId(aws.ToString(output.WidgetId))
+	return nil
+}
+
+func resourceWidgetRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	output, err := findWidgetByID(ctx, conn, d.Id())
+	return nil
+}
+
+func resourceWidgetDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return nil
+}
+`,
+				"testdata/tmpl/widget_basic.gtpl": testWidgetTemplate,
+			},
+			wantAnnotations: []string{
+				`@IdentityAttribute("id")`,
+				`@Testing(preIdentityVersion="v6.0.0")`,
+			},
+		},
+		"SDK custom importer": {
+			files: map[string]string{
+				"widget.go": `package example
+
+// @SDKResource("aws_example_widget", name="Widget")
+func resourceWidget() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWidgetCreate,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWidgetImport,
+		},
+
+		Schema: map[string]*schema.Schema{},
+	}
+}
+`,
+			},
+			wantReason: "custom importer",
+		},
+		"existing identity": {
+			files: map[string]string{
+				"widget.go": `package example
+
+// @SDKResource("aws_example_widget", name="Widget")
+// @ArnIdentity
+func resourceWidget() *schema.Resource {
+	return &schema.Resource{}
+}
+`,
+			},
+		},
+		"Framework import by ID": {
+			files: map[string]string{
+				"widget.go": `package example
+
+// @FrameworkResource("aws_example_widget", name="Widget")
+// @Testing(tagsTest=false)
+func newWidgetResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &widgetResource{}, nil
+}
+
+type widgetResource struct {
+	framework.ResourceWithModel[widgetResourceModel]
+	framework.WithImportByID
+}
+
+func (r *widgetResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+		},
+	}
+}
+
+func (r *widgetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	data.ID = data.WidgetARN
+}
+`,
+				"testdata/tmpl/widget_basic.gtpl": testWidgetTemplate,
+			},
+			wantAnnotations: []string{
+				`@ArnIdentity(identityDuplicateAttributes="id")`,
+				`@Testing(preIdentityVersion="v6.0.0")`,
+			},
+		},
+		"Framework passthrough": {
+			files: map[string]string{
+				"widget.go": `package example
+
+// @FrameworkResource("aws_example_widget", name="Widget")
+// @Testing(tagsTest=false)
+func newWidgetResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &widgetResource{}, nil
+}
+
+type widgetResource struct {
+	framework.ResourceWithModel[widgetResourceModel]
+}
+
+func (r *widgetResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"widget_name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *widgetResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("widget_name"), request, response)
+}
+`,
+				"testdata/tmpl/widget_basic.gtpl": testWidgetTemplate,
+			},
+			wantAnnotations: []string{
+				`@IdentityAttribute("widget_name")`,
+				`@Testing(preIdentityVersion="v6.0.0")`,
+			},
+		},
+		"Framework custom ImportState": {
+			files: map[string]string{
+				"widget.go": `package example
+
+// @FrameworkResource("aws_example_widget", name="Widget")
+// @Testing(tagsTest=false)
+func newWidgetResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &widgetResource{}, nil
+}
+
+type widgetResource struct {
+	framework.ResourceWithModel[widgetResourceModel]
+}
+
+func (r *widgetResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{},
+	}
+}
+
+func (r *widgetResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts := strings.Split(request.ID, ",")
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("a"), parts[0])...)
+}
+`,
+			},
+			wantReason: "custom ImportState method",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			writeFiles(t, dir, testCase.files)
+
+			p, err := Analyze(dir, Options{
+				AttrNames:              testAttrNames,
+				GlobalResourcePrefixes: []string{"aws_iam_"},
+				PreIdentityVersion:     "v6.0.0",
+			})
+			if err != nil {
+				t.Fatalf("Analyze: %s", err)
+			}
+
+			if testCase.wantAnnotations == nil && testCase.wantReason == "" {
+				if got := len(p.Resources); got != 0 {
+					t.Fatalf("Resources = %d, want 0", got)
+				}
+				return
+			}
+
+			if got := len(p.Resources); got != 1 {
+				t.Fatalf("Resources = %d, want 1", got)
+			}
+			r := p.Resources[0]
+
+			if got, want := r.Reason, testCase.wantReason; got != want {
+				t.Errorf("Reason = %q, want %q", got, want)
+			}
+			if testCase.wantReason != "" {
+				return
+			}
+
+			if got, want := r.Annotations(), testCase.wantAnnotations; !slices.Equal(got, want) {
+				t.Errorf("Annotations = %q, want %q", got, want)
+			}
+			if want := testCase.wantConfig; want != "" {
+				if got := r.Config; got != want {
+					t.Errorf("Config = %q, want %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"generate.go": `//go:generate go run ../../generate/servicepackage/main.go
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package example
+`,
+		"testdata/tmpl/widget_attachment_basic.gtpl": testWidgetTemplate,
+		"widget_attachment.go": `package example
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_example_widget_attachment", name="Widget Attachment")
+// @Testing(tagsTest=false)
+func resourceWidgetAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWidgetAttachmentCreate,
+		ReadWithoutTimeout:   resourceWidgetAttachmentRead,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"widget_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrVPCID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceWidgetAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	id := widgetAttachmentCreateResourceID(d.Get("widget_id").(string), d.Get(names.AttrVPCID).(string))
+	d.SetId(id)
+	return nil
+}
+
+func resourceWidgetAttachmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	widgetID, vpcID, err := widgetAttachmentParseResourceID(d.Id())
+	return nil
+}
+
+func widgetAttachmentCreateResourceID(widgetID, vpcID string) string {
+	return widgetID + "," + vpcID
+}
+`,
+	}
+	writeFiles(t, dir, files)
+
+	p, err := Analyze(dir, Options{AttrNames: testAttrNames})
+	if err != nil {
+		t.Fatalf("Analyze: %s", err)
+	}
+
+	applied, err := p.Apply()
+	if err != nil {
+		t.Fatalf("Apply: %s", err)
+	}
+	if got := len(applied); got != 1 {
+		t.Fatalf("applied = %d, want 1", got)
+	}
+
+	b, err := os.ReadFile(filepath.Join(dir, "widget_attachment.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(b)
+
+	for _, want := range []string{
+		`inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"`,
+		`// @SDKResource("aws_example_widget_attachment", name="Widget Attachment")
+// @IdentityAttribute("widget_id")
+// @IdentityAttribute("vpc_id")
+// @ImportIDHandler("widgetAttachmentImportID")
+// @Testing(tagsTest=false)
+func resourceWidgetAttachment() *schema.Resource {`,
+		`var _ inttypes.SDKv2ImportID = widgetAttachmentImportID{}`,
+		`func (widgetAttachmentImportID) Create(d *schema.ResourceData) string {
+	return widgetAttachmentCreateResourceID(d.Get("widget_id").(string), d.Get(names.AttrVPCID).(string))
+}`,
+		`	widgetID, vpcID, err := widgetAttachmentParseResourceID(id)`,
+		`		"widget_id":     widgetID,
+		names.AttrVPCID: vpcID,`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("widget_attachment.go does not contain %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "Importer:") {
+		t.Errorf("widget_attachment.go contains Importer:\n%s", got)
+	}
+
+	b, err = os.ReadFile(filepath.Join(dir, "generate.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), "//go:generate go run ../../generate/servicepackage/main.go\n//go:generate go run ../../generate/identitytests/main.go\n"; !strings.HasPrefix(got, want) {
+		t.Errorf("generate.go = %q, want prefix %q", got, want)
+	}
+}
+
+func TestApplySingleton(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"generate.go": `//go:generate go run ../../generate/servicepackage/main.go
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package example
+`,
+		"testdata/tmpl/widget_policy_basic.gtpl": testWidgetTemplate,
+		"widget_policy.go": `package example
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// @SDKResource("aws_example_widget_policy", name="Widget Policy")
+// @Testing(tagsTest=false)
+func resourceWidgetPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWidgetPolicyPut,
+		ReadWithoutTimeout:   resourceWidgetPolicyRead,
+		DeleteWithoutTimeout: resourceWidgetPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{},
+	}
+}
+
+func resourceWidgetPolicyPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	_, err := conn.PutWidgetPolicy(ctx, &input)
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
+	return nil
+}
+
+func resourceWidgetPolicyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return nil
+}
+
+func resourceWidgetPolicyDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	return nil
+}
+`,
+	}
+	writeFiles(t, dir, files)
+
+	p, err := Analyze(dir, Options{AttrNames: testAttrNames})
+	if err != nil {
+		t.Fatalf("Analyze: %s", err)
+	}
+
+	applied, err := p.Apply()
+	if err != nil {
+		t.Fatalf("Apply: %s", err)
+	}
+	if got := len(applied); got != 1 {
+		t.Fatalf("applied = %d, want 1", got)
+	}
+
+	b, err := os.ReadFile(filepath.Join(dir, "widget_policy.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(b)
+
+	for _, want := range []string{
+		`// @SDKResource("aws_example_widget_policy", name="Widget Policy")
+// @SingletonIdentity
+// @Testing(tagsTest=false)`,
+		`	d.SetId(meta.(*conns.AWSClient).Region(ctx))`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("widget_policy.go does not contain %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "Importer:") {
+		t.Errorf("widget_policy.go contains Importer:\n%s", got)
+	}
+}
+
+func TestSerialTestEdit(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"example_test.go": `package example
+
+func TestAccExample_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Widget": {
+			acctest.CtBasic:      testAccWidget_basic,
+			acctest.CtDisappears: testAccWidget_disappears,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
+`,
+	})
+
+	p, err := Analyze(dir, Options{})
+	if err != nil {
+		t.Fatalf("Analyze: %s", err)
+	}
+
+	e, ok := p.serialTestEdit("testAccWidget_basic", "testAccExampleWidget_IdentitySerial")
+	if !ok {
+		t.Fatal("no serialized acceptance tests found")
+	}
+
+	b, err := applyEdits(p.sources[e.fileName], []edit{e}, nil)
+	if err != nil {
+		t.Fatalf("applyEdits: %s", err)
+	}
+
+	if got, want := string(b), `		"Widget": {
+			acctest.CtBasic:      testAccWidget_basic,
+			acctest.CtDisappears: testAccWidget_disappears,
+			"Identity":           testAccExampleWidget_IdentitySerial,
+		},
+`; !strings.Contains(got, want) {
+		t.Errorf("example_test.go does not contain %q:\n%s", want, got)
+	}
+}
+
+func TestAddRegionTemplate(t *testing.T) {
+	t.Parallel()
+
+	p := &Package{
+		opts: Options{
+			GlobalResourcePrefixes: []string{"aws_iam_"},
+		},
+	}
+
+	input := `data "aws_partition" "current" {}
+
+data "aws_region" "current" {
+}
+
+resource "aws_iam_role" "test" {
+  name = var.rName
+}
+
+resource "aws_example_widget" "test" {
+{{- template "region" }}
+  name = var.rName
+}
+
+resource "aws_example_gadget" "test" {
+  name = var.rName
+}
+`
+	want := `data "aws_partition" "current" {}
+
+data "aws_region" "current" {
+{{- template "region" }}
+}
+
+resource "aws_iam_role" "test" {
+  name = var.rName
+}
+
+resource "aws_example_widget" "test" {
+{{- template "region" }}
+  name = var.rName
+}
+
+resource "aws_example_gadget" "test" {
+{{- template "region" }}
+  name = var.rName
+}
+`
+
+	if got := p.addRegionTemplate(input); got != want {
+		t.Errorf("addRegionTemplate = %q, want %q", got, want)
+	}
+}
+
+func TestLowerFirstWord(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"Widget":                      "widget",
+		"WidgetAttachment":            "widgetAttachment",
+		"VPCAssociationAuthorization": "vpcAssociationAuthorization",
+		"ACL":                         "acl",
+	}
+
+	for input, want := range testCases {
+		if got := lowerFirstWord(input); got != want {
+			t.Errorf("lowerFirstWord(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestApplyDocs(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"example_widget_attachment.html.markdown": "## Argument Reference\n\n" +
+			"* `widget_id` - (Required) ID of the widget. Changing this forces a new resource.\n\n" +
+			"## Import\n\n" +
+			"In Terraform v1.5.0 and later, use an [`import` block](https://developer.hashicorp.com/terraform/language/import) to import Widget Attachments using the `widget_id` and `vpc_id` separated by a comma (`,`). For example:\n\n" +
+			"```terraform\nimport {\n  to = aws_example_widget_attachment.example\n  id = \"wgt-12345678,vpc-12345678\"\n}\n```\n",
+	})
+
+	p := &Package{
+		opts: Options{
+			DocsDir: dir,
+		},
+	}
+	r := &Resource{
+		TypeName:     "aws_example_widget_attachment",
+		Kind:         IdentityKindParameterized,
+		Attributes:   []string{"widget_id", "vpc_id"},
+		IDAttrFormat: "{widget_id},{vpc_id}",
+	}
+
+	if err := p.applyDocs(r); err != nil {
+		t.Fatalf("applyDocs: %s", err)
+	}
+
+	b, err := os.ReadFile(filepath.Join(dir, "example_widget_attachment.html.markdown"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(b)
+
+	for _, want := range []string{
+		"## Import\n\nIn Terraform v1.12.0 and later,",
+		"  identity = {\n    widget_id = \"wgt-12345678\"\n    vpc_id    = \"vpc-12345678\"\n  }\n",
+		"resource \"aws_example_widget_attachment\" \"example\" {\n",
+		"* `widget_id` (String) ID of the widget.\n* `vpc_id` (String) VPC ID.\n",
+		"* `region` (String) Region where this resource is managed.\n\nIn Terraform v1.5.0 and later,",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("documentation does not contain %q:\n%s", want, got)
+		}
+	}
+}
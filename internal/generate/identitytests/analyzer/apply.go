@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package analyzer
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// edit replaces the source between two offsets in a file.
+type edit struct {
+	fileName   string
+	start, end int
+	text       string
+	unused     []string // Import paths which may no longer be used after the edit.
+}
+
+// removeLinesEdit removes the lines spanned by a node.
+func (p *Package) removeLinesEdit(fileName string, node ast.Node) edit {
+	src := p.sources[fileName]
+	start := p.fset.Position(node.Pos()).Offset
+	end := p.fset.Position(node.End()).Offset
+
+	start = bytes.LastIndexByte(src[:start], '\n') + 1
+	if i := bytes.IndexByte(src[end:], '\n'); i >= 0 {
+		end += i + 1
+	} else {
+		end = len(src)
+	}
+
+	return edit{fileName: fileName, start: start, end: end}
+}
+
+// removeDeclEdit removes a function declaration and its doc comment.
+func (p *Package) removeDeclEdit(fileName string, funcDecl *ast.FuncDecl) edit {
+	e := p.removeLinesEdit(fileName, funcDecl)
+	if funcDecl.Doc != nil {
+		e.start = p.removeLinesEdit(fileName, funcDecl.Doc).start
+	}
+
+	return e
+}
+
+// Apply adds Resource Identity to each applicable resource type.
+// Annotations are added to factory functions, import support is updated, Import ID Handlers are generated,
+// basic test configuration templates are written, documentation is updated and the package's generate.go is updated
+// to generate identity tests.
+// It returns the applicable resource types.
+func (p *Package) Apply() ([]*Resource, error) {
+	var (
+		applied []*Resource
+		edits   = make(map[string][]edit)
+		imports = make(map[string]map[string]string) // File name -> import path -> import name.
+	)
+
+	for _, r := range p.Resources {
+		if !r.Applicable() {
+			continue
+		}
+		applied = append(applied, r)
+
+		for _, e := range r.edits {
+			edits[e.fileName] = append(edits[e.fileName], e)
+		}
+
+		// Identity annotations follow the resource type and tagging annotations and testing annotations are last.
+		after := r.doc.List[0]
+		for _, line := range r.doc.List {
+			if m := annotationRegexp.FindStringSubmatch(line.Text); len(m) > 0 && (m[1] == "FrameworkResource" || m[1] == "SDKResource" || m[1] == "Tags") {
+				after = line
+			}
+		}
+		last := r.doc.List[len(r.doc.List)-1]
+
+		var identity, testing strings.Builder
+		for _, annotation := range r.Annotations() {
+			if strings.HasPrefix(annotation, "@Testing(") {
+				fmt.Fprintf(&testing, "\n// %s", annotation)
+			} else {
+				fmt.Fprintf(&identity, "\n// %s", annotation)
+			}
+		}
+
+		edits[r.FileName] = append(edits[r.FileName],
+			edit{
+				fileName: r.FileName,
+				start:    p.fset.Position(after.End()).Offset,
+				end:      p.fset.Position(after.End()).Offset,
+				text:     identity.String(),
+			},
+			edit{
+				fileName: r.FileName,
+				start:    p.fset.Position(last.End()).Offset,
+				end:      p.fset.Position(last.End()).Offset,
+				text:     testing.String(),
+			},
+		)
+
+		if r.handler != "" {
+			end := len(p.sources[r.FileName])
+			edits[r.FileName] = append(edits[r.FileName], edit{
+				fileName: r.FileName,
+				start:    end,
+				end:      end,
+				text:     r.handler,
+			})
+
+			if imports[r.FileName] == nil {
+				imports[r.FileName] = make(map[string]string)
+			}
+			imports[r.FileName][typesImportPath] = "inttypes"
+			if strings.Contains(r.handler, "names.") {
+				imports[r.FileName][namesImportPath] = ""
+			}
+		}
+
+		if err := p.applyDocs(r); err != nil {
+			return nil, err
+		}
+
+		if r.Config != "" {
+			sourceName := strings.TrimSuffix(strings.TrimSuffix(r.FileName, ".go"), "_")
+			dir := filepath.Join(p.Dir, "testdata", "tmpl")
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return nil, err
+			}
+			if err := os.WriteFile(filepath.Join(dir, sourceName+"_basic.gtpl"), []byte(r.Config), 0644); err != nil { //nolint:gosec // Template files are not sensitive
+				return nil, err
+			}
+		}
+	}
+
+	for fileName, edits := range edits {
+		src, err := applyEdits(p.sources[fileName], edits, imports[fileName])
+		if err != nil {
+			return nil, fmt.Errorf("updating (%s): %w", fileName, err)
+		}
+
+		if err := os.WriteFile(filepath.Join(p.Dir, fileName), src, 0644); err != nil { //nolint:gosec // Source files are not sensitive
+			return nil, err
+		}
+	}
+
+	if len(applied) > 0 {
+		if err := addGenerateDirective(filepath.Join(p.Dir, "generate.go")); err != nil {
+			return nil, err
+		}
+	}
+
+	return applied, nil
+}
+
+const (
+	namesImportPath = "github.com/hashicorp/terraform-provider-aws/names"
+	typesImportPath = "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// applyEdits applies non-overlapping edits to Go source, adds any missing imports and formats the result.
+// Insertions at the same offset are applied in order.
+func applyEdits(src []byte, edits []edit, imports map[string]string) ([]byte, error) {
+	slices.SortStableFunc(edits, func(a, b edit) int {
+		return a.start - b.start
+	})
+
+	var (
+		buf bytes.Buffer
+		pos int
+	)
+	for _, e := range edits {
+		if e.start < pos {
+			return nil, fmt.Errorf("overlapping edits at offset %d", e.start)
+		}
+		buf.Write(src[pos:e.start])
+		buf.WriteString(e.text)
+		pos = e.end
+	}
+	buf.Write(src[pos:])
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", buf.Bytes(), parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	for importPath, name := range imports {
+		astutil.AddNamedImport(fset, file, name, importPath)
+	}
+	for _, e := range edits {
+		for _, importPath := range e.unused {
+			if !astutil.UsesImport(file, importPath) {
+				astutil.DeleteImport(fset, file, importPath)
+			}
+		}
+	}
+
+	buf.Reset()
+	if err := format.Node(&buf, fset, file); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+const (
+	identityTestsDirective       = "//go:generate go run ../../generate/identitytests/main.go"
+	servicePackageDirective      = "//go:generate go run ../../generate/servicepackage/main.go"
+	generateDirectivesOnlyPrefix = "// ONLY generate directives"
+)
+
+// addGenerateDirective adds the identity tests generator to a service package's generate directives.
+func addGenerateDirective(filename string) error {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	lines := strings.Split(string(b), "\n")
+	if slices.Contains(lines, identityTestsDirective) {
+		return nil
+	}
+
+	i := slices.Index(lines, servicePackageDirective) + 1
+	if i == 0 {
+		i = slices.IndexFunc(lines, func(line string) bool {
+			return strings.HasPrefix(line, generateDirectivesOnlyPrefix)
+		})
+		if i < 0 {
+			return fmt.Errorf("%s: no generate directives", filename)
+		}
+	}
+	lines = slices.Insert(lines, i, identityTestsDirective)
+
+	return os.WriteFile(filename, []byte(strings.Join(lines, "\n")), 0644) //nolint:gosec // Source files are not sensitive
+}
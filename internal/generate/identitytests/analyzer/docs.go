@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package analyzer
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	importBlockRegexp = regexp.MustCompile(`import \{\s*\n\s*to\s*=\s*(\S+)\.(\S+)\s*\n\s*id\s*=\s*"([^"]*)"`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+	placeholderRegexp = regexp.MustCompile(`\{[^}]+\}`)                                                        // nosemgrep:ci.calling-regexp.MustCompile-directly
+)
+
+// applyDocs adds identity import documentation to a resource type's registry documentation.
+// Example identity values are taken from the existing import by ID example.
+// Documentation which cannot be updated automatically is left unchanged.
+func (p *Package) applyDocs(r *Resource) error {
+	if p.opts.DocsDir == "" {
+		return nil
+	}
+
+	filename := filepath.Join(p.opts.DocsDir, strings.TrimPrefix(r.TypeName, "aws_")+".html.markdown")
+	b, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	doc := string(b)
+
+	const importHeading = "## Import\n\n"
+	i := strings.Index(doc, importHeading)
+	if i < 0 || strings.Contains(doc[i:], "identity = {") {
+		return nil
+	}
+	i += len(importHeading)
+
+	m := importBlockRegexp.FindStringSubmatch(doc[i:])
+	if len(m) == 0 || m[1] != r.TypeName {
+		return nil
+	}
+	values := identityValues(r, m[3])
+	if values == nil {
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("In Terraform v1.12.0 and later, the [`import` block](https://developer.hashicorp.com/terraform/language/import) can be used with the `identity` attribute. For example:\n\n")
+	fmt.Fprintf(&sb, "```terraform\nimport {\n  to = %s.%s\n  identity = {\n", r.TypeName, m[2])
+
+	attrs := identityAttributes(r)
+	width := 0
+	for _, attr := range attrs {
+		width = max(width, len(identityKey(r, attr)))
+	}
+	for j, attr := range attrs {
+		fmt.Fprintf(&sb, "    %-*s = %q\n", width, identityKey(r, attr), values[j])
+	}
+	fmt.Fprintf(&sb, "  }\n}\n\nresource %q %q {\n  ### Configuration omitted for brevity ###\n}\n```\n\n", r.TypeName, m[2])
+
+	sb.WriteString("### Identity Schema\n\n#### Required\n\n")
+	for _, attr := range attrs {
+		if r.Kind == IdentityKindARN {
+			fmt.Fprintf(&sb, "- `%s` (String) %s\n", attr, attributeDescription(doc, attr))
+		} else {
+			fmt.Fprintf(&sb, "* `%s` (String) %s\n", attr, attributeDescription(doc, attr))
+		}
+	}
+	if r.Kind == IdentityKindParameterized {
+		sb.WriteString("\n#### Optional\n\n* `account_id` (String) AWS Account where this resource is managed.\n")
+		if !slices.ContainsFunc(p.opts.GlobalResourcePrefixes, func(prefix string) bool {
+			return strings.HasPrefix(r.TypeName, prefix)
+		}) {
+			sb.WriteString("* `region` (String) Region where this resource is managed.\n")
+		}
+	}
+	sb.WriteString("\n")
+
+	doc = doc[:i] + sb.String() + doc[i:]
+
+	return os.WriteFile(filename, []byte(doc), 0644) //nolint:gosec // Documentation files are not sensitive
+}
+
+func identityAttributes(r *Resource) []string {
+	if r.Kind == IdentityKindARN {
+		return []string{"arn"}
+	}
+
+	return r.Attributes
+}
+
+// identityKey returns the identity object key for an attribute. ARN keys are quoted, following existing documentation.
+func identityKey(r *Resource, attr string) string {
+	if r.Kind == IdentityKindARN {
+		return fmt.Sprintf("%q", attr)
+	}
+
+	return attr
+}
+
+var initialisms = []string{"arn", "id", "ip", "kms", "url", "vpc"}
+
+// identityValues returns example values for a resource type's identity attributes from an example import ID.
+func identityValues(r *Resource, id string) []string {
+	switch {
+	case r.Kind == IdentityKindARN:
+		if !strings.HasPrefix(id, "arn:") {
+			return nil
+		}
+		return []string{id}
+
+	case len(r.Attributes) == 1:
+		return []string{id}
+
+	case r.IDAttrFormat != "":
+		// "{a},{b}" -> ^(.+?),(.+)$
+		literals := placeholderRegexp.Split(r.IDAttrFormat, -1)
+		if len(literals) != len(r.Attributes)+1 {
+			return nil
+		}
+		var sb strings.Builder
+		sb.WriteString("^")
+		for j, literal := range literals {
+			sb.WriteString(regexp.QuoteMeta(literal))
+			if j < len(r.Attributes)-1 {
+				sb.WriteString("(.+?)")
+			} else if j == len(r.Attributes)-1 {
+				sb.WriteString("(.+)")
+			}
+		}
+		sb.WriteString("$")
+
+		re, err := regexp.Compile(sb.String())
+		if err != nil {
+			return nil
+		}
+		if m := re.FindStringSubmatch(id); len(m) == len(r.Attributes)+1 {
+			return m[1:]
+		}
+	}
+
+	return nil
+}
+
+// attributeDescription returns the first sentence of an attribute's description in registry documentation.
+func attributeDescription(doc, attr string) string {
+	re := regexp.MustCompile("(?m)^[*-] `" + regexp.QuoteMeta(attr) + "` - (?:\\((?:Required|Optional)[^)]*\\) )?(.+)$") // nosemgrep:ci.calling-regexp.MustCompile-directly
+
+	if m := re.FindStringSubmatch(doc); len(m) > 0 {
+		description := strings.TrimSpace(m[1])
+		if j := strings.Index(description, ". "); j >= 0 {
+			description = description[:j+1]
+		}
+		if !strings.HasSuffix(description, ".") {
+			description += "."
+		}
+		return description
+	}
+
+	switch attr {
+	case "arn":
+		return "Amazon Resource Name (ARN) of the resource."
+	case "id":
+		return "ID of the resource."
+	default:
+		words := strings.Split(attr, "_")
+		for j, word := range words {
+			switch {
+			case slices.Contains(initialisms, word):
+				words[j] = strings.ToUpper(word)
+			case j == 0 && word != "":
+				words[j] = strings.ToUpper(word[:1]) + word[1:]
+			}
+		}
+		return strings.Join(words, " ") + "."
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+)
+
+// analyzeFrameworkResource infers Resource Identity for a Plugin Framework resource type.
+func (p *Package) analyzeFrameworkResource(r *Resource, funcDecl *ast.FuncDecl) {
+	typeName := frameworkResourceType(funcDecl)
+	if typeName == "" {
+		r.Reason = "resource type not found"
+		return
+	}
+
+	structType, fileName := p.lookupStruct(typeName)
+	if structType == nil {
+		r.Reason = fmt.Sprintf("resource type %s not found", typeName)
+		return
+	}
+
+	var (
+		embedded      *ast.Field
+		importByID    *ast.Field
+		methods       = p.methods(typeName)
+		attributes    = p.frameworkSchema(methods["Schema"])
+		importState   = methods["ImportState"]
+		passthrough   string
+		isPassthrough bool
+	)
+	for _, field := range structType.Fields.List {
+		if len(field.Names) > 0 {
+			continue
+		}
+		if embedded == nil {
+			embedded = field
+		}
+		if isSelector(field.Type, "framework", "WithImportByID") {
+			importByID = field
+		}
+	}
+	if attributes == nil {
+		r.Reason = "schema could not be determined"
+		return
+	}
+	_, r.hasIDAttr = attributes["id"]
+
+	if importState != nil {
+		passthrough, isPassthrough = p.passthroughAttribute(importState)
+	}
+
+	switch {
+	case importByID != nil && importState == nil:
+		if _, ok := attributes["arn"]; ok && p.idIsARN(methods["Create"]) {
+			r.Kind = IdentityKindARN
+		} else {
+			r.Kind = IdentityKindParameterized
+			r.Attributes = []string{"id"}
+		}
+		r.edits = append(r.edits, edit{
+			fileName: fileName,
+			start:    p.fset.Position(importByID.Type.Pos()).Offset,
+			end:      p.fset.Position(importByID.Type.End()).Offset,
+			text:     "framework.WithImportByIdentity",
+		})
+
+	case isPassthrough:
+		if passthrough != "id" && r.hasIDAttr {
+			r.Reason = fmt.Sprintf("imports by %q but also has an id attribute", passthrough)
+			return
+		}
+		if passthrough == "arn" {
+			r.Kind = IdentityKindARN
+		} else {
+			r.Kind = IdentityKindParameterized
+			r.Attributes = []string{passthrough}
+		}
+		offset := p.fset.Position(embedded.End()).Offset
+		removeImportState := p.removeDeclEdit(methodFile(p, importState), importState)
+		removeImportState.unused = []string{frameworkPathImportPath}
+		r.edits = append(r.edits,
+			edit{
+				fileName: fileName,
+				start:    offset,
+				end:      offset,
+				text:     "\n\tframework.WithImportByIdentity",
+			},
+			removeImportState,
+		)
+
+	case importState != nil:
+		r.Reason = "custom ImportState method"
+
+	default:
+		r.Reason = "no import support"
+	}
+}
+
+const frameworkPathImportPath = "github.com/hashicorp/terraform-plugin-framework/path"
+
+// frameworkResourceType returns the name of the resource type struct constructed by a factory function.
+func frameworkResourceType(funcDecl *ast.FuncDecl) string {
+	var name string
+
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		if name != "" {
+			return false
+		}
+		if unary, ok := n.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+			if lit, ok := unary.X.(*ast.CompositeLit); ok {
+				if ident, ok := lit.Type.(*ast.Ident); ok {
+					name = ident.Name
+				}
+			}
+		}
+		return true
+	})
+
+	return name
+}
+
+func (p *Package) lookupStruct(name string) (*ast.StructType, string) {
+	for fileName, file := range p.files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				if spec := spec.(*ast.TypeSpec); spec.Name.Name == name {
+					if structType, ok := spec.Type.(*ast.StructType); ok {
+						return structType, fileName
+					}
+				}
+			}
+		}
+	}
+
+	return nil, ""
+}
+
+// methods returns the methods declared on a pointer to the named type.
+func (p *Package) methods(typeName string) map[string]*ast.FuncDecl {
+	methods := make(map[string]*ast.FuncDecl)
+
+	for _, file := range p.files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Recv == nil || len(funcDecl.Recv.List) != 1 {
+				continue
+			}
+			recv := funcDecl.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+			if ident, ok := recv.(*ast.Ident); ok && ident.Name == typeName {
+				methods[funcDecl.Name.Name] = funcDecl
+			}
+		}
+	}
+
+	return methods
+}
+
+func methodFile(p *Package, funcDecl *ast.FuncDecl) string {
+	return p.fset.Position(funcDecl.Pos()).Filename
+}
+
+// frameworkSchema returns the names of the top-level attributes in a Plugin Framework resource type's schema.
+func (p *Package) frameworkSchema(funcDecl *ast.FuncDecl) map[string]schemaAttribute {
+	if funcDecl == nil {
+		return nil
+	}
+
+	var attributes map[string]schemaAttribute
+
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		if attributes != nil {
+			return false
+		}
+		lit, ok := n.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		mapType, ok := lit.Type.(*ast.MapType)
+		if !ok || !isSelector(mapType.Value, "schema", "Attribute") {
+			return true
+		}
+
+		attributes = make(map[string]schemaAttribute)
+		for _, elt := range lit.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				if name, ok := p.attrName(kv.Key); ok {
+					attributes[name] = schemaAttribute{}
+				}
+			}
+		}
+		return false
+	})
+
+	return attributes
+}
+
+// passthroughAttribute returns the attribute an ImportState method passes the import ID through to,
+// if that is all it does.
+func (p *Package) passthroughAttribute(funcDecl *ast.FuncDecl) (string, bool) {
+	if funcDecl.Body == nil || len(funcDecl.Body.List) != 1 {
+		return "", false
+	}
+	stmt, ok := funcDecl.Body.List[0].(*ast.ExprStmt)
+	if !ok {
+		return "", false
+	}
+	call, ok := stmt.X.(*ast.CallExpr)
+	if !ok || !isSelector(call.Fun, "resource", "ImportStatePassthroughID") || len(call.Args) != 4 {
+		return "", false
+	}
+	root, ok := call.Args[1].(*ast.CallExpr)
+	if !ok || !isSelector(root.Fun, "path", "Root") || len(root.Args) != 1 {
+		return "", false
+	}
+
+	return p.attrName(root.Args[0])
+}
+
+// idIsARN returns whether a Create method sets the resource's ID to an ARN.
+func (p *Package) idIsARN(funcDecl *ast.FuncDecl) bool {
+	if funcDecl == nil {
+		return false
+	}
+
+	var isARN bool
+
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			return true
+		}
+		if sel, ok := assign.Lhs[0].(*ast.SelectorExpr); !ok || sel.Sel.Name != "ID" {
+			return true
+		}
+		ast.Inspect(assign.Rhs[0], func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if words := camelWords(sel.Sel.Name); len(words) > 0 && words[len(words)-1] == "arn" {
+					isARN = true
+				}
+			}
+			return !isARN
+		})
+		return false
+	})
+
+	return isARN
+}
@@ -0,0 +1,813 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+
+	namesgen "github.com/hashicorp/terraform-provider-aws/names/generate"
+)
+
+type schemaAttribute struct {
+	computed bool
+	forceNew bool
+	optional bool
+	required bool
+}
+
+type idKind int
+
+const (
+	idKindUnknown idKind = iota
+	idKindARN
+	idKindAttribute
+	idKindComposite
+	idKindGenerated
+)
+
+// idValue describes the value passed to d.SetId.
+type idValue struct {
+	kind       idKind
+	attrs      []string
+	keys       []string         // Source text of the attribute name expressions, e.g. "names.AttrName".
+	format     string           // ID format for composite IDs, e.g. "{domain_id}/{user_profile_name}".
+	createFunc string           // Package-level function used to create a composite ID.
+	replace    map[ast.Expr]int // Composite ID expressions resolved to attributes, indexed into attrs.
+	expr       ast.Expr         // The d.SetId argument or, for composite IDs, the call creating the ID.
+	fileName   string
+	fn         *ast.FuncDecl
+	rd         string // Name of the *schema.ResourceData parameter.
+	schema     map[string]schemaAttribute
+}
+
+// analyzeSDKResource infers Resource Identity for a Plugin SDK resource type.
+func (p *Package) analyzeSDKResource(r *Resource, funcDecl *ast.FuncDecl) {
+	lit := sdkResourceLiteral(funcDecl)
+	if lit == nil {
+		r.Reason = "no schema.Resource literal"
+		return
+	}
+	fields := compositeFields(lit)
+
+	attributes := p.sdkSchema(fields)
+	if attributes == nil {
+		r.Reason = "schema could not be determined"
+		return
+	}
+
+	var importer *ast.KeyValueExpr
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "Importer" {
+				importer = kv
+			}
+		}
+	}
+	if importer == nil {
+		r.Reason = "no import support"
+		return
+	}
+	if !isPassthroughImporter(importer.Value) {
+		r.Reason = "custom importer"
+		return
+	}
+
+	var create *ast.FuncDecl
+	var createFile string
+	for _, key := range []string{"CreateWithoutTimeout", "CreateContext", "Create"} {
+		if ident, ok := fields[key].(*ast.Ident); ok {
+			create, createFile = p.lookupFunc(ident.Name)
+			break
+		}
+	}
+	if create == nil {
+		r.Reason = "Create handler not found"
+		return
+	}
+
+	v := p.resourceID(createFile, create, attributes)
+
+	if v.expr != nil && p.isSDKSingleton(fields, attributes) {
+		r.Kind = IdentityKindSingleton
+
+		// The singleton importer sets the ID to the Region, or to the account ID for global resource types.
+		idFunc := "Region"
+		if slices.ContainsFunc(p.opts.GlobalResourcePrefixes, func(prefix string) bool {
+			return strings.HasPrefix(r.TypeName, prefix)
+		}) {
+			idFunc = "AccountID"
+		}
+		// Changing the ID would break existing state and import IDs, so other IDs must be handled manually.
+		if call, ok := v.expr.(*ast.CallExpr); !ok || !isAWSClientCall(call, idFunc) {
+			r.Reason = fmt.Sprintf("singleton ID must be set to the %s", idFunc)
+			return
+		}
+
+		r.edits = append(r.edits, p.removeLinesEdit(r.FileName, importer))
+		return
+	}
+
+	switch v.kind {
+	case idKindARN:
+		if _, ok := attributes["arn"]; !ok {
+			r.Reason = "ID is an ARN but there is no arn attribute"
+			return
+		}
+		r.Kind = IdentityKindARN
+
+	case idKindGenerated:
+		r.Kind = IdentityKindParameterized
+		r.Attributes = []string{"id"}
+
+	case idKindAttribute, idKindComposite:
+		for _, attr := range v.attrs {
+			a, ok := attributes[attr]
+			if !ok {
+				r.Reason = fmt.Sprintf("ID uses unknown attribute %q", attr)
+				return
+			}
+			if !a.forceNew && (a.required || a.optional) {
+				r.Reason = fmt.Sprintf("identity attribute %q can be updated in place", attr)
+				return
+			}
+		}
+		r.Kind = IdentityKindParameterized
+		r.Attributes = v.attrs
+
+		if v.kind == idKindAttribute {
+			r.Testing = append(r.Testing, fmt.Sprintf("idAttrDuplicates=%q", v.attrs[0]))
+			break
+		}
+
+		r.IDAttrFormat = v.format
+		if err := p.importIDHandler(r, fields, v); err != nil {
+			r.Reason = err.Error()
+			return
+		}
+
+	default:
+		r.Reason = "ID value could not be determined"
+		return
+	}
+
+	// The identity interceptor provides the importer.
+	r.edits = append(r.edits, p.removeLinesEdit(r.FileName, importer))
+}
+
+// isSDKSingleton returns whether a Plugin SDK resource type's Read and Delete handlers do not use the resource ID,
+// other than in logging and diagnostics, or any argument that requires replacement,
+// i.e. there is at most one such resource per Region or account.
+func (p *Package) isSDKSingleton(fields map[string]ast.Expr, attributes map[string]schemaAttribute) bool {
+	for _, keys := range [][]string{
+		{"ReadWithoutTimeout", "ReadContext", "Read"},
+		{"DeleteWithoutTimeout", "DeleteContext", "Delete"},
+	} {
+		var handler *ast.FuncDecl
+		for _, key := range keys {
+			if ident, ok := fields[key].(*ast.Ident); ok {
+				handler, _ = p.lookupFunc(ident.Name)
+				break
+			}
+		}
+		if handler == nil || handler.Body == nil {
+			return false
+		}
+
+		rd := resourceDataParam(handler)
+		if rd == "" {
+			return false
+		}
+
+		usesID := false
+		ast.Inspect(handler.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || usesID {
+				return !usesID
+			}
+			if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+				if x, ok := sel.X.(*ast.Ident); ok && slices.Contains([]string{"errors", "fmt", "log", "sdkdiag", "tflog"}, x.Name) {
+					return false
+				}
+			}
+			if isSelector(call.Fun, rd, "Id") {
+				usesID = true
+				return false
+			}
+			if (isSelector(call.Fun, rd, "Get") || isSelector(call.Fun, rd, "GetOk")) && len(call.Args) == 1 {
+				if attr, ok := p.attrName(call.Args[0]); !ok || attributes[attr].forceNew {
+					usesID = true
+					return false
+				}
+			}
+			// The resource data may be used by a called function.
+			for _, arg := range call.Args {
+				if ident, ok := arg.(*ast.Ident); ok && ident.Name == rd {
+					usesID = true
+					return false
+				}
+			}
+			return true
+		})
+		if usesID {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isAWSClientCall returns whether an expression is a call to the specified AWSClient method, e.g. meta.(*conns.AWSClient).Region(ctx).
+func isAWSClientCall(call *ast.CallExpr, method string) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != method {
+		return false
+	}
+	assert, ok := sel.X.(*ast.TypeAssertExpr)
+	if !ok {
+		return false
+	}
+	star, ok := assert.Type.(*ast.StarExpr)
+
+	return ok && isSelector(star.X, "conns", "AWSClient")
+}
+
+// sdkResourceLiteral returns the outermost schema.Resource composite literal in a factory function.
+func sdkResourceLiteral(funcDecl *ast.FuncDecl) *ast.CompositeLit {
+	var lit *ast.CompositeLit
+
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		if lit != nil {
+			return false
+		}
+		if n, ok := n.(*ast.CompositeLit); ok && isSelector(n.Type, "schema", "Resource") {
+			lit = n
+			return false
+		}
+		return true
+	})
+
+	return lit
+}
+
+func isPassthroughImporter(expr ast.Expr) bool {
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		expr = unary.X
+	}
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok || len(lit.Elts) != 1 {
+		return false
+	}
+
+	return isSelector(compositeFields(lit)["StateContext"], "schema", "ImportStatePassthroughContext")
+}
+
+// sdkSchema returns the top-level attributes of a Plugin SDK resource type's schema.
+func (p *Package) sdkSchema(fields map[string]ast.Expr) map[string]schemaAttribute {
+	var lit *ast.CompositeLit
+
+	switch v := fields["Schema"].(type) {
+	case *ast.CompositeLit:
+		lit = v
+	}
+
+	switch v := fields["SchemaFunc"].(type) {
+	case *ast.FuncLit:
+		lit = returnedCompositeLit(v.Body)
+	case *ast.Ident:
+		if funcDecl, _ := p.lookupFunc(v.Name); funcDecl != nil {
+			lit = returnedCompositeLit(funcDecl.Body)
+		}
+	}
+
+	if lit == nil {
+		return nil
+	}
+
+	attributes := make(map[string]schemaAttribute)
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		name, ok := p.attrName(kv.Key)
+		if !ok {
+			continue
+		}
+
+		var attribute schemaAttribute
+		if v, ok := kv.Value.(*ast.CompositeLit); ok {
+			fields := compositeFields(v)
+			attribute.computed = isTrue(fields["Computed"])
+			attribute.forceNew = isTrue(fields["ForceNew"])
+			attribute.optional = isTrue(fields["Optional"])
+			attribute.required = isTrue(fields["Required"])
+		}
+		attributes[name] = attribute
+	}
+
+	return attributes
+}
+
+func returnedCompositeLit(body *ast.BlockStmt) *ast.CompositeLit {
+	if body == nil || len(body.List) == 0 {
+		return nil
+	}
+	if ret, ok := body.List[len(body.List)-1].(*ast.ReturnStmt); ok && len(ret.Results) == 1 {
+		lit, _ := ret.Results[0].(*ast.CompositeLit)
+		return lit
+	}
+
+	return nil
+}
+
+// resourceDataParam returns the name of a handler's *schema.ResourceData parameter.
+func resourceDataParam(funcDecl *ast.FuncDecl) string {
+	for _, field := range funcDecl.Type.Params.List {
+		if star, ok := field.Type.(*ast.StarExpr); ok && isSelector(star.X, "schema", "ResourceData") && len(field.Names) > 0 {
+			return field.Names[0].Name
+		}
+	}
+
+	return ""
+}
+
+// resourceID describes the ID set by a Create handler.
+func (p *Package) resourceID(fileName string, create *ast.FuncDecl, attributes map[string]schemaAttribute) idValue {
+	v := idValue{
+		fileName: fileName,
+		fn:       create,
+		rd:       resourceDataParam(create),
+		replace:  make(map[ast.Expr]int),
+		schema:   attributes,
+	}
+	if v.rd == "" {
+		return v
+	}
+
+	ast.Inspect(create.Body, func(n ast.Node) bool {
+		if v.expr != nil {
+			return false
+		}
+		if call, ok := n.(*ast.CallExpr); ok && isSelector(call.Fun, v.rd, "SetId") && len(call.Args) == 1 {
+			if lit, ok := call.Args[0].(*ast.BasicLit); !ok || lit.Value != `""` {
+				v.expr = call.Args[0]
+			}
+		}
+		return true
+	})
+	if v.expr == nil {
+		return v
+	}
+
+	p.resolveID(&v, v.expr, 0)
+
+	return v
+}
+
+func (p *Package) resolveID(v *idValue, expr ast.Expr, depth int) {
+	if depth > 4 {
+		return
+	}
+
+	if attr, key, ok := p.resolveAttribute(v, expr, depth); ok {
+		v.kind = idKindAttribute
+		v.attrs, v.keys = []string{attr}, []string{key}
+		return
+	}
+
+	switch e := expr.(type) {
+	case *ast.CallExpr:
+		if isSelector(e.Fun, "aws", "ToString") && len(e.Args) == 1 {
+			p.resolveID(v, e.Args[0], depth+1)
+			return
+		}
+
+		if parts, format, ok := p.compositeParts(e, nil); ok {
+			p.resolveComposite(v, e, parts, format, "")
+			return
+		}
+
+		if ident, ok := e.Fun.(*ast.Ident); ok && len(e.Args) > 1 {
+			if funcDecl, _ := p.lookupFunc(ident.Name); funcDecl != nil {
+				params := paramNames(funcDecl)
+				if len(params) != len(e.Args) {
+					return
+				}
+				format := ""
+				if ret := singleReturn(funcDecl); ret != nil {
+					if call, ok := ret.(*ast.CallExpr); ok {
+						if parts, f, ok := p.compositeParts(call, params); ok && len(parts) == len(params) {
+							format = f
+						}
+					}
+				}
+				p.resolveComposite(v, e, e.Args, format, ident.Name)
+			}
+		}
+
+	case *ast.Ident:
+		if rhs := assignedValue(v.fn, e.Name); rhs != nil {
+			p.resolveID(v, rhs, depth+1)
+		}
+
+	case *ast.SelectorExpr:
+		words := camelWords(e.Sel.Name)
+		if len(words) > 0 {
+			switch words[len(words)-1] {
+			case "arn":
+				v.kind = idKindARN
+			case "id":
+				v.kind = idKindGenerated
+			}
+		}
+	}
+}
+
+// resolveComposite resolves the parts of a composite ID created by the specified call to attributes.
+func (p *Package) resolveComposite(v *idValue, call *ast.CallExpr, parts []ast.Expr, format, createFunc string) {
+	var attrs, keys []string
+
+	for _, part := range parts {
+		attr, key, ok := p.resolveAttribute(v, part, 0)
+		if !ok {
+			return
+		}
+		v.replace[part] = len(attrs)
+		attrs = append(attrs, attr)
+		keys = append(keys, key)
+	}
+
+	for i, attr := range attrs {
+		format = strings.Replace(format, fmt.Sprintf("{%d}", i), "{"+attr+"}", 1)
+	}
+
+	v.kind = idKindComposite
+	v.expr = call
+	v.attrs, v.keys = attrs, keys
+	v.format = format
+	v.createFunc = createFunc
+}
+
+// compositeParts returns the parts and format of an ID composed with fmt.Sprintf, strings.Join or flex.FlattenResourceId.
+// The format's placeholders are the parts' indices, e.g. "{0}/{1}".
+// If params is not nil, each part must be one of the named parameters and the placeholders are the parameters' indices.
+func (p *Package) compositeParts(call *ast.CallExpr, params []string) ([]ast.Expr, string, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, "", false
+	}
+
+	var (
+		parts  []ast.Expr
+		format string
+	)
+
+	switch sel.Sel.Name {
+	case "Sprintf":
+		if !isSelector(call.Fun, "fmt", "Sprintf") || len(call.Args) < 3 {
+			return nil, "", false
+		}
+		lit, ok := call.Args[0].(*ast.BasicLit)
+		if !ok {
+			return nil, "", false
+		}
+		f, err := strconv.Unquote(lit.Value)
+		if err != nil || strings.Count(f, "%") != len(call.Args)-1 || strings.Count(f, "%s") != len(call.Args)-1 {
+			return nil, "", false
+		}
+		parts = call.Args[1:]
+		for i := range parts {
+			f = strings.Replace(f, "%s", fmt.Sprintf("{%d}", i), 1)
+		}
+		format = f
+
+	case "Join", "FlattenResourceId":
+		if (sel.Sel.Name == "Join" && !isSelector(call.Fun, "strings", "Join")) || len(call.Args) < 2 {
+			return nil, "", false
+		}
+		lit, ok := call.Args[0].(*ast.CompositeLit)
+		if !ok || len(lit.Elts) < 2 {
+			return nil, "", false
+		}
+		sep, ok := p.attrName(call.Args[1])
+		if !ok {
+			return nil, "", false
+		}
+		parts = lit.Elts
+		placeholders := make([]string, len(parts))
+		for i := range parts {
+			placeholders[i] = fmt.Sprintf("{%d}", i)
+		}
+		format = strings.Join(placeholders, sep)
+
+	default:
+		return nil, "", false
+	}
+
+	if params != nil {
+		mapped := format
+		for i, part := range parts {
+			ident, ok := part.(*ast.Ident)
+			if !ok {
+				return nil, "", false
+			}
+			j := indexOf(params, ident.Name)
+			if j < 0 {
+				return nil, "", false
+			}
+			mapped = strings.Replace(mapped, fmt.Sprintf("{%d}", i), fmt.Sprintf("{@%d}", j), 1)
+		}
+		format = strings.ReplaceAll(mapped, "{@", "{")
+	}
+
+	return parts, format, true
+}
+
+// resolveAttribute resolves an expression to the attribute whose value it is.
+func (p *Package) resolveAttribute(v *idValue, expr ast.Expr, depth int) (string, string, bool) {
+	if depth > 4 {
+		return "", "", false
+	}
+
+	switch e := expr.(type) {
+	case *ast.TypeAssertExpr:
+		if call, ok := e.X.(*ast.CallExpr); ok && isSelector(call.Fun, v.rd, "Get") && len(call.Args) == 1 {
+			if attr, ok := p.attrName(call.Args[0]); ok {
+				return attr, p.source(v.fileName, call.Args[0]), true
+			}
+		}
+
+	case *ast.Ident:
+		if rhs := assignedValue(v.fn, e.Name); rhs != nil {
+			return p.resolveAttribute(v, rhs, depth+1)
+		}
+
+	case *ast.SelectorExpr:
+		// API structure fields named after an attribute, e.g. output.Repository.RepositoryName -> name.
+		// ARNs, IDs and Computed-only attributes are handled as generated values.
+		words := camelWords(e.Sel.Name)
+		for i := range words {
+			attr := strings.Join(words[i:], "_")
+			if attr == "arn" || attr == "id" {
+				break
+			}
+			if a, ok := v.schema[attr]; ok && (a.required || a.optional) {
+				return attr, namesgen.ConstOrQuote(attr), true
+			}
+		}
+
+	case *ast.CallExpr:
+		if isSelector(e.Fun, "aws", "ToString") && len(e.Args) == 1 {
+			return p.resolveAttribute(v, e.Args[0], depth+1)
+		}
+
+		// Generated names, e.g. create.Name(ctx, d.Get(names.AttrName).(string), d.Get(names.AttrNamePrefix).(string)).
+		if root := callRoot(e); root == "create" {
+			var (
+				attr, key string
+				found     bool
+			)
+			ast.Inspect(e, func(n ast.Node) bool {
+				if found {
+					return false
+				}
+				if n, ok := n.(*ast.TypeAssertExpr); ok {
+					attr, key, found = p.resolveAttribute(v, n, depth+1)
+				}
+				return !found
+			})
+			return attr, key, found
+		}
+	}
+
+	return "", "", false
+}
+
+// callRoot returns the package identifier of a (possibly chained) package function call.
+func callRoot(call *ast.CallExpr) string {
+	for {
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return ""
+		}
+		switch x := sel.X.(type) {
+		case *ast.Ident:
+			return x.Name
+		case *ast.CallExpr:
+			call = x
+		default:
+			return ""
+		}
+	}
+}
+
+// assignedValue returns the value first assigned to the named local variable.
+func assignedValue(funcDecl *ast.FuncDecl, name string) ast.Expr {
+	var value ast.Expr
+
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		if value != nil {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) != len(n.Rhs) {
+				return true
+			}
+			for i, lhs := range n.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok && ident.Name == name {
+					value = n.Rhs[i]
+				}
+			}
+		case *ast.ValueSpec:
+			for i, ident := range n.Names {
+				if ident.Name == name && i < len(n.Values) {
+					value = n.Values[i]
+				}
+			}
+		}
+		return true
+	})
+
+	return value
+}
+
+func paramNames(funcDecl *ast.FuncDecl) []string {
+	var names []string
+
+	for _, field := range funcDecl.Type.Params.List {
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+	}
+
+	return names
+}
+
+func singleReturn(funcDecl *ast.FuncDecl) ast.Expr {
+	if funcDecl.Body == nil || len(funcDecl.Body.List) != 1 {
+		return nil
+	}
+	if ret, ok := funcDecl.Body.List[0].(*ast.ReturnStmt); ok && len(ret.Results) == 1 {
+		return ret.Results[0]
+	}
+
+	return nil
+}
+
+func indexOf(s []string, v string) int {
+	for i, e := range s {
+		if e == v {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// importIDHandler generates an Import ID Handler for a resource type with a composite ID.
+// The handler's Parse method uses the package's ID parser, either named after the ID creation function
+// or called on the ID in the Read handler.
+func (p *Package) importIDHandler(r *Resource, fields map[string]ast.Expr, v idValue) error {
+	parse, err := p.idParser(fields, v)
+	if err != nil {
+		return err
+	}
+
+	if r.Name == "" {
+		return fmt.Errorf("cannot name Import ID Handler for ID composed of %s", strings.Join(v.attrs, ", "))
+	}
+	name := lowerFirstWord(r.Name) + "ImportID"
+	if p.isDeclared(name) {
+		return fmt.Errorf("Import ID Handler %s is already declared", name)
+	}
+
+	// Create replaces each part of the ID with the attribute's value.
+	src := p.source(v.fileName, v.expr)
+	offset := p.fset.Position(v.expr.Pos()).Offset
+	type replacement struct {
+		start, end int
+		text       string
+	}
+	var replacements []replacement
+	for part, i := range v.replace {
+		replacements = append(replacements, replacement{
+			start: p.fset.Position(part.Pos()).Offset - offset,
+			end:   p.fset.Position(part.End()).Offset - offset,
+			text:  fmt.Sprintf("d.Get(%s).(string)", v.keys[i]),
+		})
+	}
+	// Replace from the end so that earlier offsets remain valid.
+	slices.SortFunc(replacements, func(a, b replacement) int {
+		return b.start - a.start
+	})
+	for _, rep := range replacements {
+		src = src[:rep.start] + rep.text + src[rep.end:]
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "\nvar _ inttypes.SDKv2ImportID = %s{}\n\ntype %s struct{}\n\n", name, name)
+	fmt.Fprintf(&sb, "func (%s) Create(d *schema.ResourceData) string {\n\treturn %s\n}\n\n", name, src)
+	fmt.Fprintf(&sb, "func (%s) Parse(id string) (string, map[string]string, error) {\n", name)
+	sb.WriteString(parse)
+	sb.WriteString("\tif err != nil {\n\t\treturn \"\", nil, err\n\t}\n\n\tresult := map[string]string{\n")
+	for i, attr := range v.attrs {
+		fmt.Fprintf(&sb, "\t\t%s: %s,\n", namesgen.ConstOrQuote(attr), parsedValue(parse, attr, i))
+	}
+	sb.WriteString("\t}\n\n\treturn id, result, nil\n}\n")
+
+	r.ImportIDHandler = name
+	r.handler = sb.String()
+
+	return nil
+}
+
+// idParser returns the statement which parses an import ID into its parts.
+func (p *Package) idParser(fields map[string]ast.Expr, v idValue) (string, error) {
+	n := len(v.attrs)
+
+	results := func(vars []string) string {
+		return strings.Join(append(vars, "err"), ", ")
+	}
+	vars := make([]string, n)
+	for i, attr := range v.attrs {
+		vars[i] = goName(attr)
+	}
+
+	// parseFooID for createFooID.
+	if name, ok := strings.CutPrefix(v.createFunc, "create"); ok {
+		if funcDecl, _ := p.lookupFunc("parse" + name); funcDecl != nil && funcDecl.Type.Results != nil && funcDecl.Type.Results.NumFields() == n+1 {
+			return fmt.Sprintf("\t%s := parse%s(id)\n", results(vars), name), nil
+		}
+	}
+
+	// Parsers called on the ID in the Read handler.
+	for _, key := range []string{"ReadWithoutTimeout", "ReadContext", "Read"} {
+		ident, ok := fields[key].(*ast.Ident)
+		if !ok {
+			continue
+		}
+		read, fileName := p.lookupFunc(ident.Name)
+		if read == nil {
+			break
+		}
+		rd := resourceDataParam(read)
+
+		var stmt string
+		ast.Inspect(read.Body, func(node ast.Node) bool {
+			if stmt != "" {
+				return false
+			}
+			assign, ok := node.(*ast.AssignStmt)
+			if !ok || len(assign.Rhs) != 1 {
+				return true
+			}
+			call, ok := assign.Rhs[0].(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				return true
+			}
+			if arg, ok := call.Args[0].(*ast.CallExpr); !ok || !isSelector(arg.Fun, rd, "Id") {
+				return true
+			}
+
+			switch fun := call.Fun.(type) {
+			case *ast.Ident:
+				if len(assign.Lhs) == n+1 {
+					stmt = fmt.Sprintf("\t%s := %s(id)\n", results(vars), fun.Name)
+				}
+			case *ast.SelectorExpr:
+				if fun.Sel.Name == "ExpandResourceId" && len(assign.Lhs) == 2 && len(call.Args) >= 2 {
+					if lit, ok := call.Args[1].(*ast.BasicLit); ok && lit.Value == strconv.Itoa(n) {
+						args := make([]string, 0, len(call.Args))
+						args = append(args, "id")
+						for _, arg := range call.Args[1:] {
+							args = append(args, p.source(fileName, arg))
+						}
+						stmt = fmt.Sprintf("\tparts, err := %s(%s)\n", p.source(fileName, fun), strings.Join(args, ", "))
+					}
+				}
+			}
+			return true
+		})
+		if stmt != "" {
+			return stmt, nil
+		}
+		break
+	}
+
+	return "", fmt.Errorf("ID is composed of %s but no import ID parser was found", strings.Join(v.attrs, ", "))
+}
+
+func parsedValue(parse, attr string, i int) string {
+	if strings.HasPrefix(parse, "\tparts, err :=") {
+		return fmt.Sprintf("parts[%d]", i)
+	}
+
+	return goName(attr)
+}
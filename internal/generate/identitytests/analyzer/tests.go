@@ -0,0 +1,578 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package analyzer
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/tests"
+)
+
+// analyzeTests determines the testing annotations and basic test configuration template for a resource type.
+// Existing acceptance tests are used for resource types which do not yet have generated tests.
+func (p *Package) analyzeTests(r *Resource) {
+	if r.Name == "" {
+		r.Reason = "resource type has no name"
+		return
+	}
+
+	var testing []string
+
+	sourceName := strings.TrimSuffix(strings.TrimSuffix(r.FileName, ".go"), "_")
+	tmplPath := filepath.Join(p.Dir, "testdata", "tmpl", sourceName+"_basic.gtpl")
+
+	b, err := os.ReadFile(tmplPath)
+	switch {
+	case err == nil:
+	case errors.Is(err, os.ErrNotExist):
+		if r.hasTesting {
+			r.Reason = "no basic test configuration template"
+			return
+		}
+	default:
+		r.Reason = err.Error()
+		return
+	}
+
+	if !r.hasTesting {
+		derived, err := p.deriveTesting(r)
+		if err != nil {
+			r.Reason = err.Error()
+			return
+		}
+		testing = append(testing, derived...)
+	}
+
+	// An existing template is only updated to add the region template.
+	if b != nil {
+		r.Config = ""
+		if config := string(b); !strings.Contains(config, `template "region"`) {
+			r.Config = p.addRegionTemplate(config)
+		}
+	}
+
+	if v := p.opts.PreIdentityVersion; v != "" && !hasTestingArg(r.doc, "preIdentityVersion") && !hasTestingArg(r.doc, "hasNoPreExistingResource") {
+		testing = append(testing, fmt.Sprintf("preIdentityVersion=%q", v))
+	}
+
+	// Testing annotations inferred with the identity, e.g. idAttrDuplicates, follow.
+	r.Testing = append(testing, r.Testing...)
+
+	if !r.hasTesting {
+		var takesT []string
+		if fn := p.lookupTestFunc("testAccCheck" + r.Name + "Exists"); fn != nil && !takesTesting(fn) {
+			takesT = append(takesT, "existsTakesT=false")
+		}
+		if fn := p.lookupTestFunc("testAccCheck" + r.Name + "Destroy"); fn != nil && !takesTesting(fn) {
+			takesT = append(takesT, "destroyTakesT=false")
+		}
+		if len(takesT) > 0 {
+			r.Testing = append(r.Testing, strings.Join(takesT, ", "))
+		}
+	}
+}
+
+func hasTestingArg(doc *ast.CommentGroup, name string) bool {
+	for _, line := range doc.List {
+		if m := annotationRegexp.FindStringSubmatch(line.Text); len(m) > 0 && m[1] == "Testing" {
+			if _, ok := common.ParseArgs(m[3]).Keyword[name]; ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// deriveTesting derives testing annotations and the basic test configuration template from a resource type's
+// existing basic acceptance test.
+func (p *Package) deriveTesting(r *Resource) ([]string, error) {
+	var testing []string
+
+	basic, fileName := p.basicTest(r.Name)
+	if basic == nil {
+		return nil, errors.New("no basic acceptance test")
+	}
+
+	if exists := p.lookupTestFunc("testAccCheck" + r.Name + "Exists"); exists != nil {
+		if spec := p.existsType(exists); spec != "" {
+			testing = append(testing, fmt.Sprintf("existsType=%q", spec))
+		}
+	} else {
+		testing = append(testing, "hasExistsFunction=false")
+	}
+	if p.lookupTestFunc("testAccCheck"+r.Name+"Destroy") == nil {
+		testing = append(testing, "checkDestroyNoop=true")
+	}
+
+	var testCase *ast.CompositeLit
+	ast.Inspect(basic.Body, func(n ast.Node) bool {
+		if lit, ok := n.(*ast.CompositeLit); ok && isSelector(lit.Type, "resource", "TestCase") && testCase == nil {
+			testCase = lit
+		}
+		return testCase == nil
+	})
+	if testCase == nil {
+		return nil, errors.New("basic acceptance test has no test case")
+	}
+	fields := compositeFields(testCase)
+
+	if preCheck, ok := fields["PreCheck"].(*ast.FuncLit); ok {
+		for _, stmt := range preCheck.Body.List {
+			stmt, ok := stmt.(*ast.ExprStmt)
+			if !ok {
+				continue
+			}
+			call, ok := stmt.X.(*ast.CallExpr)
+			if !ok || isSelector(call.Fun, "acctest", "PreCheck") || len(call.Args) != 2 {
+				continue
+			}
+			if ctx, ok := call.Args[0].(*ast.Ident); !ok || ctx.Name != "ctx" {
+				continue
+			}
+			testing = append(testing, fmt.Sprintf("preCheck=%q", p.source(fileName, call.Fun)))
+		}
+	}
+
+	if strings.HasPrefix(basic.Name.Name, "testAcc") {
+		testing = append(testing, "serialize=true")
+		if p.opts.ProviderNameUpper != nil {
+			if e, ok := p.serialTestEdit(basic.Name.Name, "testAcc"+p.opts.ProviderNameUpper(r.TypeName)+r.Name+"_IdentitySerial"); ok {
+				r.edits = append(r.edits, e)
+			}
+		}
+	}
+
+	steps, ok := fields["Steps"].(*ast.CompositeLit)
+	if !ok || len(steps.Elts) == 0 {
+		return nil, errors.New("basic acceptance test has no steps")
+	}
+
+	var (
+		config       *ast.CallExpr
+		importIgnore []string
+	)
+	for _, step := range steps.Elts {
+		step, ok := step.(*ast.CompositeLit)
+		if !ok {
+			continue
+		}
+		fields := compositeFields(step)
+		if call, ok := fields["Config"].(*ast.CallExpr); ok && config == nil {
+			config = call
+		}
+		if lit, ok := fields["ImportStateVerifyIgnore"].(*ast.CompositeLit); ok {
+			for _, elt := range lit.Elts {
+				if name, ok := p.attrName(elt); ok {
+					importIgnore = append(importIgnore, name)
+				}
+			}
+		}
+	}
+	if config == nil {
+		return nil, errors.New("basic acceptance test has no configuration")
+	}
+
+	tmpl, withRName, err := p.deriveConfig(r, fileName, config)
+	if err != nil {
+		return nil, fmt.Errorf("basic test configuration: %w", err)
+	}
+	r.Config = tmpl
+
+	if !withRName {
+		testing = append(testing, "generator=false")
+	}
+	if len(importIgnore) > 0 {
+		testing = append(testing, fmt.Sprintf("importIgnore=%q", strings.Join(importIgnore, ";")))
+	}
+
+	return testing, nil
+}
+
+// basicTest returns the resource type's basic acceptance test, e.g. TestAccECRRepository_basic.
+func (p *Package) basicTest(name string) (*ast.FuncDecl, string) {
+	var (
+		basic    *ast.FuncDecl
+		fileName string
+	)
+
+	for testFileName, file := range p.tests {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Recv != nil {
+				continue
+			}
+			n := funcDecl.Name.Name
+			if !strings.HasSuffix(n, name+"_basic") || !(strings.HasPrefix(n, "TestAcc") || strings.HasPrefix(n, "testAcc")) {
+				continue
+			}
+			if basic == nil || len(n) < len(basic.Name.Name) {
+				basic, fileName = funcDecl, testFileName
+			}
+		}
+	}
+
+	return basic, fileName
+}
+
+// serialTestEdit adds a serialized test to the existing serialized acceptance tests containing another test, e.g.
+//
+//	"Widget": {
+//		acctest.CtBasic: testAccWidget_basic,
+//		"Identity":      testAccServiceWidget_IdentitySerial,
+//	},
+func (p *Package) serialTestEdit(existing, name string) (edit, bool) {
+	for fileName, file := range p.tests {
+		var tests *ast.CompositeLit
+		ast.Inspect(file, func(n ast.Node) bool {
+			lit, ok := n.(*ast.CompositeLit)
+			if !ok || tests != nil {
+				return tests == nil
+			}
+			for _, elt := range lit.Elts {
+				if kv, ok := elt.(*ast.KeyValueExpr); ok {
+					if ident, ok := kv.Value.(*ast.Ident); ok && ident.Name == existing {
+						tests = lit
+					}
+				}
+			}
+			return tests == nil
+		})
+		if tests == nil {
+			continue
+		}
+
+		offset := p.fset.Position(tests.Elts[len(tests.Elts)-1].End()).Offset
+		return edit{
+			fileName: fileName,
+			start:    offset,
+			end:      offset,
+			text:     fmt.Sprintf(",\n\"Identity\": %s", name),
+		}, true
+	}
+
+	return edit{}, false
+}
+
+func (p *Package) lookupTestFunc(name string) *ast.FuncDecl {
+	for _, file := range p.tests {
+		for _, decl := range file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Name.Name == name {
+				return funcDecl
+			}
+		}
+	}
+
+	return nil
+}
+
+func takesTesting(funcDecl *ast.FuncDecl) bool {
+	for _, field := range funcDecl.Type.Params.List {
+		if star, ok := field.Type.(*ast.StarExpr); ok && isSelector(star.X, "testing", "T") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// existsType returns the type specification, "<package path>;[<package alias>;]<type>", of the value returned by
+// an Exists function.
+func (p *Package) existsType(funcDecl *ast.FuncDecl) string {
+	params := funcDecl.Type.Params.List
+	if len(params) == 0 {
+		return ""
+	}
+	star, ok := params[len(params)-1].Type.(*ast.StarExpr)
+	if !ok {
+		return ""
+	}
+	sel, ok := star.X.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok || pkg.Name == "testing" {
+		return ""
+	}
+
+	fileName := p.fset.Position(funcDecl.Pos()).Filename
+	for _, spec := range p.tests[fileName].Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		base := path.Base(importPath)
+		switch {
+		case spec.Name == nil && base == pkg.Name, spec.Name != nil && spec.Name.Name == base && base == pkg.Name:
+			return fmt.Sprintf("%s;%s.%s", importPath, pkg.Name, sel.Sel.Name)
+		case spec.Name != nil && spec.Name.Name == pkg.Name:
+			return fmt.Sprintf("%s;%s;%s.%s", importPath, pkg.Name, pkg.Name, sel.Sel.Name)
+		}
+	}
+
+	return ""
+}
+
+// deriveConfig derives a basic test configuration template from an acceptance test configuration function call.
+// It returns whether the configuration uses the rName variable.
+func (p *Package) deriveConfig(r *Resource, fileName string, call *ast.CallExpr) (string, bool, error) {
+	c := &configDeriver{
+		p:        p,
+		fileName: fileName,
+	}
+
+	config, err := c.render(call, "")
+	if err != nil {
+		return "", false, err
+	}
+
+	// Region override tests cannot be generated for configurations using multiple providers.
+	if providerRegexp.MatchString(config) || strings.Contains(config, "acctest.ConfigAlternate") || strings.Contains(config, "acctest.ConfigMultiple") {
+		return "", false, errors.New("uses multiple providers")
+	}
+
+	config = strings.TrimLeft(config, "\n")
+	config = strings.TrimRight(config, " \t\n") + "\n"
+	config = p.addRegionTemplate(config)
+	if r.tagged {
+		config = addTagsTemplate(config, r.TypeName)
+	}
+
+	return config, c.withRName, nil
+}
+
+var providerRegexp = regexp.MustCompile(`(?m)^\s*provider\s*=`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+
+type configDeriver struct {
+	p         *Package
+	fileName  string
+	withRName bool
+}
+
+var acctestTemplates = func() *template.Template {
+	t, err := tests.AddCommonTfTemplates(template.New("acctest"))
+	if err != nil {
+		panic(err)
+	}
+	return t
+}()
+
+// render renders a configuration expression as a template.
+// param is the name of the enclosing function's rName parameter, if any.
+func (c *configDeriver) render(expr ast.Expr, param string) (string, error) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind == token.STRING {
+			return strconv.Unquote(e.Value)
+		}
+
+	case *ast.BinaryExpr:
+		if e.Op == token.ADD {
+			x, err := c.render(e.X, param)
+			if err != nil {
+				return "", err
+			}
+			y, err := c.render(e.Y, param)
+			if err != nil {
+				return "", err
+			}
+			return x + y, nil
+		}
+
+	case *ast.CallExpr:
+		switch fun := e.Fun.(type) {
+		case *ast.Ident:
+			return c.renderFunc(fun.Name, e.Args, param)
+
+		case *ast.SelectorExpr:
+			switch {
+			case isSelector(fun, "fmt", "Sprintf"):
+				return c.renderSprintf(e.Args, param)
+
+			case isSelector(fun, "acctest", "ConfigCompose"):
+				var sb strings.Builder
+				for _, arg := range e.Args {
+					s, err := c.render(arg, param)
+					if err != nil {
+						return "", err
+					}
+					sb.WriteString(s)
+				}
+				return sb.String(), nil
+
+			default:
+				name := c.p.source(c.fileName, fun)
+				if acctestTemplates.Lookup(name) == nil {
+					break
+				}
+				var data []string
+				for _, arg := range e.Args {
+					if ident, ok := arg.(*ast.Ident); ok && ident.Name == param {
+						continue
+					}
+					if lit, ok := arg.(*ast.BasicLit); ok && lit.Kind == token.INT {
+						data = append(data, lit.Value)
+						continue
+					}
+					return "", fmt.Errorf("unsupported argument to %s", name)
+				}
+				if len(data) > 1 {
+					break
+				}
+				return fmt.Sprintf("\n{{ template %q%s }}\n", name, strings.Join(append([]string{""}, data...), " ")), nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("unsupported expression at %s", c.p.fset.Position(expr.Pos()))
+}
+
+// renderFunc renders a call to an acceptance test configuration function taking at most an rName argument.
+func (c *configDeriver) renderFunc(name string, args []ast.Expr, param string) (string, error) {
+	funcDecl := c.p.lookupTestFunc(name)
+	if funcDecl == nil {
+		return "", fmt.Errorf("function %s not found", name)
+	}
+
+	params := paramNames(funcDecl)
+	if len(params) != len(args) || len(params) > 1 {
+		return "", fmt.Errorf("unsupported arguments to %s", name)
+	}
+	var inner string
+	if len(args) == 1 {
+		// At the top level the argument is the test's rName variable.
+		if ident, ok := args[0].(*ast.Ident); !ok || (param != "" && ident.Name != param) {
+			return "", fmt.Errorf("unsupported arguments to %s", name)
+		}
+		inner = params[0]
+	}
+
+	body := singleReturn(funcDecl)
+	if body == nil {
+		return "", fmt.Errorf("%s is not a single return statement", name)
+	}
+
+	fileName := c.p.fset.Position(funcDecl.Pos()).Filename
+	saved := c.fileName
+	c.fileName = fileName
+	defer func() { c.fileName = saved }()
+
+	return c.render(body, inner)
+}
+
+var verbRegexp = regexp.MustCompile(`%(\[\d+\])?[a-z%]`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+
+// renderSprintf renders a fmt.Sprintf configuration whose only argument is rName.
+func (c *configDeriver) renderSprintf(args []ast.Expr, param string) (string, error) {
+	if len(args) == 0 {
+		return "", errors.New("fmt.Sprintf without format")
+	}
+	for _, arg := range args[1:] {
+		if ident, ok := arg.(*ast.Ident); !ok || ident.Name != param || param == "" {
+			return "", fmt.Errorf("unsupported fmt.Sprintf argument at %s", c.p.fset.Position(arg.Pos()))
+		}
+	}
+	format, err := c.render(args[0], param)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		sb       strings.Builder
+		last     int
+		quoted   bool
+		rendered error
+	)
+	for _, loc := range verbRegexp.FindAllStringIndex(format, -1) {
+		for _, ch := range format[last:loc[0]] {
+			switch ch {
+			case '"':
+				quoted = !quoted
+			case '\n':
+				quoted = false
+			}
+		}
+		sb.WriteString(format[last:loc[0]])
+		last = loc[1]
+
+		switch verb := format[loc[0]:loc[1]]; verb[len(verb)-1] {
+		case '%':
+			sb.WriteString("%")
+		case 'q':
+			c.withRName = true
+			sb.WriteString("var.rName")
+		case 's':
+			if !quoted {
+				rendered = fmt.Errorf("unsupported unquoted verb %s", verb)
+			}
+			c.withRName = true
+			sb.WriteString("${var.rName}")
+		default:
+			rendered = fmt.Errorf("unsupported verb %s", verb)
+		}
+	}
+	sb.WriteString(format[last:])
+
+	if rendered != nil {
+		return "", rendered
+	}
+
+	return sb.String(), nil
+}
+
+var blockRegexp = regexp.MustCompile(`^(resource|data) "([^"]+)" "[^"]+" \{\s*$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+
+// addRegionTemplate adds the region template to each declaration of a resource or data source of a regional service.
+func (p *Package) addRegionTemplate(config string) string {
+	lines := strings.Split(config, "\n")
+	result := make([]string, 0, len(lines))
+
+	for i, line := range lines {
+		result = append(result, line)
+		m := blockRegexp.FindStringSubmatch(line)
+		if len(m) == 0 || (i+1 < len(lines) && strings.Contains(lines[i+1], `template "region"`)) {
+			continue
+		}
+		if slices.ContainsFunc(p.opts.GlobalResourcePrefixes, func(prefix string) bool {
+			return strings.HasPrefix(m[2], prefix)
+		}) {
+			continue
+		}
+		result = append(result, `{{- template "region" }}`)
+	}
+
+	return strings.Join(result, "\n")
+}
+
+// addTagsTemplate adds the tags template as the last line of the tested resource's declaration.
+func addTagsTemplate(config, typeName string) string {
+	lines := strings.Split(config, "\n")
+	header := fmt.Sprintf("resource %q %q {", typeName, "test")
+
+	for i, line := range lines {
+		if strings.TrimSpace(line) != header {
+			continue
+		}
+		for j := i + 1; j < len(lines); j++ {
+			if lines[j] == "}" {
+				return strings.Join(append(lines[:j:j], append([]string{`{{- template "tags" . }}`}, lines[j:]...)...), "\n")
+			}
+		}
+	}
+
+	return config
+}
@@ -8,6 +8,7 @@ package main
 import (
 	_ "embed"
 	"errors"
+	"flag"
 	"fmt"
 	"go/ast"
 	"go/parser"
@@ -25,15 +26,23 @@ import (
 	"github.com/dlclark/regexp2" // Regexps include Perl syntax.
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/identitytests/analyzer"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/tests"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	"github.com/hashicorp/terraform-provider-aws/names/data"
 	namesgen "github.com/hashicorp/terraform-provider-aws/names/generate"
 )
 
+var (
+	annotate = flag.Bool("Annotate", false, "whether to add inferred Resource Identity to resource types before generating")
+	report   = flag.Bool("Report", false, "whether to only report inferred Resource Identity")
+)
+
 func main() {
 	failed := false
 
+	flag.Parse()
+
 	g := common.NewGenerator()
 
 	serviceData, err := data.ReadAllServiceData()
@@ -81,6 +90,14 @@ func main() {
 		g.Fatalf("service package not found: %s", servicePackage)
 	}
 
+	if *annotate || *report {
+		inferIdentity(g, serviceData, svc, *annotate)
+
+		if *report {
+			return
+		}
+	}
+
 	// Look for Terraform Plugin Framework and SDK resource and data source annotations.
 	// These annotations are implemented as comments on factory functions.
 	v := &visitor{
@@ -280,6 +297,63 @@ var (
 	v5_100_0 = version.Must(version.NewVersion("5.100.0"))
 )
 
+// inferIdentity reports the Resource Identity inferred for resource types without it and optionally adds it.
+func inferIdentity(g *common.Generator, serviceData []data.ServiceRecord, svc serviceRecords, apply bool) {
+	attrNames, err := analyzer.LoadAttrNames("../../../names/attr_consts_gen.go")
+	if err != nil {
+		g.Fatalf("loading attribute names: %s", err)
+	}
+
+	b, err := os.ReadFile("../../../version/VERSION")
+	if err != nil {
+		g.Fatalf("reading provider version: %s", err)
+	}
+
+	var globalResourcePrefixes []string
+	for _, l := range serviceData {
+		if l.IsGlobal() {
+			globalResourcePrefixes = append(globalResourcePrefixes, l.ResourcePrefix())
+		}
+	}
+
+	p, err := analyzer.Analyze(".", analyzer.Options{
+		AttrNames:              attrNames,
+		DocsDir:                "../../../website/docs/r",
+		GlobalResourcePrefixes: globalResourcePrefixes,
+		PreIdentityVersion:     "v" + strings.TrimSpace(string(b)),
+		ProviderNameUpper: func(typeName string) string {
+			providerNameUpper, err := svc.ProviderNameUpper(typeName)
+			if err != nil {
+				g.Fatalf("determining provider name: %s", err)
+			}
+			return providerNameUpper
+		},
+	})
+	if err != nil {
+		g.Fatalf("analyzing resource types: %s", err)
+	}
+
+	for _, r := range p.Resources {
+		if !r.Applicable() {
+			g.Infof("%s: skipped: %s", r.TypeName, r.Reason)
+			continue
+		}
+
+		g.Infof("%s: %s", r.TypeName, strings.Join(r.Annotations(), " "))
+	}
+
+	if !apply {
+		return
+	}
+
+	applied, err := p.Apply()
+	if err != nil {
+		g.Fatalf("adding Resource Identity: %s", err)
+	}
+
+	g.Infof("Added Resource Identity to %d resource types", len(applied))
+}
+
 type serviceRecords struct {
 	primary    data.ServiceRecord
 	additional []data.ServiceRecord
@@ -0,0 +1,325 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	namesgen "github.com/hashicorp/terraform-provider-aws/names/generate"
+)
+
+func main() {
+	g := common.NewGenerator()
+
+	serviceData, err := data.ReadAllServiceData()
+
+	if err != nil {
+		g.Fatalf("error reading service data: %s", err)
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating List Resources for internal/service/%s", servicePackage)
+
+	i := slices.IndexFunc(serviceData, func(l data.ServiceRecord) bool {
+		if p := l.SplitPackageRealPackage(); p != "" {
+			return p == servicePackage && l.ProviderPackage() == p
+		}
+		return l.ProviderPackage() == servicePackage
+	})
+	if i < 0 {
+		g.Fatalf("service package not found: %s", servicePackage)
+	}
+	svc := serviceData[i]
+
+	// Look for SDK resource annotations.
+	// These annotations are implemented as comments on factory functions.
+	v := &visitor{
+		g: g,
+	}
+
+	v.processDir(".")
+
+	if err := errors.Join(v.errs...); err != nil {
+		g.Fatalf("%s", err.Error())
+	}
+
+	for _, resource := range v.listResources {
+		resource.PackageName = servicePackage
+		resource.GoV2Package = svc.GoV2Package()
+		resource.ProviderNameUpper = svc.ProviderNameUpper()
+		resource.HumanFriendly = svc.HumanFriendly() + " " + resource.HumanName
+		if svc.IsGlobal() {
+			resource.IsGlobal = true
+		}
+
+		filename := strings.TrimSuffix(strings.TrimSuffix(resource.FileName, ".go"), "_") + "_list_gen.go"
+
+		d := g.NewGoFileDestination(filename)
+
+		if err := d.BufferTemplate("listresource", listResourceTmpl, resource); err != nil {
+			g.Fatalf("generating %s List Resource: %s", resource.TypeName, err)
+		}
+
+		if err := d.Write(); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+	}
+}
+
+type ResourceDatum struct {
+	FileName          string
+	FactoryName       string
+	ReadFuncName      string
+	TypeName          string
+	HumanName         string // From the resource type's name, e.g. "Job".
+	Name              string // Go identifier, e.g. "Job".
+	VarName           string // Go identifier, e.g. "job".
+	IsGlobal          bool
+	IdentityAttribute string // Resource attribute set from the resource ID when the resource is not read.
+	Paginator         string
+	Items             string
+	IDField           string
+	DisplayNameField  string
+
+	PackageName       string
+	GoV2Package       string
+	ProviderNameUpper string
+	HumanFriendly     string
+}
+
+//go:embed list_resource.go.gtpl
+var listResourceTmpl string
+
+// Annotation processing.
+var (
+	annotation = regexp.MustCompile(`^//\s*@([0-9A-Za-z]+)(\((.*)\))?\s*$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+)
+
+var (
+	sdkNameRegexp = regexp.MustCompile(`^(?i:Resource)(\w+)$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+)
+
+type visitor struct {
+	errs []error
+	g    *common.Generator
+
+	fileName     string
+	functionName string
+	packageName  string
+
+	listResources []ResourceDatum
+}
+
+// processDir scans a single service package directory and processes contained Go sources files.
+func (v *visitor) processDir(path string) {
+	fileSet := token.NewFileSet()
+	packageMap, err := parser.ParseDir(fileSet, path, func(fi os.FileInfo) bool {
+		// Skip tests.
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		v.errs = append(v.errs, fmt.Errorf("parsing (%s): %w", path, err))
+
+		return
+	}
+
+	for name, pkg := range packageMap {
+		v.packageName = name
+
+		for name, file := range pkg.Files {
+			v.fileName = name
+
+			v.processFile(file)
+
+			v.fileName = ""
+		}
+
+		v.packageName = ""
+	}
+}
+
+// processFile processes a single Go source file.
+func (v *visitor) processFile(file *ast.File) {
+	ast.Walk(v, file)
+}
+
+// processFuncDecl processes a single Go function.
+// The function's comments are scanned for annotations indicating an SDK resource with a generated List Resource.
+func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
+	v.functionName = funcDecl.Name.Name
+
+	d := ResourceDatum{
+		FileName:    v.fileName,
+		FactoryName: v.functionName,
+	}
+	var (
+		identity      common.ResourceIdentity
+		goImports     []common.GoImport
+		isSDKResource bool
+		hasList       bool
+	)
+
+	for _, line := range funcDecl.Doc.List {
+		line := line.Text
+
+		m := annotation.FindStringSubmatch(line)
+		if len(m) == 0 {
+			continue
+		}
+
+		annotationName := m[1]
+		args := common.ParseArgs(m[3])
+
+		switch annotationName {
+		case "SDKResource":
+			isSDKResource = true
+
+			if len(args.Positional) == 0 {
+				v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+			d.TypeName = args.Positional[0]
+
+			if attr, ok := args.Keyword["name"]; ok {
+				d.HumanName = strings.ReplaceAll(attr, "\"", "")
+			}
+
+		case "Region":
+			if attr, ok := args.Keyword["global"]; ok {
+				if global, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid Region/global value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.IsGlobal = global
+				}
+			}
+
+		case "ArnIdentity", "IdentityAttribute", "SingletonIdentity", "CustomInherentRegionIdentity":
+			if err := common.ParseResourceIdentity(annotationName, args, common.ImplementationSDK, &identity, &goImports); err != nil {
+				v.errs = append(v.errs, fmt.Errorf("%s: %w", fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+			}
+
+		case "ListResource":
+			hasList = true
+
+			for _, k := range []string{"paginator", "items", "id"} {
+				if _, ok := args.Keyword[k]; !ok {
+					v.errs = append(v.errs, fmt.Errorf("annotation \"@ListResource\": missing required keyword parameter %q: %s", k, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				}
+			}
+			for k, attr := range args.Keyword {
+				switch k {
+				case "paginator":
+					d.Paginator = attr
+				case "items":
+					d.Items = attr
+				case "id":
+					d.IDField = attr
+				case "displayName":
+					d.DisplayNameField = attr
+				default:
+					v.errs = append(v.errs, fmt.Errorf("annotation \"@ListResource\": unexpected keyword parameter %q: %s", k, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				}
+			}
+		}
+	}
+
+	if !hasList {
+		return
+	}
+
+	if !isSDKResource {
+		v.errs = append(v.errs, fmt.Errorf("annotation \"@ListResource\" is only supported on SDK resources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+		return
+	}
+
+	m := sdkNameRegexp.FindStringSubmatch(v.functionName)
+	if m == nil {
+		v.errs = append(v.errs, fmt.Errorf("unable to determine resource name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+		return
+	}
+	d.Name = m[1]
+	if d.HumanName == "" {
+		d.HumanName = d.Name
+	}
+	d.VarName = names.ToLowerCamelCase(d.HumanName)
+
+	if d.DisplayNameField == "" {
+		d.DisplayNameField = d.IDField
+	}
+
+	// The resource ID duplicates the identity attribute for SDK resources with ARN or single-parameter identity.
+	switch {
+	case identity.IsARNIdentity():
+		d.IdentityAttribute = namesgen.ConstOrQuote(identity.IdentityAttributeName())
+	case identity.IsSingleParameterizedIdentity():
+		attr := identity.IdentityAttributes[0]
+		name := attr.ResourceAttributeName_
+		if name == "" {
+			name = attr.Name_
+		}
+		if name != names.AttrID {
+			d.IdentityAttribute = namesgen.ConstOrQuote(name)
+		}
+	default:
+		v.errs = append(v.errs, fmt.Errorf("annotation \"@ListResource\" requires ARN or single-parameter Resource Identity: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+		return
+	}
+
+	d.ReadFuncName = readFuncName(funcDecl)
+	if d.ReadFuncName == "" {
+		v.errs = append(v.errs, fmt.Errorf("no Read handler: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+		return
+	}
+
+	v.listResources = append(v.listResources, d)
+}
+
+// readFuncName returns the name of the Read handler in a resource factory function's schema.Resource.
+func readFuncName(funcDecl *ast.FuncDecl) string {
+	var name string
+
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		kv, ok := n.(*ast.KeyValueExpr)
+		if !ok || name != "" {
+			return name == ""
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok || (key.Name != "ReadWithoutTimeout" && key.Name != "ReadContext") {
+			return true
+		}
+		if value, ok := kv.Value.(*ast.Ident); ok {
+			name = value.Name
+		}
+		return false
+	})
+
+	return name
+}
+
+// Visit is called for each node visited by ast.Walk.
+func (v *visitor) Visit(node ast.Node) ast.Visitor {
+	// Look at functions (not methods) with comments.
+	if funcDecl, ok := node.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Doc != nil {
+		v.processFuncDecl(funcDecl)
+	}
+
+	return v
+}
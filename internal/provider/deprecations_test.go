@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider_test
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// TestDeprecationRegistry verifies that the deprecation registry in the names package matches the provider's schemas.
+func TestDeprecationRegistry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	factory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := factory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemas := map[names.DeprecationKind]map[string]*tfprotov5.Schema{
+		names.DeprecationKindResource:   response.ResourceSchemas,
+		names.DeprecationKindDataSource: response.DataSourceSchemas,
+	}
+
+	// Every registered deprecation must be deprecated in the schema.
+	for _, d := range names.Deprecations() {
+		s, ok := schemas[d.Kind][d.TypeName]
+		if !ok {
+			t.Errorf("%s: not found in provider schema", d.Address())
+			continue
+		}
+
+		if d.Attribute == "" {
+			if !s.Block.Deprecated {
+				t.Errorf("%s: registered as deprecated but not deprecated in schema", d.Address())
+			}
+			continue
+		}
+
+		deprecated, ok := deprecatedInBlock(s.Block, strings.Split(d.Attribute, "."))
+		if !ok {
+			t.Errorf("%s: attribute not found in provider schema", d.Address())
+		} else if !deprecated {
+			t.Errorf("%s: registered as deprecated but not deprecated in schema", d.Address())
+		}
+	}
+
+	// Every deprecated resource, data source, and configurable attribute or block must be registered.
+	for kind, m := range schemas {
+		for _, typeName := range slices.Sorted(maps.Keys(m)) {
+			block := m[typeName].Block
+			if block.Deprecated {
+				if _, ok := names.LookupDeprecation(kind, typeName, ""); !ok {
+					t.Errorf("%s %s: deprecated in schema but not registered", kind, typeName)
+				}
+				continue
+			}
+
+			walkDeprecated(block, nil, func(path string) {
+				if _, ok := names.LookupDeprecation(kind, typeName, path); !ok {
+					t.Errorf("%s %s.%s: deprecated in schema but not registered", kind, typeName, path)
+				}
+			})
+		}
+	}
+}
+
+func deprecatedInBlock(block *tfprotov5.SchemaBlock, path []string) (bool, bool) {
+	name, rest := path[0], path[1:]
+
+	for _, v := range block.Attributes {
+		if v.Name == name && len(rest) == 0 {
+			return v.Deprecated, true
+		}
+	}
+
+	for _, v := range block.BlockTypes {
+		if v.TypeName != name {
+			continue
+		}
+		if len(rest) == 0 {
+			return v.Block.Deprecated, true
+		}
+		return deprecatedInBlock(v.Block, rest)
+	}
+
+	return false, false
+}
+
+func walkDeprecated(block *tfprotov5.SchemaBlock, path []string, f func(string)) {
+	for _, v := range block.Attributes {
+		if v.Deprecated && (v.Required || v.Optional) {
+			f(strings.Join(append(slices.Clone(path), v.Name), "."))
+		}
+	}
+
+	for _, v := range block.BlockTypes {
+		p := append(slices.Clone(path), v.TypeName)
+		if v.Block.Deprecated {
+			f(strings.Join(p, "."))
+			continue
+		}
+		walkDeprecated(v.Block, p, f)
+	}
+}
@@ -35,6 +35,7 @@ func validateInContextRegionInPartition(ctx context.Context, c awsClient) diag.D
 }
 
 type dataSourceInjectRegionAttributeInterceptor struct {
+	typeName     string
 	isDeprecated bool
 }
 
@@ -44,7 +45,9 @@ func (r dataSourceInjectRegionAttributeInterceptor) schema(ctx context.Context,
 		if _, ok := response.Schema.Attributes[names.AttrRegion]; !ok {
 			// Inject a top-level "region" attribute.
 			if r.isDeprecated {
-				response.Schema.Attributes[names.AttrRegion] = datasourceattribute.RegionDeprecated()
+				attribute := datasourceattribute.RegionDeprecated()
+				attribute.DeprecationMessage = names.DataSourceDeprecationMessage(r.typeName, names.AttrRegion)
+				response.Schema.Attributes[names.AttrRegion] = attribute
 			} else {
 				response.Schema.Attributes[names.AttrRegion] = datasourceattribute.Region()
 			}
@@ -53,8 +56,9 @@ func (r dataSourceInjectRegionAttributeInterceptor) schema(ctx context.Context,
 }
 
 // dataSourceInjectRegionAttribute injects a top-level "region" attribute into a data source's schema.
-func dataSourceInjectRegionAttribute(isDeprecated bool) dataSourceSchemaInterceptor {
+func dataSourceInjectRegionAttribute(typeName string, isDeprecated bool) dataSourceSchemaInterceptor {
 	return &dataSourceInjectRegionAttributeInterceptor{
+		typeName:     typeName,
 		isDeprecated: isDeprecated,
 	}
 }
@@ -173,6 +177,7 @@ func ephemeralResourceValidateRegion() ephemeralResourceORCInterceptor {
 }
 
 type resourceInjectRegionAttributeInterceptor struct {
+	typeName     string
 	isDeprecated bool
 }
 
@@ -182,7 +187,9 @@ func (r resourceInjectRegionAttributeInterceptor) schema(ctx context.Context, op
 		if _, ok := response.Schema.Attributes[names.AttrRegion]; !ok {
 			// Inject a top-level "region" attribute.
 			if r.isDeprecated {
-				response.Schema.Attributes[names.AttrRegion] = resourceattribute.RegionDeprecated()
+				attribute := resourceattribute.RegionDeprecated()
+				attribute.DeprecationMessage = names.ResourceDeprecationMessage(r.typeName, names.AttrRegion)
+				response.Schema.Attributes[names.AttrRegion] = attribute
 			} else {
 				response.Schema.Attributes[names.AttrRegion] = resourceattribute.Region()
 			}
@@ -191,8 +198,9 @@ func (r resourceInjectRegionAttributeInterceptor) schema(ctx context.Context, op
 }
 
 // resourceInjectRegionAttribute injects a top-level "region" attribute into a resource's schema.
-func resourceInjectRegionAttribute(isDeprecated bool) resourceSchemaInterceptor {
+func resourceInjectRegionAttribute(typeName string, isDeprecated bool) resourceSchemaInterceptor {
 	return &resourceInjectRegionAttributeInterceptor{
+		typeName:     typeName,
 		isDeprecated: isDeprecated,
 	}
 }
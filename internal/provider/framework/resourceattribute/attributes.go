@@ -18,11 +18,12 @@ var Region = sync.OnceValue(func() schema.StringAttribute {
 	}
 })
 
+// RegionDeprecated is the top-level "region" attribute of types whose per-resource Region override is deprecated.
+// The caller sets the attribute's DeprecationMessage from the deprecation registry.
 var RegionDeprecated = sync.OnceValue(func() schema.StringAttribute {
 	return schema.StringAttribute{
-		Optional:           true,
-		Computed:           true,
-		Description:        names.ResourceTopLevelRegionAttributeDescription,
-		DeprecationMessage: "This attribute will be removed in a future version of the provider.",
+		Optional:    true,
+		Computed:    true,
+		Description: names.ResourceTopLevelRegionAttributeDescription,
 	}
 })
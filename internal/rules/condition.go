@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Condition is a predicate on the configuration that enables a Rule.
+type Condition interface {
+	// holds returns whether the condition holds in the scope.
+	// known is false if the condition depends on an unknown value.
+	holds(scope value) (holds bool, known bool)
+	// describe returns a description of the condition in the scope for use in diagnostics.
+	describe(scope value) string
+}
+
+// Equals returns a condition that holds when the attribute equals the value.
+// The value must be a string, bool or number.
+func Equals(attribute string, v any) Condition {
+	return equalsCondition{
+		attribute: attribute,
+		value:     v,
+	}
+}
+
+// NotEquals returns a condition that holds when the attribute is set and does not equal the value.
+func NotEquals(attribute string, v any) Condition {
+	return notEqualsCondition{
+		attribute: attribute,
+		value:     v,
+	}
+}
+
+// IsSet returns a condition that holds when the attribute is set.
+func IsSet(attribute string) Condition {
+	return isSetCondition{
+		attribute: attribute,
+		set:       true,
+	}
+}
+
+// IsNotSet returns a condition that holds when the attribute is not set.
+func IsNotSet(attribute string) Condition {
+	return isSetCondition{
+		attribute: attribute,
+	}
+}
+
+type equalsCondition struct {
+	attribute string
+	value     any
+}
+
+func (c equalsCondition) holds(scope value) (bool, bool) {
+	v := scope.Attribute(c.attribute)
+
+	if v.IsUnknown() {
+		return false, false
+	}
+
+	if v.IsNull() {
+		return false, true
+	}
+
+	return primitiveEqual(v.Primitive(), c.value), true
+}
+
+func (c equalsCondition) describe(scope value) string {
+	return fmt.Sprintf("%q is %s", scope.Attribute(c.attribute).Path(), formatPrimitive(c.value))
+}
+
+type notEqualsCondition struct {
+	attribute string
+	value     any
+}
+
+func (c notEqualsCondition) holds(scope value) (bool, bool) {
+	v := scope.Attribute(c.attribute)
+
+	if v.IsUnknown() {
+		return false, false
+	}
+
+	if v.IsNull() {
+		return false, true
+	}
+
+	return !primitiveEqual(v.Primitive(), c.value), true
+}
+
+func (c notEqualsCondition) describe(scope value) string {
+	return fmt.Sprintf("%q is not %s", scope.Attribute(c.attribute).Path(), formatPrimitive(c.value))
+}
+
+type isSetCondition struct {
+	attribute string
+	set       bool
+}
+
+func (c isSetCondition) holds(scope value) (bool, bool) {
+	v := scope.Attribute(c.attribute)
+
+	if v.IsUnknown() {
+		return false, false
+	}
+
+	return isSet(v) == c.set, true
+}
+
+func (c isSetCondition) describe(scope value) string {
+	path := scope.Attribute(c.attribute).Path()
+
+	if c.set {
+		return fmt.Sprintf("%q is specified", path)
+	}
+
+	return fmt.Sprintf("%q is not specified", path)
+}
+
+// primitiveEqual returns whether a primitive configuration value (string, bool or *big.Float) equals a Go value.
+func primitiveEqual(got, want any) bool {
+	switch got := got.(type) {
+	case string:
+		want, ok := want.(string)
+		return ok && got == want
+
+	case bool:
+		want, ok := want.(bool)
+		return ok && got == want
+
+	case *big.Float:
+		want, ok := bigFloat(want)
+		return ok && got.Cmp(want) == 0
+	}
+
+	return false
+}
+
+func bigFloat(v any) (*big.Float, bool) {
+	switch v := v.(type) {
+	case int:
+		return big.NewFloat(float64(v)), true
+	case int32:
+		return big.NewFloat(float64(v)), true
+	case int64:
+		return new(big.Float).SetInt64(v), true
+	case float32:
+		return big.NewFloat(float64(v)), true
+	case float64:
+		return big.NewFloat(v), true
+	case *big.Float:
+		return v, true
+	}
+
+	return nil, false
+}
+
+func formatPrimitive(v any) string {
+	if v, ok := v.(string); ok {
+		return fmt.Sprintf("%q", v)
+	}
+
+	return fmt.Sprintf("%v", v)
+}
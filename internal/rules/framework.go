@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	diagnosticSummary = "Invalid Attribute Combination"
+)
+
+// ResourceConfigValidator returns a Plugin Framework resource ConfigValidator that validates the configuration against the rules.
+func ResourceConfigValidator(rules ...Rule) resource.ConfigValidator {
+	return configValidator{
+		rules: rules,
+	}
+}
+
+// DataSourceConfigValidator returns a Plugin Framework data source ConfigValidator that validates the configuration against the rules.
+func DataSourceConfigValidator(rules ...Rule) datasource.ConfigValidator {
+	return configValidator{
+		rules: rules,
+	}
+}
+
+var (
+	_ datasource.ConfigValidator = configValidator{}
+	_ resource.ConfigValidator   = configValidator{}
+)
+
+type configValidator struct {
+	rules []Rule
+}
+
+func (v configValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v configValidator) MarkdownDescription(context.Context) string {
+	return "Validates the configuration against declarative cross-attribute rules"
+}
+
+func (v configValidator) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+func (v configValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+func (v configValidator) validate(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	root, err := config.Schema.Type().ValueFromTerraform(ctx, config.Raw)
+	if err != nil {
+		diags.AddError("Reading configuration", err.Error())
+		return diags
+	}
+
+	diags.Append(validateAttr(ctx, root, v.rules)...)
+
+	return diags
+}
+
+// validateAttr validates a Plugin Framework configuration value against the rules.
+func validateAttr(ctx context.Context, config attr.Value, rules []Rule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, v := range evaluate(attrValue{ctx: ctx, path: path.Empty(), value: config}, rules) {
+		if p := v.value.(attrValue).path; len(p.Steps()) > 0 {
+			diags.AddAttributeError(p, diagnosticSummary, v.detail)
+		} else {
+			diags.AddError(diagnosticSummary, v.detail)
+		}
+	}
+
+	return diags
+}
+
+var _ value = attrValue{}
+
+type attrValue struct {
+	ctx   context.Context
+	path  path.Path
+	value attr.Value
+}
+
+func (v attrValue) Path() string {
+	return v.path.String()
+}
+
+func (v attrValue) IsNull() bool {
+	return v.value == nil || v.value.IsNull()
+}
+
+func (v attrValue) IsUnknown() bool {
+	return v.value != nil && v.value.IsUnknown()
+}
+
+func (v attrValue) Attribute(name string) value {
+	child := attrValue{ctx: v.ctx, path: v.path.AtName(name)}
+
+	if v.IsNull() || v.IsUnknown() {
+		return child
+	}
+
+	if o, ok := v.underlying().(basetypes.ObjectValuable); ok {
+		if o, d := o.ToObjectValue(v.ctx); !d.HasError() {
+			child.value = o.Attributes()[name]
+		}
+	}
+
+	return child
+}
+
+func (v attrValue) Elements() ([]value, bool) {
+	var (
+		elements []attr.Value
+		set      bool
+	)
+
+	switch t := v.underlying().(type) {
+	case basetypes.ListValuable:
+		l, d := t.ToListValue(v.ctx)
+		if d.HasError() {
+			return nil, true
+		}
+		elements = l.Elements()
+
+	case basetypes.SetValuable:
+		s, d := t.ToSetValue(v.ctx)
+		if d.HasError() {
+			return nil, true
+		}
+		elements, set = s.Elements(), true
+
+	case basetypes.TupleValue:
+		elements = t.Elements()
+
+	default:
+		return nil, false
+	}
+
+	values := make([]value, 0, len(elements))
+	for i, e := range elements {
+		p := v.path.AtListIndex(i)
+		if set {
+			p = v.path.AtSetValue(e)
+		}
+		values = append(values, attrValue{ctx: v.ctx, path: p, value: e})
+	}
+
+	return values, true
+}
+
+func (v attrValue) Primitive() any {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	switch t := v.underlying().(type) {
+	case basetypes.StringValuable:
+		if s, d := t.ToStringValue(v.ctx); !d.HasError() {
+			return s.ValueString()
+		}
+
+	case basetypes.BoolValuable:
+		if b, d := t.ToBoolValue(v.ctx); !d.HasError() {
+			return b.ValueBool()
+		}
+
+	case basetypes.NumberValuable:
+		if n, d := t.ToNumberValue(v.ctx); !d.HasError() {
+			return n.ValueBigFloat()
+		}
+
+	case basetypes.Int64Valuable:
+		if n, d := t.ToInt64Value(v.ctx); !d.HasError() {
+			return new(big.Float).SetInt64(n.ValueInt64())
+		}
+
+	case basetypes.Int32Valuable:
+		if n, d := t.ToInt32Value(v.ctx); !d.HasError() {
+			return big.NewFloat(float64(n.ValueInt32()))
+		}
+
+	case basetypes.Float64Valuable:
+		if n, d := t.ToFloat64Value(v.ctx); !d.HasError() {
+			return big.NewFloat(n.ValueFloat64())
+		}
+
+	case basetypes.Float32Valuable:
+		if n, d := t.ToFloat32Value(v.ctx); !d.HasError() {
+			return big.NewFloat(float64(n.ValueFloat32()))
+		}
+	}
+
+	return nil
+}
+
+// underlying returns the underlying value of a dynamic value.
+func (v attrValue) underlying() attr.Value {
+	if t, ok := v.value.(basetypes.DynamicValuable); ok {
+		if d, diags := t.ToDynamicValue(v.ctx); !diags.HasError() {
+			return d.UnderlyingValue()
+		}
+	}
+
+	return v.value
+}
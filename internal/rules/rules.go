@@ -0,0 +1,303 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ruleKind int
+
+const (
+	ruleKindRequired ruleKind = iota
+	ruleKindForbidden
+	ruleKindExactlyOneOf
+	ruleKindAtLeastOneOf
+	ruleKindAtMostOneOf
+	ruleKindRequiredWith
+	ruleKindConflictsWith
+	ruleKindNested
+)
+
+// Rule is a declarative cross-attribute validation rule.
+// Attribute names are relative to the rule's scope, the resource's root or a block passed to Nested.
+type Rule struct {
+	kind       ruleKind
+	attributes []string
+	others     []string
+	when       []Condition
+	rules      []Rule
+}
+
+// Required returns a rule that all the attributes must be set.
+// It is usually combined with When, e.g. `Required("y").When(Equals("z", "foo"))`.
+func Required(attributes ...string) Rule {
+	return Rule{
+		kind:       ruleKindRequired,
+		attributes: attributes,
+	}
+}
+
+// Forbidden returns a rule that none of the attributes may be set.
+// It is usually combined with When.
+func Forbidden(attributes ...string) Rule {
+	return Rule{
+		kind:       ruleKindForbidden,
+		attributes: attributes,
+	}
+}
+
+// ExactlyOneOf returns a rule that exactly one of the attributes must be set.
+func ExactlyOneOf(attributes ...string) Rule {
+	return Rule{
+		kind:       ruleKindExactlyOneOf,
+		attributes: attributes,
+	}
+}
+
+// AtLeastOneOf returns a rule that at least one of the attributes must be set.
+func AtLeastOneOf(attributes ...string) Rule {
+	return Rule{
+		kind:       ruleKindAtLeastOneOf,
+		attributes: attributes,
+	}
+}
+
+// AtMostOneOf returns a rule that at most one of the attributes may be set.
+func AtMostOneOf(attributes ...string) Rule {
+	return Rule{
+		kind:       ruleKindAtMostOneOf,
+		attributes: attributes,
+	}
+}
+
+// RequiredWith returns a rule that if the attribute is set, all the others must be set.
+func RequiredWith(attribute string, others ...string) Rule {
+	return Rule{
+		kind:       ruleKindRequiredWith,
+		attributes: []string{attribute},
+		others:     others,
+	}
+}
+
+// ConflictsWith returns a rule that if the attribute is set, none of the others may be set.
+func ConflictsWith(attribute string, others ...string) Rule {
+	return Rule{
+		kind:       ruleKindConflictsWith,
+		attributes: []string{attribute},
+		others:     others,
+	}
+}
+
+// Nested returns a rule that applies rules to the block.
+// For a list or set block the rules are applied to each element.
+func Nested(block string, rules ...Rule) Rule {
+	return Rule{
+		kind:       ruleKindNested,
+		attributes: []string{block},
+		rules:      rules,
+	}
+}
+
+// When returns a copy of the rule that only applies when all the conditions hold.
+// Conditions are evaluated in the rule's scope.
+func (r Rule) When(conditions ...Condition) Rule {
+	r.when = append(append([]Condition(nil), r.when...), conditions...)
+	return r
+}
+
+// violation is a rule violation at an attribute path.
+type violation struct {
+	value  value
+	detail string
+}
+
+// evaluate evaluates the rules against the scope value, returning any violations.
+func evaluate(scope value, rules []Rule) []violation {
+	var violations []violation
+
+	for _, rule := range rules {
+		violations = append(violations, rule.evaluate(scope)...)
+	}
+
+	return violations
+}
+
+func (r Rule) evaluate(scope value) []violation {
+	when := make([]string, 0, len(r.when))
+	for _, condition := range r.when {
+		holds, known := condition.holds(scope)
+		// Delay validation until all conditions have a known value.
+		if !known || !holds {
+			return nil
+		}
+		when = append(when, condition.describe(scope))
+	}
+
+	if r.kind == ruleKindNested {
+		return r.evaluateNested(scope)
+	}
+
+	values := attributeValues(scope, r.attributes)
+	others := attributeValues(scope, r.others)
+
+	// Delay validation until all involved attributes have a known value.
+	for _, v := range values {
+		if v.IsUnknown() {
+			return nil
+		}
+	}
+	for _, v := range others {
+		if v.IsUnknown() {
+			return nil
+		}
+	}
+
+	var suffix string
+	if len(when) > 0 {
+		suffix = " when " + strings.Join(when, " and ")
+	}
+
+	var violations []violation
+
+	switch r.kind {
+	case ruleKindRequired:
+		for _, v := range values {
+			if !isSet(v) {
+				violations = append(violations, violation{
+					value:  v,
+					detail: fmt.Sprintf("Attribute %q must be specified%s.", v.Path(), suffix),
+				})
+			}
+		}
+
+	case ruleKindForbidden:
+		for _, v := range values {
+			if isSet(v) {
+				violations = append(violations, violation{
+					value:  v,
+					detail: fmt.Sprintf("Attribute %q cannot be specified%s.", v.Path(), suffix),
+				})
+			}
+		}
+
+	case ruleKindExactlyOneOf, ruleKindAtLeastOneOf, ruleKindAtMostOneOf:
+		var set []value
+		for _, v := range values {
+			if isSet(v) {
+				set = append(set, v)
+			}
+		}
+
+		switch {
+		case len(set) == 0 && r.kind != ruleKindAtMostOneOf:
+			want := "one (and only one)"
+			if r.kind == ruleKindAtLeastOneOf {
+				want = "at least one"
+			}
+			violations = append(violations, violation{
+				value:  scope,
+				detail: fmt.Sprintf("No attribute specified when %s of %s is required%s.", want, paths(values), suffix),
+			})
+
+		case len(set) > 1 && r.kind != ruleKindAtLeastOneOf:
+			want := "one (and only one)"
+			if r.kind == ruleKindAtMostOneOf {
+				want = "at most one"
+			}
+			violations = append(violations, violation{
+				value:  set[1],
+				detail: fmt.Sprintf("%d attributes specified when %s of %s is allowed%s.", len(set), want, paths(values), suffix),
+			})
+		}
+
+	case ruleKindRequiredWith:
+		if !isSet(values[0]) {
+			break
+		}
+		for _, v := range others {
+			if !isSet(v) {
+				violations = append(violations, violation{
+					value:  v,
+					detail: fmt.Sprintf("Attribute %q must be specified when %q is specified%s.", v.Path(), values[0].Path(), suffix),
+				})
+			}
+		}
+
+	case ruleKindConflictsWith:
+		if !isSet(values[0]) {
+			break
+		}
+		for _, v := range others {
+			if isSet(v) {
+				violations = append(violations, violation{
+					value:  values[0],
+					detail: fmt.Sprintf("Attribute %q cannot be specified when %q is specified%s.", values[0].Path(), v.Path(), suffix),
+				})
+			}
+		}
+	}
+
+	return violations
+}
+
+func (r Rule) evaluateNested(scope value) []violation {
+	block := scope.Attribute(r.attributes[0])
+
+	if block.IsNull() || block.IsUnknown() {
+		return nil
+	}
+
+	elements, ok := block.Elements()
+	if !ok {
+		// Single nested block.
+		return evaluate(block, r.rules)
+	}
+
+	var violations []violation
+
+	for _, element := range elements {
+		if element.IsNull() || element.IsUnknown() {
+			continue
+		}
+		violations = append(violations, evaluate(element, r.rules)...)
+	}
+
+	return violations
+}
+
+func attributeValues(scope value, attributes []string) []value {
+	values := make([]value, 0, len(attributes))
+
+	for _, attribute := range attributes {
+		values = append(values, scope.Attribute(attribute))
+	}
+
+	return values
+}
+
+// isSet returns whether a known value is set.
+// An empty collection, such as an omitted list block, is not set.
+func isSet(v value) bool {
+	if v.IsNull() {
+		return false
+	}
+
+	if elements, ok := v.Elements(); ok {
+		return len(elements) > 0
+	}
+
+	return true
+}
+
+func paths(values []value) string {
+	paths := make([]string, 0, len(values))
+
+	for _, v := range values {
+		paths = append(paths, fmt.Sprintf("%q", v.Path()))
+	}
+
+	return "[" + strings.Join(paths, ",") + "]"
+}
@@ -0,0 +1,322 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	testRuleAttrTypes = map[string]attr.Type{
+		"type":    types.StringType,
+		"name":    types.StringType,
+		"prefix":  types.StringType,
+		"port":    types.Int64Type,
+		"enabled": types.BoolType,
+		"rule":    types.ListType{ElemType: types.ObjectType{AttrTypes: testRuleBlockAttrTypes}},
+	}
+	testRuleBlockAttrTypes = map[string]attr.Type{
+		"action": types.StringType,
+		"target": types.StringType,
+	}
+	testRuleCtyType = cty.Object(map[string]cty.Type{
+		"type":    cty.String,
+		"name":    cty.String,
+		"prefix":  cty.String,
+		"port":    cty.Number,
+		"enabled": cty.Bool,
+		"rule":    cty.List(cty.Object(map[string]cty.Type{"action": cty.String, "target": cty.String})),
+	})
+)
+
+// testRuleConfig is a configuration for the test schema, using Go values:
+// nil is null, "<unknown>" is unknown.
+type testRuleConfig map[string]any
+
+const testRuleUnknown = "<unknown>"
+
+func (c testRuleConfig) cty() cty.Value {
+	attrs := make(map[string]cty.Value)
+
+	for name, t := range testRuleCtyType.AttributeTypes() {
+		v := c[name]
+		switch {
+		case v == nil:
+			attrs[name] = cty.NullVal(t)
+		case v == testRuleUnknown:
+			attrs[name] = cty.UnknownVal(t)
+		default:
+			switch v := v.(type) {
+			case string:
+				attrs[name] = cty.StringVal(v)
+			case int:
+				attrs[name] = cty.NumberIntVal(int64(v))
+			case bool:
+				attrs[name] = cty.BoolVal(v)
+			case []testRuleConfig:
+				if len(v) == 0 {
+					attrs[name] = cty.ListValEmpty(t.ElementType())
+					continue
+				}
+				var elems []cty.Value
+				for _, e := range v {
+					elemAttrs := make(map[string]cty.Value)
+					for name, t := range t.ElementType().AttributeTypes() {
+						if s, ok := e[name].(string); ok {
+							elemAttrs[name] = cty.StringVal(s)
+						} else {
+							elemAttrs[name] = cty.NullVal(t)
+						}
+					}
+					elems = append(elems, cty.ObjectVal(elemAttrs))
+				}
+				attrs[name] = cty.ListVal(elems)
+			}
+		}
+	}
+
+	return cty.ObjectVal(attrs)
+}
+
+func (c testRuleConfig) attr() attr.Value {
+	attrs := make(map[string]attr.Value)
+
+	for name, t := range testRuleAttrTypes {
+		v := c[name]
+		switch {
+		case v == nil:
+			attrs[name] = nullValue(t)
+		case v == testRuleUnknown:
+			attrs[name] = unknownValue(t)
+		default:
+			switch v := v.(type) {
+			case string:
+				attrs[name] = types.StringValue(v)
+			case int:
+				attrs[name] = types.Int64Value(int64(v))
+			case bool:
+				attrs[name] = types.BoolValue(v)
+			case []testRuleConfig:
+				elemType := types.ObjectType{AttrTypes: testRuleBlockAttrTypes}
+				elems := []attr.Value{}
+				for _, e := range v {
+					elemAttrs := make(map[string]attr.Value)
+					for name := range testRuleBlockAttrTypes {
+						if s, ok := e[name].(string); ok {
+							elemAttrs[name] = types.StringValue(s)
+						} else {
+							elemAttrs[name] = types.StringNull()
+						}
+					}
+					elems = append(elems, types.ObjectValueMust(testRuleBlockAttrTypes, elemAttrs))
+				}
+				attrs[name] = types.ListValueMust(elemType, elems)
+			}
+		}
+	}
+
+	return types.ObjectValueMust(testRuleAttrTypes, attrs)
+}
+
+func nullValue(t attr.Type) attr.Value {
+	switch t := t.(type) {
+	case types.ListType:
+		return types.ListNull(t.ElemType)
+	case basetypes.Int64Type:
+		return types.Int64Null()
+	case basetypes.BoolType:
+		return types.BoolNull()
+	}
+	return types.StringNull()
+}
+
+func unknownValue(t attr.Type) attr.Value {
+	switch t := t.(type) {
+	case types.ListType:
+		return types.ListUnknown(t.ElemType)
+	case basetypes.Int64Type:
+		return types.Int64Unknown()
+	case basetypes.BoolType:
+		return types.BoolUnknown()
+	}
+	return types.StringUnknown()
+}
+
+func TestRules(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rules    []Rule
+		config   testRuleConfig
+		expected []string // "path: detail"
+	}{
+		"required when equals holds": {
+			rules:  []Rule{Required("name").When(Equals("type", "foo"))},
+			config: testRuleConfig{"type": "foo"},
+			expected: []string{
+				`name: Attribute "name" must be specified when "type" is "foo".`,
+			},
+		},
+		"required when equals does not hold": {
+			rules:  []Rule{Required("name").When(Equals("type", "foo"))},
+			config: testRuleConfig{"type": "bar"},
+		},
+		"required when condition unknown": {
+			rules:  []Rule{Required("name").When(Equals("type", "foo"))},
+			config: testRuleConfig{"type": testRuleUnknown},
+		},
+		"required when number equals": {
+			rules:  []Rule{Required("name").When(Equals("port", 443))},
+			config: testRuleConfig{"port": 443},
+			expected: []string{
+				`name: Attribute "name" must be specified when "port" is 443.`,
+			},
+		},
+		"required satisfied": {
+			rules:  []Rule{Required("name").When(Equals("type", "foo"))},
+			config: testRuleConfig{"type": "foo", "name": "a"},
+		},
+		"required unknown": {
+			rules:  []Rule{Required("name").When(Equals("type", "foo"))},
+			config: testRuleConfig{"type": "foo", "name": testRuleUnknown},
+		},
+		"forbidden when not equals": {
+			rules:  []Rule{Forbidden("prefix").When(NotEquals("type", "foo"), IsSet("name"))},
+			config: testRuleConfig{"type": "bar", "name": "a", "prefix": "b"},
+			expected: []string{
+				`prefix: Attribute "prefix" cannot be specified when "type" is not "foo" and "name" is specified.`,
+			},
+		},
+		"exactly one of none": {
+			rules:  []Rule{ExactlyOneOf("name", "prefix")},
+			config: testRuleConfig{},
+			expected: []string{
+				`: No attribute specified when one (and only one) of ["name","prefix"] is required.`,
+			},
+		},
+		"exactly one of both": {
+			rules:  []Rule{ExactlyOneOf("name", "prefix")},
+			config: testRuleConfig{"name": "a", "prefix": "b"},
+			expected: []string{
+				`prefix: 2 attributes specified when one (and only one) of ["name","prefix"] is allowed.`,
+			},
+		},
+		"at least one of empty block": {
+			rules:  []Rule{AtLeastOneOf("name", "rule")},
+			config: testRuleConfig{"rule": []testRuleConfig{}},
+			expected: []string{
+				`: No attribute specified when at least one of ["name","rule"] is required.`,
+			},
+		},
+		"at most one of": {
+			rules:  []Rule{AtMostOneOf("name", "prefix").When(IsNotSet("type"))},
+			config: testRuleConfig{"name": "a", "prefix": "b"},
+			expected: []string{
+				`prefix: 2 attributes specified when at most one of ["name","prefix"] is allowed when "type" is not specified.`,
+			},
+		},
+		"required with": {
+			rules:  []Rule{RequiredWith("port", "enabled")},
+			config: testRuleConfig{"port": 80},
+			expected: []string{
+				`enabled: Attribute "enabled" must be specified when "port" is specified.`,
+			},
+		},
+		"conflicts with": {
+			rules:  []Rule{ConflictsWith("name", "prefix")},
+			config: testRuleConfig{"name": "a", "prefix": "b"},
+			expected: []string{
+				`name: Attribute "name" cannot be specified when "prefix" is specified.`,
+			},
+		},
+		"conflicts with bool condition": {
+			rules:  []Rule{ConflictsWith("name", "prefix").When(Equals("enabled", true))},
+			config: testRuleConfig{"name": "a", "prefix": "b", "enabled": false},
+		},
+		"nested": {
+			rules: []Rule{Nested("rule", Required("target").When(Equals("action", "forward")))},
+			config: testRuleConfig{"rule": []testRuleConfig{
+				{"action": "forward", "target": "a"},
+				{"action": "forward"},
+				{"action": "drop"},
+			}},
+			expected: []string{
+				`rule[1].target: Attribute "rule[1].target" must be specified when "rule[1].action" is "forward".`,
+			},
+		},
+		"nested null": {
+			rules:  []Rule{Nested("rule", Required("target"))},
+			config: testRuleConfig{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			var got []string
+			for _, v := range evaluate(ctyValue{value: testCase.config.cty()}, testCase.rules) {
+				got = append(got, v.value.Path()+": "+v.detail)
+			}
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected SDKv2 violations difference: %s", diff)
+			}
+
+			got = nil
+			for _, v := range evaluate(attrValue{ctx: ctx, value: testCase.config.attr()}, testCase.rules) {
+				got = append(got, v.value.Path()+": "+v.detail)
+			}
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected Framework violations difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateCty(t *testing.T) {
+	t.Parallel()
+
+	err := validateCty(testRuleConfig{"name": "a", "prefix": "b"}.cty(), []Rule{
+		ConflictsWith("name", "prefix"),
+		Required("port"),
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, want := strings.Count(err.Error(), "\n")+1, 2; got != want {
+		t.Errorf("errors = %d, want %d: %s", got, want, err)
+	}
+}
+
+func TestValidateAttr(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	diags := validateAttr(ctx, testRuleConfig{}.attr(), []Rule{
+		ExactlyOneOf("name", "prefix"),
+		Required("port"),
+	})
+
+	if got, want := diags.ErrorsCount(), 2; got != want {
+		t.Fatalf("errors = %d, want %d: %v", got, want, diags)
+	}
+
+	for _, d := range diags {
+		if got, want := d.Summary(), diagnosticSummary; got != want {
+			t.Errorf("Summary() = %q, want %q", got, want)
+		}
+	}
+}
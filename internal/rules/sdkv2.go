@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// CustomizeDiff returns a Plugin SDK v2 CustomizeDiffFunc that validates the resource's configuration against the rules.
+func CustomizeDiff(rules ...Rule) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ any) error {
+		return validateCty(d.GetRawConfig(), rules)
+	}
+}
+
+// validateCty validates a Plugin SDK v2 raw configuration value against the rules.
+func validateCty(config cty.Value, rules []Rule) error {
+	var errs []error
+
+	for _, v := range evaluate(ctyValue{value: config}, rules) {
+		errs = append(errs, errors.New(v.detail))
+	}
+
+	return errors.Join(errs...)
+}
+
+var _ value = ctyValue{}
+
+type ctyValue struct {
+	path  string
+	value cty.Value
+}
+
+func (v ctyValue) Path() string {
+	return v.path
+}
+
+func (v ctyValue) IsNull() bool {
+	return v.value.IsNull()
+}
+
+func (v ctyValue) IsUnknown() bool {
+	return !v.value.IsKnown()
+}
+
+func (v ctyValue) Attribute(name string) value {
+	path := name
+	if v.path != "" {
+		path = v.path + "." + name
+	}
+
+	if !v.value.IsKnown() || v.value.IsNull() || !v.value.Type().IsObjectType() || !v.value.Type().HasAttribute(name) {
+		return ctyValue{path: path, value: cty.NullVal(cty.DynamicPseudoType)}
+	}
+
+	return ctyValue{path: path, value: v.value.GetAttr(name)}
+}
+
+func (v ctyValue) Elements() ([]value, bool) {
+	t := v.value.Type()
+	if !t.IsListType() && !t.IsSetType() && !t.IsTupleType() {
+		return nil, false
+	}
+
+	if !v.value.IsKnown() || v.value.IsNull() {
+		return nil, true
+	}
+
+	var elements []value
+	for i, it := 0, v.value.ElementIterator(); it.Next(); i++ {
+		_, e := it.Element()
+		elements = append(elements, ctyValue{path: fmt.Sprintf("%s[%d]", v.path, i), value: e})
+	}
+
+	return elements, true
+}
+
+func (v ctyValue) Primitive() any {
+	if !v.value.IsKnown() || v.value.IsNull() {
+		return nil
+	}
+
+	switch v.value.Type() {
+	case cty.String:
+		return v.value.AsString()
+	case cty.Bool:
+		return v.value.True()
+	case cty.Number:
+		return v.value.AsBigFloat()
+	}
+
+	return nil
+}
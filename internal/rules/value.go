@@ -0,0 +1,21 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+// value is a configuration value that rules are evaluated against.
+// It is implemented for Plugin SDK v2 (cty) and Plugin Framework (attr) values.
+type value interface {
+	// Path returns the value's attribute path, e.g. `rule[0].action`.
+	Path() string
+	IsNull() bool
+	IsUnknown() bool
+	// Attribute returns the named attribute of an object value.
+	// A missing attribute is returned as a null value.
+	Attribute(name string) value
+	// Elements returns the elements of a list, set or tuple value.
+	// ok is false if the value is not a collection.
+	Elements() (elements []value, ok bool)
+	// Primitive returns a string, bool or *big.Float for a primitive value and nil otherwise.
+	Primitive() any
+}
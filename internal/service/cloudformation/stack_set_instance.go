@@ -189,7 +189,7 @@ func resourceStackSetInstance() *schema.Resource {
 				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"stack_set_instance_region"},
-				Deprecated:    "region is deprecated. Use stack_set_instance_region instead.",
+				Deprecated:    names.ResourceDeprecationMessage("aws_cloudformation_stack_set_instance", names.AttrRegion),
 			},
 			"retain_stack": {
 				Type:     schema.TypeBool,
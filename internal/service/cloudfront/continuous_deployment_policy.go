@@ -330,17 +330,21 @@ func cdpETag(ctx context.Context, conn *cloudfront.Client, id string) (string, e
 }
 
 func disableContinuousDeploymentPolicy(ctx context.Context, conn *cloudfront.Client, id string) error {
+	return updateContinuousDeploymentPolicyEnabled(ctx, conn, id, false)
+}
+
+func updateContinuousDeploymentPolicyEnabled(ctx context.Context, conn *cloudfront.Client, id string, enabled bool) error {
 	output, err := findContinuousDeploymentPolicyByID(ctx, conn, id)
 
 	if err != nil {
 		return fmt.Errorf("reading CloudFront Continuous Deployment Policy (%s): %w", id, err)
 	}
 
-	if !aws.ToBool(output.ContinuousDeploymentPolicy.ContinuousDeploymentPolicyConfig.Enabled) {
+	if aws.ToBool(output.ContinuousDeploymentPolicy.ContinuousDeploymentPolicyConfig.Enabled) == enabled {
 		return nil
 	}
 
-	output.ContinuousDeploymentPolicy.ContinuousDeploymentPolicyConfig.Enabled = aws.Bool(false)
+	output.ContinuousDeploymentPolicy.ContinuousDeploymentPolicyConfig.Enabled = aws.Bool(enabled)
 
 	input := &cloudfront.UpdateContinuousDeploymentPolicyInput{
 		ContinuousDeploymentPolicyConfig: output.ContinuousDeploymentPolicy.ContinuousDeploymentPolicyConfig,
@@ -67,14 +67,19 @@ func resourceDistribution() *schema.Resource {
 		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
+			"adopt_promoted_config": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"aliases": {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"anycast_ip_list_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressPromotedConfigDiff,
 			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -85,14 +90,16 @@ func resourceDistribution() *schema.Resource {
 				Computed: true,
 			},
 			names.AttrComment: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringLenBetween(0, 128),
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressPromotedConfigDiff,
+				ValidateFunc:     validation.StringLenBetween(0, 128),
 			},
 			"connection_function_association": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: suppressPromotedConfigDiff,
+				MaxItems:         1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrID: {
@@ -109,8 +116,9 @@ func resourceDistribution() *schema.Resource {
 				Computed: true,
 			},
 			"custom_error_response": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:             schema.TypeSet,
+				Optional:         true,
+				DiffSuppressFunc: suppressPromotedConfigDiff,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"error_caching_min_ttl": {
@@ -133,9 +141,10 @@ func resourceDistribution() *schema.Resource {
 				},
 			},
 			"default_cache_behavior": {
-				Type:     schema.TypeList,
-				Required: true,
-				MaxItems: 1,
+				Type:             schema.TypeList,
+				Required:         true,
+				DiffSuppressFunc: suppressPromotedConfigDiff,
+				MaxItems:         1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"allowed_methods": {
@@ -321,16 +330,18 @@ func resourceDistribution() *schema.Resource {
 				},
 			},
 			"default_root_object": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressPromotedConfigDiff,
 			},
 			names.AttrDomainName: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			names.AttrEnabled: {
-				Type:     schema.TypeBool,
-				Required: true,
+				Type:             schema.TypeBool,
+				Required:         true,
+				DiffSuppressFunc: suppressPromotedConfigDiff,
 			},
 			"etag": {
 				Type:     schema.TypeString,
@@ -343,6 +354,7 @@ func resourceDistribution() *schema.Resource {
 			"http_version": {
 				Type:             schema.TypeString,
 				Optional:         true,
+				DiffSuppressFunc: suppressPromotedConfigDiff,
 				Default:          awstypes.HttpVersionHttp2,
 				ValidateDiagFunc: enum.Validate[awstypes.HttpVersion](),
 			},
@@ -351,18 +363,20 @@ func resourceDistribution() *schema.Resource {
 				Computed: true,
 			},
 			"is_ipv6_enabled": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
+				Type:             schema.TypeBool,
+				Optional:         true,
+				DiffSuppressFunc: suppressPromotedConfigDiff,
+				Default:          false,
 			},
 			"last_modified_time": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			"logging_config": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: suppressPromotedConfigDiff,
+				MaxItems:         1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrBucket: {
@@ -387,8 +401,9 @@ func resourceDistribution() *schema.Resource {
 				Computed: true,
 			},
 			"ordered_cache_behavior": {
-				Type:     schema.TypeList,
-				Optional: true,
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: suppressPromotedConfigDiff,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"allowed_methods": {
@@ -575,8 +590,9 @@ func resourceDistribution() *schema.Resource {
 				},
 			},
 			"origin_group": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:             schema.TypeSet,
+				Optional:         true,
+				DiffSuppressFunc: suppressPromotedConfigDiff,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"failover_criteria": {
@@ -616,8 +632,9 @@ func resourceDistribution() *schema.Resource {
 				},
 			},
 			"origin": {
-				Type:     schema.TypeSet,
-				Required: true,
+				Type:             schema.TypeSet,
+				Required:         true,
+				DiffSuppressFunc: suppressPromotedConfigDiff,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"connection_attempts": {
@@ -786,13 +803,15 @@ func resourceDistribution() *schema.Resource {
 			"price_class": {
 				Type:             schema.TypeString,
 				Optional:         true,
+				DiffSuppressFunc: suppressPromotedConfigDiff,
 				Default:          awstypes.PriceClassPriceClassAll,
 				ValidateDiagFunc: enum.Validate[awstypes.PriceClass](),
 			},
 			"restrictions": {
-				Type:     schema.TypeList,
-				Required: true,
-				MaxItems: 1,
+				Type:             schema.TypeList,
+				Required:         true,
+				DiffSuppressFunc: suppressPromotedConfigDiff,
+				MaxItems:         1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"geo_restriction": {
@@ -900,9 +919,10 @@ func resourceDistribution() *schema.Resource {
 				},
 			},
 			"viewer_certificate": {
-				Type:     schema.TypeList,
-				Required: true,
-				MaxItems: 1,
+				Type:             schema.TypeList,
+				Required:         true,
+				DiffSuppressFunc: suppressPromotedConfigDiff,
+				MaxItems:         1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"acm_certificate_arn": {
@@ -933,9 +953,10 @@ func resourceDistribution() *schema.Resource {
 				},
 			},
 			"viewer_mtls_config": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: suppressPromotedConfigDiff,
+				MaxItems:         1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrMode: {
@@ -973,8 +994,9 @@ func resourceDistribution() *schema.Resource {
 				Default:  true,
 			},
 			"web_acl_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressPromotedConfigDiff,
 			},
 		},
 	}
@@ -1151,7 +1173,9 @@ func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, met
 		})
 
 		// Refresh our ETag if it is out of date and attempt update again.
-		if errs.IsA[*awstypes.PreconditionFailed](err) {
+		// The ETag is out of date after the distribution is modified outside of this resource,
+		// for example when a staging distribution is promoted to it.
+		if errs.IsA[*awstypes.PreconditionFailed](err) || errs.IsA[*awstypes.InvalidIfMatchVersion](err) {
 			var etag string
 			etag, err = distroETag(ctx, conn, d.Id())
 
@@ -1296,6 +1320,13 @@ func deleteDistribution(ctx context.Context, conn *cloudfront.Client, id string)
 	return nil
 }
 
+// suppressPromotedConfigDiff suppresses differences in the configuration that a staging distribution's
+// promotion copies to an existing primary distribution which adopts the promoted configuration.
+// The primary distribution retains its aliases and continuous deployment policy ID.
+func suppressPromotedConfigDiff(_, _, _ string, d *schema.ResourceData) bool {
+	return d.Id() != "" && d.Get("adopt_promoted_config").(bool)
+}
+
 func distroETag(ctx context.Context, conn *cloudfront.Client, id string) (string, error) {
 	output, err := findDistributionByID(ctx, conn, id)
 
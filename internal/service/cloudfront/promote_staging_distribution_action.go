@@ -0,0 +1,271 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_cloudfront_promote_staging_distribution, name="Promote Staging Distribution")
+func newPromoteStagingDistributionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &promoteStagingDistributionAction{}, nil
+}
+
+var (
+	_ action.Action = (*promoteStagingDistributionAction)(nil)
+)
+
+type promoteStagingDistributionAction struct {
+	framework.ActionWithModel[promoteStagingDistributionModel]
+}
+
+type promoteStagingDistributionModel struct {
+	DistributionID        types.String `tfsdk:"distribution_id"`
+	StagingDistributionID types.String `tfsdk:"staging_distribution_id"`
+	Timeout               types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *promoteStagingDistributionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	distributionIDValidator := stringvalidator.RegexMatches(
+		regexache.MustCompile(`^[A-Z0-9]+$`),
+		"must be a valid CloudFront distribution ID (e.g., E1GHKQ2EXAMPLE)",
+	)
+
+	resp.Schema = schema.Schema{
+		Description: "Promotes a CloudFront staging distribution by copying its configuration to the primary distribution, and waits for the primary distribution to deploy. " +
+			"The continuous deployment policy is re-enabled after promotion if it was enabled before.",
+		Attributes: map[string]schema.Attribute{
+			"distribution_id": schema.StringAttribute{
+				Description: "The ID of the primary CloudFront distribution to copy the staging distribution's configuration to",
+				Required:    true,
+				Validators: []validator.String{
+					distributionIDValidator,
+				},
+			},
+			"staging_distribution_id": schema.StringAttribute{
+				Description: "The ID of the staging CloudFront distribution whose configuration is promoted",
+				Required:    true,
+				Validators: []validator.String{
+					distributionIDValidator,
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the distributions to deploy (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(5400),
+				},
+			},
+		},
+	}
+}
+
+func (a *promoteStagingDistributionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config promoteStagingDistributionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().CloudFrontClient(ctx)
+
+	distributionID := config.DistributionID.ValueString()
+	stagingDistributionID := config.StagingDistributionID.ValueString()
+
+	timeout := 1800 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting CloudFront staging distribution promotion action", map[string]any{
+		"distribution_id":         distributionID,
+		"staging_distribution_id": stagingDistributionID,
+		names.AttrTimeout:         timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting promotion of CloudFront staging distribution %s to %s...", stagingDistributionID, distributionID),
+	})
+
+	primary, err := findDistributionByID(ctx, conn, distributionID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe Distribution",
+			fmt.Sprintf("Could not describe CloudFront distribution %s: %s", distributionID, err),
+		)
+		return
+	}
+
+	policyID := aws.ToString(primary.Distribution.DistributionConfig.ContinuousDeploymentPolicyId)
+	if policyID == "" {
+		resp.Diagnostics.AddError(
+			"Distribution Has No Continuous Deployment Policy",
+			fmt.Sprintf("CloudFront distribution %s must have a continuous deployment policy attached to promote a staging distribution", distributionID),
+		)
+		return
+	}
+
+	// Promotion disables the continuous deployment policy.
+	// Record whether it is enabled so that the policy is left as it is configured.
+	policy, err := findContinuousDeploymentPolicyByID(ctx, conn, policyID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe Continuous Deployment Policy",
+			fmt.Sprintf("Could not describe CloudFront continuous deployment policy %s: %s", policyID, err),
+		)
+		return
+	}
+	policyEnabled := aws.ToBool(policy.ContinuousDeploymentPolicy.ContinuousDeploymentPolicyConfig.Enabled)
+
+	staging, err := findDistributionByID(ctx, conn, stagingDistributionID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe Distribution",
+			fmt.Sprintf("Could not describe CloudFront distribution %s: %s", stagingDistributionID, err),
+		)
+		return
+	}
+
+	if !aws.ToBool(staging.Distribution.DistributionConfig.Staging) {
+		resp.Diagnostics.AddError(
+			"Not a Staging Distribution",
+			fmt.Sprintf("CloudFront distribution %s is not a staging distribution", stagingDistributionID),
+		)
+		return
+	}
+
+	// Both distributions must be deployed before the staging configuration can be copied.
+	for _, id := range []string{stagingDistributionID, distributionID} {
+		if err := a.waitDistributionDeployed(ctx, resp, id, timeout); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed While Waiting for Distribution",
+				fmt.Sprintf("Error waiting for CloudFront distribution %s to deploy: %s", id, err),
+			)
+			return
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Copying configuration of staging distribution %s to %s...", stagingDistributionID, distributionID),
+	})
+
+	// The request must include the current ETags of both distributions.
+	// Refresh them and try again if either distribution was modified in the meantime.
+	const (
+		etagTimeout = 1 * time.Minute
+	)
+	_, err = tfresource.RetryWhenIsOneOf2[any, *awstypes.PreconditionFailed, *awstypes.InvalidIfMatchVersion](ctx, etagTimeout, func(ctx context.Context) (any, error) {
+		primaryETag, err := distroETag(ctx, conn, distributionID)
+		if err != nil {
+			return nil, err
+		}
+
+		stagingETag, err := distroETag(ctx, conn, stagingDistributionID)
+		if err != nil {
+			return nil, err
+		}
+
+		input := cloudfront.UpdateDistributionWithStagingConfigInput{
+			Id:                    aws.String(distributionID),
+			IfMatch:               aws.String(primaryETag + ", " + stagingETag),
+			StagingDistributionId: aws.String(stagingDistributionID),
+		}
+
+		return conn.UpdateDistributionWithStagingConfig(ctx, &input)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Promote Staging Distribution",
+			fmt.Sprintf("Could not copy configuration of CloudFront staging distribution %s to %s: %s", stagingDistributionID, distributionID, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Configuration copied, waiting for distribution %s to deploy...", distributionID),
+	})
+
+	if err := a.waitDistributionDeployed(ctx, resp, distributionID, timeout); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed While Waiting for Distribution",
+			fmt.Sprintf("Error waiting for CloudFront distribution %s to deploy after promotion: %s", distributionID, err),
+		)
+		return
+	}
+
+	if policyEnabled {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Re-enabling continuous deployment policy %s...", policyID),
+		})
+
+		if err := updateContinuousDeploymentPolicyEnabled(ctx, conn, policyID, true); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Re-enable Continuous Deployment Policy",
+				fmt.Sprintf("Could not re-enable CloudFront continuous deployment policy %s after promotion: %s", policyID, err),
+			)
+			return
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("CloudFront staging distribution %s promoted successfully to %s", stagingDistributionID, distributionID),
+	})
+
+	tflog.Info(ctx, "CloudFront staging distribution promotion action completed successfully", map[string]any{
+		"distribution_id":         distributionID,
+		"staging_distribution_id": stagingDistributionID,
+	})
+}
+
+func (a *promoteStagingDistributionAction) waitDistributionDeployed(ctx context.Context, resp *action.InvokeResponse, id string, timeout time.Duration) error {
+	conn := a.Meta().CloudFrontClient(ctx)
+
+	_, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		output, err := findDistributionByID(ctx, conn, id)
+		if err != nil {
+			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("getting distribution status: %w", err)
+		}
+		status := aws.ToString(output.Distribution.Status)
+		return actionwait.FetchResult[struct{}]{Status: actionwait.Status(status)}, nil
+	}, actionwait.Options[struct{}]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(actionwait.DefaultPollInterval),
+		ProgressInterval: 60 * time.Second,
+		SuccessStates:    []actionwait.Status{distributionStatusDeployed},
+		TransitionalStates: []actionwait.Status{
+			distributionStatusInProgress,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Distribution %s is currently '%s', continuing to wait for deployment...", id, fr.Status)})
+		},
+	})
+
+	var timeoutErr *actionwait.TimeoutError
+	if errors.As(err, &timeoutErr) {
+		return fmt.Errorf("distribution did not deploy within %s: %w", timeout, err)
+	}
+
+	return err
+}
@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontPromoteStagingDistributionAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var distribution awstypes.Distribution
+	resourceName := "aws_cloudfront_distribution.test"
+	promotedDomain := "www.example.org"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDistributionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_init(defaultDomain),
+			},
+			{
+				Config: testAccPromoteStagingDistributionActionConfig_basic(defaultDomain, "v1"),
+			},
+			{
+				// Changes to the staging distribution are not copied to the primary distribution until it is promoted.
+				Config: testAccPromoteStagingDistributionActionConfig_basic(promotedDomain, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					testAccCheckDistributionOriginDomainName(&distribution, defaultDomain),
+				),
+			},
+			{
+				// The primary distribution adopts the promoted configuration, so no changes remain after promotion.
+				Config: testAccPromoteStagingDistributionActionConfig_basic(promotedDomain, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					testAccCheckDistributionOriginDomainName(&distribution, promotedDomain),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "origin.*", map[string]string{
+						names.AttrDomainName: promotedDomain,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "staging", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(resourceName, "continuous_deployment_policy_id", "aws_cloudfront_continuous_deployment_policy.test", names.AttrID),
+					resource.TestCheckResourceAttr("aws_cloudfront_continuous_deployment_policy.test", names.AttrEnabled, acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDistributionOriginDomainName(distribution *awstypes.Distribution, domainName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, origin := range distribution.DistributionConfig.Origins.Items {
+			if got := *origin.DomainName; got != domainName {
+				return fmt.Errorf("CloudFront Distribution (%s) origin domain name = %s, want %s", *distribution.Id, got, domainName)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccPromoteStagingDistributionActionConfig_basic(stagingDomain, release string) string {
+	return acctest.ConfigCompose(
+		testAccContinuousDeploymentPolicyConfigBase_staging(stagingDomain),
+		fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "test" {
+  enabled          = true
+  retain_on_delete = false
+
+  adopt_promoted_config           = true
+  continuous_deployment_policy_id = aws_cloudfront_continuous_deployment_policy.test.id
+
+  default_cache_behavior {
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "test"
+    viewer_protocol_policy = "allow-all"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "all"
+      }
+    }
+  }
+
+  origin {
+    domain_name = %[1]q
+    origin_id   = "test"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "https-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = true
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleWeight"
+    single_weight_config {
+      weight = "0.01"
+    }
+  }
+}
+
+action "aws_cloudfront_promote_staging_distribution" "test" {
+  config {
+    distribution_id         = aws_cloudfront_distribution.test.id
+    staging_distribution_id = aws_cloudfront_distribution.staging.id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = %[2]q
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.aws_cloudfront_promote_staging_distribution.test]
+    }
+  }
+}
+`, defaultDomain, release))
+}
@@ -30,6 +30,12 @@ func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackage
 			Name:     "Create Invalidation",
 			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
 		},
+		{
+			Factory:  newPromoteStagingDistributionAction,
+			TypeName: "aws_cloudfront_promote_staging_distribution",
+			Name:     "Promote Staging Distribution",
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+		},
 	}
 }
 
@@ -61,7 +61,7 @@ func dataSourceServiceAccount() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceServiceAccountRead,
 
-		DeprecationMessage: "This data source is deprecated. AWS recommends using a service principal name instead of an AWS account ID in any relevant IAM policy.",
+		DeprecationMessage: names.DataSourceDeprecationMessage("aws_cloudtrail_service_account", ""),
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
@@ -10,7 +10,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"maps"
 	"reflect"
+	"slices"
 	"strings"
 	"time"
 
@@ -46,6 +48,8 @@ func resourceUserPool() *schema.Resource {
 		UpdateWithoutTimeout: resourceUserPoolUpdate,
 		DeleteWithoutTimeout: resourceUserPoolDelete,
 
+		CustomizeDiff: resourceUserPoolCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -1255,11 +1259,15 @@ func resourceUserPoolUpdate(ctx context.Context, d *schema.ResourceData, meta an
 
 	if d.HasChange(names.AttrSchema) {
 		o, n := d.GetChange(names.AttrSchema)
-		os, ns := o.(*schema.Set), n.(*schema.Set)
+		customAttributes, err := userPoolSchemaAttributesToAdd(o.(*schema.Set).List(), n.(*schema.Set).List())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Cognito User Pool (%s): %s", d.Id(), err)
+		}
 
-		if os.Difference(ns).Len() == 0 {
+		if len(customAttributes) > 0 {
 			input := &cognitoidentityprovider.AddCustomAttributesInput{
-				CustomAttributes: expandSchemaAttributeTypes(ns.Difference(os).List()),
+				CustomAttributes: customAttributes,
 				UserPoolId:       aws.String(d.Id()),
 			}
 
@@ -1268,14 +1276,27 @@ func resourceUserPoolUpdate(ctx context.Context, d *schema.ResourceData, meta an
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "adding Cognito User Pool (%s) custom attributes: %s", d.Id(), err)
 			}
-		} else {
-			return sdkdiag.AppendErrorf(diags, "updating Cognito User Pool (%s): cannot modify or remove schema items", d.Id())
 		}
 	}
 
 	return append(diags, resourceUserPoolRead(ctx, d, meta)...)
 }
 
+func resourceUserPoolCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	// Schema attributes can't be modified or removed once the user pool exists, and replacing
+	// the user pool would delete all of its users. Report irreversible changes during plan.
+	if diff.Id() == "" || !diff.HasChange(names.AttrSchema) || !diff.NewValueKnown(names.AttrSchema) {
+		return nil
+	}
+
+	o, n := diff.GetChange(names.AttrSchema)
+	if _, err := userPoolSchemaAttributesToAdd(o.(*schema.Set).List(), n.(*schema.Set).List()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func resourceUserPoolDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
@@ -2348,228 +2369,228 @@ func skipFlatteningStringAttributeContraints(configuredAttributes []awstypes.Sch
 	return false
 }
 
-func userPoolSchemaAttributeMatchesStandardAttribute(apiObject *awstypes.SchemaAttributeType) bool {
-	if apiObject == nil {
-		return false
-	}
-
-	// All standard attributes always returned by API
-	// https://docs.aws.amazon.com/cognito/latest/developerguide/user-pool-settings-attributes.html#cognito-user-pools-standard-attributes
-	var standardAttributes = []awstypes.SchemaAttributeType{
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeString,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(true),
-			Name:                   aws.String(names.AttrAddress),
-			Required:               aws.Bool(false),
-			StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
-				MaxLength: aws.String("2048"),
-				MinLength: aws.String("0"),
-			},
-		},
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeString,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(true),
-			Name:                   aws.String("birthdate"),
-			Required:               aws.Bool(false),
-			StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
-				MaxLength: aws.String("10"),
-				MinLength: aws.String("10"),
-			},
-		},
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeString,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(true),
-			Name:                   aws.String(names.AttrEmail),
-			Required:               aws.Bool(false),
-			StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
-				MaxLength: aws.String("2048"),
-				MinLength: aws.String("0"),
-			},
+// All standard attributes always returned by API
+// https://docs.aws.amazon.com/cognito/latest/developerguide/user-pool-settings-attributes.html#cognito-user-pools-standard-attributes
+var userPoolStandardSchemaAttributes = []awstypes.SchemaAttributeType{
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeString,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String(names.AttrAddress),
+		Required:               aws.Bool(false),
+		StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
+			MaxLength: aws.String("2048"),
+			MinLength: aws.String("0"),
 		},
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeBoolean,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(true),
-			Name:                   aws.String("email_verified"),
-			Required:               aws.Bool(false),
+	},
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeString,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("birthdate"),
+		Required:               aws.Bool(false),
+		StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
+			MaxLength: aws.String("10"),
+			MinLength: aws.String("10"),
 		},
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeString,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(true),
-			Name:                   aws.String("family_name"),
-			Required:               aws.Bool(false),
-			StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
-				MaxLength: aws.String("2048"),
-				MinLength: aws.String("0"),
-			},
+	},
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeString,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String(names.AttrEmail),
+		Required:               aws.Bool(false),
+		StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
+			MaxLength: aws.String("2048"),
+			MinLength: aws.String("0"),
 		},
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeString,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(true),
-			Name:                   aws.String("gender"),
-			Required:               aws.Bool(false),
-			StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
-				MaxLength: aws.String("2048"),
-				MinLength: aws.String("0"),
-			},
+	},
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeBoolean,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("email_verified"),
+		Required:               aws.Bool(false),
+	},
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeString,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("family_name"),
+		Required:               aws.Bool(false),
+		StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
+			MaxLength: aws.String("2048"),
+			MinLength: aws.String("0"),
 		},
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeString,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(true),
-			Name:                   aws.String("given_name"),
-			Required:               aws.Bool(false),
-			StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
-				MaxLength: aws.String("2048"),
-				MinLength: aws.String("0"),
-			},
+	},
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeString,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("gender"),
+		Required:               aws.Bool(false),
+		StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
+			MaxLength: aws.String("2048"),
+			MinLength: aws.String("0"),
 		},
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeString,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(true),
-			Name:                   aws.String("locale"),
-			Required:               aws.Bool(false),
-			StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
-				MaxLength: aws.String("2048"),
-				MinLength: aws.String("0"),
-			},
+	},
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeString,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("given_name"),
+		Required:               aws.Bool(false),
+		StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
+			MaxLength: aws.String("2048"),
+			MinLength: aws.String("0"),
 		},
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeString,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(true),
-			Name:                   aws.String("middle_name"),
-			Required:               aws.Bool(false),
-			StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
-				MaxLength: aws.String("2048"),
-				MinLength: aws.String("0"),
-			},
+	},
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeString,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("locale"),
+		Required:               aws.Bool(false),
+		StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
+			MaxLength: aws.String("2048"),
+			MinLength: aws.String("0"),
 		},
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeString,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(true),
-			Name:                   aws.String(names.AttrName),
-			Required:               aws.Bool(false),
-			StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
-				MaxLength: aws.String("2048"),
-				MinLength: aws.String("0"),
-			},
+	},
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeString,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("middle_name"),
+		Required:               aws.Bool(false),
+		StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
+			MaxLength: aws.String("2048"),
+			MinLength: aws.String("0"),
 		},
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeString,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(true),
-			Name:                   aws.String("nickname"),
-			Required:               aws.Bool(false),
-			StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
-				MaxLength: aws.String("2048"),
-				MinLength: aws.String("0"),
-			},
+	},
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeString,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String(names.AttrName),
+		Required:               aws.Bool(false),
+		StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
+			MaxLength: aws.String("2048"),
+			MinLength: aws.String("0"),
 		},
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeString,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(true),
-			Name:                   aws.String("phone_number"),
-			Required:               aws.Bool(false),
-			StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
-				MaxLength: aws.String("2048"),
-				MinLength: aws.String("0"),
-			},
+	},
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeString,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("nickname"),
+		Required:               aws.Bool(false),
+		StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
+			MaxLength: aws.String("2048"),
+			MinLength: aws.String("0"),
 		},
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeBoolean,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(true),
-			Name:                   aws.String("phone_number_verified"),
-			Required:               aws.Bool(false),
+	},
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeString,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("phone_number"),
+		Required:               aws.Bool(false),
+		StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
+			MaxLength: aws.String("2048"),
+			MinLength: aws.String("0"),
 		},
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeString,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(true),
-			Name:                   aws.String("picture"),
-			Required:               aws.Bool(false),
-			StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
-				MaxLength: aws.String("2048"),
-				MinLength: aws.String("0"),
-			},
+	},
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeBoolean,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("phone_number_verified"),
+		Required:               aws.Bool(false),
+	},
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeString,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("picture"),
+		Required:               aws.Bool(false),
+		StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
+			MaxLength: aws.String("2048"),
+			MinLength: aws.String("0"),
 		},
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeString,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(true),
-			Name:                   aws.String("preferred_username"),
-			Required:               aws.Bool(false),
-			StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
-				MaxLength: aws.String("2048"),
-				MinLength: aws.String("0"),
-			},
+	},
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeString,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("preferred_username"),
+		Required:               aws.Bool(false),
+		StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
+			MaxLength: aws.String("2048"),
+			MinLength: aws.String("0"),
 		},
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeString,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(true),
-			Name:                   aws.String(names.AttrProfile),
-			Required:               aws.Bool(false),
-			StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
-				MaxLength: aws.String("2048"),
-				MinLength: aws.String("0"),
-			},
+	},
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeString,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String(names.AttrProfile),
+		Required:               aws.Bool(false),
+		StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
+			MaxLength: aws.String("2048"),
+			MinLength: aws.String("0"),
 		},
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeString,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(false),
-			Name:                   aws.String("sub"),
-			Required:               aws.Bool(true),
-			StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
-				MaxLength: aws.String("2048"),
-				MinLength: aws.String("1"),
-			},
+	},
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeString,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(false),
+		Name:                   aws.String("sub"),
+		Required:               aws.Bool(true),
+		StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
+			MaxLength: aws.String("2048"),
+			MinLength: aws.String("1"),
 		},
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeNumber,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(true),
-			Name:                   aws.String("updated_at"),
-			NumberAttributeConstraints: &awstypes.NumberAttributeConstraintsType{
-				MinValue: aws.String("0"),
-			},
-			Required: aws.Bool(false),
+	},
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeNumber,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("updated_at"),
+		NumberAttributeConstraints: &awstypes.NumberAttributeConstraintsType{
+			MinValue: aws.String("0"),
 		},
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeString,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(true),
-			Name:                   aws.String("website"),
-			Required:               aws.Bool(false),
-			StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
-				MaxLength: aws.String("2048"),
-				MinLength: aws.String("0"),
-			},
+		Required: aws.Bool(false),
+	},
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeString,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("website"),
+		Required:               aws.Bool(false),
+		StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
+			MaxLength: aws.String("2048"),
+			MinLength: aws.String("0"),
 		},
-		{
-			AttributeDataType:      awstypes.AttributeDataTypeString,
-			DeveloperOnlyAttribute: aws.Bool(false),
-			Mutable:                aws.Bool(true),
-			Name:                   aws.String("zoneinfo"),
-			Required:               aws.Bool(false),
-			StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
-				MaxLength: aws.String("2048"),
-				MinLength: aws.String("0"),
-			},
+	},
+	{
+		AttributeDataType:      awstypes.AttributeDataTypeString,
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("zoneinfo"),
+		Required:               aws.Bool(false),
+		StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
+			MaxLength: aws.String("2048"),
+			MinLength: aws.String("0"),
 		},
+	},
+}
+
+func userPoolSchemaAttributeMatchesStandardAttribute(apiObject *awstypes.SchemaAttributeType) bool {
+	if apiObject == nil {
+		return false
 	}
 
-	for _, standardAttribute := range standardAttributes {
+	for _, standardAttribute := range userPoolStandardSchemaAttributes {
 		if reflect.DeepEqual(*apiObject, standardAttribute) {
 			return true
 		}
@@ -2578,6 +2599,120 @@ func userPoolSchemaAttributeMatchesStandardAttribute(apiObject *awstypes.SchemaA
 	return false
 }
 
+// userPoolSchemaAttributesToAdd returns the custom attributes that must be added to an existing user pool
+// when its schema changes from oldList to newList.
+// An error describing every irreversible change is returned if the change is not purely additive.
+func userPoolSchemaAttributesToAdd(oldList, newList []any) ([]awstypes.SchemaAttributeType, error) {
+	oldAttributes := make(map[string]awstypes.SchemaAttributeType)
+	for _, apiObject := range expandSchemaAttributeTypes(oldList) {
+		oldAttributes[aws.ToString(apiObject.Name)] = apiObject
+	}
+
+	var customAttributes []awstypes.SchemaAttributeType
+	var problems []string
+
+	for _, apiObject := range expandSchemaAttributeTypes(newList) {
+		name := aws.ToString(apiObject.Name)
+		oldAttribute, ok := oldAttributes[name]
+		delete(oldAttributes, name)
+
+		switch standardAttribute := findUserPoolStandardSchemaAttribute(name); {
+		case ok:
+			if changes := userPoolSchemaAttributeChanges(&oldAttribute, &apiObject); len(changes) > 0 {
+				problems = append(problems, fmt.Sprintf("attribute %q: %s cannot be changed", name, strings.Join(changes, ", ")))
+			}
+		case standardAttribute != nil:
+			// Standard attributes always exist. Only their default definition can be added to the configuration.
+			if changes := userPoolSchemaAttributeChanges(standardAttribute, &apiObject); len(changes) > 0 {
+				problems = append(problems, fmt.Sprintf("standard attribute %q: %s cannot be changed from the default", name, strings.Join(changes, ", ")))
+			}
+		case aws.ToBool(apiObject.Required):
+			problems = append(problems, fmt.Sprintf("custom attribute %q: custom attributes cannot be required", name))
+		default:
+			customAttributes = append(customAttributes, apiObject)
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(oldAttributes)) {
+		oldAttribute := oldAttributes[name]
+
+		// Removing a standard attribute with its default definition from the configuration doesn't change the user pool.
+		if standardAttribute := findUserPoolStandardSchemaAttribute(name); standardAttribute != nil && len(userPoolSchemaAttributeChanges(standardAttribute, &oldAttribute)) == 0 {
+			continue
+		}
+
+		problems = append(problems, fmt.Sprintf("attribute %q cannot be removed", name))
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("cannot modify or remove schema items of an existing user pool, and replacing the user pool would delete all of its users. Restore the previous schema definitions and only add new custom attributes:\n  - %s", strings.Join(problems, "\n  - "))
+	}
+
+	return customAttributes, nil
+}
+
+func findUserPoolStandardSchemaAttribute(name string) *awstypes.SchemaAttributeType {
+	for _, standardAttribute := range userPoolStandardSchemaAttributes {
+		if aws.ToString(standardAttribute.Name) == name {
+			return &standardAttribute
+		}
+	}
+
+	return nil
+}
+
+// userPoolSchemaAttributeChanges returns the names of the arguments that differ between an existing and a configured schema attribute.
+// Omitted constraints are ignored, as the API returns default constraints.
+func userPoolSchemaAttributeChanges(existing, configured *awstypes.SchemaAttributeType) []string {
+	var changes []string
+
+	if existing.AttributeDataType != configured.AttributeDataType {
+		changes = append(changes, "attribute_data_type")
+	}
+
+	if aws.ToBool(existing.DeveloperOnlyAttribute) != aws.ToBool(configured.DeveloperOnlyAttribute) {
+		changes = append(changes, "developer_only_attribute")
+	}
+
+	if aws.ToBool(existing.Mutable) != aws.ToBool(configured.Mutable) {
+		changes = append(changes, "mutable")
+	}
+
+	if aws.ToBool(existing.Required) != aws.ToBool(configured.Required) {
+		changes = append(changes, "required")
+	}
+
+	if v := configured.NumberAttributeConstraints; v != nil {
+		var maxValue, minValue *string
+		if existing.NumberAttributeConstraints != nil {
+			maxValue, minValue = existing.NumberAttributeConstraints.MaxValue, existing.NumberAttributeConstraints.MinValue
+		}
+
+		if userPoolSchemaAttributeConstraintChanged(maxValue, v.MaxValue) || userPoolSchemaAttributeConstraintChanged(minValue, v.MinValue) {
+			changes = append(changes, "number_attribute_constraints")
+		}
+	}
+
+	if v := configured.StringAttributeConstraints; v != nil {
+		var maxLength, minLength *string
+		if existing.StringAttributeConstraints != nil {
+			maxLength, minLength = existing.StringAttributeConstraints.MaxLength, existing.StringAttributeConstraints.MinLength
+		}
+
+		if userPoolSchemaAttributeConstraintChanged(maxLength, v.MaxLength) || userPoolSchemaAttributeConstraintChanged(minLength, v.MinLength) {
+			changes = append(changes, "string_attribute_constraints")
+		}
+	}
+
+	return changes
+}
+
+// userPoolSchemaAttributeConstraintChanged returns whether a configured constraint differs from the existing one.
+// A constraint omitted from the configuration keeps the API's value.
+func userPoolSchemaAttributeConstraintChanged(existing, configured *string) bool {
+	return configured != nil && aws.ToString(existing) != aws.ToString(configured)
+}
+
 func resourceUserPoolSchemaHash(v any) int {
 	var buf bytes.Buffer
 	m, ok := v.(map[string]any)
@@ -1724,7 +1724,7 @@ func TestAccCognitoIDPUserPool_schemaAttributesStringAttributeConstraints(t *tes
 				// Attempting to explicitly set constraints to non-default values after creation
 				// should trigger an error
 				Config:      testAccUserPoolConfig_schemaAttributes(rName),
-				ExpectError: regexache.MustCompile("cannot modify or remove schema items"),
+				ExpectError: regexache.MustCompile(`attribute "email": string_attribute_constraints cannot be changed`),
 			},
 		},
 	})
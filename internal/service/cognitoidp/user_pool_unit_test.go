@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestUserPoolSchemaAttributesToAdd(t *testing.T) {
+	t.Parallel()
+
+	attribute := func(name, dataType string, developerOnly, mutable, required bool) map[string]any {
+		return map[string]any{
+			"attribute_data_type":          dataType,
+			"developer_only_attribute":     developerOnly,
+			"mutable":                      mutable,
+			names.AttrName:                 name,
+			"number_attribute_constraints": []any{},
+			"required":                     required,
+			"string_attribute_constraints": []any{},
+		}
+	}
+	withStringConstraints := func(tfMap map[string]any, minLength, maxLength string) map[string]any {
+		tfMap["string_attribute_constraints"] = []any{map[string]any{
+			"max_length": maxLength,
+			"min_length": minLength,
+		}}
+		return tfMap
+	}
+
+	cases := []struct {
+		name          string
+		old, new      []any
+		wantAdded     []string
+		wantErrorText []string
+	}{
+		{
+			name: "add custom attributes",
+			old: []any{
+				withStringConstraints(attribute(names.AttrEmail, "String", false, false, true), "5", "10"),
+			},
+			new: []any{
+				withStringConstraints(attribute(names.AttrEmail, "String", false, false, true), "5", "10"),
+				attribute("mybool", "Boolean", true, false, false),
+				attribute("mynumber", "Number", false, true, false),
+			},
+			wantAdded: []string{"mybool", "mynumber"},
+		},
+		{
+			name: "omitted constraints",
+			old: []any{
+				withStringConstraints(attribute("strattr", "String", false, true, false), "0", "2048"),
+			},
+			new: []any{
+				attribute("strattr", "String", false, true, false),
+			},
+		},
+		{
+			name: "partially omitted constraints",
+			old: []any{
+				withStringConstraints(attribute("strattr", "String", false, true, false), "0", "2048"),
+			},
+			new: []any{
+				withStringConstraints(attribute("strattr", "String", false, true, false), "", "2048"),
+			},
+		},
+		{
+			name: "partially configured constraints changed",
+			old: []any{
+				withStringConstraints(attribute("strattr", "String", false, true, false), "0", "2048"),
+			},
+			new: []any{
+				withStringConstraints(attribute("strattr", "String", false, true, false), "", "256"),
+			},
+			wantErrorText: []string{`attribute "strattr": string_attribute_constraints cannot be changed`},
+		},
+		{
+			name: "add default standard attribute",
+			new: []any{
+				withStringConstraints(attribute("website", "String", false, true, false), "0", "2048"),
+			},
+		},
+		{
+			name: "remove default standard attribute",
+			old: []any{
+				withStringConstraints(attribute("website", "String", false, true, false), "0", "2048"),
+			},
+		},
+		{
+			name: "modify attribute",
+			old: []any{
+				attribute("mybool", "Boolean", true, false, false),
+			},
+			new: []any{
+				attribute("mybool", "Boolean", false, true, false),
+			},
+			wantErrorText: []string{`attribute "mybool": developer_only_attribute, mutable cannot be changed`},
+		},
+		{
+			name: "remove and rename attributes",
+			old: []any{
+				withStringConstraints(attribute(names.AttrEmail, "String", false, false, true), "5", "10"),
+				attribute("mybool", "Boolean", true, false, false),
+			},
+			new: []any{
+				attribute("mybool2", "Boolean", true, false, false),
+			},
+			wantErrorText: []string{`attribute "email" cannot be removed`, `attribute "mybool" cannot be removed`},
+		},
+		{
+			name: "change standard attribute",
+			new: []any{
+				attribute("phone_number", "String", false, true, true),
+			},
+			wantErrorText: []string{`standard attribute "phone_number": required cannot be changed from the default`},
+		},
+		{
+			name: "required custom attribute",
+			new: []any{
+				attribute("tenant", "String", false, true, true),
+			},
+			wantErrorText: []string{`custom attribute "tenant": custom attributes cannot be required`},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := userPoolSchemaAttributesToAdd(tc.old, tc.new)
+
+			if len(tc.wantErrorText) > 0 {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				for _, text := range tc.wantErrorText {
+					if !strings.Contains(err.Error(), text) {
+						t.Errorf("expected error containing %q, got %q", text, err)
+					}
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var gotAdded []string
+			for _, apiObject := range got {
+				gotAdded = append(gotAdded, aws.ToString(apiObject.Name))
+			}
+			slices.Sort(gotAdded)
+
+			if !slices.Equal(gotAdded, tc.wantAdded) {
+				t.Errorf("added %v, want %v", gotAdded, tc.wantAdded)
+			}
+		})
+	}
+}
@@ -66,7 +66,7 @@ func resourceAggregateAuthorization() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				ExactlyOneOf: []string{"authorized_aws_region", names.AttrRegion},
-				Deprecated:   "region is deprecated. Use authorized_aws_region instead.",
+				Deprecated:   names.ResourceDeprecationMessage("aws_config_aggregate_authorization", names.AttrRegion),
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
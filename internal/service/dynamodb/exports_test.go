@@ -15,11 +15,14 @@ var (
 	ResourceTag                         = resourceTag
 	ResourceResourcePolicy              = newResourcePolicyResource
 	ResourceGlobalSecondaryIndex        = newResourceGlobalSecondaryIndex
+	ResourceTableItems                  = newTableItemsResource
 
 	ARNForNewRegion                              = arnForNewRegion
 	ContributorInsightsParseResourceID           = contributorInsightsParseResourceID
 	ExpandTableItemAttributes                    = expandTableItemAttributes
 	ExpandTableItemQueryKey                      = expandTableItemQueryKey
+	ExpandTableItemsCSV                          = expandTableItemsCSV
+	ExpandTableItemsJSON                         = expandTableItemsJSON
 	FindContributorInsightsByTwoPartKey          = findContributorInsightsByTwoPartKey
 	FindGlobalTableByName                        = findGlobalTableByName
 	FindGSIByTwoPartKey                          = findGSIByTwoPartKey
@@ -28,11 +31,14 @@ var (
 	FindTableByName                              = findTableByName
 	FindTableExportByARN                         = findTableExportByARN
 	FindTableItemByTwoPartKey                    = findTableItemByTwoPartKey
+	FindTableItems                               = findTableItems
 	FindTag                                      = findTag
 	FlattenTableItemAttributes                   = flattenTableItemAttributes
 	ListTags                                     = listTags
 	RegionFromARN                                = regionFromARN
 	ReplicaForRegion                             = replicaForRegion
+	TableItemKey                                 = tableItemKey
+	TableItemKeyAndHash                          = tableItemKeyAndHash
 	TableNameFromARN                             = tableNameFromARN
 	TableReplicaParseResourceID                  = tableReplicaParseResourceID
 	UpdateDiffGSI                                = updateDiffGSI
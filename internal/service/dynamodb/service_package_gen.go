@@ -68,6 +68,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 				WrappedImport: true,
 			},
 		},
+		{
+			Factory:  newTableItemsResource,
+			TypeName: "aws_dynamodb_table_items",
+			Name:     "Table Items",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
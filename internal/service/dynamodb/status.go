@@ -68,6 +68,22 @@ func statusImport(conn *dynamodb.Client, importARN string) retry.StateRefreshFun
 	}
 }
 
+func statusBackup(conn *dynamodb.Client, arn string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findBackupByARN(ctx, conn, arn)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BackupDetails.BackupStatus), nil
+	}
+}
+
 func statusReplicaUpdate(conn *dynamodb.Client, tableName, region string, optFns ...func(*dynamodb.Options)) retry.StateRefreshFunc {
 	return func(ctx context.Context) (any, string, error) {
 		output, err := findTableByName(ctx, conn, tableName, optFns...)
@@ -100,6 +100,20 @@ func resourceTable() *schema.Resource {
 				}
 				return nil
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+				// An in-place restore re-creates the table, which creates a new stream.
+				if diff.Id() != "" && diff.HasChange("point_in_time_restore") && diff.Get("stream_enabled").(bool) {
+					if v := diff.Get("point_in_time_restore").([]any); len(v) > 0 && v[0] != nil {
+						if err := diff.SetNewComputed(names.AttrStreamARN); err != nil {
+							return fmt.Errorf("setting stream_arn to computed: %w", err)
+						}
+						if err := diff.SetNewComputed("stream_label"); err != nil {
+							return fmt.Errorf("setting stream_label to computed: %w", err)
+						}
+					}
+				}
+				return nil
+			},
 			customdiff.ForceNewIfChange("restore_source_name", func(_ context.Context, old, new, meta any) bool {
 				// If they differ force new unless new is cleared
 				// https://github.com/hashicorp/terraform-provider-aws/issues/25214
@@ -190,7 +204,7 @@ func resourceTable() *schema.Resource {
 								Type:       schema.TypeString,
 								Optional:   true,
 								Computed:   true,
-								Deprecated: "hash_key is deprecated. Use key_schema instead.",
+								Deprecated: names.ResourceDeprecationMessage("aws_dynamodb_table", "global_secondary_index.hash_key"),
 							},
 							"key_schema": {
 								Type:     schema.TypeList,
@@ -228,7 +242,7 @@ func resourceTable() *schema.Resource {
 							"range_key": {
 								Type:       schema.TypeString,
 								Optional:   true,
-								Deprecated: "range_key is deprecated. Use key_schema instead.",
+								Deprecated: names.ResourceDeprecationMessage("aws_dynamodb_table", "global_secondary_index.range_key"),
 							},
 							"read_capacity": {
 								Type:     schema.TypeInt,
@@ -396,6 +410,31 @@ func resourceTable() *schema.Resource {
 						},
 					},
 				},
+				"point_in_time_restore": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"minimum_item_count": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+							},
+							"restore_date_time": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ExactlyOneOf: []string{"point_in_time_restore.0.restore_date_time", "point_in_time_restore.0.restore_to_latest_time"},
+								ValidateFunc: verify.ValidUTCTimestamp,
+							},
+							"restore_to_latest_time": {
+								Type:         schema.TypeBool,
+								Optional:     true,
+								ExactlyOneOf: []string{"point_in_time_restore.0.restore_date_time", "point_in_time_restore.0.restore_to_latest_time"},
+							},
+						},
+					},
+				},
 				"range_key": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -1086,6 +1125,23 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
 
+	// A point-in-time restore re-creates the table with its configured settings,
+	// so no other updates are necessary.
+	if d.HasChange("point_in_time_restore") {
+		if v := d.Get("point_in_time_restore").([]any); len(v) > 0 && v[0] != nil {
+			// Keep the prior state until the whole restore has succeeded, so that a failed restore is retried.
+			d.Partial(true)
+
+			if err := restoreTableInPlace(ctx, meta.(*conns.AWSClient), d, v[0].(map[string]any)); err != nil {
+				return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionUpdating, resNameTable, d.Id(), fmt.Errorf("point-in-time restore: %w", err))
+			}
+
+			d.Partial(false)
+
+			return append(diags, resourceTableRead(ctx, d, meta)...)
+		}
+	}
+
 	o, n := d.GetChange("billing_mode")
 	newBillingMode, oldBillingMode := awstypes.BillingMode(n.(string)), awstypes.BillingMode(o.(string))
 
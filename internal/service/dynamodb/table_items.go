@@ -0,0 +1,904 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_BatchWriteItem.html and
+// https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_BatchGetItem.html.
+const (
+	batchGetItemMaxKeys       = 100
+	batchWriteItemMaxRequests = 25
+)
+
+const (
+	tableItemKeySeparator = "|"
+)
+
+// @FrameworkResource("aws_dynamodb_table_items", name="Table Items")
+func newTableItemsResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &tableItemsResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+var (
+	_ resource.ResourceWithConfigValidators = &tableItemsResource{}
+	_ resource.ResourceWithModifyPlan       = &tableItemsResource{}
+)
+
+type tableItemsResource struct {
+	framework.ResourceWithModel[tableItemsResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *tableItemsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authoritative": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"hash_key": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"item_hashes": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"items": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.NoNullValues(),
+				},
+			},
+			"items_csv": schema.StringAttribute{
+				Optional: true,
+			},
+			"items_json": schema.StringAttribute{
+				Optional: true,
+			},
+			"range_key": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTableName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *tableItemsResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("items"),
+			path.MatchRoot("items_csv"),
+			path.MatchRoot("items_json"),
+		),
+	}
+}
+
+func (r *tableItemsResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan tableItemsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.itemsKnown() {
+		plan.ItemHashes = fwtypes.NewMapValueOfUnknown[types.String](ctx)
+		response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	items, diags := plan.expandItems(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The planned hashes are those of the configured items.
+	// Read sets the hashes of the items actually in the table, so any per-item drift shows up as a diff.
+	hashes, err := tableItemHashes(items, plan.HashKey.ValueString(), plan.RangeKey.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("invalid DynamoDB Table Items", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, hashes, &plan.ItemHashes)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+}
+
+func (r *tableItemsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data tableItemsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DynamoDBClient(ctx)
+
+	items, diags := data.expandItems(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tableName, hashKey, rangeKey := data.TableName.ValueString(), data.HashKey.ValueString(), data.RangeKey.ValueString()
+	hashes, err := tableItemHashes(items, hashKey, rangeKey)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DynamoDB Table (%s) Items", tableName), err.Error())
+
+		return
+	}
+
+	createTimeout := r.CreateTimeout(ctx, data.Timeouts)
+
+	if err := putTableItems(ctx, conn, tableName, items, createTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DynamoDB Table (%s) Items", tableName), err.Error())
+
+		return
+	}
+
+	if data.Authoritative.ValueBool() {
+		if err := deleteUnknownTableItems(ctx, conn, tableName, hashKey, rangeKey, items, createTimeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("creating DynamoDB Table (%s) Items", tableName), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, hashes, &data.ItemHashes)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *tableItemsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data tableItemsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DynamoDBClient(ctx)
+
+	items, diags := data.expandItems(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tableName, hashKey, rangeKey := data.TableName.ValueString(), data.HashKey.ValueString(), data.RangeKey.ValueString()
+
+	var actual []map[string]awstypes.AttributeValue
+	var err error
+	if data.Authoritative.ValueBool() {
+		actual, err = findTableItems(ctx, conn, tableName)
+	} else {
+		keys := tfslices.ApplyToAll(items, func(v map[string]awstypes.AttributeValue) map[string]awstypes.AttributeValue {
+			return expandTableItemQueryKey(v, hashKey, rangeKey)
+		})
+		actual, err = findTableItemsByKeys(ctx, conn, tableName, keys)
+	}
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DynamoDB Table (%s) Items", tableName), err.Error())
+
+		return
+	}
+
+	hashes, err := tableItemHashes(actual, hashKey, rangeKey)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DynamoDB Table (%s) Items", tableName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, hashes, &data.ItemHashes)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tableItemsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new tableItemsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DynamoDBClient(ctx)
+
+	oldItems, diags := old.expandItems(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	newItems, diags := new.expandItems(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tableName, hashKey, rangeKey := new.TableName.ValueString(), new.HashKey.ValueString(), new.RangeKey.ValueString()
+	updateTimeout := r.UpdateTimeout(ctx, new.Timeouts)
+
+	// Only write items that are missing or differ from what was last read.
+	have := fwflex.ExpandFrameworkStringValueMap(ctx, old.ItemHashes)
+	want := make(map[string]string, len(newItems))
+	var puts []map[string]awstypes.AttributeValue
+	for _, item := range newItems {
+		key, hash, err := tableItemKeyAndHash(item, hashKey, rangeKey)
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DynamoDB Table (%s) Items", tableName), err.Error())
+
+			return
+		}
+
+		want[key] = hash
+		if v, ok := have[key]; !ok || v != hash {
+			puts = append(puts, item)
+		}
+	}
+
+	if err := putTableItems(ctx, conn, tableName, puts, updateTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating DynamoDB Table (%s) Items", tableName), err.Error())
+
+		return
+	}
+
+	if new.Authoritative.ValueBool() {
+		if err := deleteUnknownTableItems(ctx, conn, tableName, hashKey, rangeKey, newItems, updateTimeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DynamoDB Table (%s) Items", tableName), err.Error())
+
+			return
+		}
+	} else {
+		// Remove items that were previously configured but no longer are.
+		var deletes []map[string]awstypes.AttributeValue
+		for _, item := range oldItems {
+			key, err := tableItemKey(item, hashKey, rangeKey)
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating DynamoDB Table (%s) Items", tableName), err.Error())
+
+				return
+			}
+
+			if _, ok := want[key]; !ok {
+				deletes = append(deletes, expandTableItemQueryKey(item, hashKey, rangeKey))
+			}
+		}
+
+		if err := deleteTableItems(ctx, conn, tableName, deletes, updateTimeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DynamoDB Table (%s) Items", tableName), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, want, &new.ItemHashes)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *tableItemsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data tableItemsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DynamoDBClient(ctx)
+
+	items, diags := data.expandItems(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tableName, hashKey, rangeKey := data.TableName.ValueString(), data.HashKey.ValueString(), data.RangeKey.ValueString()
+	keys := tfslices.ApplyToAll(items, func(v map[string]awstypes.AttributeValue) map[string]awstypes.AttributeValue {
+		return expandTableItemQueryKey(v, hashKey, rangeKey)
+	})
+
+	err := deleteTableItems(ctx, conn, tableName, keys, r.DeleteTimeout(ctx, data.Timeouts))
+
+	if retry.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DynamoDB Table (%s) Items", tableName), err.Error())
+
+		return
+	}
+}
+
+type tableItemsResourceModel struct {
+	framework.WithRegionModel
+	Authoritative types.Bool          `tfsdk:"authoritative"`
+	HashKey       types.String        `tfsdk:"hash_key"`
+	ItemHashes    fwtypes.MapOfString `tfsdk:"item_hashes"`
+	Items         fwtypes.SetOfString `tfsdk:"items"`
+	ItemsCSV      types.String        `tfsdk:"items_csv"`
+	ItemsJSON     types.String        `tfsdk:"items_json"`
+	RangeKey      types.String        `tfsdk:"range_key"`
+	TableName     types.String        `tfsdk:"table_name"`
+	Timeouts      timeouts.Value      `tfsdk:"timeouts"`
+}
+
+func (data *tableItemsResourceModel) itemsKnown() bool {
+	return data.Items.IsFullyKnown() && !data.ItemsCSV.IsUnknown() && !data.ItemsJSON.IsUnknown() && !data.HashKey.IsUnknown() && !data.RangeKey.IsUnknown()
+}
+
+// expandItems returns the items from whichever of `items`, `items_csv` or `items_json` is set.
+func (data *tableItemsResourceModel) expandItems(ctx context.Context) ([]map[string]awstypes.AttributeValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var items []map[string]awstypes.AttributeValue
+
+	switch {
+	case !data.Items.IsNull():
+		for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, data.Items) {
+			item, err := expandTableItemAttributes(v)
+			if err != nil {
+				diags.AddAttributeError(path.Root("items"), "invalid DynamoDB Table Item", err.Error())
+				return nil, diags
+			}
+
+			items = append(items, item)
+		}
+	case !data.ItemsCSV.IsNull():
+		v, err := expandTableItemsCSV(data.ItemsCSV.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("items_csv"), "invalid DynamoDB Table Items CSV", err.Error())
+			return nil, diags
+		}
+
+		items = v
+	case !data.ItemsJSON.IsNull():
+		v, err := expandTableItemsJSON(data.ItemsJSON.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("items_json"), "invalid DynamoDB Table Items JSON", err.Error())
+			return nil, diags
+		}
+
+		items = v
+	}
+
+	// Every item must be uniquely identified by its primary key.
+	hashKey, rangeKey := data.HashKey.ValueString(), data.RangeKey.ValueString()
+	seen := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		key, err := tableItemKey(item, hashKey, rangeKey)
+		if err != nil {
+			diags.AddError("invalid DynamoDB Table Item", err.Error())
+			return nil, diags
+		}
+
+		if _, ok := seen[key]; ok {
+			diags.AddError("invalid DynamoDB Table Items", fmt.Sprintf("duplicate item key: %s", key))
+			return nil, diags
+		}
+		seen[key] = struct{}{}
+	}
+
+	return items, diags
+}
+
+// expandTableItemsJSON parses a JSON array of items, each in DynamoDB JSON format.
+func expandTableItemsJSON(jsonStream string) ([]map[string]awstypes.AttributeValue, error) {
+	var rawItems []map[string]any
+
+	if err := tfjson.DecodeFromString(jsonStream, &rawItems); err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAllWithError(rawItems, func(v map[string]any) (map[string]awstypes.AttributeValue, error) {
+		item := make(map[string]awstypes.AttributeValue, len(v))
+		for k, v := range v {
+			attr, err := attributeFromRaw(v)
+			if err != nil {
+				return nil, fmt.Errorf("attribute %q: %w", k, err)
+			}
+			item[k] = attr
+		}
+		return item, nil
+	})
+}
+
+// expandTableItemsCSV parses CSV with a header row.
+// Each header is an attribute name with an optional `:TYPE` suffix (`S`, `N`, `B` or `BOOL`), defaulting to `S`.
+// Empty cells are omitted from the item.
+func expandTableItemsCSV(csvStream string) ([]map[string]awstypes.AttributeValue, error) {
+	reader := csv.NewReader(strings.NewReader(csvStream))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("missing header row")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	type column struct {
+		name, dataType string
+	}
+	columns := make([]column, len(header))
+	for i, v := range header {
+		name, dataType, ok := strings.Cut(strings.TrimSpace(v), ":")
+		if !ok {
+			dataType = dataTypeDescriptorString
+		}
+		switch dataType {
+		case dataTypeDescriptorBinary, dataTypeDescriptorBoolean, dataTypeDescriptorNumber, dataTypeDescriptorString:
+		default:
+			return nil, fmt.Errorf("column %q: unsupported data type descriptor: %s", name, dataType)
+		}
+		if name == "" {
+			return nil, fmt.Errorf("column %d: empty attribute name", i+1)
+		}
+		columns[i] = column{name: name, dataType: dataType}
+	}
+
+	var items []map[string]awstypes.AttributeValue
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		item := make(map[string]awstypes.AttributeValue, len(record))
+		for i, v := range record {
+			if v == "" {
+				continue
+			}
+
+			switch column := columns[i]; column.dataType {
+			case dataTypeDescriptorBinary:
+				b, err := inttypes.Base64Decode(v)
+				if err != nil {
+					return nil, fmt.Errorf("column %q: %w", column.name, err)
+				}
+				item[column.name] = &awstypes.AttributeValueMemberB{Value: b}
+			case dataTypeDescriptorBoolean:
+				b, err := strconv.ParseBool(v)
+				if err != nil {
+					return nil, fmt.Errorf("column %q: %w", column.name, err)
+				}
+				item[column.name] = &awstypes.AttributeValueMemberBOOL{Value: b}
+			case dataTypeDescriptorNumber:
+				if _, ok := new(big.Rat).SetString(v); !ok {
+					return nil, fmt.Errorf("column %q: invalid number: %s", column.name, v)
+				}
+				item[column.name] = &awstypes.AttributeValueMemberN{Value: v}
+			case dataTypeDescriptorString:
+				item[column.name] = &awstypes.AttributeValueMemberS{Value: v}
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// tableItemKey returns a string uniquely identifying an item by its primary key.
+// Key components are escaped so that a separator in a hash key value cannot make two different keys equal.
+func tableItemKey(item map[string]awstypes.AttributeValue, hashKey, rangeKey string) (string, error) {
+	v, ok := keyAttributeValueString(item[hashKey])
+	if !ok {
+		return "", fmt.Errorf("item is missing hash key attribute %q", hashKey)
+	}
+
+	if rangeKey == "" {
+		return v, nil
+	}
+
+	r, ok := keyAttributeValueString(item[rangeKey])
+	if !ok {
+		return "", fmt.Errorf("item (%s) is missing range key attribute %q", v, rangeKey)
+	}
+
+	return v + tableItemKeySeparator + r, nil
+}
+
+var tableItemKeyEscaper = strings.NewReplacer(`\`, `\\`, tableItemKeySeparator, `\`+tableItemKeySeparator)
+
+// keyAttributeValueString returns the escaped, canonical string form of a key attribute value.
+// Numbers are canonicalized as DynamoDB compares them by value, not formatting.
+func keyAttributeValueString(v awstypes.AttributeValue) (string, bool) {
+	switch v := v.(type) {
+	case *awstypes.AttributeValueMemberB:
+		return inttypes.Base64EncodeOnce(v.Value), true
+	case *awstypes.AttributeValueMemberN:
+		return canonicalNumber(v.Value), true
+	case *awstypes.AttributeValueMemberS:
+		return tableItemKeyEscaper.Replace(v.Value), true
+	}
+
+	return "", false
+}
+
+func tableItemKeyAndHash(item map[string]awstypes.AttributeValue, hashKey, rangeKey string) (string, string, error) {
+	key, err := tableItemKey(item, hashKey, rangeKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	raw, err := rawFromAttribute(&awstypes.AttributeValueMemberM{Value: item})
+	if err != nil {
+		return "", "", err
+	}
+
+	// Encoding sorts map keys, so the hash only depends on the normalized item content.
+	s, err := tfjson.EncodeToString(normalizeRawAttribute(raw))
+	if err != nil {
+		return "", "", err
+	}
+
+	hash := sha256.Sum256([]byte(s))
+
+	return key, hex.EncodeToString(hash[:]), nil
+}
+
+func tableItemHashes(items []map[string]awstypes.AttributeValue, hashKey, rangeKey string) (map[string]string, error) {
+	hashes := make(map[string]string, len(items))
+
+	for _, item := range items {
+		key, hash, err := tableItemKeyAndHash(item, hashKey, rangeKey)
+		if err != nil {
+			return nil, err
+		}
+
+		hashes[key] = hash
+	}
+
+	return hashes, nil
+}
+
+// normalizeRawAttribute normalizes a raw attribute so that semantically equal values are identical.
+// DynamoDB does not preserve the order of set members or the formatting of numbers.
+func normalizeRawAttribute(v any) any {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return v
+	}
+
+	output := make(map[string]any, len(m))
+	for k, v := range m {
+		switch k {
+		case dataTypeDescriptorList:
+			output[k] = tfslices.ApplyToAll(v.([]any), normalizeRawAttribute)
+		case dataTypeDescriptorMap:
+			output[k] = tfmaps.ApplyToAllValues(v.(map[string]any), normalizeRawAttribute)
+		case dataTypeDescriptorNumber:
+			output[k] = normalizeNumber(v.(string))
+		case dataTypeDescriptorNumberSet:
+			output[k] = slices.Sorted(slices.Values(tfslices.ApplyToAll(v.([]string), normalizeNumber)))
+		case dataTypeDescriptorBinarySet, dataTypeDescriptorStringSet:
+			output[k] = slices.Sorted(slices.Values(v.([]string)))
+		default:
+			output[k] = v
+		}
+	}
+
+	return output
+}
+
+func normalizeNumber(s string) string {
+	if v, ok := new(big.Rat).SetString(s); ok {
+		return v.RatString()
+	}
+
+	return s
+}
+
+// canonicalNumber returns the shortest exact decimal form of a number, e.g. "1.0" and "1e0" both return "1".
+func canonicalNumber(s string) string {
+	v, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return s
+	}
+
+	if v.IsInt() {
+		return v.RatString()
+	}
+
+	// DynamoDB numbers have at most 38 significant digits and a magnitude of at least 1e-130.
+	for prec := 1; prec <= 170; prec++ {
+		s := v.FloatString(prec)
+		if w, _ := new(big.Rat).SetString(s); w.Cmp(v) == 0 {
+			return s
+		}
+	}
+
+	return v.RatString()
+}
+
+func putTableItems(ctx context.Context, conn *dynamodb.Client, tableName string, items []map[string]awstypes.AttributeValue, timeout time.Duration) error {
+	requests := tfslices.ApplyToAll(items, func(v map[string]awstypes.AttributeValue) awstypes.WriteRequest {
+		return awstypes.WriteRequest{
+			PutRequest: &awstypes.PutRequest{
+				Item: v,
+			},
+		}
+	})
+
+	return batchWriteTableItems(ctx, conn, tableName, requests, timeout)
+}
+
+func deleteTableItems(ctx context.Context, conn *dynamodb.Client, tableName string, keys []map[string]awstypes.AttributeValue, timeout time.Duration) error {
+	requests := tfslices.ApplyToAll(keys, func(v map[string]awstypes.AttributeValue) awstypes.WriteRequest {
+		return awstypes.WriteRequest{
+			DeleteRequest: &awstypes.DeleteRequest{
+				Key: v,
+			},
+		}
+	})
+
+	return batchWriteTableItems(ctx, conn, tableName, requests, timeout)
+}
+
+// deleteUnknownTableItems deletes all items in the table that are not in the specified items.
+func deleteUnknownTableItems(ctx context.Context, conn *dynamodb.Client, tableName, hashKey, rangeKey string, items []map[string]awstypes.AttributeValue, timeout time.Duration) error {
+	want := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		key, err := tableItemKey(item, hashKey, rangeKey)
+		if err != nil {
+			return err
+		}
+		want[key] = struct{}{}
+	}
+
+	have, err := findTableItems(ctx, conn, tableName)
+	if err != nil {
+		return err
+	}
+
+	var deletes []map[string]awstypes.AttributeValue
+	for _, item := range have {
+		key, err := tableItemKey(item, hashKey, rangeKey)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := want[key]; !ok {
+			deletes = append(deletes, expandTableItemQueryKey(item, hashKey, rangeKey))
+		}
+	}
+
+	return deleteTableItems(ctx, conn, tableName, deletes, timeout)
+}
+
+// batchWriteTableItems writes the specified requests in batches, retrying any unprocessed items.
+func batchWriteTableItems(ctx context.Context, conn *dynamodb.Client, tableName string, requests []awstypes.WriteRequest, timeout time.Duration) error {
+	for chunk := range slices.Chunk(requests, batchWriteItemMaxRequests) {
+		requestItems := map[string][]awstypes.WriteRequest{
+			tableName: chunk,
+		}
+
+		for l := backoff.NewLoop(timeout); l.Continue(ctx); {
+			input := dynamodb.BatchWriteItemInput{
+				RequestItems: requestItems,
+			}
+			output, err := conn.BatchWriteItem(ctx, &input)
+
+			if errs.IsA[*awstypes.ProvisionedThroughputExceededException](err) || errs.IsA[*awstypes.RequestLimitExceeded](err) {
+				continue
+			}
+
+			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+				return &retry.NotFoundError{
+					LastError: err,
+				}
+			}
+
+			if err != nil {
+				return err
+			}
+
+			requestItems = output.UnprocessedItems
+			if len(requestItems[tableName]) == 0 {
+				break
+			}
+		}
+
+		if n := len(requestItems[tableName]); n > 0 {
+			return unprocessedTableItemsError(ctx, fmt.Errorf("%d unprocessed items", n), timeout)
+		}
+	}
+
+	return nil
+}
+
+// findTableItemsByKeys returns the items with the specified primary keys, retrying any unprocessed keys.
+// Keys with no corresponding item are ignored.
+func findTableItemsByKeys(ctx context.Context, conn *dynamodb.Client, tableName string, keys []map[string]awstypes.AttributeValue) ([]map[string]awstypes.AttributeValue, error) {
+	var items []map[string]awstypes.AttributeValue
+
+	for chunk := range slices.Chunk(keys, batchGetItemMaxKeys) {
+		requestItems := map[string]awstypes.KeysAndAttributes{
+			tableName: {
+				ConsistentRead: aws.Bool(true),
+				Keys:           chunk,
+			},
+		}
+
+		for l := backoff.NewLoop(propagationTimeout); l.Continue(ctx); {
+			input := dynamodb.BatchGetItemInput{
+				RequestItems: requestItems,
+			}
+			output, err := conn.BatchGetItem(ctx, &input)
+
+			if errs.IsA[*awstypes.ProvisionedThroughputExceededException](err) || errs.IsA[*awstypes.RequestLimitExceeded](err) {
+				continue
+			}
+
+			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+				return nil, &retry.NotFoundError{
+					LastError: err,
+				}
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			items = append(items, output.Responses[tableName]...)
+
+			requestItems = output.UnprocessedKeys
+			if len(requestItems[tableName].Keys) == 0 {
+				break
+			}
+		}
+
+		if n := len(requestItems[tableName].Keys); n > 0 {
+			return nil, unprocessedTableItemsError(ctx, fmt.Errorf("%d unprocessed keys", n), propagationTimeout)
+		}
+	}
+
+	return items, nil
+}
+
+// unprocessedTableItemsError returns the error for a batch operation that stopped retrying with unprocessed requests remaining,
+// either because the context was canceled or because the timeout elapsed.
+func unprocessedTableItemsError(ctx context.Context, err error, timeout time.Duration) error {
+	if cause := context.Cause(ctx); cause != nil {
+		return fmt.Errorf("%w: %w", err, cause)
+	}
+
+	return &retry.TimeoutError{
+		LastError:     err,
+		LastState:     "unprocessed",
+		Timeout:       timeout,
+		ExpectedState: []string{"processed"},
+	}
+}
+
+// findTableItems returns all items in the table.
+func findTableItems(ctx context.Context, conn *dynamodb.Client, tableName string) ([]map[string]awstypes.AttributeValue, error) {
+	input := dynamodb.ScanInput{
+		ConsistentRead: aws.Bool(true),
+		TableName:      aws.String(tableName),
+	}
+	var items []map[string]awstypes.AttributeValue
+
+	pages := dynamodb.NewScanPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page.Items...)
+	}
+
+	return items, nil
+}
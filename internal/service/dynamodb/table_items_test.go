@@ -0,0 +1,653 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpandTableItemsCSV(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expected      []map[string]awstypes.AttributeValue
+		expectedError bool
+	}{
+		"empty": {
+			input:         "",
+			expectedError: true,
+		},
+		"header only": {
+			input: "id,name\n",
+		},
+		"default string type": {
+			input: "id,name\none,first\ntwo,second\n",
+			expected: []map[string]awstypes.AttributeValue{
+				{
+					"id":   &awstypes.AttributeValueMemberS{Value: "one"},
+					"name": &awstypes.AttributeValueMemberS{Value: "first"},
+				},
+				{
+					"id":   &awstypes.AttributeValueMemberS{Value: "two"},
+					"name": &awstypes.AttributeValueMemberS{Value: "second"},
+				},
+			},
+		},
+		"typed columns": {
+			input: "id:N,enabled:BOOL,data:B,name:S\n1,true,YmxvYg==,first\n",
+			expected: []map[string]awstypes.AttributeValue{
+				{
+					"id":      &awstypes.AttributeValueMemberN{Value: "1"},
+					"enabled": &awstypes.AttributeValueMemberBOOL{Value: true},
+					"data":    &awstypes.AttributeValueMemberB{Value: []byte("blob")},
+					"name":    &awstypes.AttributeValueMemberS{Value: "first"},
+				},
+			},
+		},
+		"empty cells omitted": {
+			input: "id,name\none,\n",
+			expected: []map[string]awstypes.AttributeValue{
+				{
+					"id": &awstypes.AttributeValueMemberS{Value: "one"},
+				},
+			},
+		},
+		"unsupported type": {
+			input:         "id:SS\none\n",
+			expectedError: true,
+		},
+		"invalid number": {
+			input:         "id:N\none\n",
+			expectedError: true,
+		},
+		"wrong number of fields": {
+			input:         "id,name\none\n",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfdynamodb.ExpandTableItemsCSV(testCase.input)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("ExpandTableItemsCSV(%q) err %t, want %t", testCase.input, got, want)
+			}
+
+			if err == nil {
+				if !slices.EqualFunc(got, testCase.expected, func(x, y map[string]awstypes.AttributeValue) bool {
+					return maps.EqualFunc(x, y, attributeValuesEqual)
+				}) {
+					t.Errorf("got %#v, want %#v", got, testCase.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestExpandTableItemsJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expected      []map[string]awstypes.AttributeValue
+		expectedError bool
+	}{
+		"empty array": {
+			input:    `[]`,
+			expected: []map[string]awstypes.AttributeValue{},
+		},
+		"items": {
+			input: `[{"id":{"S":"one"},"count":{"N":"1"}},{"id":{"S":"two"},"tags":{"SS":["a","b"]}}]`,
+			expected: []map[string]awstypes.AttributeValue{
+				{
+					"id":    &awstypes.AttributeValueMemberS{Value: "one"},
+					"count": &awstypes.AttributeValueMemberN{Value: "1"},
+				},
+				{
+					"id":   &awstypes.AttributeValueMemberS{Value: "two"},
+					"tags": &awstypes.AttributeValueMemberSS{Value: []string{"a", "b"}},
+				},
+			},
+		},
+		"not an array": {
+			input:         `{"id":{"S":"one"}}`,
+			expectedError: true,
+		},
+		"invalid attribute": {
+			input:         `[{"id":{"X":"one"}}]`,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfdynamodb.ExpandTableItemsJSON(testCase.input)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("ExpandTableItemsJSON(%q) err %t, want %t", testCase.input, got, want)
+			}
+
+			if err == nil {
+				if !slices.EqualFunc(got, testCase.expected, func(x, y map[string]awstypes.AttributeValue) bool {
+					return maps.EqualFunc(x, y, attributeValuesEqual)
+				}) {
+					t.Errorf("got %#v, want %#v", got, testCase.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestTableItemKeyAndHash(t *testing.T) {
+	t.Parallel()
+
+	item := func(n string, ss ...string) map[string]awstypes.AttributeValue {
+		return map[string]awstypes.AttributeValue{
+			"id":    &awstypes.AttributeValueMemberS{Value: "one"},
+			"sort":  &awstypes.AttributeValueMemberN{Value: "1"},
+			"count": &awstypes.AttributeValueMemberN{Value: n},
+			"tags":  &awstypes.AttributeValueMemberSS{Value: ss},
+		}
+	}
+
+	key, hash1, err := tfdynamodb.TableItemKeyAndHash(item("10", "a", "b"), "id", "sort")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := key, "one|1"; got != want {
+		t.Errorf("key = %q, want %q", got, want)
+	}
+
+	// Set member order and number formatting are not significant.
+	_, hash2, err := tfdynamodb.TableItemKeyAndHash(item("1.0e1", "b", "a"), "id", "sort")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if hash1 != hash2 {
+		t.Errorf("hashes of equivalent items differ: %s, %s", hash1, hash2)
+	}
+
+	_, hash3, err := tfdynamodb.TableItemKeyAndHash(item("11", "a", "b"), "id", "sort")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if hash1 == hash3 {
+		t.Errorf("hashes of different items are equal: %s", hash1)
+	}
+
+	if _, _, err := tfdynamodb.TableItemKeyAndHash(item("10"), "id", "missing"); err == nil {
+		t.Error("expected error for missing range key attribute")
+	}
+}
+
+func TestTableItemKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		item1, item2 map[string]awstypes.AttributeValue
+		wantEqual    bool
+	}{
+		"separator in hash key": {
+			item1: map[string]awstypes.AttributeValue{
+				"id":   &awstypes.AttributeValueMemberS{Value: "a|b"},
+				"sort": &awstypes.AttributeValueMemberS{Value: "c"},
+			},
+			item2: map[string]awstypes.AttributeValue{
+				"id":   &awstypes.AttributeValueMemberS{Value: "a"},
+				"sort": &awstypes.AttributeValueMemberS{Value: "b|c"},
+			},
+		},
+		"escape in hash key": {
+			item1: map[string]awstypes.AttributeValue{
+				"id":   &awstypes.AttributeValueMemberS{Value: `a\`},
+				"sort": &awstypes.AttributeValueMemberS{Value: "|b"},
+			},
+			item2: map[string]awstypes.AttributeValue{
+				"id":   &awstypes.AttributeValueMemberS{Value: `a\|`},
+				"sort": &awstypes.AttributeValueMemberS{Value: "b"},
+			},
+		},
+		"number formatting": {
+			item1: map[string]awstypes.AttributeValue{
+				"id":   &awstypes.AttributeValueMemberS{Value: "a"},
+				"sort": &awstypes.AttributeValueMemberN{Value: "1"},
+			},
+			item2: map[string]awstypes.AttributeValue{
+				"id":   &awstypes.AttributeValueMemberS{Value: "a"},
+				"sort": &awstypes.AttributeValueMemberN{Value: "1.0"},
+			},
+			wantEqual: true,
+		},
+		"different numbers": {
+			item1: map[string]awstypes.AttributeValue{
+				"id":   &awstypes.AttributeValueMemberS{Value: "a"},
+				"sort": &awstypes.AttributeValueMemberN{Value: "1"},
+			},
+			item2: map[string]awstypes.AttributeValue{
+				"id":   &awstypes.AttributeValueMemberS{Value: "a"},
+				"sort": &awstypes.AttributeValueMemberN{Value: "1.5"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			key1, err := tfdynamodb.TableItemKey(testCase.item1, "id", "sort")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			key2, err := tfdynamodb.TableItemKey(testCase.item2, "id", "sort")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := key1 == key2, testCase.wantEqual; got != want {
+				t.Errorf("keys %q and %q equal = %t, want %t", key1, key2, got, want)
+			}
+		})
+	}
+}
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dynamodb_table_items.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_items(rName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, t, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "authoritative", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "id"),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "item_hashes.item-0"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrTableName, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dynamodb_table_items.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_items(rName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, t, rName, 3),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfdynamodb.ResourceTableItems, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dynamodb_table_items.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_items(rName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, t, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "3"),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_items(rName, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, t, rName, 60),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "60"),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_items(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, t, rName, 2),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_csv(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dynamodb_table_items.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_csv(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, t, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "item_hashes.a|1"),
+					resource.TestCheckResourceAttrSet(resourceName, "item_hashes.a|2"),
+					resource.TestCheckResourceAttrSet(resourceName, "item_hashes.b|1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_json(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dynamodb_table_items.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_json(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, t, rName, 2),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "item_hashes.one"),
+					resource.TestCheckResourceAttrSet(resourceName, "item_hashes.two"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_duplicateKeys(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTableItemsConfig_duplicateKeys(rName),
+				ExpectError: regexache.MustCompile(`duplicate item key: one`),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dynamodb_table_items.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_items(rName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, t, rName, 3),
+					testAccCheckTableItemsPutItem(ctx, t, rName, `{"id":{"S":"item-1"},"value":{"S":"changed"}}`),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTableItemsConfig_items(rName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, t, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_authoritative(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dynamodb_table_items.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_authoritative(rName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, t, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "authoritative", acctest.CtTrue),
+					testAccCheckTableItemsPutItem(ctx, t, rName, `{"id":{"S":"unmanaged"}}`),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTableItemsConfig_authoritative(rName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, t, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTableItemsDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).DynamoDBClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dynamodb_table_items" {
+				continue
+			}
+
+			items, err := tfdynamodb.FindTableItems(ctx, conn, rs.Primary.Attributes[names.AttrTableName])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(items) > 0 {
+				return fmt.Errorf("DynamoDB Table (%s) Items still exist", rs.Primary.Attributes[names.AttrTableName])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableItemsPutItem(ctx context.Context, t *testing.T, tableName, item string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).DynamoDBClient(ctx)
+
+		attributes, err := tfdynamodb.ExpandTableItemAttributes(item)
+		if err != nil {
+			return err
+		}
+
+		input := dynamodb.PutItemInput{
+			Item:      attributes,
+			TableName: aws.String(tableName),
+		}
+		_, err = conn.PutItem(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccTableItemsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+}
+`, rName)
+}
+
+func testAccTableItemsConfig_items(rName string, n int) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), fmt.Sprintf(`
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = [for i in range(%[1]d) : jsonencode({
+    id    = { S = "item-${i}" }
+    value = { N = tostring(i) }
+  })]
+}
+`, n))
+}
+
+func testAccTableItemsConfig_authoritative(rName string, n int) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), fmt.Sprintf(`
+resource "aws_dynamodb_table_items" "test" {
+  table_name    = aws_dynamodb_table.test.name
+  hash_key      = aws_dynamodb_table.test.hash_key
+  authoritative = true
+
+  items = [for i in range(%[1]d) : jsonencode({
+    id    = { S = "item-${i}" }
+    value = { N = tostring(i) }
+  })]
+}
+`, n))
+}
+
+func testAccTableItemsConfig_csv(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "pk"
+  range_key    = "sk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+
+  attribute {
+    name = "sk"
+    type = "N"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  items_csv = <<CSV
+%[2]s
+CSV
+}
+`, rName, strings.Join([]string{
+		"pk,sk:N,enabled:BOOL,name",
+		"a,1,true,first",
+		"a,2,false,",
+		"b,1,true,third",
+	}, "\n"))
+}
+
+func testAccTableItemsConfig_json(rName string) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), `
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items_json = jsonencode([
+    {
+      id    = { S = "one" }
+      count = { N = "1" }
+    },
+    {
+      id   = { S = "two" }
+      tags = { SS = ["a", "b"] }
+    },
+  ])
+}
+`)
+}
+
+func testAccTableItemsConfig_duplicateKeys(rName string) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), `
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = [
+    jsonencode({ id = { S = "one" }, value = { N = "1" } }),
+    jsonencode({ id = { S = "one" }, value = { N = "2" } }),
+  ]
+}
+`)
+}
@@ -0,0 +1,487 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	applicationautoscalingtypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Maximum length of the prefix of temporary table names used for in-place restores.
+	// A unique suffix is appended to the prefix.
+	restoreTableNamePrefixMaxLen = 200
+)
+
+// restoreTableInPlace restores the table to a point in time while keeping its name, so that the
+// resource continues to manage the same table.
+//
+// DynamoDB can only restore to a new table, so the table is first restored to a temporary table.
+// After the temporary table has been validated, it's backed up, the table is deleted and the backup
+// is restored under the table's name using the configured settings.
+// Settings that aren't part of a restore (streams, TTL, point-in-time recovery, replicas, tags,
+// resource policy and auto scaling) are then applied to the restored table and the temporary table is deleted.
+// If the restore fails after the table has been deleted, the temporary table and the backup are retained and named in the error.
+func restoreTableInPlace(ctx context.Context, c *conns.AWSClient, d *schema.ResourceData, tfMap map[string]any) (err error) {
+	conn := c.DynamoDBClient(ctx)
+	tableName := d.Id()
+	tableARN := d.Get(names.AttrARN).(string)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	tempTableName := id.PrefixedUniqueId(fmt.Sprintf("%.*s-restore-", restoreTableNamePrefixMaxLen, tableName))
+
+	input := &dynamodb.RestoreTableToPointInTimeInput{
+		SourceTableName: aws.String(tableName),
+		TargetTableName: aws.String(tempTableName),
+	}
+
+	if v, ok := tfMap["restore_date_time"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		input.RestoreDateTime = aws.Time(t)
+	} else if v, ok := tfMap["restore_to_latest_time"].(bool); ok && v {
+		input.UseLatestRestorableTime = aws.Bool(true)
+	} else {
+		return errors.New("one of `restore_date_time` or `restore_to_latest_time` must be set")
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.SSESpecificationOverride = expandEncryptAtRestOptions(v.([]any))
+	}
+
+	log.Printf("[DEBUG] Restoring DynamoDB Table (%s) to temporary table: %s", tableName, tempTableName)
+	if _, err := retryTableRestore(ctx, func(ctx context.Context) (any, error) {
+		return conn.RestoreTableToPointInTime(ctx, input)
+	}); err != nil {
+		return fmt.Errorf("restoring to temporary table (%s): %w", tempTableName, err)
+	}
+
+	// Until the table has been deleted the temporary table and backup are not needed if the restore fails.
+	// After that, they're retained if the restore fails as they hold the restored data.
+	tableDeleted, restored := false, false
+	var backupARN string
+	defer func() {
+		if err != nil && tableDeleted && !restored {
+			err = fmt.Errorf("%w. The restored data is retained in temporary table (%s) and backup (%s)", err, tempTableName, backupARN)
+		}
+	}()
+	defer func() {
+		if tableDeleted && !restored {
+			log.Printf("[WARN] Retaining temporary DynamoDB Table (%s) with data restored from %s", tempTableName, tableName)
+			return
+		}
+
+		if err := deleteTable(ctx, conn, tempTableName); err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			log.Printf("[WARN] Deleting temporary DynamoDB Table (%s): %s", tempTableName, err)
+		}
+	}()
+
+	if _, err := waitTableActive(ctx, conn, tempTableName, timeout); err != nil {
+		return fmt.Errorf("waiting for temporary table (%s) create: %w", tempTableName, err)
+	}
+
+	if v, ok := tfMap["minimum_item_count"].(int); ok && v > 0 {
+		count, err := countTableItems(ctx, conn, tempTableName)
+
+		if err != nil {
+			return fmt.Errorf("counting items in temporary table (%s): %w", tempTableName, err)
+		}
+
+		if count < int64(v) {
+			return fmt.Errorf("temporary table (%s) contains %d items, fewer than minimum_item_count (%d)", tempTableName, count, v)
+		}
+	}
+
+	backupInput := &dynamodb.CreateBackupInput{
+		BackupName: aws.String(tempTableName),
+		TableName:  aws.String(tempTableName),
+	}
+
+	backupOutput, err := conn.CreateBackup(ctx, backupInput)
+
+	if err != nil {
+		return fmt.Errorf("creating backup of temporary table (%s): %w", tempTableName, err)
+	}
+
+	backupARN = aws.ToString(backupOutput.BackupDetails.BackupArn)
+	defer func() {
+		if tableDeleted && !restored {
+			log.Printf("[WARN] Retaining DynamoDB Backup (%s) with data restored from %s", backupARN, tableName)
+			return
+		}
+
+		input := &dynamodb.DeleteBackupInput{
+			BackupArn: aws.String(backupARN),
+		}
+		if _, err := conn.DeleteBackup(ctx, input); err != nil && !errs.IsA[*awstypes.BackupNotFoundException](err) {
+			log.Printf("[WARN] Deleting DynamoDB Backup (%s): %s", backupARN, err)
+		}
+	}()
+
+	if _, err := waitBackupAvailable(ctx, conn, backupARN, timeout); err != nil {
+		return fmt.Errorf("waiting for backup (%s) create: %w", backupARN, err)
+	}
+
+	// Settings that are attached to the table are lost when it's deleted.
+	var policy *string
+	if output, err := findResourcePolicyByARN(ctx, conn, tableARN); err == nil {
+		policy = output.Policy
+	} else if !retry.NotFound(err) {
+		return fmt.Errorf("reading resource policy: %w", err)
+	}
+
+	scaling, err := findTableScaling(ctx, c.AppAutoScalingClient(ctx), tableName)
+
+	if err != nil {
+		return fmt.Errorf("reading auto scaling: %w", err)
+	}
+
+	// Replicas are removed before the table is deleted and re-created from the restored table.
+	o, _ := d.GetChange("replica")
+	if replicas := o.(*schema.Set).List(); len(replicas) > 0 {
+		ow, _ := d.GetChange("global_table_witness")
+		if err := deleteReplicas(ctx, conn, tableName, replicas, expandGlobalTableWitness(ow), timeout); err != nil {
+			return fmt.Errorf("deleting replicas: %w", err)
+		}
+	}
+
+	if o, _ := d.GetChange("deletion_protection_enabled"); o.(bool) {
+		input := &dynamodb.UpdateTableInput{
+			DeletionProtectionEnabled: aws.Bool(false),
+			TableName:                 aws.String(tableName),
+		}
+
+		if _, err := conn.UpdateTable(ctx, input); err != nil {
+			return fmt.Errorf("disabling deletion protection: %w", err)
+		}
+
+		if _, err := waitTableActive(ctx, conn, tableName, timeout); err != nil {
+			return fmt.Errorf("waiting for deletion protection update: %w", err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table for restore: %s", tableName)
+	if err := deleteTable(ctx, conn, tableName); err != nil {
+		return fmt.Errorf("deleting table: %w", err)
+	}
+
+	tableDeleted = true
+
+	if _, err := waitTableDeleted(ctx, conn, tableName, timeout); err != nil {
+		return fmt.Errorf("waiting for table delete: %w", err)
+	}
+
+	restoreInput, err := expandRestoreTableFromBackupInput(d, backupARN)
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Restoring DynamoDB Table (%s) from backup: %s", tableName, backupARN)
+	if _, err := retryTableRestore(ctx, func(ctx context.Context) (any, error) {
+		return conn.RestoreTableFromBackup(ctx, restoreInput)
+	}); err != nil {
+		return fmt.Errorf("restoring from backup (%s): %w", backupARN, err)
+	}
+
+	if _, err := waitTableActive(ctx, conn, tableName, timeout); err != nil {
+		return fmt.Errorf("waiting for restore: %w", err)
+	}
+
+	if _, err := waitAllGSIActive(ctx, conn, tableName, timeout); err != nil {
+		return fmt.Errorf("waiting for restore: %w", err)
+	}
+
+	restored = true
+
+	if err := updateRestoredTable(ctx, c, d, policy, scaling); err != nil {
+		return fmt.Errorf("updating restored table: %w", err)
+	}
+
+	return nil
+}
+
+// updateRestoredTable applies the settings that aren't part of a restore to the restored table.
+func updateRestoredTable(ctx context.Context, c *conns.AWSClient, d *schema.ResourceData, policy *string, scaling *tableScaling) error {
+	conn := c.DynamoDBClient(ctx)
+	tableName := d.Id()
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	input := &dynamodb.UpdateTableInput{
+		TableName: aws.String(tableName),
+	}
+	hasUpdate := false
+
+	if d.Get("deletion_protection_enabled").(bool) {
+		input.DeletionProtectionEnabled = aws.Bool(true)
+		hasUpdate = true
+	}
+
+	if d.Get("stream_enabled").(bool) {
+		input.StreamSpecification = &awstypes.StreamSpecification{
+			StreamEnabled:  aws.Bool(true),
+			StreamViewType: awstypes.StreamViewType(d.Get("stream_view_type").(string)),
+		}
+		hasUpdate = true
+	}
+
+	if v, ok := d.GetOk("table_class"); ok && v.(string) != string(awstypes.TableClassStandard) {
+		input.TableClass = awstypes.TableClass(v.(string))
+		hasUpdate = true
+	}
+
+	if hasUpdate {
+		if _, err := conn.UpdateTable(ctx, input); err != nil {
+			return err
+		}
+
+		if _, err := waitTableActive(ctx, conn, tableName, timeout); err != nil {
+			return fmt.Errorf("waiting for update: %w", err)
+		}
+	}
+
+	if v, ok := d.GetOk("warm_throughput"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		if err := updateWarmThroughput(ctx, conn, v.([]any), tableName, timeout); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("ttl.0.enabled").(bool) {
+		if err := updateTimeToLive(ctx, conn, tableName, d.Get("ttl").([]any), timeout); err != nil {
+			return fmt.Errorf("enabling TTL: %w", err)
+		}
+	}
+
+	if d.Get("point_in_time_recovery.0.enabled").(bool) {
+		if err := updatePITR(ctx, conn, tableName, true, aws.Int32(int32(d.Get("point_in_time_recovery.0.recovery_period_in_days").(int))), c.Region(ctx), timeout); err != nil {
+			return fmt.Errorf("enabling point in time recovery: %w", err)
+		}
+	}
+
+	tableARN := d.Get(names.AttrARN).(string)
+
+	if tags := getTagsIn(ctx); len(tags) > 0 {
+		if err := createTags(ctx, conn, tableARN, tags); err != nil {
+			return fmt.Errorf("setting tags: %w", err)
+		}
+	}
+
+	if v := d.Get("replica").(*schema.Set); v.Len() > 0 {
+		if err := createReplicas(ctx, conn, tableName, v.List(), expandGlobalTableWitness(d.Get("global_table_witness")), true, timeout); err != nil {
+			return fmt.Errorf("replicas: %w", err)
+		}
+
+		if err := updateReplicaTags(ctx, conn, tableARN, v.List(), keyValueTags(ctx, getTagsIn(ctx))); err != nil {
+			return fmt.Errorf("replica tags: %w", err)
+		}
+	}
+
+	if policy != nil {
+		input := &dynamodb.PutResourcePolicyInput{
+			Policy:      policy,
+			ResourceArn: aws.String(tableARN),
+		}
+
+		if _, err := conn.PutResourcePolicy(ctx, input); err != nil {
+			return fmt.Errorf("setting resource policy: %w", err)
+		}
+	}
+
+	if err := updateTableScaling(ctx, c.AppAutoScalingClient(ctx), scaling); err != nil {
+		return fmt.Errorf("auto scaling: %w", err)
+	}
+
+	return nil
+}
+
+func expandRestoreTableFromBackupInput(d *schema.ResourceData, backupARN string) (*dynamodb.RestoreTableFromBackupInput, error) {
+	billingMode := awstypes.BillingMode(d.Get("billing_mode").(string))
+	input := &dynamodb.RestoreTableFromBackupInput{
+		BackupArn:           aws.String(backupARN),
+		BillingModeOverride: billingMode,
+		TargetTableName:     aws.String(d.Id()),
+	}
+
+	keySchemaMap := map[string]any{
+		"hash_key": d.Get("hash_key").(string),
+	}
+	if v, ok := d.GetOk("range_key"); ok {
+		keySchemaMap["range_key"] = v.(string)
+	}
+
+	if billingMode == awstypes.BillingModeProvisioned {
+		input.ProvisionedThroughputOverride = expandProvisionedThroughput(map[string]any{
+			"read_capacity":  d.Get("read_capacity"),
+			"write_capacity": d.Get("write_capacity"),
+		}, billingMode)
+	}
+
+	if v, ok := d.GetOk("on_demand_throughput"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.OnDemandThroughputOverride = expandOnDemandThroughput(v.([]any)[0].(map[string]any))
+	}
+
+	if v, ok := d.GetOk("local_secondary_index"); ok {
+		input.LocalSecondaryIndexOverride = expandLocalSecondaryIndexes(v.(*schema.Set).List(), keySchemaMap)
+	}
+
+	// An empty override removes all global secondary indexes.
+	input.GlobalSecondaryIndexOverride = []awstypes.GlobalSecondaryIndex{}
+	for _, tfMapRaw := range d.Get("global_secondary_index").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]any)
+
+		if err := validateGSIProvisionedThroughput(tfMap, billingMode); err != nil {
+			return nil, err
+		}
+
+		input.GlobalSecondaryIndexOverride = append(input.GlobalSecondaryIndexOverride, *expandGlobalSecondaryIndex(tfMap, billingMode))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.SSESpecificationOverride = expandEncryptAtRestOptions(v.([]any))
+	}
+
+	return input, nil
+}
+
+func retryTableRestore(ctx context.Context, f func(context.Context) (any, error)) (any, error) {
+	return tfresource.RetryWhen(ctx, createTableTimeout, f, func(err error) (bool, error) {
+		if tfawserr.ErrCodeEquals(err, errCodeThrottlingException) {
+			return true, err
+		}
+		if errs.IsAErrorMessageContains[*awstypes.LimitExceededException](err, "can be created, updated, or deleted simultaneously") {
+			return true, err
+		}
+		if errs.IsAErrorMessageContains[*awstypes.LimitExceededException](err, "indexed tables that can be created simultaneously") {
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
+func countTableItems(ctx context.Context, conn *dynamodb.Client, tableName string) (int64, error) {
+	input := &dynamodb.ScanInput{
+		Select:    awstypes.SelectCount,
+		TableName: aws.String(tableName),
+	}
+	var count int64
+
+	pages := dynamodb.NewScanPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return 0, err
+		}
+
+		count += int64(page.Count)
+	}
+
+	return count, nil
+}
+
+// tableScaling holds the Application Auto Scaling scalable targets and scaling policies of a table and its indexes.
+type tableScaling struct {
+	policies []applicationautoscalingtypes.ScalingPolicy
+	targets  []applicationautoscalingtypes.ScalableTarget
+}
+
+func findTableScaling(ctx context.Context, conn *applicationautoscaling.Client, tableName string) (*tableScaling, error) {
+	// Scalable targets for the table are "table/<name>" and for its indexes "table/<name>/index/<index name>".
+	resourceID := "table/" + tableName
+	output := &tableScaling{}
+
+	targetsInput := &applicationautoscaling.DescribeScalableTargetsInput{
+		ServiceNamespace: applicationautoscalingtypes.ServiceNamespaceDynamodb,
+	}
+	targetPages := applicationautoscaling.NewDescribeScalableTargetsPaginator(conn, targetsInput)
+	for targetPages.HasMorePages() {
+		page, err := targetPages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ScalableTargets {
+			if rid := aws.ToString(v.ResourceId); rid == resourceID || strings.HasPrefix(rid, resourceID+"/index/") {
+				output.targets = append(output.targets, v)
+			}
+		}
+	}
+
+	for _, target := range output.targets {
+		input := &applicationautoscaling.DescribeScalingPoliciesInput{
+			ResourceId:        target.ResourceId,
+			ScalableDimension: target.ScalableDimension,
+			ServiceNamespace:  applicationautoscalingtypes.ServiceNamespaceDynamodb,
+		}
+		pages := applicationautoscaling.NewDescribeScalingPoliciesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			output.policies = append(output.policies, page.ScalingPolicies...)
+		}
+	}
+
+	return output, nil
+}
+
+// updateTableScaling registers the scalable targets and puts the scaling policies of a table again.
+// Both operations are idempotent.
+func updateTableScaling(ctx context.Context, conn *applicationautoscaling.Client, scaling *tableScaling) error {
+	for _, v := range scaling.targets {
+		input := &applicationautoscaling.RegisterScalableTargetInput{
+			MaxCapacity:       v.MaxCapacity,
+			MinCapacity:       v.MinCapacity,
+			ResourceId:        v.ResourceId,
+			RoleARN:           v.RoleARN,
+			ScalableDimension: v.ScalableDimension,
+			ServiceNamespace:  v.ServiceNamespace,
+			SuspendedState:    v.SuspendedState,
+		}
+
+		if _, err := conn.RegisterScalableTarget(ctx, input); err != nil {
+			return fmt.Errorf("registering scalable target (%s): %w", aws.ToString(v.ResourceId), err)
+		}
+	}
+
+	for _, v := range scaling.policies {
+		input := &applicationautoscaling.PutScalingPolicyInput{
+			PolicyName:                               v.PolicyName,
+			PolicyType:                               v.PolicyType,
+			PredictiveScalingPolicyConfiguration:     v.PredictiveScalingPolicyConfiguration,
+			ResourceId:                               v.ResourceId,
+			ScalableDimension:                        v.ScalableDimension,
+			ServiceNamespace:                         v.ServiceNamespace,
+			StepScalingPolicyConfiguration:           v.StepScalingPolicyConfiguration,
+			TargetTrackingScalingPolicyConfiguration: v.TargetTrackingScalingPolicyConfiguration,
+		}
+
+		if _, err := conn.PutScalingPolicy(ctx, input); err != nil {
+			return fmt.Errorf("putting scaling policy (%s): %w", aws.ToString(v.PolicyName), err)
+		}
+	}
+
+	return nil
+}
@@ -94,6 +94,23 @@ func waitImportComplete(ctx context.Context, conn *dynamodb.Client, importARN st
 	return nil, err
 }
 
+func waitBackupAvailable(ctx context.Context, conn *dynamodb.Client, arn string, timeout time.Duration) (*awstypes.BackupDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BackupStatusCreating),
+		Target:  enum.Slice(awstypes.BackupStatusAvailable),
+		Refresh: statusBackup(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.BackupDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitReplicaActive(ctx context.Context, conn *dynamodb.Client, tableName, region string, timeout time.Duration, delay time.Duration, optFns ...func(*dynamodb.Options)) (*awstypes.TableDescription, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
 		Delay:   delay,
@@ -0,0 +1,305 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	imagebuildertypes "github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type amiSelectorUpdatePolicy string
+
+const (
+	amiSelectorUpdatePolicyLatest        amiSelectorUpdatePolicy = "latest"
+	amiSelectorUpdatePolicyPinUntilOptIn amiSelectorUpdatePolicy = "pin_until_opt_in"
+)
+
+func (amiSelectorUpdatePolicy) Values() []amiSelectorUpdatePolicy {
+	return []amiSelectorUpdatePolicy{
+		amiSelectorUpdatePolicyLatest,
+		amiSelectorUpdatePolicyPinUntilOptIn,
+	}
+}
+
+// amiSelectorSchema returns the schema of the "ami_selector" block.
+// imageIDKey is the top-level attribute that the selected AMI ID is written to.
+func amiSelectorSchema(imageIDKey string) *schema.Schema {
+	sources := []string{"ami_selector.0.image_builder_pipeline_arn", "ami_selector.0.name_pattern", "ami_selector.0.ssm_parameter"}
+
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{imageIDKey},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"image_builder_pipeline_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: sources,
+					ValidateFunc: verify.ValidARN,
+				},
+				"name_pattern": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: sources,
+					RequiredWith: []string{"ami_selector.0.owners"},
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				"newer_image_ids": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"owners": {
+					Type:         schema.TypeSet,
+					Optional:     true,
+					RequiredWith: []string{"ami_selector.0.name_pattern"},
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.NoZeroValues,
+					},
+				},
+				"ssm_parameter": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: sources,
+					ValidateFunc: validation.StringLenBetween(1, 2048),
+				},
+				"update_policy": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          amiSelectorUpdatePolicyPinUntilOptIn,
+					ValidateDiagFunc: enum.Validate[amiSelectorUpdatePolicy](),
+				},
+			},
+		},
+	}
+}
+
+// customizeDiffAMISelector plans the AMI selected by "ami_selector" as the new value of imageIDKey.
+// The newest matching AMI is selected when the resource is created, when the selector's arguments change
+// and, with the "latest" update policy, on every plan. Otherwise the current AMI is kept.
+func customizeDiffAMISelector(imageIDKey string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+		v, ok := diff.GetOk("ami_selector")
+		if !ok || len(v.([]any)) == 0 || v.([]any)[0] == nil {
+			return nil
+		}
+
+		if !diff.NewValueKnown("ami_selector") {
+			return diff.SetNewComputed(imageIDKey)
+		}
+
+		tfMap := v.([]any)[0].(map[string]any)
+		current := diff.Get(imageIDKey).(string)
+
+		if diff.Id() != "" && current != "" && amiSelectorUpdatePolicy(tfMap["update_policy"].(string)) == amiSelectorUpdatePolicyPinUntilOptIn &&
+			!diff.HasChanges("ami_selector.0.image_builder_pipeline_arn", "ami_selector.0.name_pattern", "ami_selector.0.owners", "ami_selector.0.ssm_parameter", "ami_selector.0.update_policy") {
+			return nil
+		}
+
+		images, err := findAMISelectorImages(ctx, meta.(*conns.AWSClient), tfMap)
+
+		if err != nil {
+			return fmt.Errorf("resolving ami_selector: %w", err)
+		}
+
+		if id := aws.ToString(images[0].ImageId); id != current {
+			return diff.SetNew(imageIDKey, id)
+		}
+
+		return nil
+	}
+}
+
+// flattenAMISelector sets the AMIs that are newer than imageID and match "ami_selector".
+// Failures to resolve the selector are returned as warnings and the previous "newer_image_ids" are kept,
+// so that an unavailable AMI source doesn't prevent the resource from being read or the selector from being removed.
+func flattenAMISelector(ctx context.Context, c *conns.AWSClient, d *schema.ResourceData, imageID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tfMap := configuredAMISelector(d)
+	if tfMap == nil {
+		return diags
+	}
+
+	images, err := findAMISelectorImages(ctx, c, tfMap)
+
+	if err != nil {
+		return sdkdiag.AppendWarningf(diags, "resolving ami_selector: %s", err)
+	}
+
+	var createdAfter time.Time
+	if i := slices.IndexFunc(images, func(v awstypes.Image) bool { return aws.ToString(v.ImageId) == imageID }); i != -1 {
+		createdAfter = amiCreationTime(images[i])
+	} else if image, err := findImageByID(ctx, c.EC2Client(ctx), imageID); err == nil {
+		createdAfter = amiCreationTime(*image)
+	} else if !retry.NotFound(err) {
+		return sdkdiag.AppendWarningf(diags, "reading EC2 AMI (%s): %s", imageID, err)
+	}
+
+	var newerImageIDs []string
+	for _, image := range images {
+		if id := aws.ToString(image.ImageId); id != imageID && amiCreationTime(image).After(createdAfter) {
+			newerImageIDs = append(newerImageIDs, id)
+		}
+	}
+
+	tfMap["newer_image_ids"] = newerImageIDs
+
+	if err := d.Set("ami_selector", []any{tfMap}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ami_selector: %s", err)
+	}
+
+	return diags
+}
+
+// configuredAMISelector returns the "ami_selector" block, or nil if no AMI source is selected.
+// The configuration is only available when the resource is created or updated, otherwise the block in state is used.
+func configuredAMISelector(d *schema.ResourceData) map[string]any {
+	if config := d.GetRawConfig(); config.IsKnown() && !config.IsNull() {
+		if v := config.GetAttr("ami_selector"); v.IsKnown() && (v.IsNull() || v.LengthInt() == 0) {
+			return nil
+		}
+	}
+
+	v, ok := d.GetOk("ami_selector")
+	if !ok || len(v.([]any)) == 0 || v.([]any)[0] == nil {
+		return nil
+	}
+
+	tfMap := v.([]any)[0].(map[string]any)
+	for _, k := range []string{"image_builder_pipeline_arn", "name_pattern", "ssm_parameter"} {
+		if tfMap[k].(string) != "" {
+			return tfMap
+		}
+	}
+
+	return nil
+}
+
+// findAMISelectorImages returns the available AMIs matching the specified "ami_selector", newest first.
+// If the account's allowed AMIs setting is enabled, AMIs that it does not allow are excluded.
+func findAMISelectorImages(ctx context.Context, c *conns.AWSClient, tfMap map[string]any) ([]awstypes.Image, error) {
+	conn := c.EC2Client(ctx)
+	input := ec2.DescribeImagesInput{
+		Filters: newAttributeFilterList(map[string]string{
+			names.AttrState: string(awstypes.ImageStateAvailable),
+		}),
+	}
+
+	switch {
+	case tfMap["name_pattern"].(string) != "":
+		input.Filters = append(input.Filters, newFilter(names.AttrName, []string{tfMap["name_pattern"].(string)}))
+		input.Owners = flex.ExpandStringValueSet(tfMap["owners"].(*schema.Set))
+	case tfMap["ssm_parameter"].(string) != "":
+		name := tfMap["ssm_parameter"].(string)
+		output, err := c.SSMClient(ctx).GetParameter(ctx, &ssm.GetParameterInput{
+			Name: aws.String(name),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("reading SSM Parameter (%s): %w", name, err)
+		}
+
+		input.ImageIds = []string{aws.ToString(output.Parameter.Value)}
+	case tfMap["image_builder_pipeline_arn"].(string) != "":
+		arn := tfMap["image_builder_pipeline_arn"].(string)
+		imageIDs, err := findImageBuilderPipelineImageIDs(ctx, c.ImageBuilderClient(ctx), arn, c.Region(ctx))
+
+		if err != nil {
+			return nil, fmt.Errorf("reading Image Builder Image Pipeline (%s) images: %w", arn, err)
+		}
+
+		if len(imageIDs) == 0 {
+			return nil, fmt.Errorf("Image Builder Image Pipeline (%s) has no available AMIs in %s", arn, c.Region(ctx))
+		}
+
+		input.ImageIds = imageIDs
+	}
+
+	images, err := findImages(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := findAllowedImagesSettings(ctx, conn)
+
+	switch {
+	case retry.NotFound(err):
+	case err != nil:
+		return nil, fmt.Errorf("reading EC2 Allowed Images Settings: %w", err)
+	case aws.ToString(settings.State) == string(awstypes.AllowedImagesSettingsEnabledStateEnabled):
+		images = slices.DeleteFunc(images, func(v awstypes.Image) bool {
+			return v.ImageAllowed != nil && !aws.ToBool(v.ImageAllowed)
+		})
+	}
+
+	if len(images) == 0 {
+		return nil, errors.New("no allowed EC2 AMIs match")
+	}
+
+	slices.SortStableFunc(images, func(a, b awstypes.Image) int {
+		return amiCreationTime(b).Compare(amiCreationTime(a))
+	})
+
+	return images, nil
+}
+
+func findImageBuilderPipelineImageIDs(ctx context.Context, conn *imagebuilder.Client, arn, region string) ([]string, error) {
+	input := imagebuilder.ListImagePipelineImagesInput{
+		ImagePipelineArn: aws.String(arn),
+	}
+	var output []string
+
+	pages := imagebuilder.NewListImagePipelineImagesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ImageSummaryList {
+			if v.State == nil || v.State.Status != imagebuildertypes.ImageStatusAvailable || v.OutputResources == nil {
+				continue
+			}
+
+			for _, ami := range v.OutputResources.Amis {
+				if aws.ToString(ami.Region) == region && ami.Image != nil {
+					output = append(output, aws.ToString(ami.Image))
+				}
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func amiCreationTime(image awstypes.Image) time.Time {
+	t, _ := time.Parse(time.RFC3339, aws.ToString(image.CreationDate))
+	return t
+}
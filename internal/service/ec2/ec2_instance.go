@@ -99,8 +99,9 @@ func resourceInstance() *schema.Resource {
 				ForceNew:     true,
 				Computed:     true,
 				Optional:     true,
-				AtLeastOneOf: []string{"ami", names.AttrLaunchTemplate},
+				AtLeastOneOf: []string{"ami", "ami_selector", names.AttrLaunchTemplate},
 			},
+			"ami_selector": amiSelectorSchema("ami"),
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -513,7 +514,7 @@ func resourceInstance() *schema.Resource {
 				MaxItems:     1,
 				Optional:     true,
 				ForceNew:     true,
-				AtLeastOneOf: []string{"ami", names.AttrInstanceType, names.AttrLaunchTemplate},
+				AtLeastOneOf: []string{"ami", "ami_selector", names.AttrInstanceType, names.AttrLaunchTemplate},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrID: {
@@ -634,7 +635,7 @@ func resourceInstance() *schema.Resource {
 						},
 					},
 				},
-				Deprecated: "network_interface is deprecated. To specify the primary network interface, use primary_network_interface instead. To attach additional network interfaces, use the aws_network_interface_attachment resource.",
+				Deprecated: names.ResourceDeprecationMessage("aws_instance", "network_interface"),
 			},
 			"outpost_arn": {
 				Type:     schema.TypeString,
@@ -910,6 +911,7 @@ func resourceInstance() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
+			customizeDiffAMISelector("ami"),
 			func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
 				_, ok := diff.GetOk(names.AttrLaunchTemplate)
 
@@ -3144,6 +3146,10 @@ func resourceInstanceFlatten(ctx context.Context, client *conns.AWSClient, insta
 	}
 
 	rd.Set("ami", instance.ImageId)
+	diags = append(diags, flattenAMISelector(ctx, client, rd, aws.ToString(instance.ImageId))...)
+	if diags.HasError() {
+		return diags
+	}
 	rd.Set(names.AttrInstanceType, instanceType)
 	rd.Set("key_name", instance.KeyName)
 	rd.Set("public_dns", instance.PublicDnsName)
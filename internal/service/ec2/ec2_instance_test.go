@@ -247,6 +247,96 @@ func TestAccEC2Instance_disappears(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_AMISelector_namePattern(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Instance
+	resourceName := "aws_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckHasDefaultVPCDefaultSubnets(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_amiSelectorNamePattern("pin_until_opt_in"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "ami", "data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "ami_selector.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ami_selector.0.newer_image_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ami_selector.0.update_policy", "pin_until_opt_in"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ami_selector", "user_data_replace_on_change"},
+			},
+			{
+				Config: testAccInstanceConfig_amiSelectorNamePattern("latest"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "ami", "data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "ami_selector.0.update_policy", "latest"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2Instance_AMISelector_ssmParameter(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Instance
+	resourceName := "aws_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckHasDefaultVPCDefaultSubnets(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_amiSelectorSSMParameter(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "ami", "data.aws_ssm_parameter.test", names.AttrValue),
+					resource.TestCheckResourceAttr(resourceName, "ami_selector.0.newer_image_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2Instance_AMISelector_conflictsWithAMI(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInstanceConfig_amiSelectorConflictsWithAMI(),
+				ExpectError: regexache.MustCompile(`"ami_selector": conflicts with ami`),
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_inDefaultVPCBySgName(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.Instance
@@ -6742,6 +6832,58 @@ resource "aws_instance" "test" {
 `)
 }
 
+func testAccInstanceConfig_amiSelectorNamePattern(updatePolicy string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro", "t1.micro", "m1.small"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  ami_selector {
+    owners        = ["amazon"]
+    name_pattern  = "amzn2-ami-minimal-hvm-*-x86_64-ebs"
+    update_policy = %[1]q
+  }
+}
+`, updatePolicy))
+}
+
+func testAccInstanceConfig_amiSelectorSSMParameter() string {
+	return acctest.ConfigCompose(
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro", "t1.micro", "m1.small"),
+		`
+data "aws_ssm_parameter" "test" {
+  name = "/aws/service/ami-amazon-linux-latest/amzn2-ami-hvm-x86_64-gp2"
+}
+
+resource "aws_instance" "test" {
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  ami_selector {
+    ssm_parameter = data.aws_ssm_parameter.test.name
+  }
+}
+`)
+}
+
+func testAccInstanceConfig_amiSelectorConflictsWithAMI() string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro", "t1.micro", "m1.small"),
+		`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  ami_selector {
+    owners       = ["amazon"]
+    name_pattern = "amzn2-ami-minimal-hvm-*-x86_64-ebs"
+  }
+}
+`)
+}
+
 func testAccInstanceConfig_inDefaultVPCBySgName(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigAvailableAZsNoOptInDefaultExclude(),
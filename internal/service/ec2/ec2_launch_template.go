@@ -47,6 +47,7 @@ func resourceLaunchTemplate() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"ami_selector": amiSelectorSchema("image_id"),
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -277,6 +278,7 @@ func resourceLaunchTemplate() *schema.Resource {
 			"image_id": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"instance_initiated_shutdown_behavior": {
 				Type:             schema.TypeString,
@@ -1029,7 +1031,20 @@ func resourceLaunchTemplate() *schema.Resource {
 		// Enable downstream updates for resources referencing schema attributes
 		// to prevent non-empty plans after "terraform apply"
 		CustomizeDiff: customdiff.Sequence(
+			customizeDiffAMISelector("image_id"),
+			func(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+				// image_id is Computed so that it can be set from ami_selector.
+				// Clear it when neither is configured.
+				if _, ok := diff.GetOk("ami_selector"); !ok && diff.Id() != "" && diff.Get("image_id").(string) != "" && diff.GetRawConfig().GetAttr("image_id").IsNull() {
+					return diff.SetNew("image_id", "")
+				}
+				return nil
+			},
 			customdiff.ComputedIf("default_version", func(_ context.Context, diff *schema.ResourceDiff, meta any) bool {
+				// Changes planned by CustomizeDiff aren't returned by GetChangedKeysPrefix.
+				if diff.HasChange("image_id") {
+					return diff.Get("update_default_version").(bool)
+				}
 				for _, changedKey := range diff.GetChangedKeysPrefix("") {
 					switch changedKey {
 					case "name", "name_prefix", "description":
@@ -1041,6 +1056,9 @@ func resourceLaunchTemplate() *schema.Resource {
 				return false
 			}),
 			customdiff.ComputedIf("latest_version", func(_ context.Context, diff *schema.ResourceDiff, meta any) bool {
+				if diff.HasChange("image_id") {
+					return true
+				}
 				for _, changedKey := range diff.GetChangedKeysPrefix("") {
 					switch changedKey {
 					case "name", "name_prefix", "description", "default_version", "update_default_version":
@@ -1124,6 +1142,11 @@ func resourceLaunchTemplateRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	diags = append(diags, flattenAMISelector(ctx, c, d, d.Get("image_id").(string))...)
+	if diags.HasError() {
+		return diags
+	}
+
 	setTagsOut(ctx, lt.Tags)
 
 	return diags
@@ -170,6 +170,48 @@ func TestAccEC2LaunchTemplate_disappears(t *testing.T) {
 	})
 }
 
+func TestAccEC2LaunchTemplate_AMISelector(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template awstypes.LaunchTemplate
+	resourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig_amiSelector(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "ami_selector.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ami_selector.0.newer_image_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ami_selector.0.update_policy", "pin_until_opt_in"),
+					resource.TestCheckResourceAttrPair(resourceName, "image_id", "data.aws_ssm_parameter.test", names.AttrValue),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ami_selector"},
+			},
+			{
+				Config: testAccLaunchTemplateConfig_name(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "ami_selector.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "image_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2LaunchTemplate_BlockDeviceMappings_ebs(t *testing.T) {
 	ctx := acctest.Context(t)
 	var template awstypes.LaunchTemplate
@@ -3657,6 +3699,22 @@ resource "aws_launch_template" "test" {
 `, rName)
 }
 
+func testAccLaunchTemplateConfig_amiSelector(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ssm_parameter" "test" {
+  name = "/aws/service/ami-amazon-linux-latest/amzn2-ami-hvm-x86_64-gp2"
+}
+
+resource "aws_launch_template" "test" {
+  name = %[1]q
+
+  ami_selector {
+    ssm_parameter = data.aws_ssm_parameter.test.name
+  }
+}
+`, rName)
+}
+
 func testAccLaunchTemplateConfig_nameGenerated() string {
 	return `
 resource "aws_launch_template" "test" {}
@@ -186,6 +186,7 @@ var (
 	FindLocalGatewayRouteTableVPCAssociationByID                = findLocalGatewayRouteTableVPCAssociationByID
 	FindMainRouteTableAssociationByID                           = findMainRouteTableAssociationByID
 	FindManagedPrefixListByID                                   = findManagedPrefixListByID
+	FindManagedPrefixListEntriesByID                            = findManagedPrefixListEntriesByID
 	FindManagedPrefixListEntryByIDAndCIDR                       = findManagedPrefixListEntryByIDAndCIDR
 	FindNATGatewayByID                                          = findNATGatewayByID
 	FindNATGatewayAddressByNATGatewayIDAndAllocationIDSucceeded = findNATGatewayAddressByNATGatewayIDAndAllocationIDSucceeded
@@ -308,6 +309,7 @@ var (
 	VPCDHCPOptionsAssociationParseResourceID                    = vpcDHCPOptionsAssociationParseResourceID
 	VPCMigrateState                                             = vpcMigrateState
 	VPNGatewayRoutePropagationParseID                           = vpnGatewayRoutePropagationParseID
+	WaitManagedPrefixListModified                               = waitManagedPrefixListModified
 	WaitVolumeAttachmentCreated                                 = waitVolumeAttachmentCreated
 )
 
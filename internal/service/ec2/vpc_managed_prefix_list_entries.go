@@ -0,0 +1,313 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// ModifyManagedPrefixList accepts at most 100 entries to add and 100 entries to remove per call.
+	managedPrefixListEntriesMaxBatchSize = 100
+)
+
+// @FrameworkResource("aws_ec2_managed_prefix_list_entries", name="Managed Prefix List Entries")
+func newManagedPrefixListEntriesResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &managedPrefixListEntriesResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type managedPrefixListEntriesResource struct {
+	framework.ResourceWithModel[managedPrefixListEntriesResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *managedPrefixListEntriesResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"prefix_list_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"entry": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[prefixListEntryModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr": schema.StringAttribute{
+							CustomType: fwtypes.CIDRBlockType,
+							Required:   true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *managedPrefixListEntriesResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data managedPrefixListEntriesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	var want []awstypes.PrefixListEntry
+	response.Diagnostics.Append(fwflex.Expand(ctx, data.Entries, &want)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	prefixListID := fwflex.StringValueFromFramework(ctx, data.PrefixListID)
+	if err := syncManagedPrefixListEntries(ctx, conn, prefixListID, want, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating EC2 Managed Prefix List (%s) Entries", prefixListID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *managedPrefixListEntriesResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data managedPrefixListEntriesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	prefixListID := fwflex.StringValueFromFramework(ctx, data.PrefixListID)
+	output, err := findManagedPrefixListEntriesByID(ctx, conn, prefixListID)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Managed Prefix List (%s) Entries", prefixListID), err.Error())
+
+		return
+	}
+
+	if output == nil {
+		output = []awstypes.PrefixListEntry{}
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.Entries)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *managedPrefixListEntriesResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old managedPrefixListEntriesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	if !new.Entries.Equal(old.Entries) {
+		var want []awstypes.PrefixListEntry
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.Entries, &want)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		prefixListID := fwflex.StringValueFromFramework(ctx, new.PrefixListID)
+		if err := syncManagedPrefixListEntries(ctx, conn, prefixListID, want, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating EC2 Managed Prefix List (%s) Entries", prefixListID), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *managedPrefixListEntriesResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data managedPrefixListEntriesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	prefixListID := fwflex.StringValueFromFramework(ctx, data.PrefixListID)
+	err := syncManagedPrefixListEntries(ctx, conn, prefixListID, nil, r.DeleteTimeout(ctx, data.Timeouts))
+
+	if retry.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting EC2 Managed Prefix List (%s) Entries", prefixListID), err.Error())
+
+		return
+	}
+}
+
+func (r *managedPrefixListEntriesResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("prefix_list_id"), request, response)
+}
+
+// syncManagedPrefixListEntries makes the prefix list's entries match want.
+//
+// Entries are removed before they are added so that a prefix list close to its
+// maximum number of entries does not overflow. An entry whose description changes
+// is removed and added back, as a single ModifyManagedPrefixList call cannot both
+// remove and add the same CIDR.
+func syncManagedPrefixListEntries(ctx context.Context, conn *ec2.Client, prefixListID string, want []awstypes.PrefixListEntry, timeout time.Duration) error {
+	have, err := findManagedPrefixListEntriesByID(ctx, conn, prefixListID)
+
+	if err != nil {
+		return err
+	}
+
+	add, remove, modify, _ := intflex.DiffSlicesWithModify(have, want, prefixListEntryEqual, prefixListEntryCIDREqual)
+
+	removeEntries := tfslices.ApplyToAll(slices.Concat(remove, modify), func(v awstypes.PrefixListEntry) awstypes.RemovePrefixListEntry {
+		return awstypes.RemovePrefixListEntry{
+			Cidr: v.Cidr,
+		}
+	})
+	addEntries := tfslices.ApplyToAll(slices.Concat(add, modify), func(v awstypes.PrefixListEntry) awstypes.AddPrefixListEntry {
+		return awstypes.AddPrefixListEntry{
+			Cidr:        v.Cidr,
+			Description: v.Description,
+		}
+	})
+
+	for chunk := range slices.Chunk(removeEntries, managedPrefixListEntriesMaxBatchSize) {
+		input := ec2.ModifyManagedPrefixListInput{
+			PrefixListId:  aws.String(prefixListID),
+			RemoveEntries: chunk,
+		}
+
+		if err := modifyManagedPrefixList(ctx, conn, &input, timeout); err != nil {
+			return err
+		}
+	}
+
+	for chunk := range slices.Chunk(addEntries, managedPrefixListEntriesMaxBatchSize) {
+		input := ec2.ModifyManagedPrefixListInput{
+			AddEntries:   chunk,
+			PrefixListId: aws.String(prefixListID),
+		}
+
+		if err := modifyManagedPrefixList(ctx, conn, &input, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// modifyManagedPrefixList calls ModifyManagedPrefixList with the prefix list's current version,
+// retrying if the version changes underneath the call, and waits for the modification to complete.
+func modifyManagedPrefixList(ctx context.Context, conn *ec2.Client, input *ec2.ModifyManagedPrefixListInput, timeout time.Duration) error {
+	prefixListID := aws.ToString(input.PrefixListId)
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func(ctx context.Context) (any, error) {
+		mutexKey := fmt.Sprintf("vpc-managed-prefix-list-%s", prefixListID)
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		pl, err := findManagedPrefixListByID(ctx, conn, prefixListID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		input.CurrentVersion = pl.Version
+
+		return conn.ModifyManagedPrefixList(ctx, input)
+	}, errCodeIncorrectState, errCodePrefixListVersionMismatch)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitManagedPrefixListModified(ctx, conn, prefixListID); err != nil {
+		return fmt.Errorf("waiting for EC2 Managed Prefix List (%s) update: %w", prefixListID, err)
+	}
+
+	return nil
+}
+
+func prefixListEntryEqual(v1, v2 awstypes.PrefixListEntry) bool {
+	return prefixListEntryCIDREqual(v1, v2) && aws.ToString(v1.Description) == aws.ToString(v2.Description)
+}
+
+func prefixListEntryCIDREqual(v1, v2 awstypes.PrefixListEntry) bool {
+	return aws.ToString(v1.Cidr) == aws.ToString(v2.Cidr)
+}
+
+type managedPrefixListEntriesResourceModel struct {
+	framework.WithRegionModel
+	Entries      fwtypes.SetNestedObjectValueOf[prefixListEntryModel] `tfsdk:"entry"`
+	PrefixListID types.String                                         `tfsdk:"prefix_list_id"`
+	Timeouts     timeouts.Value                                       `tfsdk:"timeouts"`
+}
+
+type prefixListEntryModel struct {
+	CIDR        fwtypes.CIDRBlock `tfsdk:"cidr"`
+	Description types.String      `tfsdk:"description"`
+}
@@ -0,0 +1,313 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCManagedPrefixListEntries_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_managed_prefix_list_entries.test"
+	plResourceName := "aws_ec2_managed_prefix_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListEntriesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListEntriesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListEntriesExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "prefix_list_id", plResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"cidr":                "10.0.0.0/24",
+						names.AttrDescription: "first",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"cidr": "10.0.1.0/24",
+					}),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "prefix_list_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "prefix_list_id",
+			},
+		},
+	})
+}
+
+func TestAccVPCManagedPrefixListEntries_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_managed_prefix_list_entries.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListEntriesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListEntriesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListEntriesExists(ctx, resourceName, 2),
+				),
+			},
+			{
+				Config: testAccVPCManagedPrefixListEntriesConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListEntriesExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"cidr":                "10.0.0.0/24",
+						names.AttrDescription: "updated",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"cidr": "10.0.2.0/24",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCManagedPrefixListEntries_batched(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_managed_prefix_list_entries.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListEntriesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListEntriesConfig_count(rName, 0, 250),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListEntriesExists(ctx, resourceName, 250),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "250"),
+				),
+			},
+			{
+				Config: testAccVPCManagedPrefixListEntriesConfig_count(rName, 150, 300),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListEntriesExists(ctx, resourceName, 150),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "150"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCManagedPrefixListEntries_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_managed_prefix_list_entries.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListEntriesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListEntriesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListEntriesExists(ctx, resourceName, 2),
+					testAccCheckManagedPrefixListEntriesAddEntry(ctx, resourceName, "10.0.9.0/24"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCManagedPrefixListEntriesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListEntriesExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckManagedPrefixListEntriesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_managed_prefix_list_entries" {
+				continue
+			}
+
+			output, err := tfec2.FindManagedPrefixListEntriesByID(ctx, conn, rs.Primary.Attributes["prefix_list_id"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("EC2 Managed Prefix List %s Entries still exist", rs.Primary.Attributes["prefix_list_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckManagedPrefixListEntriesExists(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindManagedPrefixListEntriesByID(ctx, conn, rs.Primary.Attributes["prefix_list_id"])
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("EC2 Managed Prefix List %s has %d entries, want %d", rs.Primary.Attributes["prefix_list_id"], got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckManagedPrefixListEntriesAddEntry(ctx context.Context, n, cidr string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		plID := rs.Primary.Attributes["prefix_list_id"]
+		pl, err := tfec2.FindManagedPrefixListByID(ctx, conn, plID)
+
+		if err != nil {
+			return err
+		}
+
+		input := ec2.ModifyManagedPrefixListInput{
+			AddEntries:     []awstypes.AddPrefixListEntry{{Cidr: aws.String(cidr)}},
+			CurrentVersion: pl.Version,
+			PrefixListId:   aws.String(plID),
+		}
+
+		if _, err := conn.ModifyManagedPrefixList(ctx, &input); err != nil {
+			return err
+		}
+
+		_, err = tfec2.WaitManagedPrefixListModified(ctx, conn, plID)
+
+		return err
+	}
+}
+
+func testAccVPCManagedPrefixListEntriesConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  name           = %[1]q
+  address_family = "IPv4"
+  max_entries    = 5
+}
+
+resource "aws_ec2_managed_prefix_list_entries" "test" {
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+
+  entry {
+    cidr        = "10.0.0.0/24"
+    description = "first"
+  }
+
+  entry {
+    cidr = "10.0.1.0/24"
+  }
+}
+`, rName)
+}
+
+func testAccVPCManagedPrefixListEntriesConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  name           = %[1]q
+  address_family = "IPv4"
+  max_entries    = 5
+}
+
+resource "aws_ec2_managed_prefix_list_entries" "test" {
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+
+  entry {
+    cidr        = "10.0.0.0/24"
+    description = "updated"
+  }
+
+  entry {
+    cidr = "10.0.2.0/24"
+  }
+}
+`, rName)
+}
+
+// testAccVPCManagedPrefixListEntriesConfig_count configures entries 10.<i/256>.<i%256>.0/24 for i in [start, end).
+func testAccVPCManagedPrefixListEntriesConfig_count(rName string, start, end int) string {
+	var entries strings.Builder
+	for i := start; i < end; i++ {
+		fmt.Fprintf(&entries, `
+  entry {
+    cidr        = "10.%[1]d.%[2]d.0/24"
+    description = %[3]q
+  }
+`, i/256, i%256, strconv.Itoa(i))
+	}
+
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  name           = %[1]q
+  address_family = "IPv4"
+  max_entries    = 300
+}
+
+resource "aws_ec2_managed_prefix_list_entries" "test" {
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+%[2]s
+}
+`, rName, entries.String())
+}
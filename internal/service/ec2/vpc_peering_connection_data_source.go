@@ -126,7 +126,7 @@ func dataSourceVPCPeeringConnection() *schema.Resource {
 			names.AttrRegion: {
 				Type:       schema.TypeString,
 				Computed:   true,
-				Deprecated: "region is deprecated. Use requester_region instead.",
+				Deprecated: names.DataSourceDeprecationMessage("aws_vpc_peering_connection", names.AttrRegion),
 			},
 			"requester": {
 				Type:     schema.TypeMap,
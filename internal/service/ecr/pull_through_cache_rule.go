@@ -26,6 +26,10 @@ import (
 )
 
 // @SDKResource("aws_ecr_pull_through_cache_rule", name="Pull Through Cache Rule")
+// @IdentityAttribute("ecr_repository_prefix")
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(idAttrDuplicates="ecr_repository_prefix")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourcePullThroughCacheRule() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePullThroughCacheRuleCreate,
@@ -33,10 +37,6 @@ func resourcePullThroughCacheRule() *schema.Resource {
 		DeleteWithoutTimeout: resourcePullThroughCacheRuleDelete,
 		UpdateWithoutTimeout: resourcePullThroughCacheRuleUpdate,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			"credential_arn": {
 				Type:         schema.TypeString,
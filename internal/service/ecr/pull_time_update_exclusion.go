@@ -13,7 +13,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -27,6 +26,9 @@ import (
 )
 
 // @FrameworkResource("aws_ecr_pull_time_update_exclusion", name="Pull Time Update Exclusion")
+// @IdentityAttribute("principal_arn")
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func newPullTimeUpdateExclusionResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &pullTimeUpdateExclusionResource{}
 	return r, nil
@@ -34,6 +36,7 @@ func newPullTimeUpdateExclusionResource(_ context.Context) (resource.ResourceWit
 
 type pullTimeUpdateExclusionResource struct {
 	framework.ResourceWithModel[pullTimeUpdateExclusionResourceModel]
+	framework.WithImportByIdentity
 	framework.WithNoUpdate
 }
 
@@ -130,10 +133,6 @@ func (r *pullTimeUpdateExclusionResource) Delete(ctx context.Context, req resour
 	}
 }
 
-func (r *pullTimeUpdateExclusionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("principal_arn"), req, resp)
-}
-
 func findPullTimeUpdateExclusionByPrincipalARN(ctx context.Context, conn *ecr.Client, arn string) error {
 	var input ecr.ListPullTimeUpdateExclusionsInput
 	output, err := findPullTimeUpdateExclusions(ctx, conn, &input)
@@ -27,6 +27,12 @@ import (
 )
 
 // @SDKResource("aws_ecr_registry_policy", name="Registry Policy")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ecr;ecr.GetRegistryPolicyOutput")
+// @Testing(serialize=true)
+// @Testing(generator=false)
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceRegistryPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRegistryPolicyPut,
@@ -34,10 +40,6 @@ func resourceRegistryPolicy() *schema.Resource {
 		UpdateWithoutTimeout: resourceRegistryPolicyPut,
 		DeleteWithoutTimeout: resourceRegistryPolicyDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrPolicy: {
 				Type:                  schema.TypeString,
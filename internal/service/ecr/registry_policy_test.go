@@ -25,6 +25,7 @@ func TestAccECRRegistryPolicy_serial(t *testing.T) {
 	testCases := map[string]func(t *testing.T){
 		acctest.CtBasic:      testAccRegistryPolicy_basic,
 		acctest.CtDisappears: testAccRegistryPolicy_disappears,
+		"Identity":           testAccECRRegistryPolicy_IdentitySerial,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
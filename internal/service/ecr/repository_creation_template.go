@@ -32,6 +32,10 @@ import (
 )
 
 // @SDKResource("aws_ecr_repository_creation_template", name="Repository Creation Template")
+// @IdentityAttribute("prefix")
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(idAttrDuplicates="prefix")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceRepositoryCreationTemplate() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRepositoryCreationTemplateCreate,
@@ -39,10 +43,6 @@ func resourceRepositoryCreationTemplate() *schema.Resource {
 		UpdateWithoutTimeout: resourceRepositoryCreationTemplateUpdate,
 		DeleteWithoutTimeout: resourceRepositoryCreationTemplateDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			"applied_for": {
 				Type:     schema.TypeSet,
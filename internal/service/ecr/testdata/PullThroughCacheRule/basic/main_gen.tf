@@ -0,0 +1,13 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_ecr_pull_through_cache_rule" "test" {
+  ecr_repository_prefix = var.rName
+  upstream_registry_url = "public.ecr.aws"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,748 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ecs_container_definitions_document", name="Container Definitions Document")
+// @Region(overrideEnabled=false)
+func newContainerDefinitionsDocumentDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &containerDefinitionsDocumentDataSource{}, nil
+}
+
+type containerDefinitionsDocumentDataSource struct {
+	framework.DataSourceWithModel[containerDefinitionsDocumentDataSourceModel]
+}
+
+func (d *containerDefinitionsDocumentDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	keyValuePairBlock := func(keyName, valueName string) schema.NestedBlockObject {
+		return schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				keyName: schema.StringAttribute{
+					Required: true,
+				},
+				valueName: schema.StringAttribute{
+					Required: true,
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrJSON: schema.StringAttribute{
+				Computed: true,
+			},
+			"network_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.NetworkMode](),
+				Optional:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"container_definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"command": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"cpu": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"credential_specs": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"disable_networking": schema.BoolAttribute{
+							Optional: true,
+						},
+						"dns_search_domains": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"dns_servers": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"docker_labels": schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"docker_security_options": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"entry_point": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						names.AttrEnvironment: schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"essential": schema.BoolAttribute{
+							Optional: true,
+						},
+						"hostname": schema.StringAttribute{
+							Optional: true,
+						},
+						"image": schema.StringAttribute{
+							Required: true,
+						},
+						"interactive": schema.BoolAttribute{
+							Optional: true,
+						},
+						"links": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"memory": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(6),
+							},
+						},
+						"memory_reservation": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(6),
+							},
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"privileged": schema.BoolAttribute{
+							Optional: true,
+						},
+						"pseudo_terminal": schema.BoolAttribute{
+							Optional: true,
+						},
+						"readonly_root_filesystem": schema.BoolAttribute{
+							Optional: true,
+						},
+						"start_timeout": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"stop_timeout": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 120),
+							},
+						},
+						"user": schema.StringAttribute{
+							Optional: true,
+						},
+						"version_consistency": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.VersionConsistency](),
+							Optional:   true,
+						},
+						"working_directory": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"dependency": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionDependencyModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrCondition: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ContainerCondition](),
+										Required:   true,
+									},
+									"container_name": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"environment_file": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionEnvironmentFileModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.EnvironmentFileType](),
+										Required:   true,
+									},
+									names.AttrValue: schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"extra_host": schema.ListNestedBlock{
+							CustomType:   fwtypes.NewListNestedObjectTypeOf[containerDefinitionHostEntryModel](ctx),
+							NestedObject: keyValuePairBlock("hostname", names.AttrIPAddress),
+						},
+						"firelens_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionFirelensConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"options": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.FirelensConfigurationType](),
+										Required:   true,
+									},
+								},
+							},
+						},
+						"health_check": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionHealthCheckModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"command": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+									names.AttrInterval: schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(5, 300),
+										},
+									},
+									"retries": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(1, 10),
+										},
+									},
+									"start_period": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(0, 300),
+										},
+									},
+									names.AttrTimeout: schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(2, 120),
+										},
+									},
+								},
+							},
+						},
+						"linux_parameters": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionLinuxParametersModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"init_process_enabled": schema.BoolAttribute{
+										Optional: true,
+									},
+									"shared_memory_size": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"capabilities": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionKernelCapabilitiesModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"add": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Optional:    true,
+												},
+												"drop": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Optional:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"log_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionLogConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"log_driver": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.LogDriver](),
+										Required:   true,
+									},
+									"options": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"secret_option": schema.ListNestedBlock{
+										CustomType:   fwtypes.NewListNestedObjectTypeOf[containerDefinitionSecretModel](ctx),
+										NestedObject: keyValuePairBlock(names.AttrName, "value_from"),
+									},
+								},
+							},
+						},
+						"mount_point": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionMountPointModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"container_path": schema.StringAttribute{
+										Required: true,
+									},
+									"read_only": schema.BoolAttribute{
+										Optional: true,
+									},
+									"source_volume": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"port_mapping": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionPortMappingModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"app_protocol": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ApplicationProtocol](),
+										Optional:   true,
+									},
+									"container_port": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(0, 65535),
+										},
+									},
+									"container_port_range": schema.StringAttribute{
+										Optional: true,
+									},
+									"host_port": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(0, 65535),
+										},
+									},
+									names.AttrName: schema.StringAttribute{
+										Optional: true,
+									},
+									names.AttrProtocol: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.TransportProtocol](),
+										Optional:   true,
+									},
+								},
+							},
+						},
+						"repository_credentials": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionRepositoryCredentialsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"credentials_parameter": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+						"resource_requirement": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionResourceRequirementModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ResourceType](),
+										Required:   true,
+									},
+									names.AttrValue: schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"restart_policy": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionRestartPolicyModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrEnabled: schema.BoolAttribute{
+										Required: true,
+									},
+									"ignored_exit_codes": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfInt64Type,
+										ElementType: types.Int64Type,
+										Optional:    true,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(50),
+										},
+									},
+									"restart_attempt_period": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(60, 1800),
+										},
+									},
+								},
+							},
+						},
+						"secret": schema.ListNestedBlock{
+							CustomType:   fwtypes.NewListNestedObjectTypeOf[containerDefinitionSecretModel](ctx),
+							NestedObject: keyValuePairBlock(names.AttrName, "value_from"),
+						},
+						"system_control": schema.ListNestedBlock{
+							CustomType:   fwtypes.NewListNestedObjectTypeOf[containerDefinitionSystemControlModel](ctx),
+							NestedObject: keyValuePairBlock(names.AttrNamespace, names.AttrValue),
+						},
+						"ulimit": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionUlimitModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"hard_limit": schema.Int64Attribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.UlimitName](),
+										Required:   true,
+									},
+									"soft_limit": schema.Int64Attribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"volumes_from": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionVolumeFromModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"read_only": schema.BoolAttribute{
+										Optional: true,
+									},
+									"source_container": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *containerDefinitionsDocumentDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data containerDefinitionsDocumentDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tfList, diags := data.ContainerDefinitions.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var apiObjects containerDefinitions
+	response.Diagnostics.Append(fwflex.Expand(ctx, data.ContainerDefinitions, &apiObjects)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Environment variables are configured as a map.
+	for i, tfObject := range tfList {
+		environment := fwflex.ExpandFrameworkStringValueMap(ctx, tfObject.Environment)
+		for _, k := range slices.Sorted(maps.Keys(environment)) {
+			apiObjects[i].Environment = append(apiObjects[i].Environment, awstypes.KeyValuePair{
+				Name:  aws.String(k),
+				Value: aws.String(environment[k]),
+			})
+		}
+	}
+
+	for i, apiObject := range apiObjects {
+		response.Diagnostics.Append(validateContainerDefinition(apiObjects, apiObject, path.Root("container_definition").AtListIndex(i))...)
+	}
+	if !slices.ContainsFunc(apiObjects, func(v awstypes.ContainerDefinition) bool {
+		return aws.ToBool(v.Essential) || v.Essential == nil
+	}) {
+		response.Diagnostics.AddAttributeError(path.Root("container_definition"), "invalid ECS Container Definitions", "at least one container must be essential")
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply the same normalization as the aws_ecs_task_definition resource so that the document does not cause a diff.
+	apiObjects.reduce(data.NetworkMode.ValueEnum() == awstypes.NetworkModeAwsvpc)
+
+	json, err := flattenContainerDefinitions(apiObjects)
+
+	if err != nil {
+		response.Diagnostics.AddError("flattening ECS Container Definitions", err.Error())
+
+		return
+	}
+
+	data.JSON = types.StringValue(json)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// validateContainerDefinition returns diagnostics for any container definition constraints that cannot be expressed in the schema.
+func validateContainerDefinition(apiObjects []awstypes.ContainerDefinition, apiObject awstypes.ContainerDefinition, attrPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	name := aws.ToString(apiObject.Name)
+
+	if n := len(slices.DeleteFunc(slices.Clone(apiObjects), func(v awstypes.ContainerDefinition) bool {
+		return aws.ToString(v.Name) != name
+	})); n > 1 {
+		diags.AddAttributeError(attrPath.AtName(names.AttrName), "invalid ECS Container Definition", fmt.Sprintf("duplicate container name: %s", name))
+	}
+
+	if v1, v2 := apiObject.Memory, apiObject.MemoryReservation; v1 != nil && v2 != nil && aws.ToInt32(v2) > aws.ToInt32(v1) {
+		diags.AddAttributeError(attrPath.AtName("memory_reservation"), "invalid ECS Container Definition", fmt.Sprintf("memory_reservation (%d) must be less than or equal to memory (%d)", aws.ToInt32(v2), aws.ToInt32(v1)))
+	}
+
+	for _, v := range apiObject.DependsOn {
+		containerName := aws.ToString(v.ContainerName)
+
+		if containerName == name {
+			diags.AddAttributeError(attrPath.AtName("dependency"), "invalid ECS Container Definition", fmt.Sprintf("container (%s) cannot depend on itself", name))
+		} else if !slices.ContainsFunc(apiObjects, func(v awstypes.ContainerDefinition) bool {
+			return aws.ToString(v.Name) == containerName
+		}) {
+			diags.AddAttributeError(attrPath.AtName("dependency"), "invalid ECS Container Definition", fmt.Sprintf("container (%s) depends on unknown container: %s", name, containerName))
+		}
+	}
+
+	for _, v := range apiObject.PortMappings {
+		if v.ContainerPort == nil && v.ContainerPortRange == nil {
+			diags.AddAttributeError(attrPath.AtName("port_mapping"), "invalid ECS Container Definition", "one of container_port or container_port_range must be specified")
+		}
+	}
+
+	for _, v := range apiObject.VolumesFrom {
+		if sourceContainer := aws.ToString(v.SourceContainer); !slices.ContainsFunc(apiObjects, func(v awstypes.ContainerDefinition) bool {
+			return aws.ToString(v.Name) == sourceContainer
+		}) {
+			diags.AddAttributeError(attrPath.AtName("volumes_from"), "invalid ECS Container Definition", fmt.Sprintf("container (%s) mounts volumes from unknown container: %s", name, sourceContainer))
+		}
+	}
+
+	ulimits := make(map[awstypes.UlimitName]struct{}, len(apiObject.Ulimits))
+	for _, v := range apiObject.Ulimits {
+		if v.SoftLimit > v.HardLimit {
+			diags.AddAttributeError(attrPath.AtName("ulimit"), "invalid ECS Container Definition", fmt.Sprintf("ulimit (%s) soft_limit must be less than or equal to hard_limit", v.Name))
+		}
+		if _, ok := ulimits[v.Name]; ok {
+			diags.AddAttributeError(attrPath.AtName("ulimit"), "invalid ECS Container Definition", fmt.Sprintf("duplicate ulimit: %s", v.Name))
+		}
+		ulimits[v.Name] = struct{}{}
+	}
+
+	return diags
+}
+
+type containerDefinitionsDocumentDataSourceModel struct {
+	ContainerDefinitions fwtypes.ListNestedObjectValueOf[containerDefinitionModel] `tfsdk:"container_definition"`
+	JSON                 types.String                                              `tfsdk:"json"`
+	NetworkMode          fwtypes.StringEnum[awstypes.NetworkMode]                  `tfsdk:"network_mode"`
+}
+
+type containerDefinitionModel struct {
+	Command                fwtypes.ListOfString                                                           `tfsdk:"command"`
+	Cpu                    types.Int64                                                                    `tfsdk:"cpu"`
+	CredentialSpecs        fwtypes.ListOfString                                                           `tfsdk:"credential_specs"`
+	DependsOn              fwtypes.ListNestedObjectValueOf[containerDefinitionDependencyModel]            `tfsdk:"dependency"`
+	DisableNetworking      types.Bool                                                                     `tfsdk:"disable_networking"`
+	DnsSearchDomains       fwtypes.ListOfString                                                           `tfsdk:"dns_search_domains"`
+	DnsServers             fwtypes.ListOfString                                                           `tfsdk:"dns_servers"`
+	DockerLabels           fwtypes.MapOfString                                                            `tfsdk:"docker_labels"`
+	DockerSecurityOptions  fwtypes.ListOfString                                                           `tfsdk:"docker_security_options"`
+	EntryPoint             fwtypes.ListOfString                                                           `tfsdk:"entry_point"`
+	Environment            fwtypes.MapOfString                                                            `tfsdk:"environment" autoflex:"-"`
+	EnvironmentFiles       fwtypes.ListNestedObjectValueOf[containerDefinitionEnvironmentFileModel]       `tfsdk:"environment_file"`
+	Essential              types.Bool                                                                     `tfsdk:"essential"`
+	ExtraHosts             fwtypes.ListNestedObjectValueOf[containerDefinitionHostEntryModel]             `tfsdk:"extra_host"`
+	FirelensConfiguration  fwtypes.ListNestedObjectValueOf[containerDefinitionFirelensConfigurationModel] `tfsdk:"firelens_configuration"`
+	HealthCheck            fwtypes.ListNestedObjectValueOf[containerDefinitionHealthCheckModel]           `tfsdk:"health_check"`
+	Hostname               types.String                                                                   `tfsdk:"hostname"`
+	Image                  types.String                                                                   `tfsdk:"image"`
+	Interactive            types.Bool                                                                     `tfsdk:"interactive"`
+	Links                  fwtypes.ListOfString                                                           `tfsdk:"links"`
+	LinuxParameters        fwtypes.ListNestedObjectValueOf[containerDefinitionLinuxParametersModel]       `tfsdk:"linux_parameters"`
+	LogConfiguration       fwtypes.ListNestedObjectValueOf[containerDefinitionLogConfigurationModel]      `tfsdk:"log_configuration"`
+	Memory                 types.Int64                                                                    `tfsdk:"memory"`
+	MemoryReservation      types.Int64                                                                    `tfsdk:"memory_reservation"`
+	MountPoints            fwtypes.ListNestedObjectValueOf[containerDefinitionMountPointModel]            `tfsdk:"mount_point"`
+	Name                   types.String                                                                   `tfsdk:"name"`
+	PortMappings           fwtypes.ListNestedObjectValueOf[containerDefinitionPortMappingModel]           `tfsdk:"port_mapping"`
+	Privileged             types.Bool                                                                     `tfsdk:"privileged"`
+	PseudoTerminal         types.Bool                                                                     `tfsdk:"pseudo_terminal"`
+	ReadonlyRootFilesystem types.Bool                                                                     `tfsdk:"readonly_root_filesystem"`
+	RepositoryCredentials  fwtypes.ListNestedObjectValueOf[containerDefinitionRepositoryCredentialsModel] `tfsdk:"repository_credentials"`
+	ResourceRequirements   fwtypes.ListNestedObjectValueOf[containerDefinitionResourceRequirementModel]   `tfsdk:"resource_requirement"`
+	RestartPolicy          fwtypes.ListNestedObjectValueOf[containerDefinitionRestartPolicyModel]         `tfsdk:"restart_policy"`
+	Secrets                fwtypes.ListNestedObjectValueOf[containerDefinitionSecretModel]                `tfsdk:"secret"`
+	StartTimeout           types.Int64                                                                    `tfsdk:"start_timeout"`
+	StopTimeout            types.Int64                                                                    `tfsdk:"stop_timeout"`
+	SystemControls         fwtypes.ListNestedObjectValueOf[containerDefinitionSystemControlModel]         `tfsdk:"system_control"`
+	Ulimits                fwtypes.ListNestedObjectValueOf[containerDefinitionUlimitModel]                `tfsdk:"ulimit"`
+	User                   types.String                                                                   `tfsdk:"user"`
+	VersionConsistency     fwtypes.StringEnum[awstypes.VersionConsistency]                                `tfsdk:"version_consistency"`
+	VolumesFrom            fwtypes.ListNestedObjectValueOf[containerDefinitionVolumeFromModel]            `tfsdk:"volumes_from"`
+	WorkingDirectory       types.String                                                                   `tfsdk:"working_directory"`
+}
+
+type containerDefinitionDependencyModel struct {
+	Condition     fwtypes.StringEnum[awstypes.ContainerCondition] `tfsdk:"condition"`
+	ContainerName types.String                                    `tfsdk:"container_name"`
+}
+
+type containerDefinitionEnvironmentFileModel struct {
+	Type  fwtypes.StringEnum[awstypes.EnvironmentFileType] `tfsdk:"type"`
+	Value types.String                                     `tfsdk:"value"`
+}
+
+type containerDefinitionHostEntryModel struct {
+	Hostname  types.String `tfsdk:"hostname"`
+	IpAddress types.String `tfsdk:"ip_address"`
+}
+
+type containerDefinitionFirelensConfigurationModel struct {
+	Options fwtypes.MapOfString                                    `tfsdk:"options"`
+	Type    fwtypes.StringEnum[awstypes.FirelensConfigurationType] `tfsdk:"type"`
+}
+
+type containerDefinitionHealthCheckModel struct {
+	Command     fwtypes.ListOfString `tfsdk:"command"`
+	Interval    types.Int64          `tfsdk:"interval"`
+	Retries     types.Int64          `tfsdk:"retries"`
+	StartPeriod types.Int64          `tfsdk:"start_period"`
+	Timeout     types.Int64          `tfsdk:"timeout"`
+}
+
+type containerDefinitionLinuxParametersModel struct {
+	Capabilities       fwtypes.ListNestedObjectValueOf[containerDefinitionKernelCapabilitiesModel] `tfsdk:"capabilities"`
+	InitProcessEnabled types.Bool                                                                  `tfsdk:"init_process_enabled"`
+	SharedMemorySize   types.Int64                                                                 `tfsdk:"shared_memory_size"`
+}
+
+type containerDefinitionKernelCapabilitiesModel struct {
+	Add  fwtypes.ListOfString `tfsdk:"add"`
+	Drop fwtypes.ListOfString `tfsdk:"drop"`
+}
+
+type containerDefinitionLogConfigurationModel struct {
+	LogDriver     fwtypes.StringEnum[awstypes.LogDriver]                          `tfsdk:"log_driver"`
+	Options       fwtypes.MapOfString                                             `tfsdk:"options"`
+	SecretOptions fwtypes.ListNestedObjectValueOf[containerDefinitionSecretModel] `tfsdk:"secret_option"`
+}
+
+type containerDefinitionMountPointModel struct {
+	ContainerPath types.String `tfsdk:"container_path"`
+	ReadOnly      types.Bool   `tfsdk:"read_only"`
+	SourceVolume  types.String `tfsdk:"source_volume"`
+}
+
+type containerDefinitionPortMappingModel struct {
+	AppProtocol        fwtypes.StringEnum[awstypes.ApplicationProtocol] `tfsdk:"app_protocol"`
+	ContainerPort      types.Int64                                      `tfsdk:"container_port"`
+	ContainerPortRange types.String                                     `tfsdk:"container_port_range"`
+	HostPort           types.Int64                                      `tfsdk:"host_port"`
+	Name               types.String                                     `tfsdk:"name"`
+	Protocol           fwtypes.StringEnum[awstypes.TransportProtocol]   `tfsdk:"protocol"`
+}
+
+type containerDefinitionRepositoryCredentialsModel struct {
+	CredentialsParameter fwtypes.ARN `tfsdk:"credentials_parameter"`
+}
+
+type containerDefinitionResourceRequirementModel struct {
+	Type  fwtypes.StringEnum[awstypes.ResourceType] `tfsdk:"type"`
+	Value types.String                              `tfsdk:"value"`
+}
+
+type containerDefinitionRestartPolicyModel struct {
+	Enabled              types.Bool          `tfsdk:"enabled"`
+	IgnoredExitCodes     fwtypes.ListOfInt64 `tfsdk:"ignored_exit_codes"`
+	RestartAttemptPeriod types.Int64         `tfsdk:"restart_attempt_period"`
+}
+
+type containerDefinitionSecretModel struct {
+	Name      types.String `tfsdk:"name"`
+	ValueFrom types.String `tfsdk:"value_from"`
+}
+
+type containerDefinitionSystemControlModel struct {
+	Namespace types.String `tfsdk:"namespace"`
+	Value     types.String `tfsdk:"value"`
+}
+
+type containerDefinitionUlimitModel struct {
+	HardLimit types.Int64                             `tfsdk:"hard_limit"`
+	Name      fwtypes.StringEnum[awstypes.UlimitName] `tfsdk:"name"`
+	SoftLimit types.Int64                             `tfsdk:"soft_limit"`
+}
+
+type containerDefinitionVolumeFromModel struct {
+	ReadOnly        types.Bool   `tfsdk:"read_only"`
+	SourceContainer types.String `tfsdk:"source_container"`
+}
@@ -0,0 +1,264 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSContainerDefinitionsDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecs_container_definitions_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerDefinitionsDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrJSON, `[{"environment":[{"name":"A","value":"1"},{"name":"B","value":"2"}],"essential":true,"image":"nginx:latest","memory":128,"name":"web","portMappings":[{"containerPort":80}]}]`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSContainerDefinitionsDocumentDataSource_full(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecs_container_definitions_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerDefinitionsDocumentDataSourceConfig_full,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrJSON, regexache.MustCompile(`"dependsOn":\[{"condition":"START","containerName":"sidecar"}\]`)),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrJSON, regexache.MustCompile(`"healthCheck":{"command":\["CMD-SHELL","exit 0"\],"interval":30,"retries":3,"timeout":5}`)),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrJSON, regexache.MustCompile(`"logConfiguration":{"logDriver":"awslogs","options":{"awslogs-group":"example"}}`)),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrJSON, regexache.MustCompile(`"portMappings":\[{"containerPort":8080,"hostPort":8080,"protocol":"udp"}\]`)),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrJSON, regexache.MustCompile(`"essential":false,"image":"busybox","name":"sidecar"`)),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrJSON, regexache.MustCompile(`"ulimits":\[{"hardLimit":2048,"name":"nofile","softLimit":1024}\]`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSContainerDefinitionsDocumentDataSource_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccContainerDefinitionsDocumentDataSourceConfig_duplicateName,
+				ExpectError: regexache.MustCompile(`duplicate container name: web`),
+			},
+			{
+				Config:      testAccContainerDefinitionsDocumentDataSourceConfig_unknownDependency,
+				ExpectError: regexache.MustCompile(`depends on unknown container: db`),
+			},
+			{
+				Config:      testAccContainerDefinitionsDocumentDataSourceConfig_noEssential,
+				ExpectError: regexache.MustCompile(`at least one container must be essential`),
+			},
+			{
+				Config:      testAccContainerDefinitionsDocumentDataSourceConfig_memoryReservation,
+				ExpectError: regexache.MustCompile(`memory_reservation \(256\) must be less than or equal to memory \(128\)`),
+			},
+		},
+	})
+}
+
+func TestAccECSContainerDefinitionsDocumentDataSource_taskDefinition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def awstypes.TaskDefinition
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerDefinitionsDocumentDataSourceConfig_taskDefinition(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+				),
+			},
+			{
+				Config:   testAccContainerDefinitionsDocumentDataSourceConfig_taskDefinition(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+const testAccContainerDefinitionsDocumentDataSourceConfig_basic = `
+data "aws_ecs_container_definitions_document" "test" {
+  container_definition {
+    name   = "web"
+    image  = "nginx:latest"
+    memory = 128
+
+    environment = {
+      B = "2"
+      A = "1"
+    }
+
+    port_mapping {
+      container_port = 80
+      protocol       = "tcp"
+    }
+  }
+}
+`
+
+const testAccContainerDefinitionsDocumentDataSourceConfig_full = `
+data "aws_ecs_container_definitions_document" "test" {
+  network_mode = "awsvpc"
+
+  container_definition {
+    name   = "web"
+    image  = "nginx:latest"
+    cpu    = 256
+    memory = 512
+
+    dependency {
+      condition      = "START"
+      container_name = "sidecar"
+    }
+
+    health_check {
+      command = ["CMD-SHELL", "exit 0"]
+    }
+
+    log_configuration {
+      log_driver = "awslogs"
+      options = {
+        "awslogs-group" = "example"
+      }
+    }
+
+    port_mapping {
+      container_port = 8080
+      protocol       = "udp"
+    }
+
+    ulimit {
+      name       = "nofile"
+      soft_limit = 1024
+      hard_limit = 2048
+    }
+  }
+
+  container_definition {
+    name      = "sidecar"
+    image     = "busybox"
+    essential = false
+  }
+}
+`
+
+const testAccContainerDefinitionsDocumentDataSourceConfig_duplicateName = `
+data "aws_ecs_container_definitions_document" "test" {
+  container_definition {
+    name  = "web"
+    image = "nginx:latest"
+  }
+
+  container_definition {
+    name  = "web"
+    image = "nginx:latest"
+  }
+}
+`
+
+const testAccContainerDefinitionsDocumentDataSourceConfig_unknownDependency = `
+data "aws_ecs_container_definitions_document" "test" {
+  container_definition {
+    name  = "web"
+    image = "nginx:latest"
+
+    dependency {
+      condition      = "HEALTHY"
+      container_name = "db"
+    }
+  }
+}
+`
+
+const testAccContainerDefinitionsDocumentDataSourceConfig_noEssential = `
+data "aws_ecs_container_definitions_document" "test" {
+  container_definition {
+    name      = "web"
+    image     = "nginx:latest"
+    essential = false
+  }
+}
+`
+
+const testAccContainerDefinitionsDocumentDataSourceConfig_memoryReservation = `
+data "aws_ecs_container_definitions_document" "test" {
+  container_definition {
+    name               = "web"
+    image              = "nginx:latest"
+    memory             = 128
+    memory_reservation = 256
+  }
+}
+`
+
+func testAccContainerDefinitionsDocumentDataSourceConfig_taskDefinition(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ecs_container_definitions_document" "test" {
+  network_mode = "awsvpc"
+
+  container_definition {
+    name   = "web"
+    image  = "nginx:latest"
+    cpu    = 256
+    memory = 512
+
+    environment = {
+      FOO = "bar"
+    }
+
+    health_check {
+      command = ["CMD-SHELL", "exit 0"]
+    }
+
+    port_mapping {
+      container_port = 80
+      protocol       = "tcp"
+    }
+  }
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = 256
+  memory                   = 512
+  container_definitions    = data.aws_ecs_container_definitions_document.test.json
+}
+`, rName)
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_eks_access_entries", name="Access Entries")
+func newAccessEntriesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &accessEntriesDataSource{}, nil
+}
+
+type accessEntriesDataSource struct {
+	framework.DataSourceWithModel[accessEntriesDataSourceModel]
+}
+
+func (d *accessEntriesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"associated_policy_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrClusterName: schema.StringAttribute{
+				Required: true,
+			},
+			"principal_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *accessEntriesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data accessEntriesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EKSClient(ctx)
+
+	var input eks.ListAccessEntriesInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	clusterName := fwflex.StringValueFromFramework(ctx, data.ClusterName)
+	principalARNs, err := findAccessEntries(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EKS Access Entries (%s)", clusterName), err.Error())
+		return
+	}
+
+	data.PrincipalARNs = fwflex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, principalARNs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findAccessEntriesByClusterName(ctx context.Context, conn *eks.Client, clusterName string) ([]string, error) {
+	input := eks.ListAccessEntriesInput{
+		ClusterName: aws.String(clusterName),
+	}
+
+	return findAccessEntries(ctx, conn, &input)
+}
+
+func findAccessEntries(ctx context.Context, conn *eks.Client, input *eks.ListAccessEntriesInput) ([]string, error) {
+	output := make([]string, 0)
+
+	pages := eks.NewListAccessEntriesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AccessEntries...)
+	}
+
+	return output, nil
+}
+
+type accessEntriesDataSourceModel struct {
+	framework.WithRegionModel
+	AssociatedPolicyARN fwtypes.ARN         `tfsdk:"associated_policy_arn"`
+	ClusterName         types.String        `tfsdk:"cluster_name"`
+	PrincipalARNs       fwtypes.SetOfString `tfsdk:"principal_arns" autoflex:"-"`
+}
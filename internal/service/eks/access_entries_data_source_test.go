@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSAccessEntriesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_access_entries.test"
+	dataSourceNameFiltered := "data.aws_eks_access_entries.filtered"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessEntriesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrClusterName, "aws_eks_cluster.test", names.AttrName),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "principal_arns.*", "aws_iam_user.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "principal_arns.*", "aws_iam_user.test.1", names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceNameFiltered, "principal_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceNameFiltered, "principal_arns.*", "aws_iam_user.test.0", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessEntriesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAccessEntryConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+}
+
+resource "aws_eks_access_entry" "test" {
+  count = 2
+
+  cluster_name  = aws_eks_cluster.test.name
+  principal_arn = aws_iam_user.test[count.index].arn
+}
+
+resource "aws_eks_access_policy_association" "test" {
+  cluster_name  = aws_eks_cluster.test.name
+  principal_arn = aws_eks_access_entry.test[0].principal_arn
+  policy_arn    = "arn:${data.aws_partition.current.partition}:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"
+
+  access_scope {
+    type = "cluster"
+  }
+}
+
+data "aws_eks_access_entries" "test" {
+  cluster_name = aws_eks_cluster.test.name
+
+  depends_on = [aws_eks_access_entry.test]
+}
+
+data "aws_eks_access_entries" "filtered" {
+  cluster_name          = aws_eks_cluster.test.name
+  associated_policy_arn = aws_eks_access_policy_association.test.policy_arn
+}
+`, rName))
+}
@@ -0,0 +1,241 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_eks_access_entries_exclusive", name="Access Entries Exclusive")
+func newAccessEntriesExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &accessEntriesExclusiveResource{}, nil
+}
+
+type accessEntriesExclusiveResource struct {
+	framework.ResourceWithModel[accessEntriesExclusiveResourceModel]
+	framework.WithNoOpDelete
+}
+
+func (r *accessEntriesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrClusterName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+					setvalidator.ValueStringsAre(
+						validators.ARN(),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *accessEntriesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan accessEntriesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EKSClient(ctx)
+
+	clusterName := fwflex.StringValueFromFramework(ctx, plan.ClusterName)
+	if err := syncAccessEntries(ctx, conn, clusterName, fwflex.ExpandFrameworkStringValueSet(ctx, plan.PrincipalARNs)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating EKS Access Entries Exclusive (%s)", clusterName), err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (r *accessEntriesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state accessEntriesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EKSClient(ctx)
+
+	clusterName := fwflex.StringValueFromFramework(ctx, state.ClusterName)
+	principalARNs, err := findAccessEntryPrincipalARNsByClusterNameAndType(ctx, conn, clusterName, accessEntryTypeStandard)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EKS Access Entries Exclusive (%s)", clusterName), err.Error())
+		return
+	}
+
+	state.PrincipalARNs = fwflex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, principalARNs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *accessEntriesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state accessEntriesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EKSClient(ctx)
+
+	if !plan.PrincipalARNs.Equal(state.PrincipalARNs) {
+		clusterName := fwflex.StringValueFromFramework(ctx, plan.ClusterName)
+		if err := syncAccessEntries(ctx, conn, clusterName, fwflex.ExpandFrameworkStringValueSet(ctx, plan.PrincipalARNs)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating EKS Access Entries Exclusive (%s)", clusterName), err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *accessEntriesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrClusterName), request, response)
+}
+
+// syncAccessEntries keeps the cluster's standard access entries in sync with
+// the configured principals.
+//
+// Principals configured on this resource but without an access entry are
+// given a standard access entry. Standard access entries for principals that
+// are not configured on this resource are deleted. Access entries of other
+// types, such as those EKS creates for node and Fargate roles, are left alone.
+func syncAccessEntries(ctx context.Context, conn *eks.Client, clusterName string, want []string) error {
+	// Only the type of access entries that may be deleted is needed, so list all access entries rather than describing each one.
+	have, err := findAccessEntryPrincipalARNsByClusterNameAndType(ctx, conn, clusterName, "")
+	if err != nil {
+		return err
+	}
+
+	create, remove, _ := flex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+
+	for _, principalARN := range create {
+		input := eks.CreateAccessEntryInput{
+			ClusterName:  aws.String(clusterName),
+			PrincipalArn: aws.String(principalARN),
+			Type:         aws.String(accessEntryTypeStandard),
+		}
+
+		_, err := tfresource.RetryWhenIsAErrorMessageContains[any, *awstypes.InvalidParameterException](ctx, propagationTimeout, func(ctx context.Context) (any, error) {
+			return conn.CreateAccessEntry(ctx, &input)
+		}, "The specified principalArn is invalid: invalid principal")
+
+		if err != nil {
+			return fmt.Errorf("creating EKS Access Entry (%s): %w", accessEntryCreateResourceID(clusterName, principalARN), err)
+		}
+	}
+
+	for _, principalARN := range remove {
+		accessEntry, err := findAccessEntryByTwoPartKey(ctx, conn, clusterName, principalARN)
+
+		if retry.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading EKS Access Entry (%s): %w", accessEntryCreateResourceID(clusterName, principalARN), err)
+		}
+
+		if aws.ToString(accessEntry.Type) != accessEntryTypeStandard {
+			continue
+		}
+
+		input := eks.DeleteAccessEntryInput{
+			ClusterName:  aws.String(clusterName),
+			PrincipalArn: aws.String(principalARN),
+		}
+
+		_, err = conn.DeleteAccessEntry(ctx, &input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting EKS Access Entry (%s): %w", accessEntryCreateResourceID(clusterName, principalARN), err)
+		}
+	}
+
+	return nil
+}
+
+// findAccessEntryPrincipalARNsByClusterNameAndType returns the principal ARNs of the
+// cluster's access entries of the specified type, or of all its access entries if no type is specified.
+// ListAccessEntries does not return entry types, so filtering by type describes each access entry.
+func findAccessEntryPrincipalARNsByClusterNameAndType(ctx context.Context, conn *eks.Client, clusterName, entryType string) ([]string, error) {
+	principalARNs, err := findAccessEntriesByClusterName(ctx, conn, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	if entryType == "" {
+		return principalARNs, nil
+	}
+
+	output := make([]string, 0, len(principalARNs))
+	for _, principalARN := range principalARNs {
+		accessEntry, err := findAccessEntryByTwoPartKey(ctx, conn, clusterName, principalARN)
+
+		if retry.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("reading EKS Access Entry (%s): %w", accessEntryCreateResourceID(clusterName, principalARN), err)
+		}
+
+		if aws.ToString(accessEntry.Type) == entryType {
+			output = append(output, principalARN)
+		}
+	}
+
+	return output, nil
+}
+
+type accessEntriesExclusiveResourceModel struct {
+	framework.WithRegionModel
+	ClusterName   types.String        `tfsdk:"cluster_name"`
+	PrincipalARNs fwtypes.SetOfString `tfsdk:"principal_arns"`
+}
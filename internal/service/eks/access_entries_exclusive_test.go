@@ -0,0 +1,305 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSAccessEntriesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_entries_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessEntriesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessEntriesExclusiveExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrClusterName, "aws_eks_cluster.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "principal_arns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "principal_arns.*", "aws_iam_user.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "principal_arns.*", "data.aws_iam_session_context.current", "issuer_arn"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrClusterName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrClusterName,
+			},
+		},
+	})
+}
+
+func TestAccEKSAccessEntriesExclusive_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_entries_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessEntriesExclusiveConfig_multiple(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessEntriesExclusiveExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "principal_arns.#", "2"),
+				),
+			},
+			{
+				Config: testAccAccessEntriesExclusiveConfig_multiple(rName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessEntriesExclusiveExists(ctx, resourceName, 4),
+					resource.TestCheckResourceAttr(resourceName, "principal_arns.#", "4"),
+				),
+			},
+			{
+				Config: testAccAccessEntriesExclusiveConfig_multiple(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessEntriesExclusiveExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "principal_arns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSAccessEntriesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_entries_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessEntriesExclusiveConfig_outOfBandAddition(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessEntriesExclusiveExists(ctx, resourceName, 1),
+					testAccCheckAccessEntriesExclusiveCreateAccessEntry(ctx, resourceName, "aws_iam_user.test.0"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAccessEntriesExclusiveConfig_outOfBandAddition(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessEntriesExclusiveExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "principal_arns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSAccessEntriesExclusive_nonStandardEntries(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_entries_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessEntriesExclusiveConfig_nonStandardEntries(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessEntriesExclusiveExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "principal_arns.#", "1"),
+					resource.TestCheckResourceAttr("aws_eks_access_entry.test", names.AttrType, "EC2_LINUX"),
+				),
+			},
+			{
+				Config:   testAccAccessEntriesExclusiveConfig_nonStandardEntries(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccessEntriesExclusiveExists(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		clusterName := rs.Primary.Attributes[names.AttrClusterName]
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		principalARNs, err := tfeks.FindAccessEntryPrincipalARNsByClusterNameAndType(ctx, conn, clusterName, "STANDARD")
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(principalARNs); got != want {
+			return fmt.Errorf("EKS Access Entries Exclusive (%s) has %d standard access entries, want %d", clusterName, got, want)
+		}
+
+		if got := rs.Primary.Attributes["principal_arns.#"]; got != strconv.Itoa(len(principalARNs)) {
+			return fmt.Errorf("EKS Access Entries Exclusive (%s) principal_arns.# is %s, want %d", clusterName, got, len(principalARNs))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccessEntriesExclusiveCreateAccessEntry(ctx context.Context, n, principalResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		rsPrincipal, ok := s.RootModule().Resources[principalResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", principalResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		input := eks.CreateAccessEntryInput{
+			ClusterName:  aws.String(rs.Primary.Attributes[names.AttrClusterName]),
+			PrincipalArn: aws.String(rsPrincipal.Primary.Attributes[names.AttrARN]),
+		}
+
+		_, err := conn.CreateAccessEntry(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccAccessEntriesExclusiveConfig_base(rName string, userCount int) string {
+	return acctest.ConfigCompose(testAccAccessEntryConfig_base(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_iam_user" "test" {
+  count = %[2]d
+
+  name = "%[1]s-${count.index}"
+}
+`, rName, userCount))
+}
+
+func testAccAccessEntriesExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAccessEntriesExclusiveConfig_base(rName, 1), `
+resource "aws_eks_access_entries_exclusive" "test" {
+  cluster_name = aws_eks_cluster.test.name
+  principal_arns = [
+    data.aws_iam_session_context.current.issuer_arn,
+    aws_iam_user.test[0].arn,
+  ]
+}
+`)
+}
+
+func testAccAccessEntriesExclusiveConfig_multiple(rName string, userCount int) string {
+	return acctest.ConfigCompose(testAccAccessEntriesExclusiveConfig_base(rName, userCount), `
+resource "aws_eks_access_entries_exclusive" "test" {
+  cluster_name   = aws_eks_cluster.test.name
+  principal_arns = concat([data.aws_iam_session_context.current.issuer_arn], aws_iam_user.test[*].arn)
+}
+`)
+}
+
+func testAccAccessEntriesExclusiveConfig_outOfBandAddition(rName string) string {
+	return acctest.ConfigCompose(testAccAccessEntriesExclusiveConfig_base(rName, 1), `
+resource "aws_eks_access_entries_exclusive" "test" {
+  cluster_name   = aws_eks_cluster.test.name
+  principal_arns = [data.aws_iam_session_context.current.issuer_arn]
+}
+`)
+}
+
+func testAccAccessEntriesExclusiveConfig_nonStandardEntries(rName string) string {
+	return acctest.ConfigCompose(testAccAccessEntriesExclusiveConfig_base(rName, 0), fmt.Sprintf(`
+resource "aws_iam_role" "test2" {
+  name = "%[1]s-2"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_eks_access_entry" "test" {
+  cluster_name  = aws_eks_cluster.test.name
+  principal_arn = aws_iam_role.test2.arn
+  type          = "EC2_LINUX"
+}
+
+resource "aws_eks_access_entries_exclusive" "test" {
+  cluster_name   = aws_eks_cluster.test.name
+  principal_arns = [data.aws_iam_session_context.current.issuer_arn]
+
+  depends_on = [aws_eks_access_entry.test]
+}
+`, rName))
+}
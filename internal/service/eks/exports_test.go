@@ -5,6 +5,7 @@ package eks
 
 // Exports for use in tests only.
 var (
+	ResourceAccessEntriesExclusive  = newAccessEntriesExclusiveResource
 	ResourceAccessEntry             = resourceAccessEntry
 	ResourceAccessPolicyAssociation = resourceAccessPolicyAssociation
 	ResourceAddon                   = resourceAddon
@@ -15,14 +16,15 @@ var (
 	ResourceNodeGroup               = resourceNodeGroup
 	ResourcePodIdentityAssociation  = newPodIdentityAssociationResource
 
-	ClusterStateUpgradeV0                      = clusterStateUpgradeV0
-	FindAccessEntryByTwoPartKey                = findAccessEntryByTwoPartKey
-	FindAccessPolicyAssociationByThreePartKey  = findAccessPolicyAssociationByThreePartKey
-	FindAddonByTwoPartKey                      = findAddonByTwoPartKey
-	FindCapabilityByTwoPartKey                 = findCapabilityByTwoPartKey
-	FindClusterByName                          = findClusterByName
-	FindFargateProfileByTwoPartKey             = findFargateProfileByTwoPartKey
-	FindNodegroupByTwoPartKey                  = findNodegroupByTwoPartKey
-	FindOIDCIdentityProviderConfigByTwoPartKey = findOIDCIdentityProviderConfigByTwoPartKey
-	FindPodIdentityAssociationByTwoPartKey     = findPodIdentityAssociationByTwoPartKey
+	ClusterStateUpgradeV0                            = clusterStateUpgradeV0
+	FindAccessEntryByTwoPartKey                      = findAccessEntryByTwoPartKey
+	FindAccessEntryPrincipalARNsByClusterNameAndType = findAccessEntryPrincipalARNsByClusterNameAndType
+	FindAccessPolicyAssociationByThreePartKey        = findAccessPolicyAssociationByThreePartKey
+	FindAddonByTwoPartKey                            = findAddonByTwoPartKey
+	FindCapabilityByTwoPartKey                       = findCapabilityByTwoPartKey
+	FindClusterByName                                = findClusterByName
+	FindFargateProfileByTwoPartKey                   = findFargateProfileByTwoPartKey
+	FindNodegroupByTwoPartKey                        = findNodegroupByTwoPartKey
+	FindOIDCIdentityProviderConfigByTwoPartKey       = findOIDCIdentityProviderConfigByTwoPartKey
+	FindPodIdentityAssociationByTwoPartKey           = findPodIdentityAssociationByTwoPartKey
 )
@@ -33,6 +33,12 @@ func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.Ser
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newAccessEntriesDataSource,
+			TypeName: "aws_eks_access_entries",
+			Name:     "Access Entries",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newClusterVersionsDataSource,
 			TypeName: "aws_eks_cluster_versions",
@@ -44,6 +50,12 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
 	return []*inttypes.ServicePackageFrameworkResource{
+		{
+			Factory:  newAccessEntriesExclusiveResource,
+			TypeName: "aws_eks_access_entries_exclusive",
+			Name:     "Access Entries Exclusive",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newCapabilityResource,
 			TypeName: "aws_eks_capability",
@@ -36,7 +36,7 @@ func ResourcePipeline() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		DeprecationMessage: "This resource is deprecated. Use AWS Elemental MediaConvert instead.",
+		DeprecationMessage: names.ResourceDeprecationMessage("aws_elastictranscoder_pipeline", ""),
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
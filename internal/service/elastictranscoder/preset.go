@@ -33,7 +33,7 @@ func ResourcePreset() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		DeprecationMessage: "This resource is deprecated. Use AWS Elemental MediaConvert instead.",
+		DeprecationMessage: names.ResourceDeprecationMessage("aws_elastictranscoder_preset", ""),
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
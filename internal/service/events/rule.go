@@ -92,7 +92,7 @@ func resourceRule() *schema.Resource {
 			"is_enabled": {
 				Type:       schema.TypeBool,
 				Optional:   true,
-				Deprecated: "is_enabled is deprecated. Use state instead.",
+				Deprecated: names.ResourceDeprecationMessage("aws_cloudwatch_event_rule", "is_enabled"),
 				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
 					rawPlan := d.GetRawPlan()
 					rawIsEnabled := rawPlan.GetAttr("is_enabled")
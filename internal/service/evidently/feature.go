@@ -40,7 +40,7 @@ func ResourceFeature() *schema.Resource {
 		UpdateWithoutTimeout: resourceFeatureUpdate,
 		DeleteWithoutTimeout: resourceFeatureDelete,
 
-		DeprecationMessage: "This resource is deprecated. Use AWS AppConfig feature flags instead.",
+		DeprecationMessage: names.ResourceDeprecationMessage("aws_evidently_feature", ""),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
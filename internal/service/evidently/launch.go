@@ -39,7 +39,7 @@ func ResourceLaunch() *schema.Resource {
 		UpdateWithoutTimeout: resourceLaunchUpdate,
 		DeleteWithoutTimeout: resourceLaunchDelete,
 
-		DeprecationMessage: "This resource is deprecated. Use AWS AppConfig feature flags instead.",
+		DeprecationMessage: names.ResourceDeprecationMessage("aws_evidently_launch", ""),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -34,7 +34,7 @@ func ResourceProject() *schema.Resource {
 		UpdateWithoutTimeout: resourceProjectUpdate,
 		DeleteWithoutTimeout: resourceProjectDelete,
 
-		DeprecationMessage: "This resource is deprecated. Use AWS AppConfig feature flags instead.",
+		DeprecationMessage: names.ResourceDeprecationMessage("aws_evidently_project", ""),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -36,7 +36,7 @@ func ResourceSegment() *schema.Resource {
 		UpdateWithoutTimeout: resourceSegmentUpdate,
 		DeleteWithoutTimeout: resourceSegmentDelete,
 
-		DeprecationMessage: "This resource is deprecated. Use AWS AppConfig feature flags instead.",
+		DeprecationMessage: names.ResourceDeprecationMessage("aws_evidently_segment", ""),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -1,15 +1,11 @@
 // Copyright IBM Corp. 2014, 2026
 // SPDX-License-Identifier: MPL-2.0
 
-// DONOTCOPY: Copying old resources spreads bad habits. Use skaff instead.
-
 package firehose
 
 import (
 	"context"
 	"fmt"
-	"log"
-	"slices"
 	"strings"
 	"time"
 
@@ -17,20 +13,39 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/firehose"
-	"github.com/aws/aws-sdk-go-v2/service/firehose/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/firehose/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfboolplanmodifier "github.com/hashicorp/terraform-provider-aws/internal/framework/planmodifiers/boolplanmodifier"
+	tfstringplanmodifier "github.com/hashicorp/terraform-provider-aws/internal/framework/planmodifiers/stringplanmodifier"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
-	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -62,511 +77,346 @@ func (destinationType) Values() []destinationType {
 	}
 }
 
-// @SDKResource("aws_kinesis_firehose_delivery_stream", name="Delivery Stream")
+// configurationBlockName returns the name of the configuration block for the destination type.
+func (t destinationType) configurationBlockName() string {
+	return string(t) + "_configuration"
+}
+
+// @FrameworkResource("aws_kinesis_firehose_delivery_stream", name="Delivery Stream")
 // @Tags(identifierAttribute="name")
-func resourceDeliveryStream() *schema.Resource {
-	// lintignore:R011
-	return &schema.Resource{
-		CreateWithoutTimeout: resourceDeliveryStreamCreate,
-		ReadWithoutTimeout:   resourceDeliveryStreamRead,
-		UpdateWithoutTimeout: resourceDeliveryStreamUpdate,
-		DeleteWithoutTimeout: resourceDeliveryStreamDelete,
-
-		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-				idErr := fmt.Errorf("Expected ID in format of arn:PARTITION:firehose:REGION:ACCOUNTID:deliverystream/NAME and provided: %s", d.Id())
-				resARN, err := arn.Parse(d.Id())
-				if err != nil {
-					return nil, idErr
-				}
-				resourceParts := strings.Split(resARN.Resource, "/")
-				if len(resourceParts) != 2 {
-					return nil, idErr
-				}
-				d.Set(names.AttrName, resourceParts[1])
-				return []*schema.ResourceData{d}, nil
-			},
-		},
+func newDeliveryStreamResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &deliveryStreamResource{}
 
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(30 * time.Minute),
-			Update: schema.DefaultTimeout(10 * time.Minute),
-			Delete: schema.DefaultTimeout(30 * time.Minute),
-		},
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
 
-		SchemaVersion: 1,
-		MigrateState:  MigrateState,
-		SchemaFunc: func() map[string]*schema.Schema {
-			cloudWatchLoggingOptionsSchema := func() *schema.Schema {
-				return &schema.Schema{
-					Type:     schema.TypeList,
-					MaxItems: 1,
-					Optional: true,
-					Computed: true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							names.AttrEnabled: {
-								Type:     schema.TypeBool,
-								Optional: true,
-								Default:  false,
-							},
-							names.AttrLogGroupName: {
-								Type:     schema.TypeString,
-								Optional: true,
-							},
-							"log_stream_name": {
-								Type:     schema.TypeString,
-								Optional: true,
-							},
-						},
+	return r, nil
+}
+
+type deliveryStreamResource struct {
+	framework.ResourceWithModel[deliveryStreamResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *deliveryStreamResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	int64AttributeWithDefault := func(v int64, validators ...validator.Int64) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Optional:   true,
+			Computed:   true,
+			Default:    int64default.StaticInt64(v),
+			Validators: validators,
+		}
+	}
+	legacyStringAttribute := func(validators ...validator.String) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional: true,
+			Computed: true, // Because of Legacy value handling.
+			PlanModifiers: []planmodifier.String{
+				tfstringplanmodifier.LegacyValue(),
+			},
+			Validators: validators,
+		}
+	}
+	cloudWatchLoggingOptionsAttribute := func() schema.ListAttribute {
+		return optionalComputedNestedObjectAttribute[cloudWatchLoggingOptionsModel](ctx, nil)
+	}
+	processingConfigurationAttribute := func() schema.ListAttribute {
+		return optionalComputedNestedObjectAttribute[processingConfigurationModel](ctx, nil)
+	}
+	s3ConfigurationBlock := func(validators ...validator.List) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[s3ConfigurationModel](ctx),
+			Validators: append(validators, listvalidator.SizeAtMost(1)),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"bucket_arn": schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Required:   true,
 					},
-				}
-			}
-			destinationTableConfigurationSchema := func() *schema.Schema {
-				return &schema.Schema{
-					Type:     schema.TypeList,
-					Optional: true,
-					ForceNew: true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							names.AttrDatabaseName: {
-								Type:     schema.TypeString,
-								Required: true,
-							},
-							names.AttrTableName: {
-								Type:     schema.TypeString,
-								Required: true,
-							},
-							"s3_error_output_prefix": {
-								Type:         schema.TypeString,
-								Optional:     true,
-								ValidateFunc: validation.StringLenBetween(0, 1024),
-							},
-							"unique_keys": {
-								Type:     schema.TypeList,
-								Optional: true,
-								Elem:     &schema.Schema{Type: schema.TypeString},
-							},
-						},
+					"buffering_interval":         int64AttributeWithDefault(300),
+					"buffering_size":             int64AttributeWithDefault(5, int64validator.AtLeast(1)),
+					"cloudwatch_logging_options": cloudWatchLoggingOptionsAttribute(),
+					"compression_format": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.CompressionFormat](),
+						Optional:   true,
+						Computed:   true,
+						Default:    stringdefault.StaticString(string(awstypes.CompressionFormatUncompressed)),
 					},
-				}
-			}
-			dynamicPartitioningConfigurationSchema := func() *schema.Schema {
-				return &schema.Schema{
-					Type:     schema.TypeList,
-					MaxItems: 1,
-					Optional: true,
-					ForceNew: true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							names.AttrEnabled: {
-								Type:     schema.TypeBool,
-								Optional: true,
-								Default:  false,
-								ForceNew: true,
-							},
-							"retry_duration": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      300,
-								ValidateFunc: validation.IntBetween(0, 7200),
-							},
-						},
+					"error_output_prefix": legacyStringAttribute(stringvalidator.LengthBetween(0, 1024)),
+					names.AttrKMSKeyARN: schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Optional:   true,
+					},
+					names.AttrPrefix: legacyStringAttribute(),
+					names.AttrRoleARN: schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Required:   true,
 					},
-				}
+				},
+			},
+		}
+	}
+	s3BackupModeAttribute := func(defaultValue string, requiresReplace bool) schema.StringAttribute {
+		attribute := schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(defaultValue),
+		}
+		if requiresReplace {
+			attribute.PlanModifiers = []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
 			}
-			processingConfigurationSchema := func() *schema.Schema {
-				return &schema.Schema{
-					Type:             schema.TypeList,
-					Optional:         true,
-					MaxItems:         1,
-					DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							names.AttrEnabled: {
-								Type:     schema.TypeBool,
-								Optional: true,
-							},
-							"processors": {
-								Type:     schema.TypeList,
-								Optional: true,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										names.AttrParameters: {
-											// See AWS::KinesisFirehose::DeliveryStream CloudFormation resource schema.
-											// uniqueItems is true and insertionOrder is true.
-											// However, IRL the order of the processors is not important.
-											Type:     schema.TypeSet,
-											Optional: true,
-											Elem: &schema.Resource{
-												Schema: map[string]*schema.Schema{
-													"parameter_name": {
-														Type:             schema.TypeString,
-														Required:         true,
-														ValidateDiagFunc: enum.Validate[types.ProcessorParameterName](),
-													},
-													"parameter_value": {
-														Type:         schema.TypeString,
-														Required:     true,
-														ValidateFunc: validation.StringLenBetween(1, 5120),
-													},
-												},
-											},
-										},
-										names.AttrType: {
-											Type:             schema.TypeString,
-											Required:         true,
-											ValidateDiagFunc: enum.Validate[types.ProcessorType](),
-										},
-									},
-								},
-							},
+		}
+		return attribute
+	}
+	secretsManagerConfigurationAttribute := func() schema.ListAttribute {
+		return optionalComputedNestedObjectAttribute[secretsManagerConfigurationModel](ctx, nil,
+			listplanmodifier.RequiresReplaceIf(requiresReplaceIfSecretsManagerEnabledChanged, "", ""),
+		)
+	}
+	vpcConfigBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[vpcConfigModel](ctx),
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrRoleARN: schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Required:   true,
+					},
+					names.AttrSecurityGroupIDs: schema.SetAttribute{
+						CustomType:  fwtypes.SetOfStringType,
+						ElementType: types.StringType,
+						Required:    true,
+					},
+					names.AttrSubnetIDs: schema.SetAttribute{
+						CustomType:  fwtypes.SetOfStringType,
+						ElementType: types.StringType,
+						Required:    true,
+					},
+					names.AttrVPCID: schema.StringAttribute{
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
 						},
 					},
-				}
-			}
-			requestConfigurationSchema := func() *schema.Schema {
-				return &schema.Schema{
-					Type:     schema.TypeList,
-					MaxItems: 1,
-					Optional: true,
-					Computed: true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"common_attributes": {
-								Type:     schema.TypeList,
-								Optional: true,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										names.AttrName: {
-											Type:     schema.TypeString,
-											Required: true,
-										},
-										names.AttrValue: {
-											Type:     schema.TypeString,
-											Required: true,
-										},
-									},
-								},
-							},
-							"content_encoding": {
-								Type:             schema.TypeString,
-								Optional:         true,
-								Default:          types.ContentEncodingNone,
-								ValidateDiagFunc: enum.Validate[types.ContentEncoding](),
+				},
+			},
+		}
+	}
+	required := []validator.List{
+		listvalidator.IsRequired(),
+	}
+
+	response.Schema = schema.Schema{
+		Version: 2,
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDestination: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[destinationType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"server_side_encryption": optionalComputedNestedObjectAttribute[serverSideEncryptionModel](ctx, map[string]attr.Value{
+				"key_type": fwtypes.StringEnumValue(awstypes.KeyTypeAwsOwnedCmk),
+			}),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"version_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"elasticsearch_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[elasticsearchConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"buffering_interval":         int64AttributeWithDefault(300, int64validator.Between(0, 900)),
+						"buffering_size":             int64AttributeWithDefault(5, int64validator.Between(1, 100)),
+						"cloudwatch_logging_options": cloudWatchLoggingOptionsAttribute(),
+						"cluster_endpoint": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("domain_arn")),
 							},
 						},
-					},
-				}
-			}
-			s3ConfigurationElem := func() *schema.Resource {
-				return &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"bucket_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
+						"domain_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
 						},
-						"buffering_interval": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  300,
+						"index_name": schema.StringAttribute{
+							Required: true,
 						},
-						"buffering_size": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      5,
-							ValidateFunc: validation.IntAtLeast(1),
+						"index_rotation_period": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ElasticsearchIndexRotationPeriod](),
+							Optional:   true,
+							Computed:   true,
+							Default:    stringdefault.StaticString(string(awstypes.ElasticsearchIndexRotationPeriodOneDay)),
 						},
-						"cloudwatch_logging_options": cloudWatchLoggingOptionsSchema(),
-						"compression_format": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							Default:          types.CompressionFormatUncompressed,
-							ValidateDiagFunc: enum.Validate[types.CompressionFormat](),
+						"processing_configuration": processingConfigurationAttribute(),
+						"retry_duration":           int64AttributeWithDefault(300, int64validator.Between(0, 7200)),
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
 						},
-						"error_output_prefix": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringLenBetween(0, 1024),
+						"s3_backup_mode": s3BackupModeAttribute(string(awstypes.ElasticsearchS3BackupModeFailedDocumentsOnly), true),
+						"type_name":      legacyStringAttribute(stringvalidator.LengthBetween(0, 100)),
+					},
+					Blocks: map[string]schema.Block{
+						"s3_configuration":  s3ConfigurationBlock(required...),
+						names.AttrVPCConfig: vpcConfigBlock(),
+					},
+				},
+			},
+			"extended_s3_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[extendedS3ConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"bucket_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
 						},
-						names.AttrKMSKeyARN: {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+						"buffering_interval":         int64AttributeWithDefault(300),
+						"buffering_size":             int64AttributeWithDefault(5, int64validator.AtLeast(1)),
+						"cloudwatch_logging_options": cloudWatchLoggingOptionsAttribute(),
+						"compression_format": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.CompressionFormat](),
+							Optional:   true,
+							Computed:   true,
+							Default:    stringdefault.StaticString(string(awstypes.CompressionFormatUncompressed)),
 						},
-						names.AttrPrefix: {
-							Type:     schema.TypeString,
+						"custom_time_zone": schema.StringAttribute{
 							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString("UTC"),
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(0, 50),
+							},
 						},
-						names.AttrRoleARN: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
+						"error_output_prefix": legacyStringAttribute(stringvalidator.LengthBetween(0, 1024)),
+						"file_extension": legacyStringAttribute(
+							stringvalidator.LengthBetween(0, 50),
+							stringvalidator.RegexMatches(regexache.MustCompile(`^$|\.[0-9a-z!\-_.*'()]+`), ""),
+						),
+						names.AttrKMSKeyARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
 						},
-					},
-				}
-			}
-			s3BackupConfigurationSchema := func() *schema.Schema {
-				return &schema.Schema{
-					Type:     schema.TypeList,
-					MaxItems: 1,
-					Optional: true,
-					Elem:     s3ConfigurationElem(),
-				}
-			}
-			s3ConfigurationSchema := func() *schema.Schema {
-				return &schema.Schema{
-					Type:     schema.TypeList,
-					MaxItems: 1,
-					Required: true,
-					Elem:     s3ConfigurationElem(),
-				}
-			}
-			secretsManagerConfigurationSchema := func() *schema.Schema {
-				return &schema.Schema{
-					Type:     schema.TypeList,
-					MaxItems: 1,
-					Optional: true,
-					Computed: true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							names.AttrEnabled: {
-								Type:     schema.TypeBool,
-								Optional: true,
-								Computed: true,
-								ForceNew: true,
-							},
-							"secret_arn": {
-								Type:         schema.TypeString,
-								Optional:     true,
-								ValidateFunc: verify.ValidARN,
-							},
-							names.AttrRoleARN: {
-								Type:         schema.TypeString,
-								Optional:     true,
-								ValidateFunc: verify.ValidARN,
-							},
+						names.AttrPrefix:           legacyStringAttribute(),
+						"processing_configuration": processingConfigurationAttribute(),
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
 						},
+						"s3_backup_mode": s3BackupModeAttribute(string(awstypes.S3BackupModeDisabled), false),
 					},
-				}
-			}
-
-			return map[string]*schema.Schema{
-				names.AttrARN: {
-					Type:     schema.TypeString,
-					Optional: true,
-					Computed: true,
-				},
-				names.AttrDestination: {
-					Type:     schema.TypeString,
-					Required: true,
-					ForceNew: true,
-					StateFunc: func(v any) string {
-						value := v.(string)
-						return strings.ToLower(value)
-					},
-					ValidateDiagFunc: enum.Validate[destinationType](),
-				},
-				"destination_id": {
-					Type:     schema.TypeString,
-					Optional: true,
-					Computed: true,
-				},
-				"elasticsearch_configuration": {
-					Type:     schema.TypeList,
-					Optional: true,
-					MaxItems: 1,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"buffering_interval": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      300,
-								ValidateFunc: validation.IntBetween(0, 900),
-							},
-							"buffering_size": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      5,
-								ValidateFunc: validation.IntBetween(1, 100),
-							},
-							"cloudwatch_logging_options": cloudWatchLoggingOptionsSchema(),
-							"cluster_endpoint": {
-								Type:          schema.TypeString,
-								Optional:      true,
-								ConflictsWith: []string{"elasticsearch_configuration.0.domain_arn"},
-							},
-							"domain_arn": {
-								Type:          schema.TypeString,
-								Optional:      true,
-								ValidateFunc:  verify.ValidARN,
-								ConflictsWith: []string{"elasticsearch_configuration.0.cluster_endpoint"},
-							},
-							"index_name": {
-								Type:     schema.TypeString,
-								Required: true,
-							},
-							"index_rotation_period": {
-								Type:             schema.TypeString,
-								Optional:         true,
-								Default:          types.ElasticsearchIndexRotationPeriodOneDay,
-								ValidateDiagFunc: enum.Validate[types.ElasticsearchIndexRotationPeriod](),
-							},
-							"processing_configuration": processingConfigurationSchema(),
-							"retry_duration": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      300,
-								ValidateFunc: validation.IntBetween(0, 7200),
-							},
-							names.AttrRoleARN: {
-								Type:         schema.TypeString,
-								Required:     true,
-								ValidateFunc: verify.ValidARN,
-							},
-							"s3_backup_mode": {
-								Type:             schema.TypeString,
-								ForceNew:         true,
-								Optional:         true,
-								Default:          types.ElasticsearchS3BackupModeFailedDocumentsOnly,
-								ValidateDiagFunc: enum.Validate[types.ElasticsearchS3BackupMode](),
-							},
-							"s3_configuration": s3ConfigurationSchema(),
-							"type_name": {
-								Type:         schema.TypeString,
-								Optional:     true,
-								ValidateFunc: validation.StringLenBetween(0, 100),
-							},
-							names.AttrVPCConfig: {
-								Type:     schema.TypeList,
-								Optional: true,
-								ForceNew: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										names.AttrRoleARN: {
-											Type:         schema.TypeString,
-											Required:     true,
-											ForceNew:     true,
-											ValidateFunc: verify.ValidARN,
-										},
-										names.AttrSecurityGroupIDs: {
-											Type:     schema.TypeSet,
-											Required: true,
-											ForceNew: true,
-											Elem:     &schema.Schema{Type: schema.TypeString},
-										},
-										names.AttrSubnetIDs: {
-											Type:     schema.TypeSet,
-											Required: true,
-											ForceNew: true,
-											Elem:     &schema.Schema{Type: schema.TypeString},
-										},
-										names.AttrVPCID: {
-											Type:     schema.TypeString,
-											Computed: true,
-										},
+					Blocks: map[string]schema.Block{
+						"data_format_conversion_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataFormatConversionConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrEnabled: schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										Default:  booldefault.StaticBool(true),
 									},
 								},
-							},
-						},
-					},
-				},
-				"extended_s3_configuration": {
-					Type:     schema.TypeList,
-					Optional: true,
-					MaxItems: 1,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"bucket_arn": {
-								Type:         schema.TypeString,
-								Required:     true,
-								ValidateFunc: verify.ValidARN,
-							},
-							"buffering_interval": {
-								Type:     schema.TypeInt,
-								Optional: true,
-								Default:  300,
-							},
-							"buffering_size": {
-								Type:     schema.TypeInt,
-								Optional: true,
-								Default:  5,
-							},
-							"cloudwatch_logging_options": cloudWatchLoggingOptionsSchema(),
-							"compression_format": {
-								Type:             schema.TypeString,
-								Optional:         true,
-								Default:          types.CompressionFormatUncompressed,
-								ValidateDiagFunc: enum.Validate[types.CompressionFormat](),
-							},
-							"custom_time_zone": {
-								Type:         schema.TypeString,
-								Optional:     true,
-								Default:      "UTC",
-								ValidateFunc: validation.StringLenBetween(0, 50),
-							},
-							"data_format_conversion_configuration": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										names.AttrEnabled: {
-											Type:     schema.TypeBool,
-											Optional: true,
-											Default:  true,
+								Blocks: map[string]schema.Block{
+									"input_format_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[inputFormatConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
 										},
-										"input_format_configuration": {
-											Type:     schema.TypeList,
-											Required: true,
-											MaxItems: 1,
-											Elem: &schema.Resource{
-												Schema: map[string]*schema.Schema{
-													"deserializer": {
-														Type:     schema.TypeList,
-														Required: true,
-														MaxItems: 1,
-														Elem: &schema.Resource{
-															Schema: map[string]*schema.Schema{
-																"hive_json_ser_de": {
-																	Type:          schema.TypeList,
-																	Optional:      true,
-																	MaxItems:      1,
-																	ConflictsWith: []string{"extended_s3_configuration.0.data_format_conversion_configuration.0.input_format_configuration.0.deserializer.0.open_x_json_ser_de"},
-																	Elem: &schema.Resource{
-																		Schema: map[string]*schema.Schema{
-																			"timestamp_formats": {
-																				Type:     schema.TypeList,
-																				Optional: true,
-																				Elem:     &schema.Schema{Type: schema.TypeString},
-																			},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"deserializer": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[deserializerModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Blocks: map[string]schema.Block{
+															"hive_json_ser_de": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[hiveJSONSerDeModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(1),
+																	listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("open_x_json_ser_de")),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		"timestamp_formats": schema.ListAttribute{
+																			CustomType:  fwtypes.ListOfStringType,
+																			ElementType: types.StringType,
+																			Optional:    true,
 																		},
 																	},
 																},
-																"open_x_json_ser_de": {
-																	Type:          schema.TypeList,
-																	Optional:      true,
-																	MaxItems:      1,
-																	ConflictsWith: []string{"extended_s3_configuration.0.data_format_conversion_configuration.0.input_format_configuration.0.deserializer.0.hive_json_ser_de"},
-																	Elem: &schema.Resource{
-																		Schema: map[string]*schema.Schema{
-																			"case_insensitive": {
-																				Type:     schema.TypeBool,
-																				Optional: true,
-																				Default:  true,
-																			},
-																			"column_to_json_key_mappings": {
-																				Type:     schema.TypeMap,
-																				Optional: true,
-																				Elem:     &schema.Schema{Type: schema.TypeString},
-																			},
-																			"convert_dots_in_json_keys_to_underscores": {
-																				Type:     schema.TypeBool,
-																				Optional: true,
-																				Default:  false,
-																			},
+															},
+															"open_x_json_ser_de": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[openXJSONSerDeModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(1),
+																	listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("hive_json_ser_de")),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		"case_insensitive": schema.BoolAttribute{
+																			Optional: true,
+																			Computed: true,
+																			Default:  booldefault.StaticBool(true),
+																		},
+																		"column_to_json_key_mappings": schema.MapAttribute{
+																			CustomType:  fwtypes.MapOfStringType,
+																			ElementType: types.StringType,
+																			Optional:    true,
+																		},
+																		"convert_dots_in_json_keys_to_underscores": schema.BoolAttribute{
+																			Optional: true,
+																			Computed: true,
+																			Default:  booldefault.StaticBool(false),
 																		},
 																	},
 																},
@@ -576,132 +426,101 @@ func resourceDeliveryStream() *schema.Resource {
 												},
 											},
 										},
-										"output_format_configuration": {
-											Type:     schema.TypeList,
-											Required: true,
-											MaxItems: 1,
-											Elem: &schema.Resource{
-												Schema: map[string]*schema.Schema{
-													"serializer": {
-														Type:     schema.TypeList,
-														Required: true,
-														MaxItems: 1,
-														Elem: &schema.Resource{
-															Schema: map[string]*schema.Schema{
-																"orc_ser_de": {
-																	Type:          schema.TypeList,
-																	Optional:      true,
-																	MaxItems:      1,
-																	ConflictsWith: []string{"extended_s3_configuration.0.data_format_conversion_configuration.0.output_format_configuration.0.serializer.0.parquet_ser_de"},
-																	Elem: &schema.Resource{
-																		Schema: map[string]*schema.Schema{
-																			"block_size_bytes": {
-																				Type:     schema.TypeInt,
-																				Optional: true,
-																				// 256 MiB
-																				Default: 268435456,
-																				// 64 MiB
-																				ValidateFunc: validation.IntAtLeast(67108864),
-																			},
-																			"bloom_filter_columns": {
-																				Type:     schema.TypeList,
-																				Optional: true,
-																				Elem:     &schema.Schema{Type: schema.TypeString},
-																			},
-																			"bloom_filter_false_positive_probability": {
-																				Type:     schema.TypeFloat,
-																				Optional: true,
-																				Default:  0.05,
-																			},
-																			"compression": {
-																				Type:             schema.TypeString,
-																				Optional:         true,
-																				Default:          types.OrcCompressionSnappy,
-																				ValidateDiagFunc: enum.Validate[types.OrcCompression](),
-																			},
-																			"dictionary_key_threshold": {
-																				Type:     schema.TypeFloat,
-																				Optional: true,
-																				Default:  0.0,
-																			},
-																			"enable_padding": {
-																				Type:     schema.TypeBool,
-																				Optional: true,
-																				Default:  false,
-																			},
-																			"format_version": {
-																				Type:             schema.TypeString,
-																				Optional:         true,
-																				Default:          types.OrcFormatVersionV012,
-																				ValidateDiagFunc: enum.Validate[types.OrcFormatVersion](),
-																			},
-																			"padding_tolerance": {
-																				Type:     schema.TypeFloat,
-																				Optional: true,
-																				Default:  0.05,
-																			},
-																			"row_index_stride": {
-																				Type:         schema.TypeInt,
-																				Optional:     true,
-																				Default:      10000,
-																				ValidateFunc: validation.IntAtLeast(1000),
-																			},
-																			"stripe_size_bytes": {
-																				Type:     schema.TypeInt,
-																				Optional: true,
-																				// 64 MiB
-																				Default: 67108864,
-																				// 8 MiB
-																				ValidateFunc: validation.IntAtLeast(8388608),
-																			},
+									},
+									"output_format_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[outputFormatConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"serializer": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[serializerModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Blocks: map[string]schema.Block{
+															"orc_ser_de": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[orcSerDeModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(1),
+																	listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("parquet_ser_de")),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		"block_size_bytes": int64AttributeWithDefault(268435456, int64validator.AtLeast(67108864)),
+																		"bloom_filter_columns": schema.ListAttribute{
+																			CustomType:  fwtypes.ListOfStringType,
+																			ElementType: types.StringType,
+																			Optional:    true,
+																		},
+																		"bloom_filter_false_positive_probability": schema.Float64Attribute{
+																			Optional: true,
+																			Computed: true,
+																			Default:  float64default.StaticFloat64(0.05),
+																		},
+																		"compression": schema.StringAttribute{
+																			CustomType: fwtypes.StringEnumType[awstypes.OrcCompression](),
+																			Optional:   true,
+																			Computed:   true,
+																			Default:    stringdefault.StaticString(string(awstypes.OrcCompressionSnappy)),
+																		},
+																		"dictionary_key_threshold": schema.Float64Attribute{
+																			Optional: true,
+																			Computed: true,
+																			Default:  float64default.StaticFloat64(0.0),
+																		},
+																		"enable_padding": schema.BoolAttribute{
+																			Optional: true,
+																			Computed: true,
+																			Default:  booldefault.StaticBool(false),
+																		},
+																		"format_version": schema.StringAttribute{
+																			CustomType: fwtypes.StringEnumType[awstypes.OrcFormatVersion](),
+																			Optional:   true,
+																			Computed:   true,
+																			Default:    stringdefault.StaticString(string(awstypes.OrcFormatVersionV012)),
 																		},
+																		"padding_tolerance": schema.Float64Attribute{
+																			Optional: true,
+																			Computed: true,
+																			Default:  float64default.StaticFloat64(0.05),
+																		},
+																		"row_index_stride":  int64AttributeWithDefault(10000, int64validator.AtLeast(1000)),
+																		"stripe_size_bytes": int64AttributeWithDefault(67108864, int64validator.AtLeast(8388608)),
 																	},
 																},
-																"parquet_ser_de": {
-																	Type:          schema.TypeList,
-																	Optional:      true,
-																	MaxItems:      1,
-																	ConflictsWith: []string{"extended_s3_configuration.0.data_format_conversion_configuration.0.output_format_configuration.0.serializer.0.orc_ser_de"},
-																	Elem: &schema.Resource{
-																		Schema: map[string]*schema.Schema{
-																			"block_size_bytes": {
-																				Type:     schema.TypeInt,
-																				Optional: true,
-																				// 256 MiB
-																				Default: 268435456,
-																				// 64 MiB
-																				ValidateFunc: validation.IntAtLeast(67108864),
-																			},
-																			"compression": {
-																				Type:             schema.TypeString,
-																				Optional:         true,
-																				Default:          types.ParquetCompressionSnappy,
-																				ValidateDiagFunc: enum.Validate[types.ParquetCompression](),
-																			},
-																			"enable_dictionary_compression": {
-																				Type:     schema.TypeBool,
-																				Optional: true,
-																				Default:  false,
-																			},
-																			"max_padding_bytes": {
-																				Type:     schema.TypeInt,
-																				Optional: true,
-																				Default:  0,
-																			},
-																			"page_size_bytes": {
-																				Type:     schema.TypeInt,
-																				Optional: true,
-																				// 1 MiB
-																				Default: 1048576,
-																				// 64 KiB
-																				ValidateFunc: validation.IntAtLeast(65536),
-																			},
-																			"writer_version": {
-																				Type:             schema.TypeString,
-																				Optional:         true,
-																				Default:          types.ParquetWriterVersionV1,
-																				ValidateDiagFunc: enum.Validate[types.ParquetWriterVersion](),
-																			},
+															},
+															"parquet_ser_de": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[parquetSerDeModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(1),
+																	listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("orc_ser_de")),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		"block_size_bytes": int64AttributeWithDefault(268435456, int64validator.AtLeast(67108864)),
+																		"compression": schema.StringAttribute{
+																			CustomType: fwtypes.StringEnumType[awstypes.ParquetCompression](),
+																			Optional:   true,
+																			Computed:   true,
+																			Default:    stringdefault.StaticString(string(awstypes.ParquetCompressionSnappy)),
+																		},
+																		"enable_dictionary_compression": schema.BoolAttribute{
+																			Optional: true,
+																			Computed: true,
+																			Default:  booldefault.StaticBool(false),
+																		},
+																		"max_padding_bytes": int64AttributeWithDefault(0, int64validator.AtLeast(0)),
+																		"page_size_bytes":   int64AttributeWithDefault(1048576, int64validator.AtLeast(65536)),
+																		"writer_version": schema.StringAttribute{
+																			CustomType: fwtypes.StringEnumType[awstypes.ParquetWriterVersion](),
+																			Optional:   true,
+																			Computed:   true,
+																			Default:    stringdefault.StaticString(string(awstypes.ParquetWriterVersionV1)),
 																		},
 																	},
 																},
@@ -711,3684 +530,1340 @@ func resourceDeliveryStream() *schema.Resource {
 												},
 											},
 										},
-										"schema_configuration": {
-											Type:     schema.TypeList,
-											Required: true,
-											MaxItems: 1,
-											Elem: &schema.Resource{
-												Schema: map[string]*schema.Schema{
-													names.AttrCatalogID: {
-														Type:     schema.TypeString,
-														Optional: true,
-														Computed: true,
-													},
-													names.AttrDatabaseName: {
-														Type:     schema.TypeString,
-														Required: true,
-													},
-													names.AttrRegion: {
-														Type:     schema.TypeString,
-														Optional: true,
-														Computed: true,
-													},
-													names.AttrRoleARN: {
-														Type:         schema.TypeString,
-														Required:     true,
-														ValidateFunc: verify.ValidARN,
-													},
-													names.AttrTableName: {
-														Type:     schema.TypeString,
-														Required: true,
-													},
-													"version_id": {
-														Type:     schema.TypeString,
-														Optional: true,
-														Default:  "LATEST",
-													},
+									},
+									"schema_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[schemaConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrCatalogID: schema.StringAttribute{
+													Optional: true,
+													Computed: true,
+												},
+												names.AttrDatabaseName: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrRegion: schema.StringAttribute{
+													Optional: true,
+													Computed: true,
+												},
+												names.AttrRoleARN: schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Required:   true,
+												},
+												names.AttrTableName: schema.StringAttribute{
+													Required: true,
+												},
+												"version_id": schema.StringAttribute{
+													Optional: true,
+													Computed: true,
+													Default:  stringdefault.StaticString("LATEST"),
 												},
 											},
 										},
 									},
 								},
 							},
-							"dynamic_partitioning_configuration": dynamicPartitioningConfigurationSchema(),
-							"error_output_prefix": {
-								Type:         schema.TypeString,
-								Optional:     true,
-								ValidateFunc: validation.StringLenBetween(0, 1024),
-							},
-							"file_extension": {
-								Type:     schema.TypeString,
-								Optional: true,
-								ValidateFunc: validation.All(
-									validation.StringLenBetween(0, 50),
-									validation.StringMatch(regexache.MustCompile(`^$|\.[0-9a-z!\-_.*'()]+`), ""),
-								),
-							},
-							names.AttrKMSKeyARN: {
-								Type:         schema.TypeString,
-								Optional:     true,
-								ValidateFunc: verify.ValidARN,
-							},
-							names.AttrPrefix: {
-								Type:     schema.TypeString,
-								Optional: true,
-							},
-							"processing_configuration": processingConfigurationSchema(),
-							names.AttrRoleARN: {
-								Type:         schema.TypeString,
-								Required:     true,
-								ValidateFunc: verify.ValidARN,
-							},
-							"s3_backup_configuration": s3BackupConfigurationSchema(),
-							"s3_backup_mode": {
-								Type:             schema.TypeString,
-								Optional:         true,
-								Default:          types.S3BackupModeDisabled,
-								ValidateDiagFunc: enum.Validate[types.S3BackupMode](),
+						},
+						"dynamic_partitioning_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dynamicPartitioningConfigurationModel](ctx),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrEnabled: schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										Default:  booldefault.StaticBool(false),
+									},
+									"retry_duration": int64AttributeWithDefault(300, int64validator.Between(0, 7200)),
+								},
 							},
 						},
+						"s3_backup_configuration": s3ConfigurationBlock(),
 					},
 				},
-				"http_endpoint_configuration": {
-					Type:     schema.TypeList,
-					Optional: true,
-					MaxItems: 1,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							names.AttrAccessKey: {
-								Type:         schema.TypeString,
-								Optional:     true,
-								ValidateFunc: validation.StringLenBetween(0, 4096),
-								Sensitive:    true,
-							},
-							"buffering_interval": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      300,
-								ValidateFunc: validation.IntBetween(0, 900),
-							},
-							"buffering_size": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      5,
-								ValidateFunc: validation.IntBetween(1, 100),
-							},
-							"cloudwatch_logging_options": cloudWatchLoggingOptionsSchema(),
-							names.AttrName: {
-								Type:     schema.TypeString,
-								Optional: true,
-								ValidateFunc: validation.All(
-									validation.StringLenBetween(1, 256),
-								),
-							},
-							"processing_configuration": processingConfigurationSchema(),
-							"request_configuration":    requestConfigurationSchema(),
-							"retry_duration": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      300,
-								ValidateFunc: validation.IntBetween(0, 7200),
-							},
-							names.AttrRoleARN: {
-								Type:         schema.TypeString,
-								Optional:     true,
-								ValidateFunc: verify.ValidARN,
-							},
-							"s3_backup_mode": {
-								Type:             schema.TypeString,
-								Optional:         true,
-								Default:          types.HttpEndpointS3BackupModeFailedDataOnly,
-								ValidateDiagFunc: enum.Validate[types.HttpEndpointS3BackupMode](),
+			},
+			"http_endpoint_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[httpEndpointConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrAccessKey: schema.StringAttribute{
+							Optional:  true,
+							Sensitive: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(0, 4096),
 							},
-							"s3_configuration":              s3ConfigurationSchema(),
-							"secrets_manager_configuration": secretsManagerConfigurationSchema(),
-							names.AttrURL: {
-								Type:     schema.TypeString,
-								Required: true,
-								ValidateFunc: validation.All(
-									validation.StringLenBetween(1, 1000),
-									validation.StringMatch(regexache.MustCompile(`^https://.*$`), ""),
-								),
+						},
+						"buffering_interval":         int64AttributeWithDefault(300, int64validator.Between(0, 900)),
+						"buffering_size":             int64AttributeWithDefault(5, int64validator.Between(1, 100)),
+						"cloudwatch_logging_options": cloudWatchLoggingOptionsAttribute(),
+						names.AttrName:               legacyStringAttribute(stringvalidator.LengthBetween(1, 256)),
+						"processing_configuration":   processingConfigurationAttribute(),
+						"request_configuration": optionalComputedNestedObjectAttribute[httpEndpointRequestConfigurationModel](ctx, map[string]attr.Value{
+							"content_encoding": fwtypes.StringEnumValue(awstypes.ContentEncodingNone),
+						}),
+						"retry_duration":                int64AttributeWithDefault(300, int64validator.Between(0, 7200)),
+						names.AttrRoleARN:               legacyStringAttribute(fwvalidators.ARN()),
+						"s3_backup_mode":                s3BackupModeAttribute(string(awstypes.HttpEndpointS3BackupModeFailedDataOnly), false),
+						"secrets_manager_configuration": secretsManagerConfigurationAttribute(),
+						names.AttrURL: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 1000),
+								stringvalidator.RegexMatches(regexache.MustCompile(`^https://.*$`), ""),
 							},
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"s3_configuration": s3ConfigurationBlock(required...),
+					},
 				},
-				"iceberg_configuration": {
-					Type:     schema.TypeList,
-					Optional: true,
-					MaxItems: 1,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"append_only": {
-								Type:     schema.TypeBool,
-								Optional: true,
-								Computed: true,
-								ForceNew: true,
-							},
-							"buffering_interval": {
-								Type:     schema.TypeInt,
-								Optional: true,
-								Default:  300,
-							},
-							"buffering_size": {
-								Type:     schema.TypeInt,
-								Optional: true,
-								Default:  5,
-							},
-							"catalog_arn": {
-								Type:         schema.TypeString,
-								Required:     true,
-								ForceNew:     true,
-								ValidateFunc: verify.ValidARN,
-							},
-							"cloudwatch_logging_options":      cloudWatchLoggingOptionsSchema(),
-							"destination_table_configuration": destinationTableConfigurationSchema(),
-							"processing_configuration":        processingConfigurationSchema(),
-							"retry_duration": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      300,
-								ValidateFunc: validation.IntBetween(0, 7200),
-							},
-							names.AttrRoleARN: {
-								Type:         schema.TypeString,
-								Required:     true,
-								ValidateFunc: verify.ValidARN,
-							},
-							"s3_backup_mode": {
-								Type:             schema.TypeString,
-								Optional:         true,
-								Default:          types.IcebergS3BackupModeFailedDataOnly,
-								ValidateDiagFunc: enum.Validate[types.IcebergS3BackupMode](),
+			},
+			"iceberg_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[icebergConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"append_only": schema.BoolAttribute{
+							Optional: true,
+							Computed: true, // Because of Legacy value handling.
+							PlanModifiers: []planmodifier.Bool{
+								tfboolplanmodifier.LegacyValue(),
+								boolplanmodifier.RequiresReplace(),
 							},
-							"s3_configuration": s3ConfigurationSchema(),
 						},
-					},
-				},
-				"kinesis_source_configuration": {
-					Type:          schema.TypeList,
-					ForceNew:      true,
-					Optional:      true,
-					MaxItems:      1,
-					ConflictsWith: []string{"msk_source_configuration", "server_side_encryption"},
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"kinesis_stream_arn": {
-								Type:         schema.TypeString,
-								Required:     true,
-								ForceNew:     true,
-								ValidateFunc: verify.ValidARN,
+						"buffering_interval": int64AttributeWithDefault(300),
+						"buffering_size":     int64AttributeWithDefault(5),
+						"catalog_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
 							},
-							names.AttrRoleARN: {
-								Type:         schema.TypeString,
-								Required:     true,
-								ForceNew:     true,
-								ValidateFunc: verify.ValidARN,
+						},
+						"cloudwatch_logging_options": cloudWatchLoggingOptionsAttribute(),
+						"processing_configuration":   processingConfigurationAttribute(),
+						"retry_duration":             int64AttributeWithDefault(300, int64validator.Between(0, 7200)),
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"s3_backup_mode": s3BackupModeAttribute(string(awstypes.IcebergS3BackupModeFailedDataOnly), false),
+						"warehouse_location": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 2048),
 							},
 						},
 					},
-				},
-				"msk_source_configuration": {
-					Type:          schema.TypeList,
-					ForceNew:      true,
-					Optional:      true,
-					MaxItems:      1,
-					ConflictsWith: []string{"kinesis_source_configuration", "server_side_encryption"},
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"authentication_configuration": {
-								Type:     schema.TypeList,
-								ForceNew: true,
-								Required: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"connectivity": {
-											Type:             schema.TypeString,
-											Required:         true,
-											ForceNew:         true,
-											ValidateDiagFunc: enum.Validate[types.Connectivity](),
+					Blocks: map[string]schema.Block{
+						"destination_table_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[destinationTableConfigurationModel](ctx),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrDatabaseName: schema.StringAttribute{
+										Required: true,
+									},
+									"s3_error_output_prefix": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(0, 1024),
+										},
+									},
+									names.AttrTableName: schema.StringAttribute{
+										Required: true,
+									},
+									"unique_keys": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"partition_spec": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[partitionSpecModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
 										},
-										names.AttrRoleARN: {
-											Type:         schema.TypeString,
-											Required:     true,
-											ForceNew:     true,
-											ValidateFunc: verify.ValidARN,
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"identity": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[partitionFieldModel](ctx),
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"source_name": schema.StringAttribute{
+																Required: true,
+																Validators: []validator.String{
+																	stringvalidator.LengthBetween(1, 1024),
+																},
+															},
+														},
+													},
+												},
+											},
 										},
 									},
 								},
 							},
-							"msk_cluster_arn": {
-								Type:         schema.TypeString,
-								Required:     true,
-								ForceNew:     true,
-								ValidateFunc: verify.ValidARN,
-							},
-							"read_from_timestamp": {
-								Type:         schema.TypeString,
-								Optional:     true,
-								ForceNew:     true,
-								ValidateFunc: validation.IsRFC3339Time,
+						},
+						"s3_configuration": s3ConfigurationBlock(required...),
+						"schema_evolution_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[schemaEvolutionConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrEnabled: schema.BoolAttribute{
+										Required: true,
+									},
+								},
 							},
-							"topic_name": {
-								Type:     schema.TypeString,
-								Required: true,
-								ForceNew: true,
+						},
+						"table_creation_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[tableCreationConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrEnabled: schema.BoolAttribute{
+										Required: true,
+									},
+								},
 							},
 						},
 					},
 				},
-				names.AttrName: {
-					Type:         schema.TypeString,
-					Required:     true,
-					ForceNew:     true,
-					ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"kinesis_source_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[kinesisSourceConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
 				},
-				"opensearch_configuration": {
-					Type:     schema.TypeList,
-					Optional: true,
-					MaxItems: 1,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"buffering_interval": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      300,
-								ValidateFunc: validation.IntBetween(0, 900),
-							},
-							"buffering_size": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      5,
-								ValidateFunc: validation.IntBetween(1, 100),
-							},
-							"cloudwatch_logging_options": cloudWatchLoggingOptionsSchema(),
-							"cluster_endpoint": {
-								Type:          schema.TypeString,
-								Optional:      true,
-								ConflictsWith: []string{"opensearch_configuration.0.domain_arn"},
-							},
-							"document_id_options": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"default_document_id_format": {
-											Type:             schema.TypeString,
-											Required:         true,
-											ValidateDiagFunc: enum.Validate[types.DefaultDocumentIdFormat](),
-										},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.ConflictsWith(
+						path.MatchRoot("msk_source_configuration"),
+						path.MatchRoot("server_side_encryption"),
+					),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kinesis_stream_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			"msk_source_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[mskSourceConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.ConflictsWith(
+						path.MatchRoot("kinesis_source_configuration"),
+						path.MatchRoot("server_side_encryption"),
+					),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"msk_cluster_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"read_from_timestamp": schema.StringAttribute{
+							CustomType: timetypes.RFC3339Type{},
+							Optional:   true,
+						},
+						"topic_name": schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"authentication_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[authenticationConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"connectivity": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Connectivity](),
+										Required:   true,
 									},
-								},
-							},
-							"domain_arn": {
-								Type:          schema.TypeString,
-								Optional:      true,
-								ValidateFunc:  verify.ValidARN,
-								ConflictsWith: []string{"opensearch_configuration.0.cluster_endpoint"},
-							},
-							"index_name": {
-								Type:     schema.TypeString,
-								Required: true,
-							},
-							"index_rotation_period": {
-								Type:             schema.TypeString,
-								Optional:         true,
-								Default:          types.AmazonopensearchserviceIndexRotationPeriodOneDay,
-								ValidateDiagFunc: enum.Validate[types.AmazonopensearchserviceIndexRotationPeriod](),
-							},
-							"processing_configuration": processingConfigurationSchema(),
-							"retry_duration": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      300,
-								ValidateFunc: validation.IntBetween(0, 7200),
-							},
-							names.AttrRoleARN: {
-								Type:         schema.TypeString,
-								Required:     true,
-								ValidateFunc: verify.ValidARN,
-							},
-							"s3_backup_mode": {
-								Type:             schema.TypeString,
-								ForceNew:         true,
-								Optional:         true,
-								Default:          types.AmazonopensearchserviceS3BackupModeFailedDocumentsOnly,
-								ValidateDiagFunc: enum.Validate[types.AmazonopensearchserviceS3BackupMode](),
-							},
-							"s3_configuration": s3ConfigurationSchema(),
-							"type_name": {
-								Type:         schema.TypeString,
-								Optional:     true,
-								ValidateFunc: validation.StringLenBetween(0, 100),
-							},
-							names.AttrVPCConfig: {
-								Type:     schema.TypeList,
-								Optional: true,
-								ForceNew: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										names.AttrRoleARN: {
-											Type:         schema.TypeString,
-											Required:     true,
-											ForceNew:     true,
-											ValidateFunc: verify.ValidARN,
-										},
-										names.AttrSecurityGroupIDs: {
-											Type:     schema.TypeSet,
-											Required: true,
-											ForceNew: true,
-											Elem:     &schema.Schema{Type: schema.TypeString},
-										},
-										names.AttrSubnetIDs: {
-											Type:     schema.TypeSet,
-											Required: true,
-											ForceNew: true,
-											Elem:     &schema.Schema{Type: schema.TypeString},
-										},
-										names.AttrVPCID: {
-											Type:     schema.TypeString,
-											Computed: true,
-										},
+									names.AttrRoleARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
 									},
 								},
 							},
 						},
 					},
 				},
-				"opensearchserverless_configuration": {
-					Type:     schema.TypeList,
-					Optional: true,
-					MaxItems: 1,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"buffering_interval": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      300,
-								ValidateFunc: validation.IntBetween(0, 900),
-							},
-							"buffering_size": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      5,
-								ValidateFunc: validation.IntBetween(1, 100),
-							},
-							"cloudwatch_logging_options": cloudWatchLoggingOptionsSchema(),
-							"collection_endpoint": {
-								Type:     schema.TypeString,
-								Required: true,
-							},
-							"index_name": {
-								Type:     schema.TypeString,
-								Required: true,
-							},
-							"processing_configuration": processingConfigurationSchema(),
-							"retry_duration": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      300,
-								ValidateFunc: validation.IntBetween(0, 7200),
-							},
-							names.AttrRoleARN: {
-								Type:         schema.TypeString,
-								Required:     true,
-								ValidateFunc: verify.ValidARN,
-							},
-							"s3_backup_mode": {
-								Type:             schema.TypeString,
-								ForceNew:         true,
-								Optional:         true,
-								Default:          types.AmazonOpenSearchServerlessS3BackupModeFailedDocumentsOnly,
-								ValidateDiagFunc: enum.Validate[types.AmazonOpenSearchServerlessS3BackupMode](),
+			},
+			"opensearch_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[openSearchConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"buffering_interval":         int64AttributeWithDefault(300, int64validator.Between(0, 900)),
+						"buffering_size":             int64AttributeWithDefault(5, int64validator.Between(1, 100)),
+						"cloudwatch_logging_options": cloudWatchLoggingOptionsAttribute(),
+						"cluster_endpoint": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("domain_arn")),
 							},
-							"s3_configuration": s3ConfigurationSchema(),
-							names.AttrVPCConfig: {
-								Type:     schema.TypeList,
-								Optional: true,
-								ForceNew: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										names.AttrRoleARN: {
-											Type:         schema.TypeString,
-											Required:     true,
-											ForceNew:     true,
-											ValidateFunc: verify.ValidARN,
-										},
-										names.AttrSecurityGroupIDs: {
-											Type:     schema.TypeSet,
-											Required: true,
-											ForceNew: true,
-											Elem:     &schema.Schema{Type: schema.TypeString},
-										},
-										names.AttrSubnetIDs: {
-											Type:     schema.TypeSet,
-											Required: true,
-											ForceNew: true,
-											Elem:     &schema.Schema{Type: schema.TypeString},
-										},
-										names.AttrVPCID: {
-											Type:     schema.TypeString,
-											Computed: true,
-										},
+						},
+						"domain_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+						"index_name": schema.StringAttribute{
+							Required: true,
+						},
+						"index_rotation_period": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.AmazonopensearchserviceIndexRotationPeriod](),
+							Optional:   true,
+							Computed:   true,
+							Default:    stringdefault.StaticString(string(awstypes.AmazonopensearchserviceIndexRotationPeriodOneDay)),
+						},
+						"processing_configuration": processingConfigurationAttribute(),
+						"retry_duration":           int64AttributeWithDefault(300, int64validator.Between(0, 7200)),
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"s3_backup_mode": s3BackupModeAttribute(string(awstypes.AmazonopensearchserviceS3BackupModeFailedDocumentsOnly), true),
+						"type_name":      legacyStringAttribute(stringvalidator.LengthBetween(0, 100)),
+					},
+					Blocks: map[string]schema.Block{
+						"document_id_options": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[documentIDOptionsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"default_document_id_format": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.DefaultDocumentIdFormat](),
+										Required:   true,
 									},
 								},
 							},
 						},
+						"s3_configuration":  s3ConfigurationBlock(required...),
+						names.AttrVPCConfig: vpcConfigBlock(),
 					},
 				},
-				"redshift_configuration": {
-					Type:     schema.TypeList,
-					Optional: true,
-					MaxItems: 1,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"cloudwatch_logging_options": cloudWatchLoggingOptionsSchema(),
-							"cluster_jdbcurl": {
-								Type:     schema.TypeString,
-								Required: true,
-							},
-							"copy_options": {
-								Type:     schema.TypeString,
-								Optional: true,
-							},
-							"data_table_columns": {
-								Type:     schema.TypeString,
-								Optional: true,
-							},
-							"data_table_name": {
-								Type:     schema.TypeString,
-								Required: true,
-							},
-							names.AttrPassword: {
-								Type:      schema.TypeString,
-								Optional:  true,
-								Sensitive: true,
-							},
-							"processing_configuration": processingConfigurationSchema(),
-							"retry_duration": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      3600,
-								ValidateFunc: validation.IntBetween(0, 7200),
-							},
-							names.AttrRoleARN: {
-								Type:         schema.TypeString,
-								Required:     true,
-								ValidateFunc: verify.ValidARN,
-							},
-							"s3_backup_configuration": s3BackupConfigurationSchema(),
-							"s3_backup_mode": {
-								Type:             schema.TypeString,
-								Optional:         true,
-								Default:          types.RedshiftS3BackupModeDisabled,
-								ValidateDiagFunc: enum.Validate[types.RedshiftS3BackupMode](),
-							},
-							"s3_configuration":              s3ConfigurationSchema(),
-							"secrets_manager_configuration": secretsManagerConfigurationSchema(),
-							names.AttrUsername: {
-								Type:     schema.TypeString,
-								Optional: true,
-							},
+			},
+			"opensearchserverless_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[openSearchServerlessConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"buffering_interval":         int64AttributeWithDefault(300, int64validator.Between(0, 900)),
+						"buffering_size":             int64AttributeWithDefault(5, int64validator.Between(1, 100)),
+						"cloudwatch_logging_options": cloudWatchLoggingOptionsAttribute(),
+						"collection_endpoint": schema.StringAttribute{
+							Required: true,
+						},
+						"index_name": schema.StringAttribute{
+							Required: true,
+						},
+						"processing_configuration": processingConfigurationAttribute(),
+						"retry_duration":           int64AttributeWithDefault(300, int64validator.Between(0, 7200)),
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
 						},
+						"s3_backup_mode": s3BackupModeAttribute(string(awstypes.AmazonOpenSearchServerlessS3BackupModeFailedDocumentsOnly), true),
+					},
+					Blocks: map[string]schema.Block{
+						"s3_configuration":  s3ConfigurationBlock(required...),
+						names.AttrVPCConfig: vpcConfigBlock(),
 					},
 				},
-				"server_side_encryption": {
-					Type:             schema.TypeList,
-					Optional:         true,
-					MaxItems:         1,
-					DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
-					ConflictsWith:    []string{"kinesis_source_configuration", "msk_source_configuration"},
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							names.AttrEnabled: {
-								Type:     schema.TypeBool,
-								Optional: true,
-								Default:  false,
-							},
-							"key_arn": {
-								Type:         schema.TypeString,
-								Optional:     true,
-								ValidateFunc: verify.ValidARN,
-								RequiredWith: []string{"server_side_encryption.0.enabled", "server_side_encryption.0.key_type"},
-							},
-							"key_type": {
-								Type:             schema.TypeString,
-								Optional:         true,
-								Default:          types.KeyTypeAwsOwnedCmk,
-								ValidateDiagFunc: enum.Validate[types.KeyType](),
-								RequiredWith:     []string{"server_side_encryption.0.enabled"},
-							},
+			},
+			"redshift_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[redshiftConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cloudwatch_logging_options": cloudWatchLoggingOptionsAttribute(),
+						"cluster_jdbcurl": schema.StringAttribute{
+							Required: true,
+						},
+						"copy_options":       legacyStringAttribute(),
+						"data_table_columns": legacyStringAttribute(),
+						"data_table_name": schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrPassword: schema.StringAttribute{
+							Optional:  true,
+							Sensitive: true,
 						},
+						"processing_configuration": processingConfigurationAttribute(),
+						"retry_duration":           int64AttributeWithDefault(3600, int64validator.Between(0, 7200)),
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"s3_backup_mode":                s3BackupModeAttribute(string(awstypes.RedshiftS3BackupModeDisabled), false),
+						"secrets_manager_configuration": secretsManagerConfigurationAttribute(),
+						names.AttrUsername:              legacyStringAttribute(),
+					},
+					Blocks: map[string]schema.Block{
+						"s3_backup_configuration": s3ConfigurationBlock(),
+						"s3_configuration":        s3ConfigurationBlock(required...),
 					},
 				},
-				"snowflake_configuration": {
-					Type:     schema.TypeList,
-					Optional: true,
-					MaxItems: 1,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"account_url": {
-								Type:     schema.TypeString,
-								Required: true,
-							},
-							"buffering_interval": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      0,
-								ValidateFunc: validation.IntBetween(0, 900),
-							},
-							"buffering_size": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      1,
-								ValidateFunc: validation.IntBetween(1, 128),
-							},
-							"cloudwatch_logging_options": cloudWatchLoggingOptionsSchema(),
-							"content_column_name": {
-								Type:         schema.TypeString,
-								Optional:     true,
-								ValidateFunc: validation.StringLenBetween(1, 255),
-							},
-							"data_loading_option": {
-								Type:             schema.TypeString,
-								Optional:         true,
-								Default:          types.SnowflakeDataLoadingOptionJsonMapping,
-								ValidateDiagFunc: enum.Validate[types.SnowflakeDataLoadingOption](),
-							},
-							names.AttrDatabase: {
-								Type:         schema.TypeString,
-								Required:     true,
-								ValidateFunc: validation.StringLenBetween(1, 255),
-							},
-							"key_passphrase": {
-								Type:         schema.TypeString,
-								Optional:     true,
-								Sensitive:    true,
-								ValidateFunc: validation.StringLenBetween(7, 255),
-							},
-							"metadata_column_name": {
-								Type:         schema.TypeString,
-								Optional:     true,
-								ValidateFunc: validation.StringLenBetween(1, 255),
-							},
-							names.AttrPrivateKey: {
-								Type:      schema.TypeString,
-								Optional:  true,
-								Sensitive: true,
-							},
-							"processing_configuration": processingConfigurationSchema(),
-							"retry_duration": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      60,
-								ValidateFunc: validation.IntBetween(0, 7200),
-							},
-							names.AttrRoleARN: {
-								Type:         schema.TypeString,
-								Required:     true,
-								ValidateFunc: verify.ValidARN,
+			},
+			"snowflake_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[snowflakeConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"account_url": schema.StringAttribute{
+							Required: true,
+						},
+						"buffering_interval":         int64AttributeWithDefault(0, int64validator.Between(0, 900)),
+						"buffering_size":             int64AttributeWithDefault(1, int64validator.Between(1, 128)),
+						"cloudwatch_logging_options": cloudWatchLoggingOptionsAttribute(),
+						"content_column_name":        legacyStringAttribute(stringvalidator.LengthBetween(1, 255)),
+						"data_loading_option": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.SnowflakeDataLoadingOption](),
+							Optional:   true,
+							Computed:   true,
+							Default:    stringdefault.StaticString(string(awstypes.SnowflakeDataLoadingOptionJsonMapping)),
+						},
+						names.AttrDatabase: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
 							},
-							"s3_backup_mode": {
-								Type:             schema.TypeString,
-								Optional:         true,
-								Default:          types.SnowflakeS3BackupModeFailedDataOnly,
-								ValidateDiagFunc: enum.Validate[types.SnowflakeS3BackupMode](),
+						},
+						"key_passphrase": schema.StringAttribute{
+							Optional:  true,
+							Sensitive: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(7, 255),
 							},
-							"s3_configuration": s3ConfigurationSchema(),
-							names.AttrSchema: {
-								Type:         schema.TypeString,
-								Required:     true,
-								ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"metadata_column_name": legacyStringAttribute(stringvalidator.LengthBetween(1, 255)),
+						names.AttrPrivateKey: schema.StringAttribute{
+							Optional:  true,
+							Sensitive: true,
+						},
+						"processing_configuration": processingConfigurationAttribute(),
+						"retry_duration":           int64AttributeWithDefault(60, int64validator.Between(0, 7200)),
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"s3_backup_mode": s3BackupModeAttribute(string(awstypes.SnowflakeS3BackupModeFailedDataOnly), false),
+						names.AttrSchema: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
 							},
-							"secrets_manager_configuration": secretsManagerConfigurationSchema(),
-							"snowflake_role_configuration": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										names.AttrEnabled: {
-											Type:     schema.TypeBool,
-											Optional: true,
-											Default:  false,
-										},
-										"snowflake_role": {
-											Type:         schema.TypeString,
-											Optional:     true,
-											ValidateFunc: validation.StringLenBetween(1, 255),
-										},
-									},
-								},
-								DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+						},
+						"secrets_manager_configuration": secretsManagerConfigurationAttribute(),
+						"snowflake_role_configuration":  optionalComputedNestedObjectAttribute[snowflakeRoleConfigurationModel](ctx, nil),
+						"table": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
 							},
-							"snowflake_vpc_configuration": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"private_link_vpce_id": {
-											Type:     schema.TypeString,
-											Required: true,
-										},
+						},
+						"user": legacyStringAttribute(stringvalidator.LengthBetween(1, 255)),
+					},
+					Blocks: map[string]schema.Block{
+						"s3_configuration": s3ConfigurationBlock(required...),
+						"snowflake_vpc_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[snowflakeVPCConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"private_link_vpce_id": schema.StringAttribute{
+										Required: true,
 									},
 								},
 							},
-							"table": {
-								Type:         schema.TypeString,
-								Required:     true,
-								ValidateFunc: validation.StringLenBetween(1, 255),
-							},
-							"user": {
-								Type:         schema.TypeString,
-								Optional:     true,
-								ValidateFunc: validation.StringLenBetween(1, 255),
-							},
 						},
 					},
 				},
-				"splunk_configuration": {
-					Type:     schema.TypeList,
-					Optional: true,
-					MaxItems: 1,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"buffering_interval": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      60,
-								ValidateFunc: validation.IntBetween(0, 60),
-							},
-							"buffering_size": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      5,
-								ValidateFunc: validation.IntBetween(1, 5),
-							},
-							"cloudwatch_logging_options": cloudWatchLoggingOptionsSchema(),
-							"hec_acknowledgment_timeout": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      180,
-								ValidateFunc: validation.IntBetween(180, 600),
-							},
-							"hec_endpoint": {
-								Type:     schema.TypeString,
-								Required: true,
-							},
-							"hec_endpoint_type": {
-								Type:             schema.TypeString,
-								Optional:         true,
-								Default:          types.HECEndpointTypeRaw,
-								ValidateDiagFunc: enum.Validate[types.HECEndpointType](),
-							},
-							"hec_token": {
-								Type:     schema.TypeString,
-								Optional: true,
-							},
-							"processing_configuration": processingConfigurationSchema(),
-							"retry_duration": {
-								Type:         schema.TypeInt,
-								Optional:     true,
-								Default:      3600,
-								ValidateFunc: validation.IntBetween(0, 7200),
-							},
-							"s3_backup_mode": {
-								Type:             schema.TypeString,
-								Optional:         true,
-								Default:          types.SplunkS3BackupModeFailedEventsOnly,
-								ValidateDiagFunc: enum.Validate[types.SplunkS3BackupMode](),
-							},
-							"s3_configuration":              s3ConfigurationSchema(),
-							"secrets_manager_configuration": secretsManagerConfigurationSchema(),
+			},
+			"splunk_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[splunkConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"buffering_interval":         int64AttributeWithDefault(60, int64validator.Between(0, 60)),
+						"buffering_size":             int64AttributeWithDefault(5, int64validator.Between(1, 5)),
+						"cloudwatch_logging_options": cloudWatchLoggingOptionsAttribute(),
+						"hec_acknowledgment_timeout": int64AttributeWithDefault(180, int64validator.Between(180, 600)),
+						"hec_endpoint": schema.StringAttribute{
+							Required: true,
 						},
+						"hec_endpoint_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.HECEndpointType](),
+							Optional:   true,
+							Computed:   true,
+							Default:    stringdefault.StaticString(string(awstypes.HECEndpointTypeRaw)),
+						},
+						"hec_token":                     legacyStringAttribute(),
+						"processing_configuration":      processingConfigurationAttribute(),
+						"retry_duration":                int64AttributeWithDefault(3600, int64validator.Between(0, 7200)),
+						"s3_backup_mode":                s3BackupModeAttribute(string(awstypes.SplunkS3BackupModeFailedEventsOnly), false),
+						"secrets_manager_configuration": secretsManagerConfigurationAttribute(),
+					},
+					Blocks: map[string]schema.Block{
+						"s3_configuration": s3ConfigurationBlock(required...),
 					},
 				},
-				names.AttrTags:    tftags.TagsSchema(),
-				names.AttrTagsAll: tftags.TagsSchemaComputed(),
-				"version_id": {
-					Type:     schema.TypeString,
-					Optional: true,
-					Computed: true,
-				},
-			}
-		},
-
-		CustomizeDiff: customdiff.All(
-			func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
-				destination := destinationType(d.Get(names.AttrDestination).(string))
-				requiredAttribute := map[destinationType]string{
-					destinationTypeElasticsearch:        "elasticsearch_configuration",
-					destinationTypeExtendedS3:           "extended_s3_configuration",
-					destinationTypeHTTPEndpoint:         "http_endpoint_configuration",
-					destinationTypeIceberg:              "iceberg_configuration",
-					destinationTypeOpenSearch:           "opensearch_configuration",
-					destinationTypeOpenSearchServerless: "opensearchserverless_configuration",
-					destinationTypeRedshift:             "redshift_configuration",
-					destinationTypeSnowflake:            "snowflake_configuration",
-					destinationTypeSplunk:               "splunk_configuration",
-				}[destination]
-
-				if _, ok := d.GetOk(requiredAttribute); !ok {
-					return fmt.Errorf("when destination is '%s', %s is required", destination, requiredAttribute)
-				}
-
-				return nil
 			},
-		),
-	}
-}
-
-func resourceDeliveryStreamCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).FirehoseClient(ctx)
-
-	sn := d.Get(names.AttrName).(string)
-	input := &firehose.CreateDeliveryStreamInput{
-		DeliveryStreamName: aws.String(sn),
-		DeliveryStreamType: types.DeliveryStreamTypeDirectPut,
-		Tags:               getTagsIn(ctx),
-	}
-
-	if v, ok := d.GetOk("kinesis_source_configuration"); ok {
-		input.DeliveryStreamType = types.DeliveryStreamTypeKinesisStreamAsSource
-		input.KinesisStreamSourceConfiguration = expandKinesisStreamSourceConfiguration(v.([]any)[0].(map[string]any))
-	} else if v, ok := d.GetOk("msk_source_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-		input.DeliveryStreamType = types.DeliveryStreamTypeMSKAsSource
-		input.MSKSourceConfiguration = expandMSKSourceConfiguration(v.([]any)[0].(map[string]any))
-	}
-
-	switch v := destinationType(d.Get(names.AttrDestination).(string)); v {
-	case destinationTypeElasticsearch:
-		if v, ok := d.GetOk("elasticsearch_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-			input.ElasticsearchDestinationConfiguration = expandElasticsearchDestinationConfiguration(v.([]any)[0].(map[string]any))
-		}
-	case destinationTypeExtendedS3:
-		if v, ok := d.GetOk("extended_s3_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-			input.ExtendedS3DestinationConfiguration = expandExtendedS3DestinationConfiguration(v.([]any)[0].(map[string]any))
-		}
-	case destinationTypeHTTPEndpoint:
-		if v, ok := d.GetOk("http_endpoint_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-			input.HttpEndpointDestinationConfiguration = expandHTTPEndpointDestinationConfiguration(v.([]any)[0].(map[string]any))
-		}
-	case destinationTypeIceberg:
-		if v, ok := d.GetOk("iceberg_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-			input.IcebergDestinationConfiguration = expandIcebergDestinationConfiguration(v.([]any)[0].(map[string]any))
-		}
-	case destinationTypeOpenSearch:
-		if v, ok := d.GetOk("opensearch_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-			input.AmazonopensearchserviceDestinationConfiguration = expandAmazonopensearchserviceDestinationConfiguration(v.([]any)[0].(map[string]any))
-		}
-	case destinationTypeOpenSearchServerless:
-		if v, ok := d.GetOk("opensearchserverless_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-			input.AmazonOpenSearchServerlessDestinationConfiguration = expandAmazonOpenSearchServerlessDestinationConfiguration(v.([]any)[0].(map[string]any))
-		}
-	case destinationTypeRedshift:
-		if v, ok := d.GetOk("redshift_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-			input.RedshiftDestinationConfiguration = expandRedshiftDestinationConfiguration(v.([]any)[0].(map[string]any))
-		}
-	case destinationTypeSnowflake:
-		if v, ok := d.GetOk("snowflake_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-			input.SnowflakeDestinationConfiguration = expandSnowflakeDestinationConfiguration(v.([]any)[0].(map[string]any))
-		}
-	case destinationTypeSplunk:
-		if v, ok := d.GetOk("splunk_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-			input.SplunkDestinationConfiguration = expandSplunkDestinationConfiguration(v.([]any)[0].(map[string]any))
-		}
-	}
-
-	_, err := retryDeliveryStreamOp(ctx, func(ctx context.Context) (any, error) {
-		return conn.CreateDeliveryStream(ctx, input)
-	})
-
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating Kinesis Firehose Delivery Stream (%s): %s", sn, err)
-	}
-
-	output, err := waitDeliveryStreamCreated(ctx, conn, sn, d.Timeout(schema.TimeoutCreate))
-
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Firehose Delivery Stream (%s) create: %s", sn, err)
-	}
-
-	d.SetId(aws.ToString(output.DeliveryStreamARN))
-
-	if v, ok := d.GetOk("server_side_encryption"); ok && !isDeliveryStreamOptionDisabled(v) {
-		input := &firehose.StartDeliveryStreamEncryptionInput{
-			DeliveryStreamEncryptionConfigurationInput: expandDeliveryStreamEncryptionConfigurationInput(v.([]any)),
-			DeliveryStreamName:                         aws.String(sn),
-		}
-
-		_, err := conn.StartDeliveryStreamEncryption(ctx, input)
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "starting Kinesis Firehose Delivery Stream (%s) encryption: %s", sn, err)
-		}
-
-		if _, err := waitDeliveryStreamEncryptionEnabled(ctx, conn, sn, d.Timeout(schema.TimeoutCreate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Firehose Delivery Stream (%s) encryption enable: %s", sn, err)
-		}
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
-
-	return append(diags, resourceDeliveryStreamRead(ctx, d, meta)...)
 }
 
-func resourceDeliveryStreamRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).FirehoseClient(ctx)
-
-	sn := d.Get(names.AttrName).(string)
-	s, err := findDeliveryStreamByName(ctx, conn, sn)
-
-	if !d.IsNewResource() && retry.NotFound(err) {
-		log.Printf("[WARN] Kinesis Firehose Delivery Stream (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return diags
-	}
-
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading Kinesis Firehose Delivery Stream (%s): %s", sn, err)
+func (r *deliveryStreamResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data deliveryStreamResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	d.Set(names.AttrARN, s.DeliveryStreamARN)
-	if v := s.Source; v != nil {
-		if v := v.KinesisStreamSourceDescription; v != nil {
-			if err := d.Set("kinesis_source_configuration", flattenKinesisStreamSourceDescription(v)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting kinesis_source_configuration: %s", err)
-			}
-		}
-		if v := v.MSKSourceDescription; v != nil {
-			if err := d.Set("msk_source_configuration", []any{flattenMSKSourceDescription(v)}); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting msk_source_configuration: %s", err)
-			}
-		}
-	}
-	d.Set(names.AttrName, s.DeliveryStreamName)
-	d.Set("version_id", s.VersionId)
+	conn := r.Meta().FirehoseClient(ctx)
 
-	sseOptions := map[string]any{
-		names.AttrEnabled: false,
-		"key_type":        types.KeyTypeAwsOwnedCmk,
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	input := firehose.CreateDeliveryStreamInput{
+		DeliveryStreamName: aws.String(name),
+		DeliveryStreamType: awstypes.DeliveryStreamTypeDirectPut,
+		Tags:               getTagsIn(ctx),
 	}
-	if s.DeliveryStreamEncryptionConfiguration != nil && s.DeliveryStreamEncryptionConfiguration.Status == types.DeliveryStreamEncryptionStatusEnabled {
-		sseOptions[names.AttrEnabled] = true
-		sseOptions["key_type"] = s.DeliveryStreamEncryptionConfiguration.KeyType
 
-		if v := s.DeliveryStreamEncryptionConfiguration.KeyARN; v != nil {
-			sseOptions["key_arn"] = aws.ToString(v)
-		}
-	}
-	if err := d.Set("server_side_encryption", []map[string]any{sseOptions}); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting server_side_encryption: %s", err)
+	if !data.KinesisSourceConfiguration.IsNull() {
+		input.DeliveryStreamType = awstypes.DeliveryStreamTypeKinesisStreamAsSource
+		response.Diagnostics.Append(fwflex.Expand(ctx, data.KinesisSourceConfiguration, &input.KinesisStreamSourceConfiguration)...)
+	} else if !data.MSKSourceConfiguration.IsNull() {
+		input.DeliveryStreamType = awstypes.DeliveryStreamTypeMSKAsSource
+		response.Diagnostics.Append(fwflex.Expand(ctx, data.MSKSourceConfiguration, &input.MSKSourceConfiguration)...)
 	}
-
-	if len(s.Destinations) > 0 {
-		destination := s.Destinations[0]
-		switch {
-		case destination.ElasticsearchDestinationDescription != nil:
-			d.Set(names.AttrDestination, destinationTypeElasticsearch)
-			if err := d.Set("elasticsearch_configuration", flattenElasticsearchDestinationDescription(destination.ElasticsearchDestinationDescription)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting elasticsearch_configuration: %s", err)
-			}
-		case destination.HttpEndpointDestinationDescription != nil:
-			d.Set(names.AttrDestination, destinationTypeHTTPEndpoint)
-			configuredAccessKey := d.Get("http_endpoint_configuration.0.access_key").(string)
-			if err := d.Set("http_endpoint_configuration", flattenHTTPEndpointDestinationDescription(destination.HttpEndpointDestinationDescription, configuredAccessKey)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting http_endpoint_configuration: %s", err)
-			}
-		case destination.IcebergDestinationDescription != nil:
-			d.Set(names.AttrDestination, destinationTypeIceberg)
-			if err := d.Set("iceberg_configuration", flattenIcebergDestinationDescription(destination.IcebergDestinationDescription)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting iceberg_configuration: %s", err)
-			}
-		case destination.AmazonopensearchserviceDestinationDescription != nil:
-			d.Set(names.AttrDestination, destinationTypeOpenSearch)
-			if err := d.Set("opensearch_configuration", flattenAmazonopensearchserviceDestinationDescription(destination.AmazonopensearchserviceDestinationDescription)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting opensearch_configuration: %s", err)
-			}
-		case destination.AmazonOpenSearchServerlessDestinationDescription != nil:
-			d.Set(names.AttrDestination, destinationTypeOpenSearchServerless)
-			if err := d.Set("opensearchserverless_configuration", flattenAmazonOpenSearchServerlessDestinationDescription(destination.AmazonOpenSearchServerlessDestinationDescription)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting opensearchserverless_configuration: %s", err)
-			}
-		case destination.RedshiftDestinationDescription != nil:
-			d.Set(names.AttrDestination, destinationTypeRedshift)
-			configuredPassword := d.Get("redshift_configuration.0.password").(string)
-			if err := d.Set("redshift_configuration", flattenRedshiftDestinationDescription(destination.RedshiftDestinationDescription, configuredPassword)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting redshift_configuration: %s", err)
-			}
-		case destination.SnowflakeDestinationDescription != nil:
-			d.Set(names.AttrDestination, destinationTypeSnowflake)
-			configuredKeyPassphrase := d.Get("snowflake_configuration.0.key_passphrase").(string)
-			configuredPrivateKey := d.Get("snowflake_configuration.0.private_key").(string)
-			if err := d.Set("snowflake_configuration", flattenSnowflakeDestinationDescription(destination.SnowflakeDestinationDescription, configuredKeyPassphrase, configuredPrivateKey)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting snowflake_configuration: %s", err)
-			}
-		case destination.SplunkDestinationDescription != nil:
-			d.Set(names.AttrDestination, destinationTypeSplunk)
-			if err := d.Set("splunk_configuration", flattenSplunkDestinationDescription(destination.SplunkDestinationDescription)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting splunk_configuration: %s", err)
-			}
-		default:
-			d.Set(names.AttrDestination, destinationTypeExtendedS3)
-			if err := d.Set("extended_s3_configuration", flattenExtendedS3DestinationDescription(destination.ExtendedS3DestinationDescription)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "setting extended_s3_configuration: %s", err)
-			}
-		}
-		d.Set("destination_id", destination.DestinationId)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	return diags
-}
-
-func resourceDeliveryStreamUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).FirehoseClient(ctx)
-
-	sn := d.Get(names.AttrName).(string)
-
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
-		input := &firehose.UpdateDestinationInput{
-			CurrentDeliveryStreamVersionId: aws.String(d.Get("version_id").(string)),
-			DeliveryStreamName:             aws.String(sn),
-			DestinationId:                  aws.String(d.Get("destination_id").(string)),
-		}
-
-		switch v := destinationType(d.Get(names.AttrDestination).(string)); v {
-		case destinationTypeElasticsearch:
-			if v, ok := d.GetOk("elasticsearch_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-				input.ElasticsearchDestinationUpdate = expandElasticsearchDestinationUpdate(v.([]any)[0].(map[string]any))
-			}
-		case destinationTypeExtendedS3:
-			if v, ok := d.GetOk("extended_s3_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-				input.ExtendedS3DestinationUpdate = expandExtendedS3DestinationUpdate(v.([]any)[0].(map[string]any))
-			}
-		case destinationTypeHTTPEndpoint:
-			if v, ok := d.GetOk("http_endpoint_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-				input.HttpEndpointDestinationUpdate = expandHTTPEndpointDestinationUpdate(v.([]any)[0].(map[string]any))
-			}
-		case destinationTypeIceberg:
-			if v, ok := d.GetOk("iceberg_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-				input.IcebergDestinationUpdate = expandIcebergDestinationUpdate(v.([]any)[0].(map[string]any))
-			}
-		case destinationTypeOpenSearch:
-			if v, ok := d.GetOk("opensearch_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-				input.AmazonopensearchserviceDestinationUpdate = expandAmazonopensearchserviceDestinationUpdate(v.([]any)[0].(map[string]any))
-			}
-		case destinationTypeOpenSearchServerless:
-			if v, ok := d.GetOk("opensearchserverless_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-				input.AmazonOpenSearchServerlessDestinationUpdate = expandAmazonOpenSearchServerlessDestinationUpdate(v.([]any)[0].(map[string]any))
-			}
-		case destinationTypeRedshift:
-			if v, ok := d.GetOk("redshift_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-				input.RedshiftDestinationUpdate = expandRedshiftDestinationUpdate(v.([]any)[0].(map[string]any))
-			}
-		case destinationTypeSnowflake:
-			if v, ok := d.GetOk("snowflake_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-				input.SnowflakeDestinationUpdate = expandSnowflakeDestinationUpdate(v.([]any)[0].(map[string]any))
-			}
-		case destinationTypeSplunk:
-			if v, ok := d.GetOk("splunk_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-				input.SplunkDestinationUpdate = expandSplunkDestinationUpdate(v.([]any)[0].(map[string]any))
-			}
-		}
-
-		_, err := retryDeliveryStreamOp(ctx, func(ctx context.Context) (any, error) {
-			return conn.UpdateDestination(ctx, input)
-		})
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating Kinesis Firehose Delivery Stream (%s): %s", sn, err)
-		}
+	destination, diags := data.destination(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	if d.HasChange("server_side_encryption") {
-		v := d.Get("server_side_encryption")
-		if isDeliveryStreamOptionDisabled(v) {
-			input := &firehose.StopDeliveryStreamEncryptionInput{
-				DeliveryStreamName: aws.String(sn),
-			}
-
-			_, err := conn.StopDeliveryStreamEncryption(ctx, input)
-
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "stopping Kinesis Firehose Delivery Stream (%s) encryption: %s", sn, err)
-			}
-
-			if _, err := waitDeliveryStreamEncryptionDisabled(ctx, conn, sn, d.Timeout(schema.TimeoutUpdate)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Firehose Delivery Stream (%s) encryption disable: %s", sn, err)
-			}
-		} else {
-			input := &firehose.StartDeliveryStreamEncryptionInput{
-				DeliveryStreamEncryptionConfigurationInput: expandDeliveryStreamEncryptionConfigurationInput(v.([]any)),
-				DeliveryStreamName:                         aws.String(sn),
-			}
-
-			_, err := conn.StartDeliveryStreamEncryption(ctx, input)
-
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "starting Kinesis Firehose Delivery Stream (%s) encryption: %s", sn, err)
-			}
-
-			if _, err := waitDeliveryStreamEncryptionEnabled(ctx, conn, sn, d.Timeout(schema.TimeoutUpdate)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Firehose Delivery Stream (%s) encryption enable: %s", sn, err)
-			}
+	if destination != nil {
+		response.Diagnostics.Append(destination.expandCreate(ctx, &input)...)
+		if response.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	return append(diags, resourceDeliveryStreamRead(ctx, d, meta)...)
-}
-
-func resourceDeliveryStreamDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).FirehoseClient(ctx)
-
-	sn := d.Get(names.AttrName).(string)
-
-	log.Printf("[DEBUG] Deleting Kinesis Firehose Delivery Stream: (%s)", sn)
-	_, err := conn.DeleteDeliveryStream(ctx, &firehose.DeleteDeliveryStreamInput{
-		DeliveryStreamName: aws.String(sn),
+	_, err := retryDeliveryStreamOp(ctx, func(ctx context.Context) (any, error) {
+		return conn.CreateDeliveryStream(ctx, &input)
 	})
 
-	if errs.IsA[*types.ResourceNotFoundException](err) {
-		return diags
-	}
-
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting Kinesis Firehose Delivery Stream (%s): %s", sn, err)
-	}
-
-	if _, err := waitDeliveryStreamDeleted(ctx, conn, sn, d.Timeout(schema.TimeoutDelete)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Firehose Delivery Stream (%s) delete: %s", sn, err)
-	}
-
-	return diags
-}
-
-func retryDeliveryStreamOp(ctx context.Context, f func(context.Context) (any, error)) (any, error) {
-	return tfresource.RetryWhen(ctx, propagationTimeout,
-		f,
-		func(err error) (bool, error) {
-			// Access was denied when calling Glue. Please ensure that the role specified in the data format conversion configuration has the necessary permissions.
-			if errs.IsAErrorMessageContains[*types.InvalidArgumentException](err, "Access was denied") {
-				return true, err
-			}
-			if errs.IsAErrorMessageContains[*types.InvalidArgumentException](err, "is not authorized to") {
-				return true, err
-			}
-			if errs.IsAErrorMessageContains[*types.InvalidArgumentException](err, "Please make sure the role specified in VpcConfiguration has permissions") {
-				return true, err
-			}
-			// InvalidArgumentException: Verify that the IAM role has access to the Elasticsearch domain.
-			if errs.IsAErrorMessageContains[*types.InvalidArgumentException](err, "Verify that the IAM role has access") {
-				return true, err
-			}
-			if errs.IsAErrorMessageContains[*types.InvalidArgumentException](err, "Firehose is unable to assume role") {
-				return true, err
-			}
-			return false, err
-		},
-	)
-}
-
-func findDeliveryStreamByName(ctx context.Context, conn *firehose.Client, name string) (*types.DeliveryStreamDescription, error) {
-	input := &firehose.DescribeDeliveryStreamInput{
-		DeliveryStreamName: aws.String(name),
-	}
-
-	output, err := conn.DescribeDeliveryStream(ctx, input)
-
-	if errs.IsA[*types.ResourceNotFoundException](err) {
-		return nil, &retry.NotFoundError{
-			LastError: err,
-		}
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	if output == nil || output.DeliveryStreamDescription == nil {
-		return nil, tfresource.NewEmptyResultError()
-	}
-
-	return output.DeliveryStreamDescription, nil
-}
-
-func statusDeliveryStream(conn *firehose.Client, name string) retry.StateRefreshFunc {
-	return func(ctx context.Context) (any, string, error) {
-		output, err := findDeliveryStreamByName(ctx, conn, name)
-
-		if retry.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, string(output.DeliveryStreamStatus), nil
-	}
-}
-
-func waitDeliveryStreamCreated(ctx context.Context, conn *firehose.Client, name string, timeout time.Duration) (*types.DeliveryStreamDescription, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.DeliveryStreamStatusCreating),
-		Target:  enum.Slice(types.DeliveryStreamStatusActive),
-		Refresh: statusDeliveryStream(conn, name),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.DeliveryStreamDescription); ok {
-		if status, failureDescription := output.DeliveryStreamStatus, output.FailureDescription; status == types.DeliveryStreamStatusCreatingFailed && failureDescription != nil {
-			retry.SetLastError(err, fmt.Errorf("%s: %s", failureDescription.Type, aws.ToString(failureDescription.Details)))
-		}
-
-		return output, err
-	}
-
-	return nil, err
-}
-
-func waitDeliveryStreamDeleted(ctx context.Context, conn *firehose.Client, name string, timeout time.Duration) (*types.DeliveryStreamDescription, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.DeliveryStreamStatusDeleting),
-		Target:  []string{},
-		Refresh: statusDeliveryStream(conn, name),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.DeliveryStreamDescription); ok {
-		if status, failureDescription := output.DeliveryStreamStatus, output.FailureDescription; status == types.DeliveryStreamStatusDeletingFailed && failureDescription != nil {
-			retry.SetLastError(err, fmt.Errorf("%s: %s", failureDescription.Type, aws.ToString(failureDescription.Details)))
-		}
-
-		return output, err
-	}
-
-	return nil, err
-}
-
-func findDeliveryStreamEncryptionConfigurationByName(ctx context.Context, conn *firehose.Client, name string) (*types.DeliveryStreamEncryptionConfiguration, error) {
-	output, err := findDeliveryStreamByName(ctx, conn, name)
-	if err != nil {
-		return nil, err
-	}
-
-	if output.DeliveryStreamEncryptionConfiguration == nil {
-		return nil, tfresource.NewEmptyResultError()
-	}
-
-	return output.DeliveryStreamEncryptionConfiguration, nil
-}
-
-func statusDeliveryStreamEncryptionConfiguration(conn *firehose.Client, name string) retry.StateRefreshFunc {
-	return func(ctx context.Context) (any, string, error) {
-		output, err := findDeliveryStreamEncryptionConfigurationByName(ctx, conn, name)
-
-		if retry.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, string(output.Status), nil
-	}
-}
-
-func waitDeliveryStreamEncryptionEnabled(ctx context.Context, conn *firehose.Client, name string, timeout time.Duration) (*types.DeliveryStreamEncryptionConfiguration, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.DeliveryStreamEncryptionStatusEnabling),
-		Target:  enum.Slice(types.DeliveryStreamEncryptionStatusEnabled),
-		Refresh: statusDeliveryStreamEncryptionConfiguration(conn, name),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.DeliveryStreamEncryptionConfiguration); ok {
-		if status, failureDescription := output.Status, output.FailureDescription; status == types.DeliveryStreamEncryptionStatusEnablingFailed && failureDescription != nil {
-			retry.SetLastError(err, fmt.Errorf("%s: %s", failureDescription.Type, aws.ToString(failureDescription.Details)))
-		}
-
-		return output, err
-	}
-
-	return nil, err
-}
-
-func waitDeliveryStreamEncryptionDisabled(ctx context.Context, conn *firehose.Client, name string, timeout time.Duration) (*types.DeliveryStreamEncryptionConfiguration, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.DeliveryStreamEncryptionStatusDisabling),
-		Target:  enum.Slice(types.DeliveryStreamEncryptionStatusDisabled),
-		Refresh: statusDeliveryStreamEncryptionConfiguration(conn, name),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.DeliveryStreamEncryptionConfiguration); ok {
-		if status, failureDescription := output.Status, output.FailureDescription; status == types.DeliveryStreamEncryptionStatusDisablingFailed && failureDescription != nil {
-			retry.SetLastError(err, fmt.Errorf("%s: %s", failureDescription.Type, aws.ToString(failureDescription.Details)))
-		}
-
-		return output, err
-	}
-
-	return nil, err
-}
-
-func expandKinesisStreamSourceConfiguration(source map[string]any) *types.KinesisStreamSourceConfiguration {
-	configuration := &types.KinesisStreamSourceConfiguration{
-		KinesisStreamARN: aws.String(source["kinesis_stream_arn"].(string)),
-		RoleARN:          aws.String(source[names.AttrRoleARN].(string)),
-	}
-
-	return configuration
-}
-
-func expandS3DestinationConfiguration(tfList []any) *types.S3DestinationConfiguration {
-	s3 := tfList[0].(map[string]any)
-
-	configuration := &types.S3DestinationConfiguration{
-		BucketARN: aws.String(s3["bucket_arn"].(string)),
-		RoleARN:   aws.String(s3[names.AttrRoleARN].(string)),
-		BufferingHints: &types.BufferingHints{
-			IntervalInSeconds: aws.Int32(int32(s3["buffering_interval"].(int))),
-			SizeInMBs:         aws.Int32(int32(s3["buffering_size"].(int))),
-		},
-		Prefix:                  expandPrefix(s3),
-		CompressionFormat:       types.CompressionFormat(s3["compression_format"].(string)),
-		EncryptionConfiguration: expandEncryptionConfiguration(s3),
-	}
-
-	if v, ok := s3["error_output_prefix"].(string); ok && v != "" {
-		configuration.ErrorOutputPrefix = aws.String(v)
-	}
-
-	if _, ok := s3["cloudwatch_logging_options"]; ok {
-		configuration.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(s3)
-	}
-
-	return configuration
-}
-
-func expandS3DestinationConfigurationBackup(d map[string]any) *types.S3DestinationConfiguration {
-	config := d["s3_backup_configuration"].([]any)
-	if len(config) == 0 {
-		return nil
-	}
-
-	s3 := config[0].(map[string]any)
-
-	configuration := &types.S3DestinationConfiguration{
-		BucketARN: aws.String(s3["bucket_arn"].(string)),
-		RoleARN:   aws.String(s3[names.AttrRoleARN].(string)),
-		BufferingHints: &types.BufferingHints{
-			IntervalInSeconds: aws.Int32(int32(s3["buffering_interval"].(int))),
-			SizeInMBs:         aws.Int32(int32(s3["buffering_size"].(int))),
-		},
-		Prefix:                  expandPrefix(s3),
-		CompressionFormat:       types.CompressionFormat(s3["compression_format"].(string)),
-		EncryptionConfiguration: expandEncryptionConfiguration(s3),
-	}
-
-	if _, ok := s3["cloudwatch_logging_options"]; ok {
-		configuration.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(s3)
-	}
-
-	if v, ok := s3["error_output_prefix"].(string); ok && v != "" {
-		configuration.ErrorOutputPrefix = aws.String(v)
-	}
-
-	return configuration
-}
-
-func expandExtendedS3DestinationConfiguration(s3 map[string]any) *types.ExtendedS3DestinationConfiguration {
-	roleARN := s3[names.AttrRoleARN].(string)
-	configuration := &types.ExtendedS3DestinationConfiguration{
-		BucketARN: aws.String(s3["bucket_arn"].(string)),
-		RoleARN:   aws.String(roleARN),
-		BufferingHints: &types.BufferingHints{
-			IntervalInSeconds: aws.Int32(int32(s3["buffering_interval"].(int))),
-			SizeInMBs:         aws.Int32(int32(s3["buffering_size"].(int))),
-		},
-		Prefix:                            expandPrefix(s3),
-		CompressionFormat:                 types.CompressionFormat(s3["compression_format"].(string)),
-		CustomTimeZone:                    aws.String(s3["custom_time_zone"].(string)),
-		DataFormatConversionConfiguration: expandDataFormatConversionConfiguration(s3["data_format_conversion_configuration"].([]any)),
-		EncryptionConfiguration:           expandEncryptionConfiguration(s3),
-		FileExtension:                     aws.String(s3["file_extension"].(string)),
-	}
-
-	if _, ok := s3["processing_configuration"]; ok {
-		configuration.ProcessingConfiguration = expandProcessingConfiguration(s3, destinationTypeExtendedS3, roleARN)
-	}
-
-	if _, ok := s3["dynamic_partitioning_configuration"]; ok {
-		configuration.DynamicPartitioningConfiguration = expandDynamicPartitioningConfiguration(s3)
-	}
-
-	if _, ok := s3["cloudwatch_logging_options"]; ok {
-		configuration.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(s3)
-	}
-
-	if v, ok := s3["error_output_prefix"].(string); ok && v != "" {
-		configuration.ErrorOutputPrefix = aws.String(v)
-	}
-
-	if s3BackupMode, ok := s3["s3_backup_mode"]; ok {
-		configuration.S3BackupMode = types.S3BackupMode(s3BackupMode.(string))
-		configuration.S3BackupConfiguration = expandS3DestinationConfigurationBackup(s3)
-	}
-
-	return configuration
-}
-
-func expandS3DestinationUpdate(tfList []any) *types.S3DestinationUpdate {
-	s3 := tfList[0].(map[string]any)
-	configuration := &types.S3DestinationUpdate{
-		BucketARN: aws.String(s3["bucket_arn"].(string)),
-		RoleARN:   aws.String(s3[names.AttrRoleARN].(string)),
-		BufferingHints: &types.BufferingHints{
-			IntervalInSeconds: aws.Int32(int32(s3["buffering_interval"].(int))),
-			SizeInMBs:         aws.Int32(int32(s3["buffering_size"].(int))),
-		},
-		ErrorOutputPrefix:        aws.String(s3["error_output_prefix"].(string)),
-		Prefix:                   expandPrefix(s3),
-		CompressionFormat:        types.CompressionFormat(s3["compression_format"].(string)),
-		EncryptionConfiguration:  expandEncryptionConfiguration(s3),
-		CloudWatchLoggingOptions: expandCloudWatchLoggingOptions(s3),
-	}
-
-	if _, ok := s3["cloudwatch_logging_options"]; ok {
-		configuration.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(s3)
-	}
-
-	return configuration
-}
-
-func expandS3DestinationUpdateBackup(d map[string]any) *types.S3DestinationUpdate {
-	config := d["s3_backup_configuration"].([]any)
-	if len(config) == 0 {
-		return nil
-	}
-
-	s3 := config[0].(map[string]any)
-
-	configuration := &types.S3DestinationUpdate{
-		BucketARN: aws.String(s3["bucket_arn"].(string)),
-		RoleARN:   aws.String(s3[names.AttrRoleARN].(string)),
-		BufferingHints: &types.BufferingHints{
-			IntervalInSeconds: aws.Int32(int32(s3["buffering_interval"].(int))),
-			SizeInMBs:         aws.Int32(int32(s3["buffering_size"].(int))),
-		},
-		ErrorOutputPrefix:        aws.String(s3["error_output_prefix"].(string)),
-		Prefix:                   expandPrefix(s3),
-		CompressionFormat:        types.CompressionFormat(s3["compression_format"].(string)),
-		EncryptionConfiguration:  expandEncryptionConfiguration(s3),
-		CloudWatchLoggingOptions: expandCloudWatchLoggingOptions(s3),
-	}
-
-	if _, ok := s3["cloudwatch_logging_options"]; ok {
-		configuration.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(s3)
-	}
-
-	return configuration
-}
-
-func expandExtendedS3DestinationUpdate(s3 map[string]any) *types.ExtendedS3DestinationUpdate {
-	roleARN := s3[names.AttrRoleARN].(string)
-	configuration := &types.ExtendedS3DestinationUpdate{
-		BucketARN: aws.String(s3["bucket_arn"].(string)),
-		RoleARN:   aws.String(roleARN),
-		BufferingHints: &types.BufferingHints{
-			IntervalInSeconds: aws.Int32(int32(s3["buffering_interval"].(int))),
-			SizeInMBs:         aws.Int32(int32(s3["buffering_size"].(int))),
-		},
-		CustomTimeZone:                    aws.String(s3["custom_time_zone"].(string)),
-		ErrorOutputPrefix:                 aws.String(s3["error_output_prefix"].(string)),
-		FileExtension:                     aws.String(s3["file_extension"].(string)),
-		Prefix:                            expandPrefix(s3),
-		CompressionFormat:                 types.CompressionFormat(s3["compression_format"].(string)),
-		EncryptionConfiguration:           expandEncryptionConfiguration(s3),
-		DataFormatConversionConfiguration: expandDataFormatConversionConfiguration(s3["data_format_conversion_configuration"].([]any)),
-		CloudWatchLoggingOptions:          expandCloudWatchLoggingOptions(s3),
-		ProcessingConfiguration:           expandProcessingConfiguration(s3, destinationTypeExtendedS3, roleARN),
-	}
-
-	if _, ok := s3["cloudwatch_logging_options"]; ok {
-		configuration.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(s3)
-	}
-
-	if _, ok := s3["dynamic_partitioning_configuration"]; ok {
-		configuration.DynamicPartitioningConfiguration = expandDynamicPartitioningConfiguration(s3)
-	}
-
-	if s3BackupMode, ok := s3["s3_backup_mode"]; ok {
-		configuration.S3BackupMode = types.S3BackupMode(s3BackupMode.(string))
-		configuration.S3BackupUpdate = expandS3DestinationUpdateBackup(s3)
-	}
-
-	return configuration
-}
-
-func expandDataFormatConversionConfiguration(l []any) *types.DataFormatConversionConfiguration {
-	if len(l) == 0 || l[0] == nil {
-		// It is possible to just pass nil here, but this seems to be the
-		// canonical form that AWS uses, and is less likely to produce diffs.
-		return &types.DataFormatConversionConfiguration{
-			Enabled: aws.Bool(false),
-		}
-	}
-
-	m := l[0].(map[string]any)
-
-	return &types.DataFormatConversionConfiguration{
-		Enabled:                   aws.Bool(m[names.AttrEnabled].(bool)),
-		InputFormatConfiguration:  expandInputFormatConfiguration(m["input_format_configuration"].([]any)),
-		OutputFormatConfiguration: expandOutputFormatConfiguration(m["output_format_configuration"].([]any)),
-		SchemaConfiguration:       expandSchemaConfiguration(m["schema_configuration"].([]any)),
-	}
-}
-
-func expandInputFormatConfiguration(l []any) *types.InputFormatConfiguration {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]any)
-
-	return &types.InputFormatConfiguration{
-		Deserializer: expandDeserializer(m["deserializer"].([]any)),
-	}
-}
-
-func expandDeserializer(l []any) *types.Deserializer {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]any)
-
-	return &types.Deserializer{
-		HiveJsonSerDe:  expandHiveJSONSerDe(m["hive_json_ser_de"].([]any)),
-		OpenXJsonSerDe: expandOpenXJSONSerDe(m["open_x_json_ser_de"].([]any)),
-	}
-}
-
-func expandHiveJSONSerDe(l []any) *types.HiveJsonSerDe {
-	if len(l) == 0 {
-		return nil
-	}
-
-	if l[0] == nil {
-		return &types.HiveJsonSerDe{}
-	}
-
-	m := l[0].(map[string]any)
-
-	return &types.HiveJsonSerDe{
-		TimestampFormats: flex.ExpandStringValueList(m["timestamp_formats"].([]any)),
-	}
-}
-
-func expandOpenXJSONSerDe(l []any) *types.OpenXJsonSerDe {
-	if len(l) == 0 {
-		return nil
-	}
-
-	if l[0] == nil {
-		return &types.OpenXJsonSerDe{}
-	}
-
-	m := l[0].(map[string]any)
-
-	return &types.OpenXJsonSerDe{
-		CaseInsensitive:                    aws.Bool(m["case_insensitive"].(bool)),
-		ColumnToJsonKeyMappings:            flex.ExpandStringValueMap(m["column_to_json_key_mappings"].(map[string]any)),
-		ConvertDotsInJsonKeysToUnderscores: aws.Bool(m["convert_dots_in_json_keys_to_underscores"].(bool)),
-	}
-}
-
-func expandOutputFormatConfiguration(l []any) *types.OutputFormatConfiguration {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]any)
-
-	return &types.OutputFormatConfiguration{
-		Serializer: expandSerializer(m["serializer"].([]any)),
-	}
-}
-
-func expandSerializer(l []any) *types.Serializer {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]any)
-
-	return &types.Serializer{
-		OrcSerDe:     expandOrcSerDe(m["orc_ser_de"].([]any)),
-		ParquetSerDe: expandParquetSerDe(m["parquet_ser_de"].([]any)),
-	}
-}
-
-func expandOrcSerDe(l []any) *types.OrcSerDe {
-	if len(l) == 0 {
-		return nil
-	}
-
-	if l[0] == nil {
-		return &types.OrcSerDe{}
-	}
-
-	m := l[0].(map[string]any)
-
-	orcSerDe := &types.OrcSerDe{
-		BlockSizeBytes:                      aws.Int32(int32(m["block_size_bytes"].(int))),
-		BloomFilterFalsePositiveProbability: aws.Float64(m["bloom_filter_false_positive_probability"].(float64)),
-		Compression:                         types.OrcCompression(m["compression"].(string)),
-		DictionaryKeyThreshold:              aws.Float64(m["dictionary_key_threshold"].(float64)),
-		EnablePadding:                       aws.Bool(m["enable_padding"].(bool)),
-		FormatVersion:                       types.OrcFormatVersion(m["format_version"].(string)),
-		PaddingTolerance:                    aws.Float64(m["padding_tolerance"].(float64)),
-		RowIndexStride:                      aws.Int32(int32(m["row_index_stride"].(int))),
-		StripeSizeBytes:                     aws.Int32(int32(m["stripe_size_bytes"].(int))),
-	}
-
-	if v, ok := m["bloom_filter_columns"].([]any); ok && len(v) > 0 {
-		orcSerDe.BloomFilterColumns = flex.ExpandStringValueList(v)
-	}
-
-	return orcSerDe
-}
-
-func expandParquetSerDe(l []any) *types.ParquetSerDe {
-	if len(l) == 0 {
-		return nil
-	}
-
-	if l[0] == nil {
-		return &types.ParquetSerDe{}
-	}
-
-	m := l[0].(map[string]any)
-
-	return &types.ParquetSerDe{
-		BlockSizeBytes:              aws.Int32(int32(m["block_size_bytes"].(int))),
-		Compression:                 types.ParquetCompression(m["compression"].(string)),
-		EnableDictionaryCompression: aws.Bool(m["enable_dictionary_compression"].(bool)),
-		MaxPaddingBytes:             aws.Int32(int32(m["max_padding_bytes"].(int))),
-		PageSizeBytes:               aws.Int32(int32(m["page_size_bytes"].(int))),
-		WriterVersion:               types.ParquetWriterVersion(m["writer_version"].(string)),
-	}
-}
-
-func expandSchemaConfiguration(l []any) *types.SchemaConfiguration {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	m := l[0].(map[string]any)
-
-	config := &types.SchemaConfiguration{
-		DatabaseName: aws.String(m[names.AttrDatabaseName].(string)),
-		RoleARN:      aws.String(m[names.AttrRoleARN].(string)),
-		TableName:    aws.String(m[names.AttrTableName].(string)),
-		VersionId:    aws.String(m["version_id"].(string)),
-	}
-
-	if v, ok := m[names.AttrCatalogID].(string); ok && v != "" {
-		config.CatalogId = aws.String(v)
-	}
-	if v, ok := m[names.AttrRegion].(string); ok && v != "" {
-		config.Region = aws.String(v)
-	}
-
-	return config
-}
-
-func expandDynamicPartitioningConfiguration(s3 map[string]any) *types.DynamicPartitioningConfiguration {
-	config := s3["dynamic_partitioning_configuration"].([]any)
-	if len(config) == 0 {
-		return nil
-	}
-
-	dynamicPartitioningConfig := config[0].(map[string]any)
-	DynamicPartitioningConfiguration := &types.DynamicPartitioningConfiguration{
-		Enabled: aws.Bool(dynamicPartitioningConfig[names.AttrEnabled].(bool)),
-	}
-
-	if retryDuration, ok := dynamicPartitioningConfig["retry_duration"]; ok {
-		DynamicPartitioningConfiguration.RetryOptions = &types.RetryOptions{
-			DurationInSeconds: aws.Int32(int32(retryDuration.(int))),
-		}
-	}
-
-	return DynamicPartitioningConfiguration
-}
-
-func expandProcessingConfiguration(tfMap map[string]any, destinationType destinationType, roleARN string) *types.ProcessingConfiguration {
-	config := tfMap["processing_configuration"].([]any)
-	if len(config) == 0 || config[0] == nil {
-		// It is possible to just pass nil here, but this seems to be the
-		// canonical form that AWS uses, and is less likely to produce diffs.
-		return &types.ProcessingConfiguration{
-			Enabled:    aws.Bool(false),
-			Processors: []types.Processor{},
-		}
-	}
-
-	processingConfiguration := config[0].(map[string]any)
-
-	return &types.ProcessingConfiguration{
-		Enabled:    aws.Bool(processingConfiguration[names.AttrEnabled].(bool)),
-		Processors: expandProcessors(processingConfiguration["processors"].([]any), destinationType, roleARN),
-	}
-}
-
-func expandProcessors(processingConfigurationProcessors []any, destinationType destinationType, roleARN string) []types.Processor {
-	processors := []types.Processor{}
-
-	for _, processor := range processingConfigurationProcessors {
-		extractedProcessor := expandProcessor(processor.(map[string]any))
-		if extractedProcessor != nil {
-			// Merge in defaults.
-			for name, value := range defaultProcessorParameters(destinationType, extractedProcessor.Type, roleARN) {
-				if !slices.ContainsFunc(extractedProcessor.Parameters, func(param types.ProcessorParameter) bool { return name == param.ParameterName }) {
-					extractedProcessor.Parameters = append(extractedProcessor.Parameters, types.ProcessorParameter{
-						ParameterName:  name,
-						ParameterValue: aws.String(value),
-					})
-				}
-			}
-			processors = append(processors, *extractedProcessor)
-		}
-	}
-
-	return processors
-}
-
-func expandProcessor(processingConfigurationProcessor map[string]any) *types.Processor {
-	var processor *types.Processor
-	processorType := processingConfigurationProcessor[names.AttrType].(string)
-	if processorType != "" {
-		processor = &types.Processor{
-			Type:       types.ProcessorType(processorType),
-			Parameters: expandProcessorParameters(processingConfigurationProcessor[names.AttrParameters].(*schema.Set).List()),
-		}
-	}
-	return processor
-}
-
-func expandProcessorParameters(processorParameters []any) []types.ProcessorParameter {
-	parameters := []types.ProcessorParameter{}
-
-	for _, attr := range processorParameters {
-		parameters = append(parameters, expandProcessorParameter(attr.(map[string]any)))
-	}
-
-	return parameters
-}
-
-func expandProcessorParameter(processorParameter map[string]any) types.ProcessorParameter {
-	parameter := types.ProcessorParameter{
-		ParameterName:  types.ProcessorParameterName(processorParameter["parameter_name"].(string)),
-		ParameterValue: aws.String(processorParameter["parameter_value"].(string)),
-	}
-
-	return parameter
-}
-
-func expandSecretsManagerConfiguration(tfMap map[string]any) *types.SecretsManagerConfiguration {
-	config := tfMap["secrets_manager_configuration"].([]any)
-
-	if len(config) == 0 || config[0] == nil {
-		return nil
-	}
-
-	secretsManagerConfiguration := config[0].(map[string]any)
-	configuration := &types.SecretsManagerConfiguration{
-		Enabled: aws.Bool(secretsManagerConfiguration[names.AttrEnabled].(bool)),
-	}
-
-	if v, ok := secretsManagerConfiguration["secret_arn"]; ok && len(v.(string)) > 0 {
-		configuration.SecretARN = aws.String(v.(string))
-	}
-
-	if v, ok := secretsManagerConfiguration[names.AttrRoleARN]; ok && len(v.(string)) > 0 {
-		configuration.RoleARN = aws.String(v.(string))
-	}
-
-	return configuration
-}
-
-func expandEncryptionConfiguration(s3 map[string]any) *types.EncryptionConfiguration {
-	if key, ok := s3[names.AttrKMSKeyARN]; ok && len(key.(string)) > 0 {
-		return &types.EncryptionConfiguration{
-			KMSEncryptionConfig: &types.KMSEncryptionConfig{
-				AWSKMSKeyARN: aws.String(key.(string)),
-			},
-		}
-	}
-
-	return &types.EncryptionConfiguration{
-		NoEncryptionConfig: types.NoEncryptionConfigNoEncryption,
-	}
-}
-
-func expandCloudWatchLoggingOptions(s3 map[string]any) *types.CloudWatchLoggingOptions {
-	config := s3["cloudwatch_logging_options"].([]any)
-	if len(config) == 0 {
-		return nil
-	}
-
-	loggingConfig := config[0].(map[string]any)
-	loggingOptions := &types.CloudWatchLoggingOptions{
-		Enabled: aws.Bool(loggingConfig[names.AttrEnabled].(bool)),
-	}
-
-	if v, ok := loggingConfig[names.AttrLogGroupName]; ok {
-		loggingOptions.LogGroupName = aws.String(v.(string))
-	}
-
-	if v, ok := loggingConfig["log_stream_name"]; ok {
-		loggingOptions.LogStreamName = aws.String(v.(string))
-	}
-
-	return loggingOptions
-}
-
-func expandVPCConfiguration(es map[string]any) *types.VpcConfiguration {
-	config := es[names.AttrVPCConfig].([]any)
-	if len(config) == 0 {
-		return nil
-	}
-
-	vpcConfig := config[0].(map[string]any)
-
-	return &types.VpcConfiguration{
-		RoleARN:          aws.String(vpcConfig[names.AttrRoleARN].(string)),
-		SubnetIds:        flex.ExpandStringValueSet(vpcConfig[names.AttrSubnetIDs].(*schema.Set)),
-		SecurityGroupIds: flex.ExpandStringValueSet(vpcConfig[names.AttrSecurityGroupIDs].(*schema.Set)),
-	}
-}
-
-func expandPrefix(s3 map[string]any) *string {
-	if v, ok := s3[names.AttrPrefix]; ok {
-		return aws.String(v.(string))
-	}
-
-	return nil
-}
-
-func expandIcebergDestinationConfiguration(tfMap map[string]any) *types.IcebergDestinationConfiguration {
-	roleARN := tfMap[names.AttrRoleARN].(string)
-	apiObject := &types.IcebergDestinationConfiguration{
-		BufferingHints: &types.BufferingHints{
-			IntervalInSeconds: aws.Int32(int32(tfMap["buffering_interval"].(int))),
-			SizeInMBs:         aws.Int32(int32(tfMap["buffering_size"].(int))),
-		},
-		CatalogConfiguration: &types.CatalogConfiguration{
-			CatalogARN: aws.String(tfMap["catalog_arn"].(string)),
-		},
-		RoleARN:         aws.String(roleARN),
-		S3Configuration: expandS3DestinationConfiguration(tfMap["s3_configuration"].([]any)),
-	}
-
-	if v, ok := tfMap["append_only"].(bool); ok && v {
-		apiObject.AppendOnly = aws.Bool(v)
-	}
-
-	if _, ok := tfMap["cloudwatch_logging_options"]; ok {
-		apiObject.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(tfMap)
-	}
-
-	if _, ok := tfMap["destination_table_configuration"]; ok {
-		apiObject.DestinationTableConfigurationList = expandDestinationTableConfigurationList(tfMap)
-	}
-
-	if _, ok := tfMap["processing_configuration"]; ok {
-		apiObject.ProcessingConfiguration = expandProcessingConfiguration(tfMap, destinationTypeIceberg, roleARN)
-	}
-
-	if _, ok := tfMap["retry_duration"]; ok {
-		apiObject.RetryOptions = expandIcebergRetryOptions(tfMap)
-	}
-
-	if v, ok := tfMap["s3_backup_mode"]; ok {
-		apiObject.S3BackupMode = types.IcebergS3BackupMode(v.(string))
-	}
-
-	return apiObject
-}
-
-func expandIcebergDestinationUpdate(tfMap map[string]any) *types.IcebergDestinationUpdate {
-	roleARN := tfMap[names.AttrRoleARN].(string)
-	apiObject := &types.IcebergDestinationUpdate{
-		BufferingHints: &types.BufferingHints{
-			IntervalInSeconds: aws.Int32(int32(tfMap["buffering_interval"].(int))),
-			SizeInMBs:         aws.Int32(int32(tfMap["buffering_size"].(int))),
-		},
-		RoleARN: aws.String(roleARN),
-	}
-
-	if v, ok := tfMap["append_only"].(bool); ok && v {
-		apiObject.AppendOnly = aws.Bool(v)
-	}
-
-	if catalogARN, ok := tfMap["catalog_arn"].(string); ok {
-		apiObject.CatalogConfiguration = &types.CatalogConfiguration{
-			CatalogARN: aws.String(catalogARN),
-		}
-	}
-
-	if _, ok := tfMap["cloudwatch_logging_options"]; ok {
-		apiObject.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(tfMap)
-	}
-
-	if _, ok := tfMap["destination_table_configuration"]; ok {
-		apiObject.DestinationTableConfigurationList = expandDestinationTableConfigurationList(tfMap)
-	}
-
-	if _, ok := tfMap["processing_configuration"]; ok {
-		apiObject.ProcessingConfiguration = expandProcessingConfiguration(tfMap, destinationTypeIceberg, roleARN)
-	}
-
-	if _, ok := tfMap["retry_duration"]; ok {
-		apiObject.RetryOptions = expandIcebergRetryOptions(tfMap)
-	}
-
-	if v, ok := tfMap["s3_backup_mode"]; ok {
-		apiObject.S3BackupMode = types.IcebergS3BackupMode(v.(string))
-	}
-
-	if v, ok := tfMap["s3_configuration"]; ok {
-		apiObject.S3Configuration = expandS3DestinationConfiguration(v.([]any))
-	}
-
-	return apiObject
-}
-
-func expandRedshiftDestinationConfiguration(tfMap map[string]any) *types.RedshiftDestinationConfiguration {
-	roleARN := tfMap[names.AttrRoleARN].(string)
-	apiObject := &types.RedshiftDestinationConfiguration{
-		ClusterJDBCURL:  aws.String(tfMap["cluster_jdbcurl"].(string)),
-		CopyCommand:     expandCopyCommand(tfMap),
-		RetryOptions:    expandRedshiftRetryOptions(tfMap),
-		RoleARN:         aws.String(roleARN),
-		S3Configuration: expandS3DestinationConfiguration(tfMap["s3_configuration"].([]any)),
-	}
-
-	if _, ok := tfMap["cloudwatch_logging_options"]; ok {
-		apiObject.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(tfMap)
-	}
-
-	if v, ok := tfMap[names.AttrPassword]; ok && v.(string) != "" {
-		apiObject.Password = aws.String(v.(string))
-	}
-
-	if _, ok := tfMap["processing_configuration"]; ok {
-		apiObject.ProcessingConfiguration = expandProcessingConfiguration(tfMap, destinationTypeRedshift, roleARN)
-	}
-
-	if v, ok := tfMap["s3_backup_mode"]; ok {
-		apiObject.S3BackupMode = types.RedshiftS3BackupMode(v.(string))
-		apiObject.S3BackupConfiguration = expandS3DestinationConfigurationBackup(tfMap)
-	}
-
-	if _, ok := tfMap["secrets_manager_configuration"]; ok {
-		apiObject.SecretsManagerConfiguration = expandSecretsManagerConfiguration(tfMap)
-	}
-
-	if v, ok := tfMap[names.AttrUsername]; ok && v.(string) != "" {
-		apiObject.Username = aws.String(v.(string))
-	}
-
-	return apiObject
-}
-
-func expandRedshiftDestinationUpdate(tfMap map[string]any) *types.RedshiftDestinationUpdate {
-	roleARN := tfMap[names.AttrRoleARN].(string)
-	apiObject := &types.RedshiftDestinationUpdate{
-		ClusterJDBCURL: aws.String(tfMap["cluster_jdbcurl"].(string)),
-		CopyCommand:    expandCopyCommand(tfMap),
-		RetryOptions:   expandRedshiftRetryOptions(tfMap),
-		RoleARN:        aws.String(roleARN),
-	}
-
-	s3Config := expandS3DestinationUpdate(tfMap["s3_configuration"].([]any))
-	// Redshift does not currently support ErrorOutputPrefix,
-	// which is set to the empty string within "updateS3Config",
-	// thus we must remove it here to avoid an InvalidArgumentException.
-	s3Config.ErrorOutputPrefix = nil
-	apiObject.S3Update = s3Config
-
-	if _, ok := tfMap["cloudwatch_logging_options"]; ok {
-		apiObject.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(tfMap)
-	}
-
-	if v, ok := tfMap[names.AttrPassword]; ok && v.(string) != "" {
-		apiObject.Password = aws.String(v.(string))
-	}
-
-	if _, ok := tfMap["processing_configuration"]; ok {
-		apiObject.ProcessingConfiguration = expandProcessingConfiguration(tfMap, destinationTypeRedshift, roleARN)
-	}
-
-	if v, ok := tfMap["s3_backup_mode"]; ok {
-		apiObject.S3BackupMode = types.RedshiftS3BackupMode(v.(string))
-		apiObject.S3BackupUpdate = expandS3DestinationUpdateBackup(tfMap)
-		if apiObject.S3BackupUpdate != nil {
-			// Redshift does not currently support ErrorOutputPrefix,
-			// which is set to the empty string within "updateS3BackupConfig",
-			// thus we must remove it here to avoid an InvalidArgumentException.
-			apiObject.S3BackupUpdate.ErrorOutputPrefix = nil
-		}
-	}
-
-	if _, ok := tfMap["secrets_manager_configuration"]; ok {
-		apiObject.SecretsManagerConfiguration = expandSecretsManagerConfiguration(tfMap)
-	}
-
-	if v, ok := tfMap[names.AttrUsername]; ok && v.(string) != "" {
-		apiObject.Username = aws.String(v.(string))
-	}
-
-	return apiObject
-}
-
-func expandElasticsearchDestinationConfiguration(es map[string]any) *types.ElasticsearchDestinationConfiguration {
-	roleARN := es[names.AttrRoleARN].(string)
-	config := &types.ElasticsearchDestinationConfiguration{
-		BufferingHints:  expandElasticsearchBufferingHints(es),
-		IndexName:       aws.String(es["index_name"].(string)),
-		RetryOptions:    expandElasticsearchRetryOptions(es),
-		RoleARN:         aws.String(roleARN),
-		TypeName:        aws.String(es["type_name"].(string)),
-		S3Configuration: expandS3DestinationConfiguration(es["s3_configuration"].([]any)),
-	}
-
-	if v, ok := es["domain_arn"]; ok && v.(string) != "" {
-		config.DomainARN = aws.String(v.(string))
-	}
-
-	if v, ok := es["cluster_endpoint"]; ok && v.(string) != "" {
-		config.ClusterEndpoint = aws.String(v.(string))
-	}
-
-	if _, ok := es["cloudwatch_logging_options"]; ok {
-		config.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(es)
-	}
-
-	if _, ok := es["processing_configuration"]; ok {
-		config.ProcessingConfiguration = expandProcessingConfiguration(es, destinationTypeElasticsearch, roleARN)
-	}
-
-	if indexRotationPeriod, ok := es["index_rotation_period"]; ok {
-		config.IndexRotationPeriod = types.ElasticsearchIndexRotationPeriod(indexRotationPeriod.(string))
-	}
-	if s3BackupMode, ok := es["s3_backup_mode"]; ok {
-		config.S3BackupMode = types.ElasticsearchS3BackupMode(s3BackupMode.(string))
-	}
-
-	if _, ok := es[names.AttrVPCConfig]; ok {
-		config.VpcConfiguration = expandVPCConfiguration(es)
-	}
-
-	return config
-}
-
-func expandElasticsearchDestinationUpdate(es map[string]any) *types.ElasticsearchDestinationUpdate {
-	roleARN := es[names.AttrRoleARN].(string)
-	update := &types.ElasticsearchDestinationUpdate{
-		BufferingHints: expandElasticsearchBufferingHints(es),
-		IndexName:      aws.String(es["index_name"].(string)),
-		RetryOptions:   expandElasticsearchRetryOptions(es),
-		RoleARN:        aws.String(roleARN),
-		TypeName:       aws.String(es["type_name"].(string)),
-		S3Update:       expandS3DestinationUpdate(es["s3_configuration"].([]any)),
-	}
-
-	if v, ok := es["domain_arn"]; ok && v.(string) != "" {
-		update.DomainARN = aws.String(v.(string))
-	}
-
-	if v, ok := es["cluster_endpoint"]; ok && v.(string) != "" {
-		update.ClusterEndpoint = aws.String(v.(string))
-	}
-
-	if _, ok := es["cloudwatch_logging_options"]; ok {
-		update.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(es)
-	}
-
-	if _, ok := es["processing_configuration"]; ok {
-		update.ProcessingConfiguration = expandProcessingConfiguration(es, destinationTypeElasticsearch, roleARN)
-	}
-
-	if indexRotationPeriod, ok := es["index_rotation_period"]; ok {
-		update.IndexRotationPeriod = types.ElasticsearchIndexRotationPeriod(indexRotationPeriod.(string))
-	}
-
-	return update
-}
-
-func expandAmazonopensearchserviceDestinationConfiguration(os map[string]any) *types.AmazonopensearchserviceDestinationConfiguration {
-	roleARN := os[names.AttrRoleARN].(string)
-	config := &types.AmazonopensearchserviceDestinationConfiguration{
-		BufferingHints:  expandAmazonopensearchserviceBufferingHints(os),
-		IndexName:       aws.String(os["index_name"].(string)),
-		RetryOptions:    expandAmazonopensearchserviceRetryOptions(os),
-		RoleARN:         aws.String(roleARN),
-		TypeName:        aws.String(os["type_name"].(string)),
-		S3Configuration: expandS3DestinationConfiguration(os["s3_configuration"].([]any)),
-	}
-
-	if v, ok := os["domain_arn"]; ok && v.(string) != "" {
-		config.DomainARN = aws.String(v.(string))
-	}
-
-	if v, ok := os["cluster_endpoint"]; ok && v.(string) != "" {
-		config.ClusterEndpoint = aws.String(v.(string))
-	}
-
-	if _, ok := os["cloudwatch_logging_options"]; ok {
-		config.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(os)
-	}
-
-	if _, ok := os["processing_configuration"]; ok {
-		config.ProcessingConfiguration = expandProcessingConfiguration(os, destinationTypeOpenSearch, roleARN)
-	}
-
-	if indexRotationPeriod, ok := os["index_rotation_period"]; ok {
-		config.IndexRotationPeriod = types.AmazonopensearchserviceIndexRotationPeriod(indexRotationPeriod.(string))
-	}
-	if s3BackupMode, ok := os["s3_backup_mode"]; ok {
-		config.S3BackupMode = types.AmazonopensearchserviceS3BackupMode(s3BackupMode.(string))
-	}
-
-	if _, ok := os[names.AttrVPCConfig]; ok {
-		config.VpcConfiguration = expandVPCConfiguration(os)
-	}
-
-	if v, ok := os["document_id_options"].([]any); ok && len(v) > 0 && v[0] != nil {
-		config.DocumentIdOptions = expandDocumentIDOptions(v[0].(map[string]any))
-	}
-
-	return config
-}
-
-func expandAmazonopensearchserviceDestinationUpdate(os map[string]any) *types.AmazonopensearchserviceDestinationUpdate {
-	roleARN := os[names.AttrRoleARN].(string)
-	update := &types.AmazonopensearchserviceDestinationUpdate{
-		BufferingHints: expandAmazonopensearchserviceBufferingHints(os),
-		IndexName:      aws.String(os["index_name"].(string)),
-		RetryOptions:   expandAmazonopensearchserviceRetryOptions(os),
-		RoleARN:        aws.String(roleARN),
-		TypeName:       aws.String(os["type_name"].(string)),
-		S3Update:       expandS3DestinationUpdate(os["s3_configuration"].([]any)),
-	}
-
-	if v, ok := os["domain_arn"]; ok && v.(string) != "" {
-		update.DomainARN = aws.String(v.(string))
-	}
-
-	if v, ok := os["cluster_endpoint"]; ok && v.(string) != "" {
-		update.ClusterEndpoint = aws.String(v.(string))
-	}
-
-	if _, ok := os["cloudwatch_logging_options"]; ok {
-		update.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(os)
-	}
-
-	if _, ok := os["processing_configuration"]; ok {
-		update.ProcessingConfiguration = expandProcessingConfiguration(os, destinationTypeOpenSearch, roleARN)
-	}
-
-	if indexRotationPeriod, ok := os["index_rotation_period"]; ok {
-		update.IndexRotationPeriod = types.AmazonopensearchserviceIndexRotationPeriod(indexRotationPeriod.(string))
-	}
-
-	if v, ok := os["document_id_options"].([]any); ok && len(v) > 0 && v[0] != nil {
-		update.DocumentIdOptions = expandDocumentIDOptions(v[0].(map[string]any))
-	}
-
-	return update
-}
-
-func expandAmazonOpenSearchServerlessDestinationConfiguration(oss map[string]any) *types.AmazonOpenSearchServerlessDestinationConfiguration {
-	roleARN := oss[names.AttrRoleARN].(string)
-	config := &types.AmazonOpenSearchServerlessDestinationConfiguration{
-		BufferingHints:  expandAmazonOpenSearchServerlessBufferingHints(oss),
-		IndexName:       aws.String(oss["index_name"].(string)),
-		RetryOptions:    expandAmazonOpenSearchServerlessRetryOptions(oss),
-		RoleARN:         aws.String(roleARN),
-		S3Configuration: expandS3DestinationConfiguration(oss["s3_configuration"].([]any)),
-	}
-
-	if v, ok := oss["collection_endpoint"]; ok && v.(string) != "" {
-		config.CollectionEndpoint = aws.String(v.(string))
-	}
-
-	if _, ok := oss["cloudwatch_logging_options"]; ok {
-		config.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(oss)
-	}
-
-	if _, ok := oss["processing_configuration"]; ok {
-		config.ProcessingConfiguration = expandProcessingConfiguration(oss, destinationTypeOpenSearchServerless, roleARN)
-	}
-
-	if s3BackupMode, ok := oss["s3_backup_mode"]; ok {
-		config.S3BackupMode = types.AmazonOpenSearchServerlessS3BackupMode(s3BackupMode.(string))
-	}
-
-	if _, ok := oss[names.AttrVPCConfig]; ok {
-		config.VpcConfiguration = expandVPCConfiguration(oss)
-	}
-
-	return config
-}
-
-func expandAmazonOpenSearchServerlessDestinationUpdate(oss map[string]any) *types.AmazonOpenSearchServerlessDestinationUpdate {
-	roleARN := oss[names.AttrRoleARN].(string)
-	update := &types.AmazonOpenSearchServerlessDestinationUpdate{
-		BufferingHints: expandAmazonOpenSearchServerlessBufferingHints(oss),
-		IndexName:      aws.String(oss["index_name"].(string)),
-		RetryOptions:   expandAmazonOpenSearchServerlessRetryOptions(oss),
-		RoleARN:        aws.String(roleARN),
-		S3Update:       expandS3DestinationUpdate(oss["s3_configuration"].([]any)),
-	}
-	if v, ok := oss["collection_endpoint"]; ok && v.(string) != "" {
-		update.CollectionEndpoint = aws.String(v.(string))
-	}
-
-	if _, ok := oss["cloudwatch_logging_options"]; ok {
-		update.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(oss)
-	}
-
-	if _, ok := oss["processing_configuration"]; ok {
-		update.ProcessingConfiguration = expandProcessingConfiguration(oss, destinationTypeOpenSearchServerless, roleARN)
-	}
-
-	return update
-}
-
-func expandSnowflakeDestinationConfiguration(tfMap map[string]any) *types.SnowflakeDestinationConfiguration {
-	roleARN := tfMap[names.AttrRoleARN].(string)
-	apiObject := &types.SnowflakeDestinationConfiguration{
-		AccountUrl: aws.String(tfMap["account_url"].(string)),
-		BufferingHints: &types.SnowflakeBufferingHints{
-			IntervalInSeconds: aws.Int32(int32(tfMap["buffering_interval"].(int))),
-			SizeInMBs:         aws.Int32(int32(tfMap["buffering_size"].(int))),
-		},
-		Database:                  aws.String(tfMap[names.AttrDatabase].(string)),
-		RetryOptions:              expandSnowflakeRetryOptions(tfMap),
-		RoleARN:                   aws.String(roleARN),
-		S3Configuration:           expandS3DestinationConfiguration(tfMap["s3_configuration"].([]any)),
-		Schema:                    aws.String(tfMap[names.AttrSchema].(string)),
-		SnowflakeVpcConfiguration: expandSnowflakeVPCConfiguration(tfMap),
-		Table:                     aws.String(tfMap["table"].(string)),
-	}
-
-	if _, ok := tfMap["cloudwatch_logging_options"]; ok {
-		apiObject.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(tfMap)
-	}
-
-	if v, ok := tfMap["content_column_name"]; ok && v.(string) != "" {
-		apiObject.ContentColumnName = aws.String(v.(string))
-	}
-
-	if v, ok := tfMap["data_loading_option"]; ok && v.(string) != "" {
-		apiObject.DataLoadingOption = types.SnowflakeDataLoadingOption(v.(string))
-	}
-
-	if v, ok := tfMap[names.AttrPrivateKey]; ok && v.(string) != "" {
-		apiObject.PrivateKey = aws.String(v.(string))
-	}
-
-	if v, ok := tfMap["key_passphrase"]; ok && v.(string) != "" {
-		apiObject.KeyPassphrase = aws.String(v.(string))
-	}
-
-	if v, ok := tfMap["metadata_column_name"]; ok && v.(string) != "" {
-		apiObject.MetaDataColumnName = aws.String(v.(string))
-	}
-
-	if _, ok := tfMap["processing_configuration"]; ok {
-		apiObject.ProcessingConfiguration = expandProcessingConfiguration(tfMap, destinationTypeSnowflake, roleARN)
-	}
-
-	if v, ok := tfMap["s3_backup_mode"]; ok {
-		apiObject.S3BackupMode = types.SnowflakeS3BackupMode(v.(string))
-	}
-
-	if _, ok := tfMap["secrets_manager_configuration"]; ok {
-		apiObject.SecretsManagerConfiguration = expandSecretsManagerConfiguration(tfMap)
-	}
-
-	if _, ok := tfMap["snowflake_role_configuration"]; ok {
-		apiObject.SnowflakeRoleConfiguration = expandSnowflakeRoleConfiguration(tfMap)
-	}
-
-	if _, ok := tfMap["snowflake_vpc_configuration"]; ok {
-		apiObject.SnowflakeVpcConfiguration = expandSnowflakeVPCConfiguration(tfMap)
-	}
-
-	if v, ok := tfMap["user"]; ok && v.(string) != "" {
-		apiObject.User = aws.String(v.(string))
-	}
-
-	return apiObject
-}
-
-func expandSnowflakeDestinationUpdate(tfMap map[string]any) *types.SnowflakeDestinationUpdate {
-	roleARN := tfMap[names.AttrRoleARN].(string)
-	apiObject := &types.SnowflakeDestinationUpdate{
-		AccountUrl: aws.String(tfMap["account_url"].(string)),
-		BufferingHints: &types.SnowflakeBufferingHints{
-			IntervalInSeconds: aws.Int32(int32(tfMap["buffering_interval"].(int))),
-			SizeInMBs:         aws.Int32(int32(tfMap["buffering_size"].(int))),
-		},
-		Database:     aws.String(tfMap[names.AttrDatabase].(string)),
-		RetryOptions: expandSnowflakeRetryOptions(tfMap),
-		RoleARN:      aws.String(roleARN),
-		S3Update:     expandS3DestinationUpdate(tfMap["s3_configuration"].([]any)),
-		Schema:       aws.String(tfMap[names.AttrSchema].(string)),
-		Table:        aws.String(tfMap["table"].(string)),
-	}
-
-	if _, ok := tfMap["cloudwatch_logging_options"]; ok {
-		apiObject.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(tfMap)
-	}
-
-	if v, ok := tfMap["content_column_name"]; ok && v.(string) != "" {
-		apiObject.ContentColumnName = aws.String(v.(string))
-	}
-
-	if v, ok := tfMap["data_loading_option"]; ok && v.(string) != "" {
-		apiObject.DataLoadingOption = types.SnowflakeDataLoadingOption(v.(string))
-	}
-
-	if v, ok := tfMap[names.AttrPrivateKey]; ok && v.(string) != "" {
-		apiObject.PrivateKey = aws.String(v.(string))
-	}
-
-	if v, ok := tfMap["key_passphrase"]; ok && v.(string) != "" {
-		apiObject.KeyPassphrase = aws.String(v.(string))
-	}
-
-	if v, ok := tfMap["metadata_column_name"]; ok && v.(string) != "" {
-		apiObject.MetaDataColumnName = aws.String(v.(string))
-	}
-
-	if _, ok := tfMap["processing_configuration"]; ok {
-		apiObject.ProcessingConfiguration = expandProcessingConfiguration(tfMap, destinationTypeSnowflake, roleARN)
-	}
-
-	if v, ok := tfMap["s3_backup_mode"]; ok {
-		apiObject.S3BackupMode = types.SnowflakeS3BackupMode(v.(string))
-	}
-
-	if _, ok := tfMap["secrets_manager_configuration"]; ok {
-		apiObject.SecretsManagerConfiguration = expandSecretsManagerConfiguration(tfMap)
-	}
-
-	if _, ok := tfMap["snowflake_role_configuration"]; ok {
-		apiObject.SnowflakeRoleConfiguration = expandSnowflakeRoleConfiguration(tfMap)
-	}
-
-	if v, ok := tfMap["user"]; ok && v.(string) != "" {
-		apiObject.User = aws.String(v.(string))
-	}
-
-	return apiObject
-}
-
-func expandSplunkDestinationConfiguration(tfMap map[string]any) *types.SplunkDestinationConfiguration {
-	apiObject := &types.SplunkDestinationConfiguration{
-		HECAcknowledgmentTimeoutInSeconds: aws.Int32(int32(tfMap["hec_acknowledgment_timeout"].(int))),
-		HECEndpoint:                       aws.String(tfMap["hec_endpoint"].(string)),
-		HECEndpointType:                   types.HECEndpointType(tfMap["hec_endpoint_type"].(string)),
-		RetryOptions:                      expandSplunkRetryOptions(tfMap),
-		S3Configuration:                   expandS3DestinationConfiguration(tfMap["s3_configuration"].([]any)),
-	}
-
-	bufferingHints := &types.SplunkBufferingHints{}
-	if bufferingInterval, ok := tfMap["buffering_interval"].(int); ok {
-		bufferingHints.IntervalInSeconds = aws.Int32(int32(bufferingInterval))
-	}
-	if bufferingSize, ok := tfMap["buffering_size"].(int); ok {
-		bufferingHints.SizeInMBs = aws.Int32(int32(bufferingSize))
-	}
-	apiObject.BufferingHints = bufferingHints
-
-	if _, ok := tfMap["cloudwatch_logging_options"]; ok {
-		apiObject.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(tfMap)
-	}
-
-	if v, ok := tfMap["hec_token"]; ok && v.(string) != "" {
-		apiObject.HECToken = aws.String(v.(string))
-	}
-
-	if _, ok := tfMap["processing_configuration"]; ok {
-		apiObject.ProcessingConfiguration = expandProcessingConfiguration(tfMap, destinationTypeSplunk, "")
-	}
-
-	if v, ok := tfMap["s3_backup_mode"]; ok {
-		apiObject.S3BackupMode = types.SplunkS3BackupMode(v.(string))
-	}
-
-	if _, ok := tfMap["secrets_manager_configuration"]; ok {
-		apiObject.SecretsManagerConfiguration = expandSecretsManagerConfiguration(tfMap)
-	}
-
-	return apiObject
-}
-
-func expandSplunkDestinationUpdate(tfMap map[string]any) *types.SplunkDestinationUpdate {
-	apiObject := &types.SplunkDestinationUpdate{
-		HECAcknowledgmentTimeoutInSeconds: aws.Int32(int32(tfMap["hec_acknowledgment_timeout"].(int))),
-		HECEndpoint:                       aws.String(tfMap["hec_endpoint"].(string)),
-		HECEndpointType:                   types.HECEndpointType(tfMap["hec_endpoint_type"].(string)),
-		RetryOptions:                      expandSplunkRetryOptions(tfMap),
-		S3Update:                          expandS3DestinationUpdate(tfMap["s3_configuration"].([]any)),
-	}
-
-	bufferingHints := &types.SplunkBufferingHints{}
-	if bufferingInterval, ok := tfMap["buffering_interval"].(int); ok {
-		bufferingHints.IntervalInSeconds = aws.Int32(int32(bufferingInterval))
-	}
-	if bufferingSize, ok := tfMap["buffering_size"].(int); ok {
-		bufferingHints.SizeInMBs = aws.Int32(int32(bufferingSize))
-	}
-	apiObject.BufferingHints = bufferingHints
-
-	if _, ok := tfMap["cloudwatch_logging_options"]; ok {
-		apiObject.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(tfMap)
-	}
-
-	if v, ok := tfMap["hec_token"]; ok && v.(string) != "" {
-		apiObject.HECToken = aws.String(v.(string))
-	}
-
-	if _, ok := tfMap["processing_configuration"]; ok {
-		apiObject.ProcessingConfiguration = expandProcessingConfiguration(tfMap, destinationTypeSplunk, "")
-	}
-
-	if v, ok := tfMap["s3_backup_mode"]; ok {
-		apiObject.S3BackupMode = types.SplunkS3BackupMode(v.(string))
-	}
-
-	if _, ok := tfMap["secrets_manager_configuration"]; ok {
-		apiObject.SecretsManagerConfiguration = expandSecretsManagerConfiguration(tfMap)
-	}
-
-	return apiObject
-}
-
-func expandHTTPEndpointDestinationConfiguration(tfMap map[string]any) *types.HttpEndpointDestinationConfiguration {
-	roleARN := tfMap[names.AttrRoleARN].(string)
-	apiObject := &types.HttpEndpointDestinationConfiguration{
-		EndpointConfiguration: expandHTTPEndpointConfiguration(tfMap),
-		RetryOptions:          expandHTTPEndpointRetryOptions(tfMap),
-		RoleARN:               aws.String(roleARN),
-		S3Configuration:       expandS3DestinationConfiguration(tfMap["s3_configuration"].([]any)),
-	}
-
-	bufferingHints := &types.HttpEndpointBufferingHints{}
-	if v, ok := tfMap["buffering_interval"].(int); ok {
-		bufferingHints.IntervalInSeconds = aws.Int32(int32(v))
-	}
-	if v, ok := tfMap["buffering_size"].(int); ok {
-		bufferingHints.SizeInMBs = aws.Int32(int32(v))
-	}
-	apiObject.BufferingHints = bufferingHints
-
-	if _, ok := tfMap["cloudwatch_logging_options"]; ok {
-		apiObject.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(tfMap)
-	}
-
-	if _, ok := tfMap["processing_configuration"]; ok {
-		apiObject.ProcessingConfiguration = expandProcessingConfiguration(tfMap, destinationTypeHTTPEndpoint, roleARN)
-	}
-
-	if _, ok := tfMap["request_configuration"]; ok {
-		apiObject.RequestConfiguration = expandHTTPEndpointRequestConfiguration(tfMap)
-	}
-
-	if v, ok := tfMap["s3_backup_mode"]; ok {
-		apiObject.S3BackupMode = types.HttpEndpointS3BackupMode(v.(string))
-	}
-
-	if _, ok := tfMap["secrets_manager_configuration"]; ok {
-		apiObject.SecretsManagerConfiguration = expandSecretsManagerConfiguration(tfMap)
-	}
-
-	return apiObject
-}
-
-func expandHTTPEndpointDestinationUpdate(tfMap map[string]any) *types.HttpEndpointDestinationUpdate {
-	roleARN := tfMap[names.AttrRoleARN].(string)
-	apiObject := &types.HttpEndpointDestinationUpdate{
-		EndpointConfiguration: expandHTTPEndpointConfiguration(tfMap),
-		RetryOptions:          expandHTTPEndpointRetryOptions(tfMap),
-		RoleARN:               aws.String(roleARN),
-		S3Update:              expandS3DestinationUpdate(tfMap["s3_configuration"].([]any)),
-	}
-
-	bufferingHints := &types.HttpEndpointBufferingHints{}
-	if v, ok := tfMap["buffering_interval"].(int); ok {
-		bufferingHints.IntervalInSeconds = aws.Int32(int32(v))
-	}
-	if v, ok := tfMap["buffering_size"].(int); ok {
-		bufferingHints.SizeInMBs = aws.Int32(int32(v))
-	}
-	apiObject.BufferingHints = bufferingHints
-
-	if _, ok := tfMap["cloudwatch_logging_options"]; ok {
-		apiObject.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(tfMap)
-	}
-
-	if _, ok := tfMap["processing_configuration"]; ok {
-		apiObject.ProcessingConfiguration = expandProcessingConfiguration(tfMap, destinationTypeHTTPEndpoint, roleARN)
-	}
-
-	if _, ok := tfMap["request_configuration"]; ok {
-		apiObject.RequestConfiguration = expandHTTPEndpointRequestConfiguration(tfMap)
-	}
-
-	if v, ok := tfMap["s3_backup_mode"]; ok {
-		apiObject.S3BackupMode = types.HttpEndpointS3BackupMode(v.(string))
-	}
-
-	if _, ok := tfMap["secrets_manager_configuration"]; ok {
-		apiObject.SecretsManagerConfiguration = expandSecretsManagerConfiguration(tfMap)
-	}
-
-	return apiObject
-}
-
-func expandHTTPEndpointCommonAttributes(ca []any) []types.HttpEndpointCommonAttribute {
-	commonAttributes := make([]types.HttpEndpointCommonAttribute, 0, len(ca))
-
-	for _, raw := range ca {
-		data := raw.(map[string]any)
-
-		a := types.HttpEndpointCommonAttribute{
-			AttributeName:  aws.String(data[names.AttrName].(string)),
-			AttributeValue: aws.String(data[names.AttrValue].(string)),
-		}
-		commonAttributes = append(commonAttributes, a)
-	}
-
-	return commonAttributes
-}
-
-func expandHTTPEndpointRequestConfiguration(rc map[string]any) *types.HttpEndpointRequestConfiguration {
-	config := rc["request_configuration"].([]any)
-	if len(config) == 0 {
-		return nil
-	}
-
-	requestConfig := config[0].(map[string]any)
-	RequestConfiguration := &types.HttpEndpointRequestConfiguration{}
-
-	if contentEncoding, ok := requestConfig["content_encoding"]; ok {
-		RequestConfiguration.ContentEncoding = types.ContentEncoding(contentEncoding.(string))
-	}
-
-	if commonAttributes, ok := requestConfig["common_attributes"]; ok {
-		RequestConfiguration.CommonAttributes = expandHTTPEndpointCommonAttributes(commonAttributes.([]any))
-	}
-
-	return RequestConfiguration
-}
-
-func expandHTTPEndpointConfiguration(ep map[string]any) *types.HttpEndpointConfiguration {
-	endpointConfiguration := &types.HttpEndpointConfiguration{
-		Url: aws.String(ep[names.AttrURL].(string)),
-	}
-
-	if Name, ok := ep[names.AttrName]; ok {
-		endpointConfiguration.Name = aws.String(Name.(string))
-	}
-
-	if AccessKey, ok := ep[names.AttrAccessKey]; ok {
-		endpointConfiguration.AccessKey = aws.String(AccessKey.(string))
-	}
-
-	return endpointConfiguration
-}
-
-func expandElasticsearchBufferingHints(es map[string]any) *types.ElasticsearchBufferingHints {
-	bufferingHints := &types.ElasticsearchBufferingHints{}
-
-	if bufferingInterval, ok := es["buffering_interval"].(int); ok {
-		bufferingHints.IntervalInSeconds = aws.Int32(int32(bufferingInterval))
-	}
-	if bufferingSize, ok := es["buffering_size"].(int); ok {
-		bufferingHints.SizeInMBs = aws.Int32(int32(bufferingSize))
-	}
-
-	return bufferingHints
-}
-
-func expandAmazonopensearchserviceBufferingHints(es map[string]any) *types.AmazonopensearchserviceBufferingHints {
-	bufferingHints := &types.AmazonopensearchserviceBufferingHints{}
-
-	if bufferingInterval, ok := es["buffering_interval"].(int); ok {
-		bufferingHints.IntervalInSeconds = aws.Int32(int32(bufferingInterval))
-	}
-	if bufferingSize, ok := es["buffering_size"].(int); ok {
-		bufferingHints.SizeInMBs = aws.Int32(int32(bufferingSize))
-	}
-
-	return bufferingHints
-}
-
-func expandAmazonOpenSearchServerlessBufferingHints(es map[string]any) *types.AmazonOpenSearchServerlessBufferingHints {
-	bufferingHints := &types.AmazonOpenSearchServerlessBufferingHints{}
-
-	if bufferingInterval, ok := es["buffering_interval"].(int); ok {
-		bufferingHints.IntervalInSeconds = aws.Int32(int32(bufferingInterval))
-	}
-	if bufferingSize, ok := es["buffering_size"].(int); ok {
-		bufferingHints.SizeInMBs = aws.Int32(int32(bufferingSize))
-	}
-
-	return bufferingHints
-}
-
-func expandIcebergRetryOptions(tfMap map[string]any) *types.RetryOptions {
-	apiObject := &types.RetryOptions{}
-
-	if v, ok := tfMap["retry_duration"].(int); ok {
-		apiObject.DurationInSeconds = aws.Int32(int32(v))
-	}
-
-	return apiObject
-}
-
-func expandElasticsearchRetryOptions(es map[string]any) *types.ElasticsearchRetryOptions {
-	retryOptions := &types.ElasticsearchRetryOptions{}
-
-	if retryDuration, ok := es["retry_duration"].(int); ok {
-		retryOptions.DurationInSeconds = aws.Int32(int32(retryDuration))
-	}
-
-	return retryOptions
-}
-
-func expandAmazonopensearchserviceRetryOptions(es map[string]any) *types.AmazonopensearchserviceRetryOptions {
-	retryOptions := &types.AmazonopensearchserviceRetryOptions{}
-
-	if retryDuration, ok := es["retry_duration"].(int); ok {
-		retryOptions.DurationInSeconds = aws.Int32(int32(retryDuration))
-	}
-
-	return retryOptions
-}
-
-func expandAmazonOpenSearchServerlessRetryOptions(es map[string]any) *types.AmazonOpenSearchServerlessRetryOptions {
-	retryOptions := &types.AmazonOpenSearchServerlessRetryOptions{}
-
-	if retryDuration, ok := es["retry_duration"].(int); ok {
-		retryOptions.DurationInSeconds = aws.Int32(int32(retryDuration))
-	}
-
-	return retryOptions
-}
-
-func expandHTTPEndpointRetryOptions(tfMap map[string]any) *types.HttpEndpointRetryOptions {
-	retryOptions := &types.HttpEndpointRetryOptions{}
-
-	if retryDuration, ok := tfMap["retry_duration"].(int); ok {
-		retryOptions.DurationInSeconds = aws.Int32(int32(retryDuration))
-	}
-
-	return retryOptions
-}
-
-func expandRedshiftRetryOptions(redshift map[string]any) *types.RedshiftRetryOptions {
-	retryOptions := &types.RedshiftRetryOptions{}
-
-	if retryDuration, ok := redshift["retry_duration"].(int); ok {
-		retryOptions.DurationInSeconds = aws.Int32(int32(retryDuration))
-	}
-
-	return retryOptions
-}
-
-func expandSnowflakeRetryOptions(tfMap map[string]any) *types.SnowflakeRetryOptions {
-	apiObject := &types.SnowflakeRetryOptions{}
-
-	if v, ok := tfMap["retry_duration"].(int); ok {
-		apiObject.DurationInSeconds = aws.Int32(int32(v))
-	}
-
-	return apiObject
-}
-
-func expandSnowflakeRoleConfiguration(tfMap map[string]any) *types.SnowflakeRoleConfiguration {
-	config := tfMap["snowflake_role_configuration"].([]any)
-	if len(config) == 0 || config[0] == nil {
-		// It is possible to just pass nil here, but this seems to be the
-		// canonical form that AWS uses, and is less likely to produce diffs.
-		return &types.SnowflakeRoleConfiguration{
-			Enabled: aws.Bool(false),
-		}
-	}
-
-	snowflakeRoleConfiguration := config[0].(map[string]any)
-	apiObject := &types.SnowflakeRoleConfiguration{
-		Enabled: aws.Bool(snowflakeRoleConfiguration[names.AttrEnabled].(bool)),
-	}
-
-	if v, ok := snowflakeRoleConfiguration["snowflake_role"]; ok && len(v.(string)) > 0 {
-		apiObject.SnowflakeRole = aws.String(v.(string))
-	}
-
-	return apiObject
-}
-
-func expandSnowflakeVPCConfiguration(tfMap map[string]any) *types.SnowflakeVpcConfiguration {
-	tfList := tfMap["snowflake_vpc_configuration"].([]any)
-	if len(tfList) == 0 {
-		return nil
-	}
-
-	tfMap = tfList[0].(map[string]any)
-
-	apiObject := &types.SnowflakeVpcConfiguration{
-		PrivateLinkVpceId: aws.String(tfMap["private_link_vpce_id"].(string)),
-	}
-
-	return apiObject
-}
-
-func expandSplunkRetryOptions(splunk map[string]any) *types.SplunkRetryOptions {
-	retryOptions := &types.SplunkRetryOptions{}
-
-	if retryDuration, ok := splunk["retry_duration"].(int); ok {
-		retryOptions.DurationInSeconds = aws.Int32(int32(retryDuration))
-	}
-
-	return retryOptions
-}
-
-func expandDestinationTableConfigurationList(tfMap map[string]any) []types.DestinationTableConfiguration {
-	tfList := tfMap["destination_table_configuration"].([]any)
-	if len(tfList) == 0 {
-		return nil
-	}
-
-	apiObjects := make([]types.DestinationTableConfiguration, 0, len(tfList))
-	for _, table := range tfList {
-		apiObjects = append(apiObjects, expandDestinationTableConfiguration(table.(map[string]any)))
-	}
-
-	return apiObjects
-}
-
-func expandDestinationTableConfiguration(tfMap map[string]any) types.DestinationTableConfiguration {
-	apiObject := types.DestinationTableConfiguration{
-		DestinationDatabaseName: aws.String(tfMap[names.AttrDatabaseName].(string)),
-		DestinationTableName:    aws.String(tfMap[names.AttrTableName].(string)),
-	}
-
-	if v, ok := tfMap["s3_error_output_prefix"].(string); ok {
-		apiObject.S3ErrorOutputPrefix = aws.String(v)
-	}
-
-	if v, ok := tfMap["unique_keys"].([]any); ok {
-		apiObject.UniqueKeys = flex.ExpandStringValueList(v)
-	}
-
-	return apiObject
-}
-
-func expandCopyCommand(redshift map[string]any) *types.CopyCommand {
-	cmd := &types.CopyCommand{
-		DataTableName: aws.String(redshift["data_table_name"].(string)),
-	}
-	if copyOptions, ok := redshift["copy_options"]; ok {
-		cmd.CopyOptions = aws.String(copyOptions.(string))
-	}
-	if columns, ok := redshift["data_table_columns"]; ok {
-		cmd.DataTableColumns = aws.String(columns.(string))
-	}
-
-	return cmd
-}
-
-func expandDeliveryStreamEncryptionConfigurationInput(tfList []any) *types.DeliveryStreamEncryptionConfigurationInput {
-	if len(tfList) == 0 {
-		return nil
-	}
-
-	tfMap, ok := tfList[0].(map[string]any)
-
-	if !ok {
-		return nil
-	}
-
-	apiObject := &types.DeliveryStreamEncryptionConfigurationInput{}
-
-	if v, ok := tfMap["key_arn"].(string); ok && v != "" {
-		apiObject.KeyARN = aws.String(v)
-	}
-
-	if v, ok := tfMap["key_type"].(string); ok && v != "" {
-		apiObject.KeyType = types.KeyType(v)
-	}
-
-	return apiObject
-}
-
-func expandMSKSourceConfiguration(tfMap map[string]any) *types.MSKSourceConfiguration {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &types.MSKSourceConfiguration{}
-
-	if v, ok := tfMap["authentication_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
-		apiObject.AuthenticationConfiguration = expandAuthenticationConfiguration(v[0].(map[string]any))
-	}
-
-	if v, ok := tfMap["msk_cluster_arn"].(string); ok && v != "" {
-		apiObject.MSKClusterARN = aws.String(v)
-	}
-
-	if v, ok := tfMap["read_from_timestamp"].(string); ok && v != "" {
-		v, _ := time.Parse(time.RFC3339, v)
-		apiObject.ReadFromTimestamp = aws.Time(v)
-	}
-
-	if v, ok := tfMap["topic_name"].(string); ok && v != "" {
-		apiObject.TopicName = aws.String(v)
-	}
-
-	return apiObject
-}
-
-func expandAuthenticationConfiguration(tfMap map[string]any) *types.AuthenticationConfiguration {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &types.AuthenticationConfiguration{}
-
-	if v, ok := tfMap["connectivity"].(string); ok && v != "" {
-		apiObject.Connectivity = types.Connectivity(v)
-	}
-
-	if v, ok := tfMap[names.AttrRoleARN].(string); ok && v != "" {
-		apiObject.RoleARN = aws.String(v)
-	}
-
-	return apiObject
-}
-
-func flattenMSKSourceDescription(apiObject *types.MSKSourceDescription) map[string]any {
-	if apiObject == nil {
-		return nil
-	}
-
-	tfMap := map[string]any{}
-
-	if v := apiObject.AuthenticationConfiguration; v != nil {
-		tfMap["authentication_configuration"] = []any{flattenAuthenticationConfiguration(v)}
-	}
-
-	if v := apiObject.MSKClusterARN; v != nil {
-		tfMap["msk_cluster_arn"] = aws.ToString(v)
-	}
-
-	if v := apiObject.ReadFromTimestamp; v != nil {
-		tfMap["read_from_timestamp"] = aws.ToTime(v).Format(time.RFC3339)
-	}
-
-	if v := apiObject.TopicName; v != nil {
-		tfMap["topic_name"] = aws.ToString(v)
-	}
-
-	return tfMap
-}
-
-func flattenAuthenticationConfiguration(apiObject *types.AuthenticationConfiguration) map[string]any {
-	if apiObject == nil {
-		return nil
-	}
-
-	tfMap := map[string]any{
-		"connectivity": apiObject.Connectivity,
-	}
-
-	if v := apiObject.RoleARN; v != nil {
-		tfMap[names.AttrRoleARN] = aws.ToString(v)
-	}
-
-	return tfMap
-}
-
-func flattenCloudWatchLoggingOptions(clo *types.CloudWatchLoggingOptions) []any {
-	if clo == nil {
-		return []any{}
-	}
-
-	cloudwatchLoggingOptions := map[string]any{
-		names.AttrEnabled: aws.ToBool(clo.Enabled),
-	}
-	if aws.ToBool(clo.Enabled) {
-		cloudwatchLoggingOptions[names.AttrLogGroupName] = aws.ToString(clo.LogGroupName)
-		cloudwatchLoggingOptions["log_stream_name"] = aws.ToString(clo.LogStreamName)
-	}
-	return []any{cloudwatchLoggingOptions}
-}
-
-func flattenElasticsearchDestinationDescription(description *types.ElasticsearchDestinationDescription) []map[string]any {
-	if description == nil {
-		return []map[string]any{}
-	}
-
-	m := map[string]any{
-		"cloudwatch_logging_options": flattenCloudWatchLoggingOptions(description.CloudWatchLoggingOptions),
-		names.AttrRoleARN:            aws.ToString(description.RoleARN),
-		"type_name":                  aws.ToString(description.TypeName),
-		"index_name":                 aws.ToString(description.IndexName),
-		"s3_backup_mode":             description.S3BackupMode,
-		"s3_configuration":           flattenS3DestinationDescription(description.S3DestinationDescription),
-		"index_rotation_period":      description.IndexRotationPeriod,
-		names.AttrVPCConfig:          flattenVPCConfigurationDescription(description.VpcConfigurationDescription),
-		"processing_configuration":   flattenProcessingConfiguration(description.ProcessingConfiguration, destinationTypeElasticsearch, aws.ToString(description.RoleARN)),
-	}
-
-	if description.DomainARN != nil {
-		m["domain_arn"] = aws.ToString(description.DomainARN)
-	}
-
-	if description.ClusterEndpoint != nil {
-		m["cluster_endpoint"] = aws.ToString(description.ClusterEndpoint)
-	}
-
-	if description.BufferingHints != nil {
-		m["buffering_interval"] = int(aws.ToInt32(description.BufferingHints.IntervalInSeconds))
-		m["buffering_size"] = int(aws.ToInt32(description.BufferingHints.SizeInMBs))
-	}
-
-	if description.RetryOptions != nil {
-		m["retry_duration"] = int(aws.ToInt32(description.RetryOptions.DurationInSeconds))
-	}
-
-	return []map[string]any{m}
-}
-
-func flattenAmazonopensearchserviceDestinationDescription(description *types.AmazonopensearchserviceDestinationDescription) []map[string]any {
-	if description == nil {
-		return []map[string]any{}
-	}
-
-	m := map[string]any{
-		"cloudwatch_logging_options": flattenCloudWatchLoggingOptions(description.CloudWatchLoggingOptions),
-		names.AttrRoleARN:            aws.ToString(description.RoleARN),
-		"type_name":                  aws.ToString(description.TypeName),
-		"index_name":                 aws.ToString(description.IndexName),
-		"s3_backup_mode":             description.S3BackupMode,
-		"s3_configuration":           flattenS3DestinationDescription(description.S3DestinationDescription),
-		"index_rotation_period":      description.IndexRotationPeriod,
-		names.AttrVPCConfig:          flattenVPCConfigurationDescription(description.VpcConfigurationDescription),
-		"processing_configuration":   flattenProcessingConfiguration(description.ProcessingConfiguration, destinationTypeOpenSearch, aws.ToString(description.RoleARN)),
-	}
-
-	if description.DomainARN != nil {
-		m["domain_arn"] = aws.ToString(description.DomainARN)
-	}
-
-	if description.ClusterEndpoint != nil {
-		m["cluster_endpoint"] = aws.ToString(description.ClusterEndpoint)
-	}
-
-	if description.BufferingHints != nil {
-		m["buffering_interval"] = int(aws.ToInt32(description.BufferingHints.IntervalInSeconds))
-		m["buffering_size"] = int(aws.ToInt32(description.BufferingHints.SizeInMBs))
-	}
-
-	if description.RetryOptions != nil {
-		m["retry_duration"] = int(aws.ToInt32(description.RetryOptions.DurationInSeconds))
-	}
-
-	if v := description.DocumentIdOptions; v != nil {
-		m["document_id_options"] = []any{flattenDocumentIDOptions(v)}
-	}
-
-	return []map[string]any{m}
-}
-
-func flattenAmazonOpenSearchServerlessDestinationDescription(description *types.AmazonOpenSearchServerlessDestinationDescription) []map[string]any {
-	if description == nil {
-		return []map[string]any{}
-	}
-
-	m := map[string]any{
-		"cloudwatch_logging_options": flattenCloudWatchLoggingOptions(description.CloudWatchLoggingOptions),
-		names.AttrRoleARN:            aws.ToString(description.RoleARN),
-		"index_name":                 aws.ToString(description.IndexName),
-		"s3_backup_mode":             description.S3BackupMode,
-		"s3_configuration":           flattenS3DestinationDescription(description.S3DestinationDescription),
-		names.AttrVPCConfig:          flattenVPCConfigurationDescription(description.VpcConfigurationDescription),
-		"processing_configuration":   flattenProcessingConfiguration(description.ProcessingConfiguration, destinationTypeOpenSearchServerless, aws.ToString(description.RoleARN)),
-	}
-
-	if description.CollectionEndpoint != nil {
-		m["collection_endpoint"] = aws.ToString(description.CollectionEndpoint)
-	}
-
-	if description.BufferingHints != nil {
-		m["buffering_interval"] = int(aws.ToInt32(description.BufferingHints.IntervalInSeconds))
-		m["buffering_size"] = int(aws.ToInt32(description.BufferingHints.SizeInMBs))
-	}
-
-	if description.RetryOptions != nil {
-		m["retry_duration"] = int(aws.ToInt32(description.RetryOptions.DurationInSeconds))
-	}
-
-	return []map[string]any{m}
-}
-
-func flattenVPCConfigurationDescription(description *types.VpcConfigurationDescription) []map[string]any {
-	if description == nil {
-		return []map[string]any{}
-	}
-
-	m := map[string]any{
-		names.AttrVPCID:            aws.ToString(description.VpcId),
-		names.AttrSubnetIDs:        description.SubnetIds,
-		names.AttrSecurityGroupIDs: description.SecurityGroupIds,
-		names.AttrRoleARN:          aws.ToString(description.RoleARN),
-	}
-
-	return []map[string]any{m}
-}
-
-func flattenExtendedS3DestinationDescription(description *types.ExtendedS3DestinationDescription) []map[string]any {
-	if description == nil {
-		return []map[string]any{}
-	}
-
-	m := map[string]any{
-		"bucket_arn":                           aws.ToString(description.BucketARN),
-		"cloudwatch_logging_options":           flattenCloudWatchLoggingOptions(description.CloudWatchLoggingOptions),
-		"compression_format":                   description.CompressionFormat,
-		"custom_time_zone":                     aws.ToString(description.CustomTimeZone),
-		"data_format_conversion_configuration": flattenDataFormatConversionConfiguration(description.DataFormatConversionConfiguration),
-		"error_output_prefix":                  aws.ToString(description.ErrorOutputPrefix),
-		"file_extension":                       aws.ToString(description.FileExtension),
-		names.AttrPrefix:                       aws.ToString(description.Prefix),
-		"processing_configuration":             flattenProcessingConfiguration(description.ProcessingConfiguration, destinationTypeExtendedS3, aws.ToString(description.RoleARN)),
-		"dynamic_partitioning_configuration":   flattenDynamicPartitioningConfiguration(description.DynamicPartitioningConfiguration),
-		names.AttrRoleARN:                      aws.ToString(description.RoleARN),
-		"s3_backup_configuration":              flattenS3DestinationDescription(description.S3BackupDescription),
-		"s3_backup_mode":                       description.S3BackupMode,
-	}
-
-	if description.BufferingHints != nil {
-		m["buffering_interval"] = int(aws.ToInt32(description.BufferingHints.IntervalInSeconds))
-		m["buffering_size"] = int(aws.ToInt32(description.BufferingHints.SizeInMBs))
-	}
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Kinesis Firehose Delivery Stream (%s)", name), err.Error())
 
-	if description.EncryptionConfiguration != nil && description.EncryptionConfiguration.KMSEncryptionConfig != nil {
-		m[names.AttrKMSKeyARN] = aws.ToString(description.EncryptionConfiguration.KMSEncryptionConfig.AWSKMSKeyARN)
+		return
 	}
 
-	return []map[string]any{m}
-}
+	output, err := waitDeliveryStreamCreated(ctx, conn, name, r.CreateTimeout(ctx, data.Timeouts))
 
-func flattenRedshiftDestinationDescription(apiObject *types.RedshiftDestinationDescription, configuredPassword string) []any {
-	if apiObject == nil {
-		return []any{}
-	}
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Kinesis Firehose Delivery Stream (%s) create", name), err.Error())
 
-	tfMap := map[string]any{
-		"cloudwatch_logging_options":    flattenCloudWatchLoggingOptions(apiObject.CloudWatchLoggingOptions),
-		"cluster_jdbcurl":               aws.ToString(apiObject.ClusterJDBCURL),
-		names.AttrPassword:              configuredPassword,
-		"processing_configuration":      flattenProcessingConfiguration(apiObject.ProcessingConfiguration, destinationTypeRedshift, aws.ToString(apiObject.RoleARN)),
-		names.AttrRoleARN:               aws.ToString(apiObject.RoleARN),
-		"s3_backup_configuration":       flattenS3DestinationDescription(apiObject.S3BackupDescription),
-		"s3_backup_mode":                apiObject.S3BackupMode,
-		"s3_configuration":              flattenS3DestinationDescription(apiObject.S3DestinationDescription),
-		"secrets_manager_configuration": flattenSecretsManagerConfiguration(apiObject.SecretsManagerConfiguration),
-		names.AttrUsername:              aws.ToString(apiObject.Username),
+		return
 	}
 
-	if apiObject.CopyCommand != nil {
-		tfMap["copy_options"] = aws.ToString(apiObject.CopyCommand.CopyOptions)
-		tfMap["data_table_columns"] = aws.ToString(apiObject.CopyCommand.DataTableColumns)
-		tfMap["data_table_name"] = aws.ToString(apiObject.CopyCommand.DataTableName)
-	}
+	data.ID = fwflex.StringToFramework(ctx, output.DeliveryStreamARN)
 
-	if apiObject.RetryOptions != nil {
-		tfMap["retry_duration"] = aws.ToInt32(apiObject.RetryOptions.DurationInSeconds)
+	sse, diags := data.serverSideEncryption(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	return []any{tfMap}
-}
+	if sse.Enabled.ValueBool() {
+		input := firehose.StartDeliveryStreamEncryptionInput{
+			DeliveryStreamEncryptionConfigurationInput: sse.expand(ctx),
+			DeliveryStreamName:                         aws.String(name),
+		}
 
-func flattenSnowflakeDestinationDescription(apiObject *types.SnowflakeDestinationDescription, configuredKeyPassphrase, configuredPrivateKey string) []any {
-	if apiObject == nil {
-		return []any{}
-	}
+		_, err := conn.StartDeliveryStreamEncryption(ctx, &input)
 
-	roleARN := aws.ToString(apiObject.RoleARN)
-	tfMap := map[string]any{
-		"account_url":                   aws.ToString(apiObject.AccountUrl),
-		"cloudwatch_logging_options":    flattenCloudWatchLoggingOptions(apiObject.CloudWatchLoggingOptions),
-		"content_column_name":           aws.ToString(apiObject.ContentColumnName),
-		"data_loading_option":           apiObject.DataLoadingOption,
-		names.AttrDatabase:              aws.ToString(apiObject.Database),
-		"key_passphrase":                configuredKeyPassphrase,
-		"metadata_column_name":          aws.ToString(apiObject.MetaDataColumnName),
-		names.AttrPrivateKey:            configuredPrivateKey,
-		"processing_configuration":      flattenProcessingConfiguration(apiObject.ProcessingConfiguration, destinationTypeSnowflake, roleARN),
-		names.AttrRoleARN:               roleARN,
-		"s3_backup_mode":                apiObject.S3BackupMode,
-		"s3_configuration":              flattenS3DestinationDescription(apiObject.S3DestinationDescription),
-		names.AttrSchema:                aws.ToString(apiObject.Schema),
-		"secrets_manager_configuration": flattenSecretsManagerConfiguration(apiObject.SecretsManagerConfiguration),
-		"snowflake_role_configuration":  flattenSnowflakeRoleConfiguration(apiObject.SnowflakeRoleConfiguration),
-		"snowflake_vpc_configuration":   flattenSnowflakeVPCConfiguration(apiObject.SnowflakeVpcConfiguration),
-		"table":                         aws.ToString(apiObject.Table),
-		"user":                          aws.ToString(apiObject.User),
-	}
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("starting Kinesis Firehose Delivery Stream (%s) encryption", name), err.Error())
 
-	if v := apiObject.BufferingHints; v != nil {
-		if v.IntervalInSeconds != nil {
-			tfMap["buffering_interval"] = int(aws.ToInt32(v.IntervalInSeconds))
+			return
 		}
-		if v.SizeInMBs != nil {
-			tfMap["buffering_size"] = int(aws.ToInt32(v.SizeInMBs))
-		}
-	}
 
-	if apiObject.RetryOptions != nil {
-		tfMap["retry_duration"] = int(aws.ToInt32(apiObject.RetryOptions.DurationInSeconds))
-	}
-
-	return []any{tfMap}
-}
+		if _, err := waitDeliveryStreamEncryptionEnabled(ctx, conn, name, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Kinesis Firehose Delivery Stream (%s) encryption enable", name), err.Error())
 
-func flattenSplunkDestinationDescription(apiObject *types.SplunkDestinationDescription) []any {
-	if apiObject == nil {
-		return []any{}
+			return
+		}
 	}
 
-	tfMap := map[string]any{
-		"cloudwatch_logging_options":    flattenCloudWatchLoggingOptions(apiObject.CloudWatchLoggingOptions),
-		"hec_acknowledgment_timeout":    int(aws.ToInt32(apiObject.HECAcknowledgmentTimeoutInSeconds)),
-		"hec_endpoint":                  aws.ToString(apiObject.HECEndpoint),
-		"hec_endpoint_type":             apiObject.HECEndpointType,
-		"hec_token":                     aws.ToString(apiObject.HECToken),
-		"processing_configuration":      flattenProcessingConfiguration(apiObject.ProcessingConfiguration, destinationTypeSplunk, ""),
-		"s3_backup_mode":                apiObject.S3BackupMode,
-		"s3_configuration":              flattenS3DestinationDescription(apiObject.S3DestinationDescription),
-		"secrets_manager_configuration": flattenSecretsManagerConfiguration(apiObject.SecretsManagerConfiguration),
-	}
+	// Set values for unknowns.
+	output, err = findDeliveryStreamByName(ctx, conn, name)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Kinesis Firehose Delivery Stream (%s)", name), err.Error())
 
-	if apiObject.BufferingHints != nil {
-		tfMap["buffering_interval"] = int(aws.ToInt32(apiObject.BufferingHints.IntervalInSeconds))
-		tfMap["buffering_size"] = int(aws.ToInt32(apiObject.BufferingHints.SizeInMBs))
+		return
 	}
 
-	if apiObject.RetryOptions != nil {
-		tfMap["retry_duration"] = int(aws.ToInt32(apiObject.RetryOptions.DurationInSeconds))
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	return []any{tfMap}
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
 
-func flattenS3DestinationDescription(description *types.S3DestinationDescription) []map[string]any {
-	if description == nil {
-		return []map[string]any{}
-	}
-
-	m := map[string]any{
-		"bucket_arn":                 aws.ToString(description.BucketARN),
-		"cloudwatch_logging_options": flattenCloudWatchLoggingOptions(description.CloudWatchLoggingOptions),
-		"compression_format":         description.CompressionFormat,
-		"error_output_prefix":        aws.ToString(description.ErrorOutputPrefix),
-		names.AttrPrefix:             aws.ToString(description.Prefix),
-		names.AttrRoleARN:            aws.ToString(description.RoleARN),
+func (r *deliveryStreamResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data deliveryStreamResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	if description.BufferingHints != nil {
-		m["buffering_interval"] = int(aws.ToInt32(description.BufferingHints.IntervalInSeconds))
-		m["buffering_size"] = int(aws.ToInt32(description.BufferingHints.SizeInMBs))
-	}
+	conn := r.Meta().FirehoseClient(ctx)
 
-	if description.EncryptionConfiguration != nil && description.EncryptionConfiguration.KMSEncryptionConfig != nil {
-		m[names.AttrKMSKeyARN] = aws.ToString(description.EncryptionConfiguration.KMSEncryptionConfig.AWSKMSKeyARN)
-	}
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	output, err := findDeliveryStreamByName(ctx, conn, name)
 
-	return []map[string]any{m}
-}
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
 
-func flattenDataFormatConversionConfiguration(dfcc *types.DataFormatConversionConfiguration) []map[string]any {
-	if dfcc == nil {
-		return []map[string]any{}
+		return
 	}
 
-	enabled := aws.ToBool(dfcc.Enabled)
-	ifc := flattenInputFormatConfiguration(dfcc.InputFormatConfiguration)
-	ofc := flattenOutputFormatConfiguration(dfcc.OutputFormatConfiguration)
-	sc := flattenSchemaConfiguration(dfcc.SchemaConfiguration)
-
-	// The AWS SDK can represent "no data format conversion configuration" in two ways:
-	// 1. With a nil value
-	// 2. With enabled set to false and nil for ALL the config sections.
-	// We normalize this with an empty configuration in the state due
-	// to the existing Default: true on the enabled attribute.
-	if !enabled && len(ifc) == 0 && len(ofc) == 0 && len(sc) == 0 {
-		return []map[string]any{}
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Kinesis Firehose Delivery Stream (%s)", name), err.Error())
+
+		return
 	}
 
-	m := map[string]any{
-		names.AttrEnabled:             enabled,
-		"input_format_configuration":  ifc,
-		"output_format_configuration": ofc,
-		"schema_configuration":        sc,
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	return []map[string]any{m}
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
-func flattenInputFormatConfiguration(ifc *types.InputFormatConfiguration) []map[string]any {
-	if ifc == nil {
-		return []map[string]any{}
+func (r *deliveryStreamResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new deliveryStreamResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
-
-	m := map[string]any{
-		"deserializer": flattenDeserializer(ifc.Deserializer),
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	return []map[string]any{m}
-}
+	conn := r.Meta().FirehoseClient(ctx)
 
-func flattenDeserializer(deserializer *types.Deserializer) []map[string]any {
-	if deserializer == nil {
-		return []map[string]any{}
-	}
+	name := fwflex.StringValueFromFramework(ctx, new.Name)
 
-	m := map[string]any{
-		"hive_json_ser_de":   flattenHiveJSONSerDe(deserializer.HiveJsonSerDe),
-		"open_x_json_ser_de": flattenOpenXJSONSerDe(deserializer.OpenXJsonSerDe),
-	}
+	if !new.destinationConfiguration().Equal(old.destinationConfiguration()) {
+		destination, diags := new.destination(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
 
-	return []map[string]any{m}
-}
+		input := firehose.UpdateDestinationInput{
+			CurrentDeliveryStreamVersionId: fwflex.StringFromFramework(ctx, old.VersionID),
+			DeliveryStreamName:             aws.String(name),
+			DestinationId:                  fwflex.StringFromFramework(ctx, old.DestinationID),
+		}
 
-func flattenHiveJSONSerDe(hjsd *types.HiveJsonSerDe) []map[string]any {
-	if hjsd == nil {
-		return []map[string]any{}
-	}
+		if destination != nil {
+			response.Diagnostics.Append(destination.expandUpdate(ctx, &input)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
 
-	m := map[string]any{
-		"timestamp_formats": hjsd.TimestampFormats,
-	}
+		_, err := retryDeliveryStreamOp(ctx, func(ctx context.Context) (any, error) {
+			return conn.UpdateDestination(ctx, &input)
+		})
 
-	return []map[string]any{m}
-}
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Kinesis Firehose Delivery Stream (%s)", name), err.Error())
 
-func flattenOpenXJSONSerDe(oxjsd *types.OpenXJsonSerDe) []map[string]any {
-	if oxjsd == nil {
-		return []map[string]any{}
+			return
+		}
 	}
 
-	m := map[string]any{
-		"column_to_json_key_mappings":              oxjsd.ColumnToJsonKeyMappings,
-		"convert_dots_in_json_keys_to_underscores": aws.ToBool(oxjsd.ConvertDotsInJsonKeysToUnderscores),
+	oldSSE, diags := old.serverSideEncryption(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	newSSE, diags := new.serverSideEncryption(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	// API omits default values
-	// Return defaults that are not type zero values to prevent extraneous difference
+	if !newSSE.equal(oldSSE) {
+		if !newSSE.Enabled.ValueBool() {
+			input := firehose.StopDeliveryStreamEncryptionInput{
+				DeliveryStreamName: aws.String(name),
+			}
 
-	m["case_insensitive"] = true
-	if oxjsd.CaseInsensitive != nil {
-		m["case_insensitive"] = aws.ToBool(oxjsd.CaseInsensitive)
-	}
+			_, err := conn.StopDeliveryStreamEncryption(ctx, &input)
 
-	return []map[string]any{m}
-}
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("stopping Kinesis Firehose Delivery Stream (%s) encryption", name), err.Error())
 
-func flattenOutputFormatConfiguration(ofc *types.OutputFormatConfiguration) []map[string]any {
-	if ofc == nil {
-		return []map[string]any{}
-	}
+				return
+			}
 
-	m := map[string]any{
-		"serializer": flattenSerializer(ofc.Serializer),
-	}
+			if _, err := waitDeliveryStreamEncryptionDisabled(ctx, conn, name, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("waiting for Kinesis Firehose Delivery Stream (%s) encryption disable", name), err.Error())
 
-	return []map[string]any{m}
-}
+				return
+			}
+		} else {
+			input := firehose.StartDeliveryStreamEncryptionInput{
+				DeliveryStreamEncryptionConfigurationInput: newSSE.expand(ctx),
+				DeliveryStreamName:                         aws.String(name),
+			}
 
-func flattenSerializer(serializer *types.Serializer) []map[string]any {
-	if serializer == nil {
-		return []map[string]any{}
-	}
+			_, err := conn.StartDeliveryStreamEncryption(ctx, &input)
 
-	m := map[string]any{
-		"orc_ser_de":     flattenOrcSerDe(serializer.OrcSerDe),
-		"parquet_ser_de": flattenParquetSerDe(serializer.ParquetSerDe),
-	}
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("starting Kinesis Firehose Delivery Stream (%s) encryption", name), err.Error())
 
-	return []map[string]any{m}
-}
+				return
+			}
 
-func flattenOrcSerDe(osd *types.OrcSerDe) []map[string]any {
-	if osd == nil {
-		return []map[string]any{}
-	}
+			if _, err := waitDeliveryStreamEncryptionEnabled(ctx, conn, name, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("waiting for Kinesis Firehose Delivery Stream (%s) encryption enable", name), err.Error())
 
-	m := map[string]any{
-		"bloom_filter_columns":     osd.BloomFilterColumns,
-		"dictionary_key_threshold": aws.ToFloat64(osd.DictionaryKeyThreshold),
-		"enable_padding":           aws.ToBool(osd.EnablePadding),
+				return
+			}
+		}
 	}
 
-	// API omits default values
-	// Return defaults that are not type zero values to prevent extraneous difference
+	// Set values for unknowns.
+	output, err := findDeliveryStreamByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Kinesis Firehose Delivery Stream (%s)", name), err.Error())
 
-	m["block_size_bytes"] = 268435456
-	if osd.BlockSizeBytes != nil {
-		m["block_size_bytes"] = int(aws.ToInt32(osd.BlockSizeBytes))
+		return
 	}
 
-	m["bloom_filter_false_positive_probability"] = 0.05
-	if osd.BloomFilterFalsePositiveProbability != nil {
-		m["bloom_filter_false_positive_probability"] = aws.ToFloat64(osd.BloomFilterFalsePositiveProbability)
+	response.Diagnostics.Append(new.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	m["compression"] = types.OrcCompressionSnappy
-	if osd.Compression != "" {
-		m["compression"] = osd.Compression
-	}
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
 
-	m["format_version"] = types.OrcFormatVersionV012
-	if osd.FormatVersion != "" {
-		m["format_version"] = osd.FormatVersion
+func (r *deliveryStreamResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data deliveryStreamResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	m["padding_tolerance"] = 0.05
-	if osd.PaddingTolerance != nil {
-		m["padding_tolerance"] = aws.ToFloat64(osd.PaddingTolerance)
+	conn := r.Meta().FirehoseClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	input := firehose.DeleteDeliveryStreamInput{
+		DeliveryStreamName: aws.String(name),
 	}
+	_, err := conn.DeleteDeliveryStream(ctx, &input)
 
-	m["row_index_stride"] = 10000
-	if osd.RowIndexStride != nil {
-		m["row_index_stride"] = int(aws.ToInt32(osd.RowIndexStride))
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
 	}
 
-	m["stripe_size_bytes"] = 67108864
-	if osd.StripeSizeBytes != nil {
-		m["stripe_size_bytes"] = int(aws.ToInt32(osd.StripeSizeBytes))
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Kinesis Firehose Delivery Stream (%s)", name), err.Error())
+
+		return
 	}
 
-	return []map[string]any{m}
-}
+	if _, err := waitDeliveryStreamDeleted(ctx, conn, name, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Kinesis Firehose Delivery Stream (%s) delete", name), err.Error())
 
-func flattenParquetSerDe(psd *types.ParquetSerDe) []map[string]any {
-	if psd == nil {
-		return []map[string]any{}
+		return
 	}
+}
 
-	m := map[string]any{
-		"enable_dictionary_compression": aws.ToBool(psd.EnableDictionaryCompression),
-		"max_padding_bytes":             int(aws.ToInt32(psd.MaxPaddingBytes)),
+func (r *deliveryStreamResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	const (
+		deliveryStreamResourceIDPartCount = 2
+	)
+	resARN, err := arn.Parse(request.ID)
+	var parts []string
+	if err == nil {
+		parts = strings.Split(resARN.Resource, "/")
 	}
 
-	// API omits default values
-	// Return defaults that are not type zero values to prevent extraneous difference
+	if len(parts) != deliveryStreamResourceIDPartCount {
+		response.Diagnostics.AddError(
+			"Resource Import Invalid ID",
+			fmt.Sprintf("Expected ID in format of arn:PARTITION:firehose:REGION:ACCOUNTID:deliverystream/NAME and provided: %s", request.ID),
+		)
 
-	m["block_size_bytes"] = 268435456
-	if psd.BlockSizeBytes != nil {
-		m["block_size_bytes"] = int(aws.ToInt32(psd.BlockSizeBytes))
+		return
 	}
 
-	m["compression"] = types.ParquetCompressionSnappy
-	if psd.Compression != "" {
-		m["compression"] = psd.Compression
-	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrName), parts[1])...)
+}
 
-	m["page_size_bytes"] = 1048576
-	if psd.PageSizeBytes != nil {
-		m["page_size_bytes"] = int(aws.ToInt32(psd.PageSizeBytes))
+func (r *deliveryStreamResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data deliveryStreamResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	m["writer_version"] = types.ParquetWriterVersionV1
-	if psd.WriterVersion != "" {
-		m["writer_version"] = psd.WriterVersion
+	if data.Destination.IsNull() || data.Destination.IsUnknown() {
+		return
 	}
 
-	return []map[string]any{m}
+	destinationType := data.Destination.ValueEnum()
+	if v := data.destinationConfigurationFor(destinationType); v.IsNull() {
+		response.Diagnostics.Append(fwdiag.NewAttributeRequiredWhenError(
+			path.Root(destinationType.configurationBlockName()),
+			path.Root(names.AttrDestination),
+			string(destinationType),
+		))
+	}
 }
 
-func flattenSchemaConfiguration(sc *types.SchemaConfiguration) []map[string]any {
-	if sc == nil {
-		return []map[string]any{}
-	}
+func (r *deliveryStreamResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var response resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &response)
+	s, meta := response.Schema, r.Meta()
 
-	m := map[string]any{
-		names.AttrCatalogID:    aws.ToString(sc.CatalogId),
-		names.AttrDatabaseName: aws.ToString(sc.DatabaseName),
-		names.AttrRegion:       aws.ToString(sc.Region),
-		names.AttrRoleARN:      aws.ToString(sc.RoleARN),
-		names.AttrTableName:    aws.ToString(sc.TableName),
-		"version_id":           aws.ToString(sc.VersionId),
+	return map[int64]resource.StateUpgrader{
+		0: framework.NewSDKv2StateUpgrader(s, meta, upgradeDeliveryStreamResourceStateV0toV1),
+		1: framework.NewSDKv2StateUpgrader(s, meta),
 	}
+}
 
-	return []map[string]any{m}
+type deliveryStreamResourceModel struct {
+	framework.WithRegionModel
+	ARN                               types.String                                                            `tfsdk:"arn"`
+	Destination                       fwtypes.StringEnum[destinationType]                                     `tfsdk:"destination"`
+	DestinationID                     types.String                                                            `tfsdk:"destination_id"`
+	ElasticsearchConfiguration        fwtypes.ListNestedObjectValueOf[elasticsearchConfigurationModel]        `tfsdk:"elasticsearch_configuration"`
+	ExtendedS3Configuration           fwtypes.ListNestedObjectValueOf[extendedS3ConfigurationModel]           `tfsdk:"extended_s3_configuration"`
+	HTTPEndpointConfiguration         fwtypes.ListNestedObjectValueOf[httpEndpointConfigurationModel]         `tfsdk:"http_endpoint_configuration"`
+	IcebergConfiguration              fwtypes.ListNestedObjectValueOf[icebergConfigurationModel]              `tfsdk:"iceberg_configuration"`
+	ID                                types.String                                                            `tfsdk:"id"`
+	KinesisSourceConfiguration        fwtypes.ListNestedObjectValueOf[kinesisSourceConfigurationModel]        `tfsdk:"kinesis_source_configuration"`
+	MSKSourceConfiguration            fwtypes.ListNestedObjectValueOf[mskSourceConfigurationModel]            `tfsdk:"msk_source_configuration"`
+	Name                              types.String                                                            `tfsdk:"name"`
+	OpenSearchConfiguration           fwtypes.ListNestedObjectValueOf[openSearchConfigurationModel]           `tfsdk:"opensearch_configuration"`
+	OpenSearchServerlessConfiguration fwtypes.ListNestedObjectValueOf[openSearchServerlessConfigurationModel] `tfsdk:"opensearchserverless_configuration"`
+	RedshiftConfiguration             fwtypes.ListNestedObjectValueOf[redshiftConfigurationModel]             `tfsdk:"redshift_configuration"`
+	ServerSideEncryption              fwtypes.ListNestedObjectValueOf[serverSideEncryptionModel]              `tfsdk:"server_side_encryption"`
+	SnowflakeConfiguration            fwtypes.ListNestedObjectValueOf[snowflakeConfigurationModel]            `tfsdk:"snowflake_configuration"`
+	SplunkConfiguration               fwtypes.ListNestedObjectValueOf[splunkConfigurationModel]               `tfsdk:"splunk_configuration"`
+	Tags                              tftags.Map                                                              `tfsdk:"tags"`
+	TagsAll                           tftags.Map                                                              `tfsdk:"tags_all"`
+	Timeouts                          timeouts.Value                                                          `tfsdk:"timeouts"`
+	VersionID                         types.String                                                            `tfsdk:"version_id"`
 }
 
-func flattenHTTPEndpointRequestConfiguration(rc *types.HttpEndpointRequestConfiguration) []map[string]any {
-	if rc == nil {
-		return []map[string]any{}
+// destinationConfigurationFor returns the configuration block value for the specified destination type.
+func (m *deliveryStreamResourceModel) destinationConfigurationFor(t destinationType) attr.Value {
+	switch t {
+	case destinationTypeElasticsearch:
+		return m.ElasticsearchConfiguration
+	case destinationTypeExtendedS3:
+		return m.ExtendedS3Configuration
+	case destinationTypeHTTPEndpoint:
+		return m.HTTPEndpointConfiguration
+	case destinationTypeIceberg:
+		return m.IcebergConfiguration
+	case destinationTypeOpenSearch:
+		return m.OpenSearchConfiguration
+	case destinationTypeOpenSearchServerless:
+		return m.OpenSearchServerlessConfiguration
+	case destinationTypeRedshift:
+		return m.RedshiftConfiguration
+	case destinationTypeSnowflake:
+		return m.SnowflakeConfiguration
+	case destinationTypeSplunk:
+		return m.SplunkConfiguration
+	default:
+		return types.ListNull(types.StringType)
 	}
+}
 
-	requestConfiguration := make([]map[string]any, 1)
-
-	commonAttributes := make([]any, 0)
-	for _, params := range rc.CommonAttributes {
-		name := aws.ToString(params.AttributeName)
-		value := aws.ToString(params.AttributeValue)
+// destinationConfiguration returns the configuration block value for the delivery stream's destination.
+func (m *deliveryStreamResourceModel) destinationConfiguration() attr.Value {
+	return m.destinationConfigurationFor(m.Destination.ValueEnum())
+}
 
-		commonAttributes = append(commonAttributes, map[string]any{
-			names.AttrName:  name,
-			names.AttrValue: value,
-		})
+// destination returns the configuration model for the delivery stream's destination.
+// nil is returned if the corresponding configuration block is not set.
+func (m *deliveryStreamResourceModel) destination(ctx context.Context) (destinationModel, diag.Diagnostics) {
+	switch m.Destination.ValueEnum() {
+	case destinationTypeElasticsearch:
+		return toDestinationModel(ctx, m.ElasticsearchConfiguration)
+	case destinationTypeExtendedS3:
+		return toDestinationModel(ctx, m.ExtendedS3Configuration)
+	case destinationTypeHTTPEndpoint:
+		return toDestinationModel(ctx, m.HTTPEndpointConfiguration)
+	case destinationTypeIceberg:
+		return toDestinationModel(ctx, m.IcebergConfiguration)
+	case destinationTypeOpenSearch:
+		return toDestinationModel(ctx, m.OpenSearchConfiguration)
+	case destinationTypeOpenSearchServerless:
+		return toDestinationModel(ctx, m.OpenSearchServerlessConfiguration)
+	case destinationTypeRedshift:
+		return toDestinationModel(ctx, m.RedshiftConfiguration)
+	case destinationTypeSnowflake:
+		return toDestinationModel(ctx, m.SnowflakeConfiguration)
+	case destinationTypeSplunk:
+		return toDestinationModel(ctx, m.SplunkConfiguration)
+	default:
+		return nil, nil
 	}
+}
 
-	requestConfiguration[0] = map[string]any{
-		"common_attributes": commonAttributes,
-		"content_encoding":  rc.ContentEncoding,
+func toDestinationModel[T any, PT interface {
+	*T
+	destinationModel
+}](ctx context.Context, v fwtypes.ListNestedObjectValueOf[T]) (destinationModel, diag.Diagnostics) {
+	data, diags := v.ToPtr(ctx)
+	if diags.HasError() || data == nil {
+		return nil, diags
 	}
 
-	return requestConfiguration
+	return PT(data), diags
 }
 
-func flattenProcessingConfiguration(pc *types.ProcessingConfiguration, destinationType destinationType, roleARN string) []map[string]any {
-	if pc == nil {
-		return []map[string]any{}
+func flattenDestination[T any, PT interface {
+	*T
+	destinationModel
+}](ctx context.Context, apiObject *awstypes.DestinationDescription, v *fwtypes.ListNestedObjectValueOf[T]) diag.Diagnostics {
+	data, diags := v.ToPtr(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	if data == nil {
+		data = new(T)
 	}
 
-	processingConfiguration := make([]map[string]any, 1)
+	diags.Append(PT(data).flatten(ctx, apiObject)...)
+	if diags.HasError() {
+		return diags
+	}
 
-	processors := make([]any, len(pc.Processors))
-	for i, p := range pc.Processors {
-		t := p.Type
-		parameters := make([]any, 0)
+	*v = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, data)
 
-		// It is necessary to explicitly filter this out
-		// to prevent diffs during routine use and retain the ability
-		// to show diffs if any field has drifted.
-		defaultProcessorParameters := defaultProcessorParameters(destinationType, t, roleARN)
+	return diags
+}
 
-		for _, params := range p.Parameters {
-			name := params.ParameterName
-			value := aws.ToString(params.ParameterValue)
+func (m *deliveryStreamResourceModel) flatten(ctx context.Context, apiObject *awstypes.DeliveryStreamDescription) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-			// Ignore defaults.
-			if v, ok := defaultProcessorParameters[name]; ok && v == value {
-				continue
-			}
+	m.ARN = fwflex.StringToFramework(ctx, apiObject.DeliveryStreamARN)
+	m.Name = fwflex.StringToFramework(ctx, apiObject.DeliveryStreamName)
+	m.VersionID = fwflex.StringToFramework(ctx, apiObject.VersionId)
 
-			parameters = append(parameters, map[string]any{
-				"parameter_name":  name,
-				"parameter_value": value,
-			})
+	if v := apiObject.Source; v != nil {
+		if v := v.KinesisStreamSourceDescription; v != nil {
+			diags.Append(fwflex.Flatten(ctx, v, &m.KinesisSourceConfiguration)...)
 		}
-
-		processors[i] = map[string]any{
-			names.AttrType:       t,
-			names.AttrParameters: parameters,
+		if v := v.MSKSourceDescription; v != nil {
+			diags.Append(fwflex.Flatten(ctx, v, &m.MSKSourceConfiguration)...)
+		}
+		if diags.HasError() {
+			return diags
 		}
 	}
-	processingConfiguration[0] = map[string]any{
-		names.AttrEnabled: aws.ToBool(pc.Enabled),
-		"processors":      processors,
-	}
-	return processingConfiguration
-}
 
-func flattenSecretsManagerConfiguration(smc *types.SecretsManagerConfiguration) []any {
-	if smc == nil {
-		return []any{}
+	sse := serverSideEncryptionModel{
+		Enabled: types.BoolValue(false),
+		KeyARN:  fwtypes.ARNNull(),
+		KeyType: fwtypes.StringEnumValue(awstypes.KeyTypeAwsOwnedCmk),
 	}
-
-	secretsManagerConfiguration := map[string]any{
-		names.AttrEnabled: aws.ToBool(smc.Enabled),
+	if v := apiObject.DeliveryStreamEncryptionConfiguration; v != nil && v.Status == awstypes.DeliveryStreamEncryptionStatusEnabled {
+		sse.Enabled = types.BoolValue(true)
+		sse.KeyARN = fwflex.StringToFrameworkARN(ctx, v.KeyARN)
+		sse.KeyType = fwtypes.StringEnumValue(v.KeyType)
 	}
-	if aws.ToBool(smc.Enabled) {
-		secretsManagerConfiguration["secret_arn"] = aws.ToString(smc.SecretARN)
-		if smc.RoleARN != nil {
-			secretsManagerConfiguration[names.AttrRoleARN] = aws.ToString(smc.RoleARN)
+	m.ServerSideEncryption = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &sse)
+
+	if len(apiObject.Destinations) > 0 {
+		destination := apiObject.Destinations[0]
+
+		switch {
+		case destination.ElasticsearchDestinationDescription != nil:
+			m.Destination = fwtypes.StringEnumValue(destinationTypeElasticsearch)
+			diags.Append(flattenDestination(ctx, &destination, &m.ElasticsearchConfiguration)...)
+		case destination.HttpEndpointDestinationDescription != nil:
+			m.Destination = fwtypes.StringEnumValue(destinationTypeHTTPEndpoint)
+			diags.Append(flattenDestination(ctx, &destination, &m.HTTPEndpointConfiguration)...)
+		case destination.IcebergDestinationDescription != nil:
+			m.Destination = fwtypes.StringEnumValue(destinationTypeIceberg)
+			diags.Append(flattenDestination(ctx, &destination, &m.IcebergConfiguration)...)
+		case destination.AmazonopensearchserviceDestinationDescription != nil:
+			m.Destination = fwtypes.StringEnumValue(destinationTypeOpenSearch)
+			diags.Append(flattenDestination(ctx, &destination, &m.OpenSearchConfiguration)...)
+		case destination.AmazonOpenSearchServerlessDestinationDescription != nil:
+			m.Destination = fwtypes.StringEnumValue(destinationTypeOpenSearchServerless)
+			diags.Append(flattenDestination(ctx, &destination, &m.OpenSearchServerlessConfiguration)...)
+		case destination.RedshiftDestinationDescription != nil:
+			m.Destination = fwtypes.StringEnumValue(destinationTypeRedshift)
+			diags.Append(flattenDestination(ctx, &destination, &m.RedshiftConfiguration)...)
+		case destination.SnowflakeDestinationDescription != nil:
+			m.Destination = fwtypes.StringEnumValue(destinationTypeSnowflake)
+			diags.Append(flattenDestination(ctx, &destination, &m.SnowflakeConfiguration)...)
+		case destination.SplunkDestinationDescription != nil:
+			m.Destination = fwtypes.StringEnumValue(destinationTypeSplunk)
+			diags.Append(flattenDestination(ctx, &destination, &m.SplunkConfiguration)...)
+		case destination.ExtendedS3DestinationDescription != nil:
+			m.Destination = fwtypes.StringEnumValue(destinationTypeExtendedS3)
+			diags.Append(flattenDestination(ctx, &destination, &m.ExtendedS3Configuration)...)
 		}
+
+		m.DestinationID = fwflex.StringToFramework(ctx, destination.DestinationId)
 	}
-	return []any{secretsManagerConfiguration}
+
+	return diags
 }
 
-func flattenDynamicPartitioningConfiguration(dpc *types.DynamicPartitioningConfiguration) []map[string]any {
-	if dpc == nil {
-		return []map[string]any{}
+// serverSideEncryption returns the server-side encryption configuration.
+// The default (disabled) configuration is returned if the corresponding configuration block is not set,
+// and unset values in the configuration block are replaced with their defaults.
+func (m *deliveryStreamResourceModel) serverSideEncryption(ctx context.Context) (*serverSideEncryptionModel, diag.Diagnostics) {
+	data, diags := m.ServerSideEncryption.ToPtr(ctx)
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	dynamicPartitioningConfiguration := make([]map[string]any, 1)
-
-	dynamicPartitioningConfiguration[0] = map[string]any{
-		names.AttrEnabled: aws.ToBool(dpc.Enabled),
+	if data == nil {
+		data = &serverSideEncryptionModel{}
 	}
 
-	if dpc.RetryOptions != nil && dpc.RetryOptions.DurationInSeconds != nil {
-		dynamicPartitioningConfiguration[0]["retry_duration"] = int(aws.ToInt32(dpc.RetryOptions.DurationInSeconds))
+	// Unset values are equivalent to the defaults.
+	sse := &serverSideEncryptionModel{
+		Enabled: types.BoolValue(data.Enabled.ValueBool()),
+		KeyARN:  fwtypes.ARNNull(),
+		KeyType: fwtypes.StringEnumValue(awstypes.KeyTypeAwsOwnedCmk),
+	}
+	if v := data.KeyARN.ValueString(); v != "" {
+		sse.KeyARN = fwtypes.ARNValue(v)
+	}
+	if v := data.KeyType.ValueEnum(); v != "" {
+		sse.KeyType = fwtypes.StringEnumValue(v)
 	}
 
-	return dynamicPartitioningConfiguration
+	return sse, diags
 }
 
-func flattenKinesisStreamSourceDescription(desc *types.KinesisStreamSourceDescription) []any {
-	if desc == nil {
-		return []any{}
-	}
+type kinesisSourceConfigurationModel struct {
+	KinesisStreamARN fwtypes.ARN `tfsdk:"kinesis_stream_arn"`
+	RoleARN          fwtypes.ARN `tfsdk:"role_arn"`
+}
 
-	mDesc := map[string]any{
-		"kinesis_stream_arn": aws.ToString(desc.KinesisStreamARN),
-		names.AttrRoleARN:    aws.ToString(desc.RoleARN),
-	}
+type mskSourceConfigurationModel struct {
+	AuthenticationConfiguration fwtypes.ListNestedObjectValueOf[authenticationConfigurationModel] `tfsdk:"authentication_configuration"`
+	MSKClusterARN               fwtypes.ARN                                                       `tfsdk:"msk_cluster_arn"`
+	ReadFromTimestamp           timetypes.RFC3339                                                 `tfsdk:"read_from_timestamp"`
+	TopicName                   types.String                                                      `tfsdk:"topic_name"`
+}
 
-	return []any{mDesc}
+type authenticationConfigurationModel struct {
+	Connectivity fwtypes.StringEnum[awstypes.Connectivity] `tfsdk:"connectivity"`
+	RoleARN      fwtypes.ARN                               `tfsdk:"role_arn"`
 }
 
-func flattenHTTPEndpointDestinationDescription(apiObject *types.HttpEndpointDestinationDescription, configuredAccessKey string) []any {
-	if apiObject == nil {
-		return []any{}
-	}
+type serverSideEncryptionModel struct {
+	Enabled types.Bool                           `tfsdk:"enabled"`
+	KeyARN  fwtypes.ARN                          `tfsdk:"key_arn"`
+	KeyType fwtypes.StringEnum[awstypes.KeyType] `tfsdk:"key_type"`
+}
 
-	tfMap := map[string]any{
-		names.AttrAccessKey:             configuredAccessKey,
-		"cloudwatch_logging_options":    flattenCloudWatchLoggingOptions(apiObject.CloudWatchLoggingOptions),
-		names.AttrName:                  aws.ToString(apiObject.EndpointConfiguration.Name),
-		"processing_configuration":      flattenProcessingConfiguration(apiObject.ProcessingConfiguration, destinationTypeHTTPEndpoint, aws.ToString(apiObject.RoleARN)),
-		"request_configuration":         flattenHTTPEndpointRequestConfiguration(apiObject.RequestConfiguration),
-		names.AttrRoleARN:               aws.ToString(apiObject.RoleARN),
-		"s3_backup_mode":                apiObject.S3BackupMode,
-		"s3_configuration":              flattenS3DestinationDescription(apiObject.S3DestinationDescription),
-		"secrets_manager_configuration": flattenSecretsManagerConfiguration(apiObject.SecretsManagerConfiguration),
-		names.AttrURL:                   aws.ToString(apiObject.EndpointConfiguration.Url),
-	}
+func (m *serverSideEncryptionModel) equal(other *serverSideEncryptionModel) bool {
+	return m.Enabled.Equal(other.Enabled) && m.KeyARN.Equal(other.KeyARN) && m.KeyType.Equal(other.KeyType)
+}
 
-	if apiObject.BufferingHints != nil {
-		tfMap["buffering_interval"] = int(aws.ToInt32(apiObject.BufferingHints.IntervalInSeconds))
-		tfMap["buffering_size"] = int(aws.ToInt32(apiObject.BufferingHints.SizeInMBs))
+func (m *serverSideEncryptionModel) expand(ctx context.Context) *awstypes.DeliveryStreamEncryptionConfigurationInput {
+	apiObject := &awstypes.DeliveryStreamEncryptionConfigurationInput{
+		KeyType: m.KeyType.ValueEnum(),
 	}
 
-	if apiObject.RetryOptions != nil {
-		tfMap["retry_duration"] = int(aws.ToInt32(apiObject.RetryOptions.DurationInSeconds))
+	if v := fwflex.StringValueFromFramework(ctx, m.KeyARN); v != "" {
+		apiObject.KeyARN = aws.String(v)
 	}
 
-	return []any{tfMap}
+	return apiObject
+}
+
+func retryDeliveryStreamOp(ctx context.Context, f func(context.Context) (any, error)) (any, error) {
+	return tfresource.RetryWhen(ctx, propagationTimeout,
+		f,
+		func(err error) (bool, error) {
+			// Access was denied when calling Glue. Please ensure that the role specified in the data format conversion configuration has the necessary permissions.
+			if errs.IsAErrorMessageContains[*awstypes.InvalidArgumentException](err, "Access was denied") {
+				return true, err
+			}
+			if errs.IsAErrorMessageContains[*awstypes.InvalidArgumentException](err, "is not authorized to") {
+				return true, err
+			}
+			if errs.IsAErrorMessageContains[*awstypes.InvalidArgumentException](err, "Please make sure the role specified in VpcConfiguration has permissions") {
+				return true, err
+			}
+			// InvalidArgumentException: Verify that the IAM role has access to the Elasticsearch domain.
+			if errs.IsAErrorMessageContains[*awstypes.InvalidArgumentException](err, "Verify that the IAM role has access") {
+				return true, err
+			}
+			if errs.IsAErrorMessageContains[*awstypes.InvalidArgumentException](err, "Firehose is unable to assume role") {
+				return true, err
+			}
+			return false, err
+		},
+	)
 }
 
-func flattenIcebergDestinationDescription(apiObject *types.IcebergDestinationDescription) []any {
-	if apiObject == nil {
-		return []any{}
+func findDeliveryStreamByName(ctx context.Context, conn *firehose.Client, name string) (*awstypes.DeliveryStreamDescription, error) {
+	input := &firehose.DescribeDeliveryStreamInput{
+		DeliveryStreamName: aws.String(name),
 	}
 
-	tfMap := map[string]any{
-		"append_only":      aws.ToBool(apiObject.AppendOnly),
-		"catalog_arn":      aws.ToString(apiObject.CatalogConfiguration.CatalogARN),
-		"s3_configuration": flattenS3DestinationDescription(apiObject.S3DestinationDescription),
-		names.AttrRoleARN:  aws.ToString(apiObject.RoleARN),
+	output, err := conn.DescribeDeliveryStream(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
 	}
 
-	if apiObject.BufferingHints != nil {
-		tfMap["buffering_interval"] = int(aws.ToInt32(apiObject.BufferingHints.IntervalInSeconds))
-		tfMap["buffering_size"] = int(aws.ToInt32(apiObject.BufferingHints.SizeInMBs))
+	if err != nil {
+		return nil, err
 	}
 
-	if apiObject.CloudWatchLoggingOptions != nil {
-		tfMap["cloudwatch_logging_options"] = flattenCloudWatchLoggingOptions(apiObject.CloudWatchLoggingOptions)
+	if output == nil || output.DeliveryStreamDescription == nil {
+		return nil, tfresource.NewEmptyResultError()
 	}
 
-	if apiObject.DestinationTableConfigurationList != nil {
-		tableConfigurations := make([]map[string]any, 0, len(apiObject.DestinationTableConfigurationList))
-		for _, table := range apiObject.DestinationTableConfigurationList {
-			tableConfigurations = append(tableConfigurations, map[string]any{
-				names.AttrDatabaseName:   aws.ToString(table.DestinationDatabaseName),
-				names.AttrTableName:      aws.ToString(table.DestinationTableName),
-				"s3_error_output_prefix": table.S3ErrorOutputPrefix,
-				"unique_keys":            table.UniqueKeys,
-			})
+	return output.DeliveryStreamDescription, nil
+}
+
+func statusDeliveryStream(conn *firehose.Client, name string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findDeliveryStreamByName(ctx, conn, name)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
 		}
-		tfMap["destination_table_configuration"] = tableConfigurations
-	}
 
-	if apiObject.ProcessingConfiguration != nil {
-		tfMap["processing_configuration"] = flattenProcessingConfiguration(apiObject.ProcessingConfiguration, destinationTypeIceberg, aws.ToString(apiObject.RoleARN))
+		return output, string(output.DeliveryStreamStatus), nil
 	}
+}
 
-	if apiObject.RetryOptions != nil {
-		tfMap["retry_duration"] = int(aws.ToInt32(apiObject.RetryOptions.DurationInSeconds))
+func waitDeliveryStreamCreated(ctx context.Context, conn *firehose.Client, name string, timeout time.Duration) (*awstypes.DeliveryStreamDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DeliveryStreamStatusCreating),
+		Target:  enum.Slice(awstypes.DeliveryStreamStatusActive),
+		Refresh: statusDeliveryStream(conn, name),
+		Timeout: timeout,
 	}
 
-	if apiObject.S3BackupMode != "" {
-		tfMap["s3_backup_mode"] = apiObject.S3BackupMode
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DeliveryStreamDescription); ok {
+		if status, failureDescription := output.DeliveryStreamStatus, output.FailureDescription; status == awstypes.DeliveryStreamStatusCreatingFailed && failureDescription != nil {
+			retry.SetLastError(err, fmt.Errorf("%s: %s", failureDescription.Type, aws.ToString(failureDescription.Details)))
+		}
+
+		return output, err
 	}
 
-	return []any{tfMap}
+	return nil, err
 }
 
-func expandDocumentIDOptions(tfMap map[string]any) *types.DocumentIdOptions {
-	if tfMap == nil {
-		return nil
+func waitDeliveryStreamDeleted(ctx context.Context, conn *firehose.Client, name string, timeout time.Duration) (*awstypes.DeliveryStreamDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DeliveryStreamStatusDeleting),
+		Target:  []string{},
+		Refresh: statusDeliveryStream(conn, name),
+		Timeout: timeout,
 	}
 
-	apiObject := &types.DocumentIdOptions{}
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DeliveryStreamDescription); ok {
+		if status, failureDescription := output.DeliveryStreamStatus, output.FailureDescription; status == awstypes.DeliveryStreamStatusDeletingFailed && failureDescription != nil {
+			retry.SetLastError(err, fmt.Errorf("%s: %s", failureDescription.Type, aws.ToString(failureDescription.Details)))
+		}
 
-	if v, ok := tfMap["default_document_id_format"].(string); ok && v != "" {
-		apiObject.DefaultDocumentIdFormat = types.DefaultDocumentIdFormat(v)
+		return output, err
 	}
 
-	return apiObject
+	return nil, err
 }
 
-func flattenDocumentIDOptions(apiObject *types.DocumentIdOptions) map[string]any {
-	if apiObject == nil {
-		return nil
+func findDeliveryStreamEncryptionConfigurationByName(ctx context.Context, conn *firehose.Client, name string) (*awstypes.DeliveryStreamEncryptionConfiguration, error) {
+	output, err := findDeliveryStreamByName(ctx, conn, name)
+	if err != nil {
+		return nil, err
 	}
 
-	tfMap := map[string]any{
-		"default_document_id_format": apiObject.DefaultDocumentIdFormat,
+	if output.DeliveryStreamEncryptionConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError()
 	}
 
-	return tfMap
+	return output.DeliveryStreamEncryptionConfiguration, nil
 }
 
-func flattenSnowflakeRoleConfiguration(apiObject *types.SnowflakeRoleConfiguration) []map[string]any {
-	if apiObject == nil {
-		return []map[string]any{}
-	}
+func statusDeliveryStreamEncryptionConfiguration(conn *firehose.Client, name string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findDeliveryStreamEncryptionConfigurationByName(ctx, conn, name)
 
-	m := map[string]any{
-		names.AttrEnabled: aws.ToBool(apiObject.Enabled),
-	}
-	if aws.ToBool(apiObject.Enabled) {
-		m["snowflake_role"] = aws.ToString(apiObject.SnowflakeRole)
-	}
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
 
-	return []map[string]any{m}
+		return output, string(output.Status), nil
+	}
 }
 
-func flattenSnowflakeVPCConfiguration(apiObject *types.SnowflakeVpcConfiguration) []map[string]any {
-	if apiObject == nil {
-		return []map[string]any{}
+func waitDeliveryStreamEncryptionEnabled(ctx context.Context, conn *firehose.Client, name string, timeout time.Duration) (*awstypes.DeliveryStreamEncryptionConfiguration, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DeliveryStreamEncryptionStatusEnabling),
+		Target:  enum.Slice(awstypes.DeliveryStreamEncryptionStatusEnabled),
+		Refresh: statusDeliveryStreamEncryptionConfiguration(conn, name),
+		Timeout: timeout,
 	}
 
-	m := map[string]any{
-		"private_link_vpce_id": aws.ToString(apiObject.PrivateLinkVpceId),
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DeliveryStreamEncryptionConfiguration); ok {
+		if status, failureDescription := output.Status, output.FailureDescription; status == awstypes.DeliveryStreamEncryptionStatusEnablingFailed && failureDescription != nil {
+			retry.SetLastError(err, fmt.Errorf("%s: %s", failureDescription.Type, aws.ToString(failureDescription.Details)))
+		}
+
+		return output, err
 	}
 
-	return []map[string]any{m}
+	return nil, err
 }
 
-func isDeliveryStreamOptionDisabled(v any) bool {
-	tfList := v.([]any)
-	if len(tfList) == 0 || tfList[0] == nil {
-		return true
+func waitDeliveryStreamEncryptionDisabled(ctx context.Context, conn *firehose.Client, name string, timeout time.Duration) (*awstypes.DeliveryStreamEncryptionConfiguration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DeliveryStreamEncryptionStatusDisabling),
+		Target:  enum.Slice(awstypes.DeliveryStreamEncryptionStatusDisabled),
+		Refresh: statusDeliveryStreamEncryptionConfiguration(conn, name),
+		Timeout: timeout,
 	}
-	tfMap := tfList[0].(map[string]any)
 
-	var enabled bool
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DeliveryStreamEncryptionConfiguration); ok {
+		if status, failureDescription := output.Status, output.FailureDescription; status == awstypes.DeliveryStreamEncryptionStatusDisablingFailed && failureDescription != nil {
+			retry.SetLastError(err, fmt.Errorf("%s: %s", failureDescription.Type, aws.ToString(failureDescription.Details)))
+		}
 
-	if v, ok := tfMap[names.AttrEnabled]; ok {
-		enabled = v.(bool)
+		return output, err
 	}
 
-	return !enabled
+	return nil, err
 }
 
 // See https://docs.aws.amazon.com/firehose/latest/dev/data-transformation.html.
-func defaultProcessorParameters(destinationType destinationType, processorType types.ProcessorType, roleARN string) map[types.ProcessorParameterName]string {
+func defaultProcessorParameters(destinationType destinationType, processorType awstypes.ProcessorType, roleARN string) map[awstypes.ProcessorParameterName]string {
 	switch processorType {
-	case types.ProcessorTypeLambda:
-		params := map[types.ProcessorParameterName]string{
-			types.ProcessorParameterNameLambdaNumberOfRetries:   "3",
-			types.ProcessorParameterNameBufferIntervalInSeconds: "60",
+	case awstypes.ProcessorTypeLambda:
+		params := map[awstypes.ProcessorParameterName]string{
+			awstypes.ProcessorParameterNameLambdaNumberOfRetries:   "3",
+			awstypes.ProcessorParameterNameBufferIntervalInSeconds: "60",
 		}
 		if roleARN != "" {
-			params[types.ProcessorParameterNameRoleArn] = roleARN
+			params[awstypes.ProcessorParameterNameRoleArn] = roleARN
 		}
 		switch destinationType {
 		case destinationTypeSplunk:
-			params[types.ProcessorParameterNameBufferSizeInMb] = "0.25"
+			params[awstypes.ProcessorParameterNameBufferSizeInMb] = "0.25"
 		default:
-			params[types.ProcessorParameterNameBufferSizeInMb] = "1"
+			params[awstypes.ProcessorParameterNameBufferSizeInMb] = "1"
 		}
 		return params
 	default:
-		return make(map[types.ProcessorParameterName]string)
+		return make(map[awstypes.ProcessorParameterName]string)
 	}
 }
@@ -0,0 +1,1535 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package firehose
+
+import (
+	"context"
+	"math/big"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/firehose/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tflistplanmodifier "github.com/hashicorp/terraform-provider-aws/internal/framework/planmodifiers/listplanmodifier"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// destinationModel is implemented by each of the per-destination configuration models.
+type destinationModel interface {
+	expandCreate(context.Context, *firehose.CreateDeliveryStreamInput) diag.Diagnostics
+	expandUpdate(context.Context, *firehose.UpdateDestinationInput) diag.Diagnostics
+	flatten(context.Context, *awstypes.DestinationDescription) diag.Diagnostics
+}
+
+// expandNestedObject expands the single element of a nested object list using the supplied function.
+// A null or empty list expands to nil.
+func expandNestedObject[T, A any](ctx context.Context, v fwtypes.ListNestedObjectValueOf[T], f func(context.Context, *T) (*A, diag.Diagnostics)) (*A, diag.Diagnostics) {
+	data, diags := v.ToPtr(ctx)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	apiObject, d := f(ctx, data)
+	diags.Append(d...)
+
+	return apiObject, diags
+}
+
+// flattenNestedObject flattens an API object into the single element of a nested object list using the supplied function.
+// The existing element, if any, is used as the starting point so that values not returned by the API are retained.
+func flattenNestedObject[T, A any](ctx context.Context, apiObject *A, v *fwtypes.ListNestedObjectValueOf[T], f func(context.Context, *A, *T) diag.Diagnostics) diag.Diagnostics {
+	if apiObject == nil {
+		*v = fwtypes.NewListNestedObjectValueOfNull[T](ctx)
+		return nil
+	}
+
+	data, diags := v.ToPtr(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	if data == nil {
+		data = new(T)
+	}
+
+	diags.Append(f(ctx, apiObject, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	*v = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, data)
+
+	return diags
+}
+
+// nullIfDefault returns nil if the corresponding configuration block is not set and the API object has its default value.
+// Nested blocks cannot be Computed, so this prevents differences for values that the API always returns.
+func nullIfDefault[T any](v interface{ IsNull() bool }, apiObject *T, isDefault func(*T) bool) *T {
+	if apiObject == nil || (v.IsNull() && isDefault(apiObject)) {
+		return nil
+	}
+
+	return apiObject
+}
+
+// optionalComputedNestedObjectAttribute returns an Optional+Computed list attribute for a single nested object of the specified type.
+// It replaces a configuration block that the API always returns, so that the object is present in state even when it is not configured.
+// Attribute values that are null, the zero value or the specified default are semantically equal, so that omitting or configuring defaults
+// does not produce a difference.
+func optionalComputedNestedObjectAttribute[T any](ctx context.Context, defaults map[string]attr.Value, planModifiers ...planmodifier.List) schema.ListAttribute {
+	return framework.ResourceOptionalComputedListOfObjectsAttribute(ctx, 1, []fwtypes.NestedObjectOfOption[T]{
+		fwtypes.WithSemanticEqualityFunc(nullOrDefaultSemanticEquality[T](defaults)),
+	}, append([]planmodifier.List{
+		listplanmodifier.UseStateForUnknown(),
+		tflistplanmodifier.UseStateForSemanticEquality(),
+	}, planModifiers...)...)
+}
+
+// nullOrDefaultSemanticEquality returns a semantic equality function for nested objects whose attribute values are equal
+// if they are equal or if both are null, the zero value or the specified default.
+func nullOrDefaultSemanticEquality[T any](defaults map[string]attr.Value) func(context.Context, fwtypes.NestedCollectionValue[T], fwtypes.NestedCollectionValue[T]) (bool, diag.Diagnostics) {
+	return func(ctx context.Context, oldValue, newValue fwtypes.NestedCollectionValue[T]) (bool, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		oldElements, err := nestedObjectElements(ctx, oldValue)
+		if err != nil {
+			diags.AddError("Semantic Equality Check Error", err.Error())
+			return false, diags
+		}
+		newElements, err := nestedObjectElements(ctx, newValue)
+		if err != nil {
+			diags.AddError("Semantic Equality Check Error", err.Error())
+			return false, diags
+		}
+
+		if oldElements == nil || newElements == nil || len(oldElements) != len(newElements) {
+			return false, diags
+		}
+
+		for i, oldElement := range oldElements {
+			newElement := newElements[i]
+
+			for k, oldAttribute := range oldElement {
+				newAttribute := newElement[k]
+
+				if oldAttribute.Equal(newAttribute) {
+					continue
+				}
+
+				var defaultValue tftypes.Value
+				if v, ok := defaults[k]; ok {
+					if defaultValue, err = v.ToTerraformValue(ctx); err != nil {
+						diags.AddError("Semantic Equality Check Error", err.Error())
+						return false, diags
+					}
+				}
+
+				if !isNullOrDefaultValue(oldAttribute, defaultValue) || !isNullOrDefaultValue(newAttribute, defaultValue) {
+					return false, diags
+				}
+			}
+		}
+
+		return true, diags
+	}
+}
+
+// nestedObjectElements returns the attribute values of the elements of a known, non-null nested object collection.
+func nestedObjectElements(ctx context.Context, v attr.Value) ([]map[string]tftypes.Value, error) {
+	tfValue, err := v.ToTerraformValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !tfValue.IsKnown() || tfValue.IsNull() {
+		return nil, nil
+	}
+
+	var tfElements []tftypes.Value
+	if err := tfValue.As(&tfElements); err != nil {
+		return nil, err
+	}
+
+	elements := make([]map[string]tftypes.Value, 0, len(tfElements))
+	for _, tfElement := range tfElements {
+		var element map[string]tftypes.Value
+		if err := tfElement.As(&element); err != nil {
+			return nil, err
+		}
+		elements = append(elements, element)
+	}
+
+	return elements, nil
+}
+
+// isNullOrDefaultValue returns whether the specified value is null, the zero value or the specified default.
+func isNullOrDefaultValue(v, defaultValue tftypes.Value) bool {
+	switch {
+	case v.IsNull():
+		return true
+	case !v.IsKnown():
+		return false
+	case defaultValue.Type() != nil && v.Equal(defaultValue):
+		return true
+	}
+
+	switch t := v.Type(); {
+	case t.Is(tftypes.Bool):
+		var b bool
+		return v.As(&b) == nil && !b
+	case t.Is(tftypes.Number):
+		var n big.Float
+		return v.As(&n) == nil && n.Sign() == 0
+	case t.Is(tftypes.String):
+		var s string
+		return v.As(&s) == nil && s == ""
+	case t.Is(tftypes.List{}), t.Is(tftypes.Set{}):
+		var elements []tftypes.Value
+		return v.As(&elements) == nil && len(elements) == 0
+	}
+
+	return false
+}
+
+type cloudWatchLoggingOptionsModel struct {
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	LogGroupName  types.String `tfsdk:"log_group_name"`
+	LogStreamName types.String `tfsdk:"log_stream_name"`
+}
+
+type processingConfigurationModel struct {
+	Enabled    types.Bool                                      `tfsdk:"enabled"`
+	Processors fwtypes.ListNestedObjectValueOf[processorModel] `tfsdk:"processors"`
+}
+
+type processorModel struct {
+	Parameters fwtypes.SetNestedObjectValueOf[processorParameterModel] `tfsdk:"parameters"`
+	Type       fwtypes.StringEnum[awstypes.ProcessorType]              `tfsdk:"type"`
+}
+
+type processorParameterModel struct {
+	ParameterName  fwtypes.StringEnum[awstypes.ProcessorParameterName] `tfsdk:"parameter_name"`
+	ParameterValue types.String                                        `tfsdk:"parameter_value"`
+}
+
+func expandProcessingConfiguration(ctx context.Context, v fwtypes.ListNestedObjectValueOf[processingConfigurationModel], destinationType destinationType, roleARN string) (*awstypes.ProcessingConfiguration, diag.Diagnostics) {
+	data, diags := v.ToPtr(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if data == nil {
+		// It is possible to just pass nil here, but this seems to be the
+		// canonical form that AWS uses, and is less likely to produce diffs.
+		return &awstypes.ProcessingConfiguration{
+			Enabled:    aws.Bool(false),
+			Processors: []awstypes.Processor{},
+		}, diags
+	}
+
+	var apiObject awstypes.ProcessingConfiguration
+	diags.Append(fwflex.Expand(ctx, data, &apiObject)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObject.Enabled = aws.Bool(data.Enabled.ValueBool())
+	if apiObject.Processors == nil {
+		apiObject.Processors = []awstypes.Processor{}
+	}
+
+	// Merge in defaults.
+	for i := range apiObject.Processors {
+		processor := &apiObject.Processors[i]
+		for name, value := range defaultProcessorParameters(destinationType, processor.Type, roleARN) {
+			if !slices.ContainsFunc(processor.Parameters, func(param awstypes.ProcessorParameter) bool { return name == param.ParameterName }) {
+				processor.Parameters = append(processor.Parameters, awstypes.ProcessorParameter{
+					ParameterName:  name,
+					ParameterValue: aws.String(value),
+				})
+			}
+		}
+	}
+
+	return &apiObject, diags
+}
+
+func flattenProcessingConfiguration(ctx context.Context, apiObject *awstypes.ProcessingConfiguration, v *fwtypes.ListNestedObjectValueOf[processingConfigurationModel], destinationType destinationType, roleARN string) diag.Diagnostics {
+	return flattenNestedObject(ctx, apiObject, v, func(ctx context.Context, apiObject *awstypes.ProcessingConfiguration, data *processingConfigurationModel) diag.Diagnostics {
+		processors := make([]awstypes.Processor, 0, len(apiObject.Processors))
+		for _, processor := range apiObject.Processors {
+			// It is necessary to explicitly filter this out
+			// to prevent diffs during routine use and retain the ability
+			// to show diffs if any field has drifted.
+			defaultProcessorParameters := defaultProcessorParameters(destinationType, processor.Type, roleARN)
+
+			processor.Parameters = slices.DeleteFunc(slices.Clone(processor.Parameters), func(param awstypes.ProcessorParameter) bool {
+				v, ok := defaultProcessorParameters[param.ParameterName]
+				return ok && v == aws.ToString(param.ParameterValue)
+			})
+			processors = append(processors, processor)
+		}
+
+		diags := fwflex.Flatten(ctx, &awstypes.ProcessingConfiguration{Processors: processors}, data)
+		data.Enabled = types.BoolValue(aws.ToBool(apiObject.Enabled))
+
+		return diags
+	})
+}
+
+type s3ConfigurationModel struct {
+	BucketARN                fwtypes.ARN                                                    `tfsdk:"bucket_arn"`
+	BufferingInterval        types.Int64                                                    `tfsdk:"buffering_interval" autoflex:"-"`
+	BufferingSize            types.Int64                                                    `tfsdk:"buffering_size" autoflex:"-"`
+	CloudWatchLoggingOptions fwtypes.ListNestedObjectValueOf[cloudWatchLoggingOptionsModel] `tfsdk:"cloudwatch_logging_options"`
+	CompressionFormat        fwtypes.StringEnum[awstypes.CompressionFormat]                 `tfsdk:"compression_format"`
+	ErrorOutputPrefix        types.String                                                   `tfsdk:"error_output_prefix" autoflex:",legacy"`
+	KMSKeyARN                fwtypes.ARN                                                    `tfsdk:"kms_key_arn" autoflex:"-"`
+	Prefix                   types.String                                                   `tfsdk:"prefix" autoflex:",legacy"`
+	RoleARN                  fwtypes.ARN                                                    `tfsdk:"role_arn"`
+}
+
+func (m *s3ConfigurationModel) expandBufferingHints(ctx context.Context) *awstypes.BufferingHints {
+	return &awstypes.BufferingHints{
+		IntervalInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.BufferingInterval),
+		SizeInMBs:         fwflex.Int32FromFrameworkInt64(ctx, m.BufferingSize),
+	}
+}
+
+func (m *s3ConfigurationModel) expandEncryptionConfiguration(ctx context.Context) *awstypes.EncryptionConfiguration {
+	if v := fwflex.StringValueFromFramework(ctx, m.KMSKeyARN); v != "" {
+		return &awstypes.EncryptionConfiguration{
+			KMSEncryptionConfig: &awstypes.KMSEncryptionConfig{
+				AWSKMSKeyARN: aws.String(v),
+			},
+		}
+	}
+
+	return &awstypes.EncryptionConfiguration{
+		NoEncryptionConfig: awstypes.NoEncryptionConfigNoEncryption,
+	}
+}
+
+func expandS3DestinationConfiguration(ctx context.Context, data *s3ConfigurationModel) (*awstypes.S3DestinationConfiguration, diag.Diagnostics) {
+	var apiObject awstypes.S3DestinationConfiguration
+	diags := fwflex.Expand(ctx, data, &apiObject)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObject.BufferingHints = data.expandBufferingHints(ctx)
+	apiObject.EncryptionConfiguration = data.expandEncryptionConfiguration(ctx)
+	apiObject.Prefix = aws.String(data.Prefix.ValueString())
+
+	return &apiObject, diags
+}
+
+func expandS3DestinationUpdate(ctx context.Context, data *s3ConfigurationModel) (*awstypes.S3DestinationUpdate, diag.Diagnostics) {
+	var apiObject awstypes.S3DestinationUpdate
+	diags := fwflex.Expand(ctx, data, &apiObject)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObject.BufferingHints = data.expandBufferingHints(ctx)
+	apiObject.EncryptionConfiguration = data.expandEncryptionConfiguration(ctx)
+	apiObject.ErrorOutputPrefix = aws.String(data.ErrorOutputPrefix.ValueString())
+	apiObject.Prefix = aws.String(data.Prefix.ValueString())
+
+	return &apiObject, diags
+}
+
+func flattenS3DestinationDescription(ctx context.Context, apiObject *awstypes.S3DestinationDescription, data *s3ConfigurationModel) diag.Diagnostics {
+
+	diags := fwflex.Flatten(ctx, apiObject, data)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := apiObject.BufferingHints; v != nil {
+		data.BufferingInterval = fwflex.Int32ToFrameworkInt64(ctx, v.IntervalInSeconds)
+		data.BufferingSize = fwflex.Int32ToFrameworkInt64(ctx, v.SizeInMBs)
+	}
+	if v := apiObject.EncryptionConfiguration; v != nil && v.KMSEncryptionConfig != nil {
+		data.KMSKeyARN = fwflex.StringToFrameworkARN(ctx, v.KMSEncryptionConfig.AWSKMSKeyARN)
+	} else {
+		data.KMSKeyARN = fwtypes.ARNNull()
+	}
+
+	return diags
+}
+
+type secretsManagerConfigurationModel struct {
+	Enabled   types.Bool  `tfsdk:"enabled"`
+	RoleARN   fwtypes.ARN `tfsdk:"role_arn"`
+	SecretARN fwtypes.ARN `tfsdk:"secret_arn"`
+}
+
+func (m secretsManagerConfigurationModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// The secrets manager feature is disabled if not specified.
+	apiObject := &awstypes.SecretsManagerConfiguration{
+		Enabled: aws.Bool(m.Enabled.ValueBool()),
+	}
+
+	if v := fwflex.StringValueFromFramework(ctx, m.RoleARN); v != "" {
+		apiObject.RoleARN = aws.String(v)
+	}
+
+	if v := fwflex.StringValueFromFramework(ctx, m.SecretARN); v != "" {
+		apiObject.SecretARN = aws.String(v)
+	}
+
+	return apiObject, diags
+}
+
+// requiresReplaceIfSecretsManagerEnabledChanged requires replacement if the secrets manager feature is enabled or disabled.
+func requiresReplaceIfSecretsManagerEnabledChanged(ctx context.Context, request planmodifier.ListRequest, response *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	if request.PlanValue.IsUnknown() {
+		return
+	}
+
+	isEnabled := func(v types.List) (bool, diag.Diagnostics) {
+		var data []secretsManagerConfigurationModel
+		diags := v.ElementsAs(ctx, &data, false)
+
+		return len(data) > 0 && data[0].Enabled.ValueBool(), diags
+	}
+
+	old, diags := isEnabled(request.StateValue)
+	response.Diagnostics.Append(diags...)
+	new, diags := isEnabled(request.PlanValue)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.RequiresReplace = old != new
+}
+
+type vpcConfigModel struct {
+	RoleARN          fwtypes.ARN         `tfsdk:"role_arn"`
+	SecurityGroupIDs fwtypes.SetOfString `tfsdk:"security_group_ids"`
+	SubnetIDs        fwtypes.SetOfString `tfsdk:"subnet_ids"`
+	VpcID            types.String        `tfsdk:"vpc_id"`
+}
+
+type documentIDOptionsModel struct {
+	DefaultDocumentIdFormat fwtypes.StringEnum[awstypes.DefaultDocumentIdFormat] `tfsdk:"default_document_id_format"`
+}
+
+type elasticsearchConfigurationModel struct {
+	BufferingInterval        types.Int64                                                    `tfsdk:"buffering_interval" autoflex:"-"`
+	BufferingSize            types.Int64                                                    `tfsdk:"buffering_size" autoflex:"-"`
+	CloudWatchLoggingOptions fwtypes.ListNestedObjectValueOf[cloudWatchLoggingOptionsModel] `tfsdk:"cloudwatch_logging_options"`
+	ClusterEndpoint          types.String                                                   `tfsdk:"cluster_endpoint"`
+	DomainARN                fwtypes.ARN                                                    `tfsdk:"domain_arn"`
+	IndexName                types.String                                                   `tfsdk:"index_name"`
+	IndexRotationPeriod      fwtypes.StringEnum[awstypes.ElasticsearchIndexRotationPeriod]  `tfsdk:"index_rotation_period"`
+	ProcessingConfiguration  fwtypes.ListNestedObjectValueOf[processingConfigurationModel]  `tfsdk:"processing_configuration" autoflex:"-"`
+	RetryDuration            types.Int64                                                    `tfsdk:"retry_duration" autoflex:"-"`
+	RoleARN                  fwtypes.ARN                                                    `tfsdk:"role_arn"`
+	S3BackupMode             types.String                                                   `tfsdk:"s3_backup_mode"`
+	S3Configuration          fwtypes.ListNestedObjectValueOf[s3ConfigurationModel]          `tfsdk:"s3_configuration" autoflex:"-"`
+	TypeName                 types.String                                                   `tfsdk:"type_name" autoflex:",legacy"`
+	VpcConfiguration         fwtypes.ListNestedObjectValueOf[vpcConfigModel]                `tfsdk:"vpc_config"`
+}
+
+func (m *elasticsearchConfigurationModel) expandCreate(ctx context.Context, input *firehose.CreateDeliveryStreamInput) diag.Diagnostics {
+	var apiObject awstypes.ElasticsearchDestinationConfiguration
+	diags := fwflex.Expand(ctx, m, &apiObject)
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	apiObject.BufferingHints = &awstypes.ElasticsearchBufferingHints{
+		IntervalInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.BufferingInterval),
+		SizeInMBs:         fwflex.Int32FromFrameworkInt64(ctx, m.BufferingSize),
+	}
+	apiObject.ProcessingConfiguration, d = expandProcessingConfiguration(ctx, m.ProcessingConfiguration, destinationTypeElasticsearch, m.RoleARN.ValueString())
+	diags.Append(d...)
+	apiObject.RetryOptions = &awstypes.ElasticsearchRetryOptions{
+		DurationInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.RetryDuration),
+	}
+	apiObject.S3Configuration, d = expandNestedObject(ctx, m.S3Configuration, expandS3DestinationConfiguration)
+	diags.Append(d...)
+	apiObject.TypeName = aws.String(m.TypeName.ValueString())
+
+	input.ElasticsearchDestinationConfiguration = &apiObject
+
+	return diags
+}
+
+func (m *elasticsearchConfigurationModel) expandUpdate(ctx context.Context, input *firehose.UpdateDestinationInput) diag.Diagnostics {
+	var apiObject awstypes.ElasticsearchDestinationUpdate
+	diags := fwflex.Expand(ctx, m, &apiObject)
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	apiObject.BufferingHints = &awstypes.ElasticsearchBufferingHints{
+		IntervalInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.BufferingInterval),
+		SizeInMBs:         fwflex.Int32FromFrameworkInt64(ctx, m.BufferingSize),
+	}
+	apiObject.ProcessingConfiguration, d = expandProcessingConfiguration(ctx, m.ProcessingConfiguration, destinationTypeElasticsearch, m.RoleARN.ValueString())
+	diags.Append(d...)
+	apiObject.RetryOptions = &awstypes.ElasticsearchRetryOptions{
+		DurationInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.RetryDuration),
+	}
+	apiObject.S3Update, d = expandNestedObject(ctx, m.S3Configuration, expandS3DestinationUpdate)
+	diags.Append(d...)
+	apiObject.TypeName = aws.String(m.TypeName.ValueString())
+
+	input.ElasticsearchDestinationUpdate = &apiObject
+
+	return diags
+}
+
+func (m *elasticsearchConfigurationModel) flatten(ctx context.Context, destination *awstypes.DestinationDescription) diag.Diagnostics {
+	apiObject := destination.ElasticsearchDestinationDescription
+
+	diags := fwflex.Flatten(ctx, apiObject, m, fwflex.WithFieldNameSuffix("Description"))
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := apiObject.BufferingHints; v != nil {
+		m.BufferingInterval = fwflex.Int32ToFrameworkInt64(ctx, v.IntervalInSeconds)
+		m.BufferingSize = fwflex.Int32ToFrameworkInt64(ctx, v.SizeInMBs)
+	}
+	diags.Append(flattenProcessingConfiguration(ctx, apiObject.ProcessingConfiguration, &m.ProcessingConfiguration, destinationTypeElasticsearch, aws.ToString(apiObject.RoleARN))...)
+	if v := apiObject.RetryOptions; v != nil {
+		m.RetryDuration = fwflex.Int32ToFrameworkInt64(ctx, v.DurationInSeconds)
+	}
+	diags.Append(flattenNestedObject(ctx, apiObject.S3DestinationDescription, &m.S3Configuration, flattenS3DestinationDescription)...)
+
+	return diags
+}
+
+type dataFormatConversionConfigurationModel struct {
+	Enabled                   types.Bool                                                      `tfsdk:"enabled"`
+	InputFormatConfiguration  fwtypes.ListNestedObjectValueOf[inputFormatConfigurationModel]  `tfsdk:"input_format_configuration"`
+	OutputFormatConfiguration fwtypes.ListNestedObjectValueOf[outputFormatConfigurationModel] `tfsdk:"output_format_configuration"`
+	SchemaConfiguration       fwtypes.ListNestedObjectValueOf[schemaConfigurationModel]       `tfsdk:"schema_configuration"`
+}
+
+type inputFormatConfigurationModel struct {
+	Deserializer fwtypes.ListNestedObjectValueOf[deserializerModel] `tfsdk:"deserializer"`
+}
+
+type deserializerModel struct {
+	HiveJsonSerDe  fwtypes.ListNestedObjectValueOf[hiveJSONSerDeModel]  `tfsdk:"hive_json_ser_de"`
+	OpenXJsonSerDe fwtypes.ListNestedObjectValueOf[openXJSONSerDeModel] `tfsdk:"open_x_json_ser_de"`
+}
+
+type hiveJSONSerDeModel struct {
+	TimestampFormats fwtypes.ListOfString `tfsdk:"timestamp_formats"`
+}
+
+type openXJSONSerDeModel struct {
+	CaseInsensitive                    types.Bool          `tfsdk:"case_insensitive"`
+	ColumnToJsonKeyMappings            fwtypes.MapOfString `tfsdk:"column_to_json_key_mappings"`
+	ConvertDotsInJsonKeysToUnderscores types.Bool          `tfsdk:"convert_dots_in_json_keys_to_underscores"`
+}
+
+type outputFormatConfigurationModel struct {
+	Serializer fwtypes.ListNestedObjectValueOf[serializerModel] `tfsdk:"serializer"`
+}
+
+type serializerModel struct {
+	OrcSerDe     fwtypes.ListNestedObjectValueOf[orcSerDeModel]     `tfsdk:"orc_ser_de"`
+	ParquetSerDe fwtypes.ListNestedObjectValueOf[parquetSerDeModel] `tfsdk:"parquet_ser_de"`
+}
+
+type orcSerDeModel struct {
+	BlockSizeBytes                      types.Int64                                   `tfsdk:"block_size_bytes"`
+	BloomFilterColumns                  fwtypes.ListOfString                          `tfsdk:"bloom_filter_columns"`
+	BloomFilterFalsePositiveProbability types.Float64                                 `tfsdk:"bloom_filter_false_positive_probability"`
+	Compression                         fwtypes.StringEnum[awstypes.OrcCompression]   `tfsdk:"compression"`
+	DictionaryKeyThreshold              types.Float64                                 `tfsdk:"dictionary_key_threshold"`
+	EnablePadding                       types.Bool                                    `tfsdk:"enable_padding"`
+	FormatVersion                       fwtypes.StringEnum[awstypes.OrcFormatVersion] `tfsdk:"format_version"`
+	PaddingTolerance                    types.Float64                                 `tfsdk:"padding_tolerance"`
+	RowIndexStride                      types.Int64                                   `tfsdk:"row_index_stride"`
+	StripeSizeBytes                     types.Int64                                   `tfsdk:"stripe_size_bytes"`
+}
+
+type parquetSerDeModel struct {
+	BlockSizeBytes              types.Int64                                       `tfsdk:"block_size_bytes"`
+	Compression                 fwtypes.StringEnum[awstypes.ParquetCompression]   `tfsdk:"compression"`
+	EnableDictionaryCompression types.Bool                                        `tfsdk:"enable_dictionary_compression"`
+	MaxPaddingBytes             types.Int64                                       `tfsdk:"max_padding_bytes"`
+	PageSizeBytes               types.Int64                                       `tfsdk:"page_size_bytes"`
+	WriterVersion               fwtypes.StringEnum[awstypes.ParquetWriterVersion] `tfsdk:"writer_version"`
+}
+
+type schemaConfigurationModel struct {
+	CatalogId    types.String `tfsdk:"catalog_id" autoflex:",legacy"`
+	DatabaseName types.String `tfsdk:"database_name"`
+	Region       types.String `tfsdk:"region" autoflex:",legacy"`
+	RoleARN      fwtypes.ARN  `tfsdk:"role_arn"`
+	TableName    types.String `tfsdk:"table_name"`
+	VersionId    types.String `tfsdk:"version_id"`
+}
+
+// setDataFormatConversionConfigurationDefaults fills in the values that the API omits when they are the default.
+func setDataFormatConversionConfigurationDefaults(apiObject *awstypes.DataFormatConversionConfiguration) {
+	if v := apiObject.InputFormatConfiguration; v != nil && v.Deserializer != nil {
+		if v := v.Deserializer.OpenXJsonSerDe; v != nil {
+			if v.CaseInsensitive == nil {
+				v.CaseInsensitive = aws.Bool(true)
+			}
+			if v.ConvertDotsInJsonKeysToUnderscores == nil {
+				v.ConvertDotsInJsonKeysToUnderscores = aws.Bool(false)
+			}
+		}
+	}
+
+	if v := apiObject.OutputFormatConfiguration; v != nil && v.Serializer != nil {
+		if v := v.Serializer.OrcSerDe; v != nil {
+			if v.BlockSizeBytes == nil {
+				v.BlockSizeBytes = aws.Int32(268435456)
+			}
+			if v.BloomFilterFalsePositiveProbability == nil {
+				v.BloomFilterFalsePositiveProbability = aws.Float64(0.05)
+			}
+			if v.Compression == "" {
+				v.Compression = awstypes.OrcCompressionSnappy
+			}
+			if v.DictionaryKeyThreshold == nil {
+				v.DictionaryKeyThreshold = aws.Float64(0.0)
+			}
+			if v.EnablePadding == nil {
+				v.EnablePadding = aws.Bool(false)
+			}
+			if v.FormatVersion == "" {
+				v.FormatVersion = awstypes.OrcFormatVersionV012
+			}
+			if v.PaddingTolerance == nil {
+				v.PaddingTolerance = aws.Float64(0.05)
+			}
+			if v.RowIndexStride == nil {
+				v.RowIndexStride = aws.Int32(10000)
+			}
+			if v.StripeSizeBytes == nil {
+				v.StripeSizeBytes = aws.Int32(67108864)
+			}
+		}
+		if v := v.Serializer.ParquetSerDe; v != nil {
+			if v.BlockSizeBytes == nil {
+				v.BlockSizeBytes = aws.Int32(268435456)
+			}
+			if v.Compression == "" {
+				v.Compression = awstypes.ParquetCompressionSnappy
+			}
+			if v.EnableDictionaryCompression == nil {
+				v.EnableDictionaryCompression = aws.Bool(false)
+			}
+			if v.MaxPaddingBytes == nil {
+				v.MaxPaddingBytes = aws.Int32(0)
+			}
+			if v.PageSizeBytes == nil {
+				v.PageSizeBytes = aws.Int32(1048576)
+			}
+			if v.WriterVersion == "" {
+				v.WriterVersion = awstypes.ParquetWriterVersionV1
+			}
+		}
+	}
+}
+
+type dynamicPartitioningConfigurationModel struct {
+	Enabled       types.Bool  `tfsdk:"enabled"`
+	RetryDuration types.Int64 `tfsdk:"retry_duration"`
+}
+
+func expandDynamicPartitioningConfiguration(ctx context.Context, data *dynamicPartitioningConfigurationModel) (*awstypes.DynamicPartitioningConfiguration, diag.Diagnostics) {
+	return &awstypes.DynamicPartitioningConfiguration{
+		Enabled: fwflex.BoolFromFramework(ctx, data.Enabled),
+		RetryOptions: &awstypes.RetryOptions{
+			DurationInSeconds: fwflex.Int32FromFrameworkInt64(ctx, data.RetryDuration),
+		},
+	}, nil
+}
+
+func flattenDynamicPartitioningConfiguration(ctx context.Context, apiObject *awstypes.DynamicPartitioningConfiguration, data *dynamicPartitioningConfigurationModel) diag.Diagnostics {
+	data.Enabled = types.BoolValue(aws.ToBool(apiObject.Enabled))
+	if v := apiObject.RetryOptions; v != nil && v.DurationInSeconds != nil {
+		data.RetryDuration = fwflex.Int32ToFrameworkInt64(ctx, v.DurationInSeconds)
+	}
+
+	return nil
+}
+
+type extendedS3ConfigurationModel struct {
+	BucketARN                         fwtypes.ARN                                                             `tfsdk:"bucket_arn"`
+	BufferingInterval                 types.Int64                                                             `tfsdk:"buffering_interval" autoflex:"-"`
+	BufferingSize                     types.Int64                                                             `tfsdk:"buffering_size" autoflex:"-"`
+	CloudWatchLoggingOptions          fwtypes.ListNestedObjectValueOf[cloudWatchLoggingOptionsModel]          `tfsdk:"cloudwatch_logging_options"`
+	CompressionFormat                 fwtypes.StringEnum[awstypes.CompressionFormat]                          `tfsdk:"compression_format"`
+	CustomTimeZone                    types.String                                                            `tfsdk:"custom_time_zone"`
+	DataFormatConversionConfiguration fwtypes.ListNestedObjectValueOf[dataFormatConversionConfigurationModel] `tfsdk:"data_format_conversion_configuration"`
+	DynamicPartitioningConfiguration  fwtypes.ListNestedObjectValueOf[dynamicPartitioningConfigurationModel]  `tfsdk:"dynamic_partitioning_configuration" autoflex:"-"`
+	ErrorOutputPrefix                 types.String                                                            `tfsdk:"error_output_prefix" autoflex:",legacy"`
+	FileExtension                     types.String                                                            `tfsdk:"file_extension" autoflex:",legacy"`
+	KMSKeyARN                         fwtypes.ARN                                                             `tfsdk:"kms_key_arn" autoflex:"-"`
+	Prefix                            types.String                                                            `tfsdk:"prefix" autoflex:",legacy"`
+	ProcessingConfiguration           fwtypes.ListNestedObjectValueOf[processingConfigurationModel]           `tfsdk:"processing_configuration" autoflex:"-"`
+	RoleARN                           fwtypes.ARN                                                             `tfsdk:"role_arn"`
+	S3BackupConfiguration             fwtypes.ListNestedObjectValueOf[s3ConfigurationModel]                   `tfsdk:"s3_backup_configuration" autoflex:"-"`
+	S3BackupMode                      types.String                                                            `tfsdk:"s3_backup_mode"`
+}
+
+func (m *extendedS3ConfigurationModel) expandDataFormatConversionConfiguration(ctx context.Context) (*awstypes.DataFormatConversionConfiguration, diag.Diagnostics) {
+	if m.DataFormatConversionConfiguration.IsNull() {
+		// It is possible to just pass nil here, but this seems to be the
+		// canonical form that AWS uses, and is less likely to produce diffs.
+		return &awstypes.DataFormatConversionConfiguration{
+			Enabled: aws.Bool(false),
+		}, nil
+	}
+
+	var apiObject awstypes.DataFormatConversionConfiguration
+	diags := fwflex.Expand(ctx, m.DataFormatConversionConfiguration, &apiObject)
+
+	return &apiObject, diags
+}
+
+func (m *extendedS3ConfigurationModel) expandCreate(ctx context.Context, input *firehose.CreateDeliveryStreamInput) diag.Diagnostics {
+	var apiObject awstypes.ExtendedS3DestinationConfiguration
+	diags := fwflex.Expand(ctx, m, &apiObject, fwflex.WithIgnoredFieldNamesAppend("DataFormatConversionConfiguration"))
+	if diags.HasError() {
+		return diags
+	}
+
+	s3 := s3ConfigurationModel{BufferingInterval: m.BufferingInterval, BufferingSize: m.BufferingSize, KMSKeyARN: m.KMSKeyARN}
+	var d diag.Diagnostics
+	apiObject.BufferingHints = s3.expandBufferingHints(ctx)
+	apiObject.DataFormatConversionConfiguration, d = m.expandDataFormatConversionConfiguration(ctx)
+	diags.Append(d...)
+	apiObject.DynamicPartitioningConfiguration, d = expandNestedObject(ctx, m.DynamicPartitioningConfiguration, expandDynamicPartitioningConfiguration)
+	diags.Append(d...)
+	apiObject.EncryptionConfiguration = s3.expandEncryptionConfiguration(ctx)
+	apiObject.FileExtension = aws.String(m.FileExtension.ValueString())
+	apiObject.Prefix = aws.String(m.Prefix.ValueString())
+	apiObject.ProcessingConfiguration, d = expandProcessingConfiguration(ctx, m.ProcessingConfiguration, destinationTypeExtendedS3, m.RoleARN.ValueString())
+	diags.Append(d...)
+	apiObject.S3BackupConfiguration, d = expandNestedObject(ctx, m.S3BackupConfiguration, expandS3DestinationConfiguration)
+	diags.Append(d...)
+
+	input.ExtendedS3DestinationConfiguration = &apiObject
+
+	return diags
+}
+
+func (m *extendedS3ConfigurationModel) expandUpdate(ctx context.Context, input *firehose.UpdateDestinationInput) diag.Diagnostics {
+	var apiObject awstypes.ExtendedS3DestinationUpdate
+	diags := fwflex.Expand(ctx, m, &apiObject, fwflex.WithIgnoredFieldNamesAppend("DataFormatConversionConfiguration"))
+	if diags.HasError() {
+		return diags
+	}
+
+	s3 := s3ConfigurationModel{BufferingInterval: m.BufferingInterval, BufferingSize: m.BufferingSize, KMSKeyARN: m.KMSKeyARN}
+	var d diag.Diagnostics
+	apiObject.BufferingHints = s3.expandBufferingHints(ctx)
+	apiObject.DataFormatConversionConfiguration, d = m.expandDataFormatConversionConfiguration(ctx)
+	diags.Append(d...)
+	apiObject.DynamicPartitioningConfiguration, d = expandNestedObject(ctx, m.DynamicPartitioningConfiguration, expandDynamicPartitioningConfiguration)
+	diags.Append(d...)
+	apiObject.EncryptionConfiguration = s3.expandEncryptionConfiguration(ctx)
+	apiObject.ErrorOutputPrefix = aws.String(m.ErrorOutputPrefix.ValueString())
+	apiObject.FileExtension = aws.String(m.FileExtension.ValueString())
+	apiObject.Prefix = aws.String(m.Prefix.ValueString())
+	apiObject.ProcessingConfiguration, d = expandProcessingConfiguration(ctx, m.ProcessingConfiguration, destinationTypeExtendedS3, m.RoleARN.ValueString())
+	diags.Append(d...)
+	apiObject.S3BackupUpdate, d = expandNestedObject(ctx, m.S3BackupConfiguration, expandS3DestinationUpdate)
+	diags.Append(d...)
+
+	input.ExtendedS3DestinationUpdate = &apiObject
+
+	return diags
+}
+
+func (m *extendedS3ConfigurationModel) flatten(ctx context.Context, destination *awstypes.DestinationDescription) diag.Diagnostics {
+	apiObject := destination.ExtendedS3DestinationDescription
+	// The AWS SDK can represent "no data format conversion configuration" in two ways:
+	// 1. With a nil value
+	// 2. With enabled set to false and nil for ALL the config sections.
+	apiObject.DataFormatConversionConfiguration = nullIfDefault(m.DataFormatConversionConfiguration, apiObject.DataFormatConversionConfiguration, func(apiObject *awstypes.DataFormatConversionConfiguration) bool {
+		return !aws.ToBool(apiObject.Enabled) && apiObject.InputFormatConfiguration == nil && apiObject.OutputFormatConfiguration == nil && apiObject.SchemaConfiguration == nil
+	})
+	if v := apiObject.DataFormatConversionConfiguration; v != nil {
+		setDataFormatConversionConfigurationDefaults(v)
+	}
+
+	diags := fwflex.Flatten(ctx, apiObject, m, fwflex.WithFieldNameSuffix("Description"))
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := apiObject.BufferingHints; v != nil {
+		m.BufferingInterval = fwflex.Int32ToFrameworkInt64(ctx, v.IntervalInSeconds)
+		m.BufferingSize = fwflex.Int32ToFrameworkInt64(ctx, v.SizeInMBs)
+	}
+	diags.Append(flattenNestedObject(ctx, apiObject.DynamicPartitioningConfiguration, &m.DynamicPartitioningConfiguration, flattenDynamicPartitioningConfiguration)...)
+	if v := apiObject.EncryptionConfiguration; v != nil && v.KMSEncryptionConfig != nil {
+		m.KMSKeyARN = fwflex.StringToFrameworkARN(ctx, v.KMSEncryptionConfig.AWSKMSKeyARN)
+	} else {
+		m.KMSKeyARN = fwtypes.ARNNull()
+	}
+	diags.Append(flattenProcessingConfiguration(ctx, apiObject.ProcessingConfiguration, &m.ProcessingConfiguration, destinationTypeExtendedS3, aws.ToString(apiObject.RoleARN))...)
+	diags.Append(flattenNestedObject(ctx, apiObject.S3BackupDescription, &m.S3BackupConfiguration, flattenS3DestinationDescription)...)
+
+	return diags
+}
+
+type httpEndpointRequestConfigurationModel struct {
+	CommonAttributes fwtypes.ListNestedObjectValueOf[httpEndpointCommonAttributeModel] `tfsdk:"common_attributes"`
+	ContentEncoding  fwtypes.StringEnum[awstypes.ContentEncoding]                      `tfsdk:"content_encoding"`
+}
+
+type httpEndpointCommonAttributeModel struct {
+	AttributeName  types.String `tfsdk:"name"`
+	AttributeValue types.String `tfsdk:"value"`
+}
+
+type httpEndpointConfigurationModel struct {
+	AccessKey                   types.String                                                           `tfsdk:"access_key" autoflex:"-"`
+	BufferingInterval           types.Int64                                                            `tfsdk:"buffering_interval" autoflex:"-"`
+	BufferingSize               types.Int64                                                            `tfsdk:"buffering_size" autoflex:"-"`
+	CloudWatchLoggingOptions    fwtypes.ListNestedObjectValueOf[cloudWatchLoggingOptionsModel]         `tfsdk:"cloudwatch_logging_options"`
+	Name                        types.String                                                           `tfsdk:"name" autoflex:"-"`
+	ProcessingConfiguration     fwtypes.ListNestedObjectValueOf[processingConfigurationModel]          `tfsdk:"processing_configuration" autoflex:"-"`
+	RequestConfiguration        fwtypes.ListNestedObjectValueOf[httpEndpointRequestConfigurationModel] `tfsdk:"request_configuration"`
+	RetryDuration               types.Int64                                                            `tfsdk:"retry_duration" autoflex:"-"`
+	RoleARN                     types.String                                                           `tfsdk:"role_arn" autoflex:",legacy"`
+	S3BackupMode                types.String                                                           `tfsdk:"s3_backup_mode"`
+	S3Configuration             fwtypes.ListNestedObjectValueOf[s3ConfigurationModel]                  `tfsdk:"s3_configuration" autoflex:"-"`
+	SecretsManagerConfiguration fwtypes.ListNestedObjectValueOf[secretsManagerConfigurationModel]      `tfsdk:"secrets_manager_configuration"`
+	URL                         types.String                                                           `tfsdk:"url" autoflex:"-"`
+}
+
+func (m *httpEndpointConfigurationModel) expandEndpointConfiguration(ctx context.Context) *awstypes.HttpEndpointConfiguration {
+	apiObject := &awstypes.HttpEndpointConfiguration{
+		Url: fwflex.StringFromFramework(ctx, m.URL),
+	}
+
+	if !m.Name.IsNull() && !m.Name.IsUnknown() {
+		apiObject.Name = aws.String(m.Name.ValueString())
+	}
+
+	if !m.AccessKey.IsNull() && !m.AccessKey.IsUnknown() {
+		apiObject.AccessKey = aws.String(m.AccessKey.ValueString())
+	}
+
+	return apiObject
+}
+
+func (m *httpEndpointConfigurationModel) expandCreate(ctx context.Context, input *firehose.CreateDeliveryStreamInput) diag.Diagnostics {
+	var apiObject awstypes.HttpEndpointDestinationConfiguration
+	diags := fwflex.Expand(ctx, m, &apiObject)
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	apiObject.BufferingHints = &awstypes.HttpEndpointBufferingHints{
+		IntervalInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.BufferingInterval),
+		SizeInMBs:         fwflex.Int32FromFrameworkInt64(ctx, m.BufferingSize),
+	}
+	apiObject.EndpointConfiguration = m.expandEndpointConfiguration(ctx)
+	apiObject.ProcessingConfiguration, d = expandProcessingConfiguration(ctx, m.ProcessingConfiguration, destinationTypeHTTPEndpoint, m.RoleARN.ValueString())
+	diags.Append(d...)
+	apiObject.RetryOptions = &awstypes.HttpEndpointRetryOptions{
+		DurationInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.RetryDuration),
+	}
+	apiObject.RoleARN = aws.String(m.RoleARN.ValueString())
+	apiObject.S3Configuration, d = expandNestedObject(ctx, m.S3Configuration, expandS3DestinationConfiguration)
+	diags.Append(d...)
+
+	input.HttpEndpointDestinationConfiguration = &apiObject
+
+	return diags
+}
+
+func (m *httpEndpointConfigurationModel) expandUpdate(ctx context.Context, input *firehose.UpdateDestinationInput) diag.Diagnostics {
+	var apiObject awstypes.HttpEndpointDestinationUpdate
+	diags := fwflex.Expand(ctx, m, &apiObject)
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	apiObject.BufferingHints = &awstypes.HttpEndpointBufferingHints{
+		IntervalInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.BufferingInterval),
+		SizeInMBs:         fwflex.Int32FromFrameworkInt64(ctx, m.BufferingSize),
+	}
+	apiObject.EndpointConfiguration = m.expandEndpointConfiguration(ctx)
+	apiObject.ProcessingConfiguration, d = expandProcessingConfiguration(ctx, m.ProcessingConfiguration, destinationTypeHTTPEndpoint, m.RoleARN.ValueString())
+	diags.Append(d...)
+	apiObject.RetryOptions = &awstypes.HttpEndpointRetryOptions{
+		DurationInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.RetryDuration),
+	}
+	apiObject.RoleARN = aws.String(m.RoleARN.ValueString())
+	apiObject.S3Update, d = expandNestedObject(ctx, m.S3Configuration, expandS3DestinationUpdate)
+	diags.Append(d...)
+
+	input.HttpEndpointDestinationUpdate = &apiObject
+
+	return diags
+}
+
+func (m *httpEndpointConfigurationModel) flatten(ctx context.Context, destination *awstypes.DestinationDescription) diag.Diagnostics {
+	apiObject := destination.HttpEndpointDestinationDescription
+
+	diags := fwflex.Flatten(ctx, apiObject, m, fwflex.WithFieldNameSuffix("Description"))
+	if diags.HasError() {
+		return diags
+	}
+
+	// The access key is not returned by the API.
+	if v := apiObject.BufferingHints; v != nil {
+		m.BufferingInterval = fwflex.Int32ToFrameworkInt64(ctx, v.IntervalInSeconds)
+		m.BufferingSize = fwflex.Int32ToFrameworkInt64(ctx, v.SizeInMBs)
+	}
+	if v := apiObject.EndpointConfiguration; v != nil {
+		m.Name = fwflex.StringToFrameworkLegacy(ctx, v.Name)
+		m.URL = fwflex.StringToFramework(ctx, v.Url)
+	}
+	diags.Append(flattenProcessingConfiguration(ctx, apiObject.ProcessingConfiguration, &m.ProcessingConfiguration, destinationTypeHTTPEndpoint, aws.ToString(apiObject.RoleARN))...)
+	if v := apiObject.RetryOptions; v != nil {
+		m.RetryDuration = fwflex.Int32ToFrameworkInt64(ctx, v.DurationInSeconds)
+	}
+	diags.Append(flattenNestedObject(ctx, apiObject.S3DestinationDescription, &m.S3Configuration, flattenS3DestinationDescription)...)
+
+	return diags
+}
+
+type destinationTableConfigurationModel struct {
+	DestinationDatabaseName types.String                                        `tfsdk:"database_name"`
+	DestinationTableName    types.String                                        `tfsdk:"table_name"`
+	PartitionSpec           fwtypes.ListNestedObjectValueOf[partitionSpecModel] `tfsdk:"partition_spec"`
+	S3ErrorOutputPrefix     types.String                                        `tfsdk:"s3_error_output_prefix"`
+	UniqueKeys              fwtypes.ListOfString                                `tfsdk:"unique_keys"`
+}
+
+type partitionSpecModel struct {
+	Identity fwtypes.ListNestedObjectValueOf[partitionFieldModel] `tfsdk:"identity"`
+}
+
+type partitionFieldModel struct {
+	SourceName types.String `tfsdk:"source_name"`
+}
+
+type schemaEvolutionConfigurationModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+type tableCreationConfigurationModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+type icebergConfigurationModel struct {
+	AppendOnly                        types.Bool                                                          `tfsdk:"append_only" autoflex:",legacy"`
+	BufferingInterval                 types.Int64                                                         `tfsdk:"buffering_interval" autoflex:"-"`
+	BufferingSize                     types.Int64                                                         `tfsdk:"buffering_size" autoflex:"-"`
+	CatalogARN                        fwtypes.ARN                                                         `tfsdk:"catalog_arn" autoflex:"-"`
+	CloudWatchLoggingOptions          fwtypes.ListNestedObjectValueOf[cloudWatchLoggingOptionsModel]      `tfsdk:"cloudwatch_logging_options"`
+	DestinationTableConfigurationList fwtypes.ListNestedObjectValueOf[destinationTableConfigurationModel] `tfsdk:"destination_table_configuration"`
+	ProcessingConfiguration           fwtypes.ListNestedObjectValueOf[processingConfigurationModel]       `tfsdk:"processing_configuration" autoflex:"-"`
+	RetryDuration                     types.Int64                                                         `tfsdk:"retry_duration" autoflex:"-"`
+	RoleARN                           fwtypes.ARN                                                         `tfsdk:"role_arn"`
+	S3BackupMode                      types.String                                                        `tfsdk:"s3_backup_mode"`
+	S3Configuration                   fwtypes.ListNestedObjectValueOf[s3ConfigurationModel]               `tfsdk:"s3_configuration" autoflex:"-"`
+	SchemaEvolutionConfiguration      fwtypes.ListNestedObjectValueOf[schemaEvolutionConfigurationModel]  `tfsdk:"schema_evolution_configuration"`
+	TableCreationConfiguration        fwtypes.ListNestedObjectValueOf[tableCreationConfigurationModel]    `tfsdk:"table_creation_configuration"`
+	WarehouseLocation                 types.String                                                        `tfsdk:"warehouse_location" autoflex:"-"`
+}
+
+func (m *icebergConfigurationModel) expandCatalogConfiguration(ctx context.Context) *awstypes.CatalogConfiguration {
+	return &awstypes.CatalogConfiguration{
+		CatalogARN:        fwflex.StringFromFramework(ctx, m.CatalogARN),
+		WarehouseLocation: fwflex.StringFromFramework(ctx, m.WarehouseLocation),
+	}
+}
+
+func (m *icebergConfigurationModel) expandCreate(ctx context.Context, input *firehose.CreateDeliveryStreamInput) diag.Diagnostics {
+	var apiObject awstypes.IcebergDestinationConfiguration
+	diags := fwflex.Expand(ctx, m, &apiObject)
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	apiObject.BufferingHints = &awstypes.BufferingHints{
+		IntervalInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.BufferingInterval),
+		SizeInMBs:         fwflex.Int32FromFrameworkInt64(ctx, m.BufferingSize),
+	}
+	apiObject.CatalogConfiguration = m.expandCatalogConfiguration(ctx)
+	apiObject.ProcessingConfiguration, d = expandProcessingConfiguration(ctx, m.ProcessingConfiguration, destinationTypeIceberg, m.RoleARN.ValueString())
+	diags.Append(d...)
+	apiObject.RetryOptions = &awstypes.RetryOptions{
+		DurationInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.RetryDuration),
+	}
+	apiObject.S3Configuration, d = expandNestedObject(ctx, m.S3Configuration, expandS3DestinationConfiguration)
+	diags.Append(d...)
+
+	input.IcebergDestinationConfiguration = &apiObject
+
+	return diags
+}
+
+func (m *icebergConfigurationModel) expandUpdate(ctx context.Context, input *firehose.UpdateDestinationInput) diag.Diagnostics {
+	var apiObject awstypes.IcebergDestinationUpdate
+	diags := fwflex.Expand(ctx, m, &apiObject)
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	apiObject.BufferingHints = &awstypes.BufferingHints{
+		IntervalInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.BufferingInterval),
+		SizeInMBs:         fwflex.Int32FromFrameworkInt64(ctx, m.BufferingSize),
+	}
+	apiObject.CatalogConfiguration = m.expandCatalogConfiguration(ctx)
+	apiObject.ProcessingConfiguration, d = expandProcessingConfiguration(ctx, m.ProcessingConfiguration, destinationTypeIceberg, m.RoleARN.ValueString())
+	diags.Append(d...)
+	apiObject.RetryOptions = &awstypes.RetryOptions{
+		DurationInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.RetryDuration),
+	}
+	apiObject.S3Configuration, d = expandNestedObject(ctx, m.S3Configuration, expandS3DestinationConfiguration)
+	diags.Append(d...)
+
+	input.IcebergDestinationUpdate = &apiObject
+
+	return diags
+}
+
+func (m *icebergConfigurationModel) flatten(ctx context.Context, destination *awstypes.DestinationDescription) diag.Diagnostics {
+	apiObject := destination.IcebergDestinationDescription
+	if len(apiObject.DestinationTableConfigurationList) == 0 {
+		apiObject.DestinationTableConfigurationList = nil
+	}
+	apiObject.SchemaEvolutionConfiguration = nullIfDefault(m.SchemaEvolutionConfiguration, apiObject.SchemaEvolutionConfiguration, func(apiObject *awstypes.SchemaEvolutionConfiguration) bool {
+		return !aws.ToBool(apiObject.Enabled)
+	})
+	apiObject.TableCreationConfiguration = nullIfDefault(m.TableCreationConfiguration, apiObject.TableCreationConfiguration, func(apiObject *awstypes.TableCreationConfiguration) bool {
+		return !aws.ToBool(apiObject.Enabled)
+	})
+
+	diags := fwflex.Flatten(ctx, apiObject, m, fwflex.WithFieldNameSuffix("Description"))
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := apiObject.BufferingHints; v != nil {
+		m.BufferingInterval = fwflex.Int32ToFrameworkInt64(ctx, v.IntervalInSeconds)
+		m.BufferingSize = fwflex.Int32ToFrameworkInt64(ctx, v.SizeInMBs)
+	}
+	if v := apiObject.CatalogConfiguration; v != nil {
+		m.CatalogARN = fwflex.StringToFrameworkARN(ctx, v.CatalogARN)
+		m.WarehouseLocation = fwflex.StringToFramework(ctx, v.WarehouseLocation)
+	}
+	diags.Append(flattenProcessingConfiguration(ctx, apiObject.ProcessingConfiguration, &m.ProcessingConfiguration, destinationTypeIceberg, aws.ToString(apiObject.RoleARN))...)
+	if v := apiObject.RetryOptions; v != nil {
+		m.RetryDuration = fwflex.Int32ToFrameworkInt64(ctx, v.DurationInSeconds)
+	}
+	diags.Append(flattenNestedObject(ctx, apiObject.S3DestinationDescription, &m.S3Configuration, flattenS3DestinationDescription)...)
+
+	return diags
+}
+
+type openSearchConfigurationModel struct {
+	BufferingInterval        types.Int64                                                             `tfsdk:"buffering_interval" autoflex:"-"`
+	BufferingSize            types.Int64                                                             `tfsdk:"buffering_size" autoflex:"-"`
+	CloudWatchLoggingOptions fwtypes.ListNestedObjectValueOf[cloudWatchLoggingOptionsModel]          `tfsdk:"cloudwatch_logging_options"`
+	ClusterEndpoint          types.String                                                            `tfsdk:"cluster_endpoint"`
+	DocumentIdOptions        fwtypes.ListNestedObjectValueOf[documentIDOptionsModel]                 `tfsdk:"document_id_options"`
+	DomainARN                fwtypes.ARN                                                             `tfsdk:"domain_arn"`
+	IndexName                types.String                                                            `tfsdk:"index_name"`
+	IndexRotationPeriod      fwtypes.StringEnum[awstypes.AmazonopensearchserviceIndexRotationPeriod] `tfsdk:"index_rotation_period"`
+	ProcessingConfiguration  fwtypes.ListNestedObjectValueOf[processingConfigurationModel]           `tfsdk:"processing_configuration" autoflex:"-"`
+	RetryDuration            types.Int64                                                             `tfsdk:"retry_duration" autoflex:"-"`
+	RoleARN                  fwtypes.ARN                                                             `tfsdk:"role_arn"`
+	S3BackupMode             types.String                                                            `tfsdk:"s3_backup_mode"`
+	S3Configuration          fwtypes.ListNestedObjectValueOf[s3ConfigurationModel]                   `tfsdk:"s3_configuration" autoflex:"-"`
+	TypeName                 types.String                                                            `tfsdk:"type_name" autoflex:",legacy"`
+	VpcConfiguration         fwtypes.ListNestedObjectValueOf[vpcConfigModel]                         `tfsdk:"vpc_config"`
+}
+
+func (m *openSearchConfigurationModel) expandCreate(ctx context.Context, input *firehose.CreateDeliveryStreamInput) diag.Diagnostics {
+	var apiObject awstypes.AmazonopensearchserviceDestinationConfiguration
+	diags := fwflex.Expand(ctx, m, &apiObject)
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	apiObject.BufferingHints = &awstypes.AmazonopensearchserviceBufferingHints{
+		IntervalInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.BufferingInterval),
+		SizeInMBs:         fwflex.Int32FromFrameworkInt64(ctx, m.BufferingSize),
+	}
+	apiObject.ProcessingConfiguration, d = expandProcessingConfiguration(ctx, m.ProcessingConfiguration, destinationTypeOpenSearch, m.RoleARN.ValueString())
+	diags.Append(d...)
+	apiObject.RetryOptions = &awstypes.AmazonopensearchserviceRetryOptions{
+		DurationInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.RetryDuration),
+	}
+	apiObject.S3Configuration, d = expandNestedObject(ctx, m.S3Configuration, expandS3DestinationConfiguration)
+	diags.Append(d...)
+	apiObject.TypeName = aws.String(m.TypeName.ValueString())
+
+	input.AmazonopensearchserviceDestinationConfiguration = &apiObject
+
+	return diags
+}
+
+func (m *openSearchConfigurationModel) expandUpdate(ctx context.Context, input *firehose.UpdateDestinationInput) diag.Diagnostics {
+	var apiObject awstypes.AmazonopensearchserviceDestinationUpdate
+	diags := fwflex.Expand(ctx, m, &apiObject)
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	apiObject.BufferingHints = &awstypes.AmazonopensearchserviceBufferingHints{
+		IntervalInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.BufferingInterval),
+		SizeInMBs:         fwflex.Int32FromFrameworkInt64(ctx, m.BufferingSize),
+	}
+	apiObject.ProcessingConfiguration, d = expandProcessingConfiguration(ctx, m.ProcessingConfiguration, destinationTypeOpenSearch, m.RoleARN.ValueString())
+	diags.Append(d...)
+	apiObject.RetryOptions = &awstypes.AmazonopensearchserviceRetryOptions{
+		DurationInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.RetryDuration),
+	}
+	apiObject.S3Update, d = expandNestedObject(ctx, m.S3Configuration, expandS3DestinationUpdate)
+	diags.Append(d...)
+	apiObject.TypeName = aws.String(m.TypeName.ValueString())
+
+	input.AmazonopensearchserviceDestinationUpdate = &apiObject
+
+	return diags
+}
+
+func (m *openSearchConfigurationModel) flatten(ctx context.Context, destination *awstypes.DestinationDescription) diag.Diagnostics {
+	apiObject := destination.AmazonopensearchserviceDestinationDescription
+
+	diags := fwflex.Flatten(ctx, apiObject, m, fwflex.WithFieldNameSuffix("Description"))
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := apiObject.BufferingHints; v != nil {
+		m.BufferingInterval = fwflex.Int32ToFrameworkInt64(ctx, v.IntervalInSeconds)
+		m.BufferingSize = fwflex.Int32ToFrameworkInt64(ctx, v.SizeInMBs)
+	}
+	diags.Append(flattenProcessingConfiguration(ctx, apiObject.ProcessingConfiguration, &m.ProcessingConfiguration, destinationTypeOpenSearch, aws.ToString(apiObject.RoleARN))...)
+	if v := apiObject.RetryOptions; v != nil {
+		m.RetryDuration = fwflex.Int32ToFrameworkInt64(ctx, v.DurationInSeconds)
+	}
+	diags.Append(flattenNestedObject(ctx, apiObject.S3DestinationDescription, &m.S3Configuration, flattenS3DestinationDescription)...)
+
+	return diags
+}
+
+type openSearchServerlessConfigurationModel struct {
+	BufferingInterval        types.Int64                                                    `tfsdk:"buffering_interval" autoflex:"-"`
+	BufferingSize            types.Int64                                                    `tfsdk:"buffering_size" autoflex:"-"`
+	CloudWatchLoggingOptions fwtypes.ListNestedObjectValueOf[cloudWatchLoggingOptionsModel] `tfsdk:"cloudwatch_logging_options"`
+	CollectionEndpoint       types.String                                                   `tfsdk:"collection_endpoint"`
+	IndexName                types.String                                                   `tfsdk:"index_name"`
+	ProcessingConfiguration  fwtypes.ListNestedObjectValueOf[processingConfigurationModel]  `tfsdk:"processing_configuration" autoflex:"-"`
+	RetryDuration            types.Int64                                                    `tfsdk:"retry_duration" autoflex:"-"`
+	RoleARN                  fwtypes.ARN                                                    `tfsdk:"role_arn"`
+	S3BackupMode             types.String                                                   `tfsdk:"s3_backup_mode"`
+	S3Configuration          fwtypes.ListNestedObjectValueOf[s3ConfigurationModel]          `tfsdk:"s3_configuration" autoflex:"-"`
+	VpcConfiguration         fwtypes.ListNestedObjectValueOf[vpcConfigModel]                `tfsdk:"vpc_config"`
+}
+
+func (m *openSearchServerlessConfigurationModel) expandCreate(ctx context.Context, input *firehose.CreateDeliveryStreamInput) diag.Diagnostics {
+	var apiObject awstypes.AmazonOpenSearchServerlessDestinationConfiguration
+	diags := fwflex.Expand(ctx, m, &apiObject)
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	apiObject.BufferingHints = &awstypes.AmazonOpenSearchServerlessBufferingHints{
+		IntervalInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.BufferingInterval),
+		SizeInMBs:         fwflex.Int32FromFrameworkInt64(ctx, m.BufferingSize),
+	}
+	apiObject.ProcessingConfiguration, d = expandProcessingConfiguration(ctx, m.ProcessingConfiguration, destinationTypeOpenSearchServerless, m.RoleARN.ValueString())
+	diags.Append(d...)
+	apiObject.RetryOptions = &awstypes.AmazonOpenSearchServerlessRetryOptions{
+		DurationInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.RetryDuration),
+	}
+	apiObject.S3Configuration, d = expandNestedObject(ctx, m.S3Configuration, expandS3DestinationConfiguration)
+	diags.Append(d...)
+
+	input.AmazonOpenSearchServerlessDestinationConfiguration = &apiObject
+
+	return diags
+}
+
+func (m *openSearchServerlessConfigurationModel) expandUpdate(ctx context.Context, input *firehose.UpdateDestinationInput) diag.Diagnostics {
+	var apiObject awstypes.AmazonOpenSearchServerlessDestinationUpdate
+	diags := fwflex.Expand(ctx, m, &apiObject)
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	apiObject.BufferingHints = &awstypes.AmazonOpenSearchServerlessBufferingHints{
+		IntervalInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.BufferingInterval),
+		SizeInMBs:         fwflex.Int32FromFrameworkInt64(ctx, m.BufferingSize),
+	}
+	apiObject.ProcessingConfiguration, d = expandProcessingConfiguration(ctx, m.ProcessingConfiguration, destinationTypeOpenSearchServerless, m.RoleARN.ValueString())
+	diags.Append(d...)
+	apiObject.RetryOptions = &awstypes.AmazonOpenSearchServerlessRetryOptions{
+		DurationInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.RetryDuration),
+	}
+	apiObject.S3Update, d = expandNestedObject(ctx, m.S3Configuration, expandS3DestinationUpdate)
+	diags.Append(d...)
+
+	input.AmazonOpenSearchServerlessDestinationUpdate = &apiObject
+
+	return diags
+}
+
+func (m *openSearchServerlessConfigurationModel) flatten(ctx context.Context, destination *awstypes.DestinationDescription) diag.Diagnostics {
+	apiObject := destination.AmazonOpenSearchServerlessDestinationDescription
+
+	diags := fwflex.Flatten(ctx, apiObject, m, fwflex.WithFieldNameSuffix("Description"))
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := apiObject.BufferingHints; v != nil {
+		m.BufferingInterval = fwflex.Int32ToFrameworkInt64(ctx, v.IntervalInSeconds)
+		m.BufferingSize = fwflex.Int32ToFrameworkInt64(ctx, v.SizeInMBs)
+	}
+	diags.Append(flattenProcessingConfiguration(ctx, apiObject.ProcessingConfiguration, &m.ProcessingConfiguration, destinationTypeOpenSearchServerless, aws.ToString(apiObject.RoleARN))...)
+	if v := apiObject.RetryOptions; v != nil {
+		m.RetryDuration = fwflex.Int32ToFrameworkInt64(ctx, v.DurationInSeconds)
+	}
+	diags.Append(flattenNestedObject(ctx, apiObject.S3DestinationDescription, &m.S3Configuration, flattenS3DestinationDescription)...)
+
+	return diags
+}
+
+type redshiftConfigurationModel struct {
+	CloudWatchLoggingOptions    fwtypes.ListNestedObjectValueOf[cloudWatchLoggingOptionsModel]    `tfsdk:"cloudwatch_logging_options"`
+	ClusterJDBCURL              types.String                                                      `tfsdk:"cluster_jdbcurl"`
+	CopyOptions                 types.String                                                      `tfsdk:"copy_options" autoflex:"-"`
+	DataTableColumns            types.String                                                      `tfsdk:"data_table_columns" autoflex:"-"`
+	DataTableName               types.String                                                      `tfsdk:"data_table_name" autoflex:"-"`
+	Password                    types.String                                                      `tfsdk:"password" autoflex:",noflatten"`
+	ProcessingConfiguration     fwtypes.ListNestedObjectValueOf[processingConfigurationModel]     `tfsdk:"processing_configuration" autoflex:"-"`
+	RetryDuration               types.Int64                                                       `tfsdk:"retry_duration" autoflex:"-"`
+	RoleARN                     fwtypes.ARN                                                       `tfsdk:"role_arn"`
+	S3BackupConfiguration       fwtypes.ListNestedObjectValueOf[s3ConfigurationModel]             `tfsdk:"s3_backup_configuration" autoflex:"-"`
+	S3BackupMode                types.String                                                      `tfsdk:"s3_backup_mode"`
+	S3Configuration             fwtypes.ListNestedObjectValueOf[s3ConfigurationModel]             `tfsdk:"s3_configuration" autoflex:"-"`
+	SecretsManagerConfiguration fwtypes.ListNestedObjectValueOf[secretsManagerConfigurationModel] `tfsdk:"secrets_manager_configuration"`
+	Username                    types.String                                                      `tfsdk:"username" autoflex:",legacy"`
+}
+
+func (m *redshiftConfigurationModel) expandCopyCommand(ctx context.Context) *awstypes.CopyCommand {
+	return &awstypes.CopyCommand{
+		CopyOptions:      aws.String(m.CopyOptions.ValueString()),
+		DataTableColumns: aws.String(m.DataTableColumns.ValueString()),
+		DataTableName:    fwflex.StringFromFramework(ctx, m.DataTableName),
+	}
+}
+
+func (m *redshiftConfigurationModel) expandCreate(ctx context.Context, input *firehose.CreateDeliveryStreamInput) diag.Diagnostics {
+	var apiObject awstypes.RedshiftDestinationConfiguration
+	diags := fwflex.Expand(ctx, m, &apiObject)
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	apiObject.CopyCommand = m.expandCopyCommand(ctx)
+	apiObject.ProcessingConfiguration, d = expandProcessingConfiguration(ctx, m.ProcessingConfiguration, destinationTypeRedshift, m.RoleARN.ValueString())
+	diags.Append(d...)
+	apiObject.RetryOptions = &awstypes.RedshiftRetryOptions{
+		DurationInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.RetryDuration),
+	}
+	apiObject.S3BackupConfiguration, d = expandNestedObject(ctx, m.S3BackupConfiguration, expandS3DestinationConfiguration)
+	diags.Append(d...)
+	apiObject.S3Configuration, d = expandNestedObject(ctx, m.S3Configuration, expandS3DestinationConfiguration)
+	diags.Append(d...)
+
+	input.RedshiftDestinationConfiguration = &apiObject
+
+	return diags
+}
+
+func (m *redshiftConfigurationModel) expandUpdate(ctx context.Context, input *firehose.UpdateDestinationInput) diag.Diagnostics {
+	var apiObject awstypes.RedshiftDestinationUpdate
+	diags := fwflex.Expand(ctx, m, &apiObject)
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	apiObject.CopyCommand = m.expandCopyCommand(ctx)
+	apiObject.ProcessingConfiguration, d = expandProcessingConfiguration(ctx, m.ProcessingConfiguration, destinationTypeRedshift, m.RoleARN.ValueString())
+	diags.Append(d...)
+	apiObject.RetryOptions = &awstypes.RedshiftRetryOptions{
+		DurationInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.RetryDuration),
+	}
+	apiObject.S3BackupUpdate, d = expandNestedObject(ctx, m.S3BackupConfiguration, expandS3DestinationUpdate)
+	diags.Append(d...)
+	if apiObject.S3BackupUpdate != nil {
+		// Redshift does not currently support ErrorOutputPrefix,
+		// which is set to the empty string within "updateS3Config",
+		// thus we must remove it here to avoid an InvalidArgumentException.
+		apiObject.S3BackupUpdate.ErrorOutputPrefix = nil
+	}
+	apiObject.S3Update, d = expandNestedObject(ctx, m.S3Configuration, expandS3DestinationUpdate)
+	diags.Append(d...)
+	if apiObject.S3Update != nil {
+		apiObject.S3Update.ErrorOutputPrefix = nil
+	}
+
+	input.RedshiftDestinationUpdate = &apiObject
+
+	return diags
+}
+
+func (m *redshiftConfigurationModel) flatten(ctx context.Context, destination *awstypes.DestinationDescription) diag.Diagnostics {
+	apiObject := destination.RedshiftDestinationDescription
+
+	diags := fwflex.Flatten(ctx, apiObject, m, fwflex.WithFieldNameSuffix("Description"))
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := apiObject.CopyCommand; v != nil {
+		m.CopyOptions = fwflex.StringToFrameworkLegacy(ctx, v.CopyOptions)
+		m.DataTableColumns = fwflex.StringToFrameworkLegacy(ctx, v.DataTableColumns)
+		m.DataTableName = fwflex.StringToFramework(ctx, v.DataTableName)
+	}
+	diags.Append(flattenProcessingConfiguration(ctx, apiObject.ProcessingConfiguration, &m.ProcessingConfiguration, destinationTypeRedshift, aws.ToString(apiObject.RoleARN))...)
+	if v := apiObject.RetryOptions; v != nil {
+		m.RetryDuration = fwflex.Int32ToFrameworkInt64(ctx, v.DurationInSeconds)
+	}
+	diags.Append(flattenNestedObject(ctx, apiObject.S3BackupDescription, &m.S3BackupConfiguration, flattenS3DestinationDescription)...)
+	diags.Append(flattenNestedObject(ctx, apiObject.S3DestinationDescription, &m.S3Configuration, flattenS3DestinationDescription)...)
+
+	return diags
+}
+
+type snowflakeRoleConfigurationModel struct {
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	SnowflakeRole types.String `tfsdk:"snowflake_role" autoflex:",legacy"`
+}
+
+type snowflakeVPCConfigurationModel struct {
+	PrivateLinkVpceId types.String `tfsdk:"private_link_vpce_id"`
+}
+
+type snowflakeConfigurationModel struct {
+	AccountUrl                  types.String                                                      `tfsdk:"account_url"`
+	BufferingInterval           types.Int64                                                       `tfsdk:"buffering_interval" autoflex:"-"`
+	BufferingSize               types.Int64                                                       `tfsdk:"buffering_size" autoflex:"-"`
+	CloudWatchLoggingOptions    fwtypes.ListNestedObjectValueOf[cloudWatchLoggingOptionsModel]    `tfsdk:"cloudwatch_logging_options"`
+	ContentColumnName           types.String                                                      `tfsdk:"content_column_name" autoflex:",legacy"`
+	DataLoadingOption           fwtypes.StringEnum[awstypes.SnowflakeDataLoadingOption]           `tfsdk:"data_loading_option"`
+	Database                    types.String                                                      `tfsdk:"database"`
+	KeyPassphrase               types.String                                                      `tfsdk:"key_passphrase" autoflex:",legacy,noflatten"`
+	MetaDataColumnName          types.String                                                      `tfsdk:"metadata_column_name" autoflex:",legacy"`
+	PrivateKey                  types.String                                                      `tfsdk:"private_key" autoflex:",legacy,noflatten"`
+	ProcessingConfiguration     fwtypes.ListNestedObjectValueOf[processingConfigurationModel]     `tfsdk:"processing_configuration" autoflex:"-"`
+	RetryDuration               types.Int64                                                       `tfsdk:"retry_duration" autoflex:"-"`
+	RoleARN                     fwtypes.ARN                                                       `tfsdk:"role_arn"`
+	S3BackupMode                types.String                                                      `tfsdk:"s3_backup_mode"`
+	S3Configuration             fwtypes.ListNestedObjectValueOf[s3ConfigurationModel]             `tfsdk:"s3_configuration" autoflex:"-"`
+	Schema                      types.String                                                      `tfsdk:"schema"`
+	SecretsManagerConfiguration fwtypes.ListNestedObjectValueOf[secretsManagerConfigurationModel] `tfsdk:"secrets_manager_configuration"`
+	SnowflakeRoleConfiguration  fwtypes.ListNestedObjectValueOf[snowflakeRoleConfigurationModel]  `tfsdk:"snowflake_role_configuration"`
+	SnowflakeVpcConfiguration   fwtypes.ListNestedObjectValueOf[snowflakeVPCConfigurationModel]   `tfsdk:"snowflake_vpc_configuration"`
+	Table                       types.String                                                      `tfsdk:"table"`
+	User                        types.String                                                      `tfsdk:"user" autoflex:",legacy"`
+}
+
+func (m *snowflakeConfigurationModel) expandCreate(ctx context.Context, input *firehose.CreateDeliveryStreamInput) diag.Diagnostics {
+	var apiObject awstypes.SnowflakeDestinationConfiguration
+	diags := fwflex.Expand(ctx, m, &apiObject)
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	apiObject.BufferingHints = &awstypes.SnowflakeBufferingHints{
+		IntervalInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.BufferingInterval),
+		SizeInMBs:         fwflex.Int32FromFrameworkInt64(ctx, m.BufferingSize),
+	}
+	apiObject.ProcessingConfiguration, d = expandProcessingConfiguration(ctx, m.ProcessingConfiguration, destinationTypeSnowflake, m.RoleARN.ValueString())
+	diags.Append(d...)
+	apiObject.RetryOptions = &awstypes.SnowflakeRetryOptions{
+		DurationInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.RetryDuration),
+	}
+	apiObject.S3Configuration, d = expandNestedObject(ctx, m.S3Configuration, expandS3DestinationConfiguration)
+	diags.Append(d...)
+	if apiObject.SnowflakeRoleConfiguration == nil {
+		// It is possible to just pass nil here, but this seems to be the
+		// canonical form that AWS uses, and is less likely to produce diffs.
+		apiObject.SnowflakeRoleConfiguration = &awstypes.SnowflakeRoleConfiguration{
+			Enabled: aws.Bool(false),
+		}
+	}
+
+	input.SnowflakeDestinationConfiguration = &apiObject
+
+	return diags
+}
+
+func (m *snowflakeConfigurationModel) expandUpdate(ctx context.Context, input *firehose.UpdateDestinationInput) diag.Diagnostics {
+	var apiObject awstypes.SnowflakeDestinationUpdate
+	diags := fwflex.Expand(ctx, m, &apiObject)
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	apiObject.BufferingHints = &awstypes.SnowflakeBufferingHints{
+		IntervalInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.BufferingInterval),
+		SizeInMBs:         fwflex.Int32FromFrameworkInt64(ctx, m.BufferingSize),
+	}
+	apiObject.ProcessingConfiguration, d = expandProcessingConfiguration(ctx, m.ProcessingConfiguration, destinationTypeSnowflake, m.RoleARN.ValueString())
+	diags.Append(d...)
+	apiObject.RetryOptions = &awstypes.SnowflakeRetryOptions{
+		DurationInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.RetryDuration),
+	}
+	apiObject.S3Update, d = expandNestedObject(ctx, m.S3Configuration, expandS3DestinationUpdate)
+	diags.Append(d...)
+	if apiObject.SnowflakeRoleConfiguration == nil {
+		apiObject.SnowflakeRoleConfiguration = &awstypes.SnowflakeRoleConfiguration{
+			Enabled: aws.Bool(false),
+		}
+	}
+
+	input.SnowflakeDestinationUpdate = &apiObject
+
+	return diags
+}
+
+func (m *snowflakeConfigurationModel) flatten(ctx context.Context, destination *awstypes.DestinationDescription) diag.Diagnostics {
+	apiObject := destination.SnowflakeDestinationDescription
+
+	diags := fwflex.Flatten(ctx, apiObject, m, fwflex.WithFieldNameSuffix("Description"))
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := apiObject.BufferingHints; v != nil {
+		m.BufferingInterval = fwflex.Int32ToFrameworkInt64(ctx, v.IntervalInSeconds)
+		m.BufferingSize = fwflex.Int32ToFrameworkInt64(ctx, v.SizeInMBs)
+	}
+	diags.Append(flattenProcessingConfiguration(ctx, apiObject.ProcessingConfiguration, &m.ProcessingConfiguration, destinationTypeSnowflake, aws.ToString(apiObject.RoleARN))...)
+	if v := apiObject.RetryOptions; v != nil {
+		m.RetryDuration = fwflex.Int32ToFrameworkInt64(ctx, v.DurationInSeconds)
+	}
+	diags.Append(flattenNestedObject(ctx, apiObject.S3DestinationDescription, &m.S3Configuration, flattenS3DestinationDescription)...)
+
+	return diags
+}
+
+type splunkConfigurationModel struct {
+	BufferingInterval                 types.Int64                                                       `tfsdk:"buffering_interval" autoflex:"-"`
+	BufferingSize                     types.Int64                                                       `tfsdk:"buffering_size" autoflex:"-"`
+	CloudWatchLoggingOptions          fwtypes.ListNestedObjectValueOf[cloudWatchLoggingOptionsModel]    `tfsdk:"cloudwatch_logging_options"`
+	HECAcknowledgmentTimeoutInSeconds types.Int64                                                       `tfsdk:"hec_acknowledgment_timeout"`
+	HECEndpoint                       types.String                                                      `tfsdk:"hec_endpoint"`
+	HECEndpointType                   fwtypes.StringEnum[awstypes.HECEndpointType]                      `tfsdk:"hec_endpoint_type"`
+	HECToken                          types.String                                                      `tfsdk:"hec_token" autoflex:",legacy"`
+	ProcessingConfiguration           fwtypes.ListNestedObjectValueOf[processingConfigurationModel]     `tfsdk:"processing_configuration" autoflex:"-"`
+	RetryDuration                     types.Int64                                                       `tfsdk:"retry_duration" autoflex:"-"`
+	S3BackupMode                      types.String                                                      `tfsdk:"s3_backup_mode"`
+	S3Configuration                   fwtypes.ListNestedObjectValueOf[s3ConfigurationModel]             `tfsdk:"s3_configuration" autoflex:"-"`
+	SecretsManagerConfiguration       fwtypes.ListNestedObjectValueOf[secretsManagerConfigurationModel] `tfsdk:"secrets_manager_configuration"`
+}
+
+func (m *splunkConfigurationModel) expandCreate(ctx context.Context, input *firehose.CreateDeliveryStreamInput) diag.Diagnostics {
+	var apiObject awstypes.SplunkDestinationConfiguration
+	diags := fwflex.Expand(ctx, m, &apiObject)
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	apiObject.BufferingHints = &awstypes.SplunkBufferingHints{
+		IntervalInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.BufferingInterval),
+		SizeInMBs:         fwflex.Int32FromFrameworkInt64(ctx, m.BufferingSize),
+	}
+	apiObject.ProcessingConfiguration, d = expandProcessingConfiguration(ctx, m.ProcessingConfiguration, destinationTypeSplunk, "")
+	diags.Append(d...)
+	apiObject.RetryOptions = &awstypes.SplunkRetryOptions{
+		DurationInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.RetryDuration),
+	}
+	apiObject.S3Configuration, d = expandNestedObject(ctx, m.S3Configuration, expandS3DestinationConfiguration)
+	diags.Append(d...)
+
+	input.SplunkDestinationConfiguration = &apiObject
+
+	return diags
+}
+
+func (m *splunkConfigurationModel) expandUpdate(ctx context.Context, input *firehose.UpdateDestinationInput) diag.Diagnostics {
+	var apiObject awstypes.SplunkDestinationUpdate
+	diags := fwflex.Expand(ctx, m, &apiObject)
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	apiObject.BufferingHints = &awstypes.SplunkBufferingHints{
+		IntervalInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.BufferingInterval),
+		SizeInMBs:         fwflex.Int32FromFrameworkInt64(ctx, m.BufferingSize),
+	}
+	apiObject.ProcessingConfiguration, d = expandProcessingConfiguration(ctx, m.ProcessingConfiguration, destinationTypeSplunk, "")
+	diags.Append(d...)
+	apiObject.RetryOptions = &awstypes.SplunkRetryOptions{
+		DurationInSeconds: fwflex.Int32FromFrameworkInt64(ctx, m.RetryDuration),
+	}
+	apiObject.S3Update, d = expandNestedObject(ctx, m.S3Configuration, expandS3DestinationUpdate)
+	diags.Append(d...)
+
+	input.SplunkDestinationUpdate = &apiObject
+
+	return diags
+}
+
+func (m *splunkConfigurationModel) flatten(ctx context.Context, destination *awstypes.DestinationDescription) diag.Diagnostics {
+	apiObject := destination.SplunkDestinationDescription
+
+	diags := fwflex.Flatten(ctx, apiObject, m, fwflex.WithFieldNameSuffix("Description"))
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := apiObject.BufferingHints; v != nil {
+		m.BufferingInterval = fwflex.Int32ToFrameworkInt64(ctx, v.IntervalInSeconds)
+		m.BufferingSize = fwflex.Int32ToFrameworkInt64(ctx, v.SizeInMBs)
+	}
+	diags.Append(flattenProcessingConfiguration(ctx, apiObject.ProcessingConfiguration, &m.ProcessingConfiguration, destinationTypeSplunk, "")...)
+	if v := apiObject.RetryOptions; v != nil {
+		m.RetryDuration = fwflex.Int32ToFrameworkInt64(ctx, v.DurationInSeconds)
+	}
+	diags.Append(flattenNestedObject(ctx, apiObject.S3DestinationDescription, &m.S3Configuration, flattenS3DestinationDescription)...)
+
+	return diags
+}
@@ -4,60 +4,41 @@
 package firehose
 
 import (
-	"fmt"
-	"log"
+	"context"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func MigrateState(
-	v int, is *terraform.InstanceState, meta any) (*terraform.InstanceState, error) {
-	switch v {
-	case 0:
-		log.Println("[INFO] Found AWS Kinesis Firehose Delivery Stream State v0; migrating to v1")
-		return migrateV0toV1(is)
-	default:
-		return is, fmt.Errorf("Unexpected schema version: %d", v)
+// upgradeDeliveryStreamResourceStateV0toV1 moves the flat S3 configuration attributes of schema version 0 into an s3_configuration block.
+// Schema version 0 state was written by Terraform v0.11 and earlier in the legacy flatmap format, so primitive values are strings.
+func upgradeDeliveryStreamResourceStateV0toV1(_ context.Context, rawState map[string]any, _ any) (map[string]any, error) {
+	if rawState == nil {
+		return rawState, nil
 	}
-}
 
-func migrateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
-	if is.Empty() {
-		log.Println("[DEBUG] Empty Kinesis Firehose Delivery State; nothing to migrate.")
-		return is, nil
+	s3Configuration := map[string]any{
+		// Required parameters.
+		names.AttrRoleARN: rawState[names.AttrRoleARN],
+		"bucket_arn":      rawState["s3_bucket_arn"],
 	}
 
-	log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
+	// Optional parameters.
+	for from, to := range map[string]string{
+		"s3_buffer_interval":  "buffer_interval",
+		"s3_buffer_size":      "buffer_size",
+		"s3_data_compression": "compression_format",
+		"s3_prefix":           names.AttrPrefix,
+	} {
+		if v, ok := rawState[from]; ok && v != nil && v != "" {
+			s3Configuration[to] = v
+		}
+	}
 
-	// migrate flate S3 configuration to a s3_configuration block
-	// grab initial values
-	is.Attributes["s3_configuration.#"] = "1"
-	// Required parameters
-	is.Attributes["s3_configuration.0.role_arn"] = is.Attributes[names.AttrRoleARN]
-	is.Attributes["s3_configuration.0.bucket_arn"] = is.Attributes["s3_bucket_arn"]
+	rawState["s3_configuration"] = []any{s3Configuration}
 
-	// Optional parameters
-	if is.Attributes["s3_buffer_size"] != "" {
-		is.Attributes["s3_configuration.0.buffer_size"] = is.Attributes["s3_buffer_size"]
-	}
-	if is.Attributes["s3_data_compression"] != "" {
-		is.Attributes["s3_configuration.0.compression_format"] = is.Attributes["s3_data_compression"]
+	for _, k := range []string{names.AttrRoleARN, "s3_bucket_arn", "s3_buffer_interval", "s3_buffer_size", "s3_data_compression", "s3_prefix"} {
+		delete(rawState, k)
 	}
-	if is.Attributes["s3_buffer_interval"] != "" {
-		is.Attributes["s3_configuration.0.buffer_interval"] = is.Attributes["s3_buffer_interval"]
-	}
-	if is.Attributes["s3_prefix"] != "" {
-		is.Attributes["s3_configuration.0.prefix"] = is.Attributes["s3_prefix"]
-	}
-
-	delete(is.Attributes, names.AttrRoleARN)
-	delete(is.Attributes, "s3_bucket_arn")
-	delete(is.Attributes, "s3_buffer_size")
-	delete(is.Attributes, "s3_data_compression")
-	delete(is.Attributes, "s3_buffer_interval")
-	delete(is.Attributes, "s3_prefix")
 
-	log.Printf("[DEBUG] Attributes after migration: %#v", is.Attributes)
-	return is, nil
+	return rawState, nil
 }
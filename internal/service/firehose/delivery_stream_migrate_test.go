@@ -6,23 +6,20 @@ package firehose_test
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/google/go-cmp/cmp"
 	tffirehose "github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func TestMigrateState(t *testing.T) {
+func TestUpgradeDeliveryStreamResourceStateV0toV1(t *testing.T) {
 	t.Parallel()
 
-	cases := map[string]struct {
-		StateVersion int
-		Attributes   map[string]string
-		Expected     map[string]string
-		Meta         any
+	testCases := map[string]struct {
+		rawState map[string]any
+		want     map[string]any
 	}{
 		"v0.6.16 and earlier": {
-			StateVersion: 0,
-			Attributes: map[string]string{
+			rawState: map[string]any{
 				// EBS
 				names.AttrRoleARN:     "arn:aws:iam::somenumber:role/tf_acctest_4271506651559170635", //lintignore:AWSAT005
 				"s3_bucket_arn":       "arn:aws:s3:::tf-test-bucket",                                 //lintignore:AWSAT005
@@ -30,48 +27,49 @@ func TestMigrateState(t *testing.T) {
 				"s3_buffer_size":      "10",
 				"s3_data_compression": "GZIP",
 			},
-			Expected: map[string]string{
-				"s3_configuration.#":                    "1",
-				"s3_configuration.0.bucket_arn":         "arn:aws:s3:::tf-test-bucket", //lintignore:AWSAT005
-				"s3_configuration.0.buffer_interval":    "400",
-				"s3_configuration.0.buffer_size":        "10",
-				"s3_configuration.0.compression_format": "GZIP",
-				"s3_configuration.0.role_arn":           "arn:aws:iam::somenumber:role/tf_acctest_4271506651559170635", //lintignore:AWSAT005
+			want: map[string]any{
+				"s3_configuration": []any{
+					map[string]any{
+						"bucket_arn":         "arn:aws:s3:::tf-test-bucket", //lintignore:AWSAT005
+						"buffer_interval":    "400",
+						"buffer_size":        "10",
+						"compression_format": "GZIP",
+						names.AttrRoleARN:    "arn:aws:iam::somenumber:role/tf_acctest_4271506651559170635", //lintignore:AWSAT005
+					},
+				},
 			},
 		},
 		"v0.6.16 and earlier, sparse": {
-			StateVersion: 0,
-			Attributes: map[string]string{
+			rawState: map[string]any{
 				// EBS
 				names.AttrRoleARN: "arn:aws:iam::somenumber:role/tf_acctest_4271506651559170635", //lintignore:AWSAT005
 				"s3_bucket_arn":   "arn:aws:s3:::tf-test-bucket",                                 //lintignore:AWSAT005
+				"s3_buffer_size":  "",
 			},
-			Expected: map[string]string{
-				"s3_configuration.#":            "1",
-				"s3_configuration.0.bucket_arn": "arn:aws:s3:::tf-test-bucket",                                 //lintignore:AWSAT005
-				"s3_configuration.0.role_arn":   "arn:aws:iam::somenumber:role/tf_acctest_4271506651559170635", //lintignore:AWSAT005
+			want: map[string]any{
+				"s3_configuration": []any{
+					map[string]any{
+						"bucket_arn":      "arn:aws:s3:::tf-test-bucket",                                 //lintignore:AWSAT005
+						names.AttrRoleARN: "arn:aws:iam::somenumber:role/tf_acctest_4271506651559170635", //lintignore:AWSAT005
+					},
+				},
 			},
 		},
 	}
 
-	for tn, tc := range cases {
-		is := &terraform.InstanceState{
-			ID:         "i-abc123",
-			Attributes: tc.Attributes,
-		}
-		is, err := tffirehose.MigrateState(
-			tc.StateVersion, is, tc.Meta)
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
 
-		if err != nil {
-			t.Fatalf("bad: %s, err: %#v", tn, err)
-		}
+			got, err := tffirehose.UpgradeDeliveryStreamResourceStateV0toV1(t.Context(), testCase.rawState, nil)
 
-		for k, v := range tc.Expected {
-			if is.Attributes[k] != v {
-				t.Fatalf(
-					"bad: %s\n\n expected: %#v -> %#v\n got: %#v -> %#v\n in: %#v",
-					tn, k, v, k, is.Attributes[k], is.Attributes)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
 			}
-		}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
 	}
 }
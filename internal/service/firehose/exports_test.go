@@ -5,7 +5,8 @@ package firehose
 
 // Exports for use in tests only.
 var (
-	ResourceDeliveryStream = resourceDeliveryStream
+	ResourceDeliveryStream = newDeliveryStreamResource
 
-	FindDeliveryStreamByName = findDeliveryStreamByName
+	FindDeliveryStreamByName                 = findDeliveryStreamByName
+	UpgradeDeliveryStreamResourceStateV0toV1 = upgradeDeliveryStreamResourceStateV0toV1
 )
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -37,12 +38,10 @@ func sweepDeliveryStreams(region string) error {
 		}
 
 		for _, name := range page.DeliveryStreamNames {
-			r := resourceDeliveryStream()
-			d := r.Data(nil)
-			d.SetId(client.RegionalARN(ctx, "firehose", fmt.Sprintf("deliverystream/%s", name)))
-			d.Set(names.AttrName, name)
-
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, framework.NewSweepResource(newDeliveryStreamResource, client,
+				framework.NewAttribute(names.AttrID, client.RegionalARN(ctx, "firehose", fmt.Sprintf("deliverystream/%s", name))),
+				framework.NewAttribute(names.AttrName, name),
+			))
 		}
 
 		return !lastPage
@@ -30,6 +30,7 @@ import (
 // @SDKResource("aws_glue_registry", name="Registry")
 // @Tags(identifierAttribute="arn")
 // @ArnIdentity
+// @ListResource(paginator="ListRegistries", items="Registries", id="RegistryArn", displayName="RegistryName")
 // @Testing(preIdentityVersion="v6.3.0")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/glue;glue.GetRegistryOutput")
 // @Testing(existsTakesT=false, destroyTakesT=false)
@@ -30,6 +30,7 @@ import (
 // @SDKResource("aws_glue_schema", name="Schema")
 // @Tags(identifierAttribute="arn")
 // @ArnIdentity
+// @ListResource(paginator="ListSchemas", items="Schemas", id="SchemaArn", displayName="SchemaName")
 // @Testing(preIdentityVersion="v6.3.0")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/glue;glue.GetSchemaOutput")
 // @Testing(existsTakesT=false, destroyTakesT=false)
@@ -58,7 +58,7 @@ func resourceDetector() *schema.Resource {
 				MaxItems:   1,
 				Optional:   true,
 				Computed:   true,
-				Deprecated: "datasources is deprecated. Use aws_guardduty_detector_feature resources instead.",
+				Deprecated: names.ResourceDeprecationMessage("aws_guardduty_detector", "datasources"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"kubernetes": {
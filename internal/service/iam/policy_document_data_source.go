@@ -75,8 +75,7 @@ func dataSourcePolicyDocument() *schema.Resource {
 					Type:         schema.TypeString,
 					Optional:     true,
 					ValidateFunc: validation.StringIsEmpty,
-					Deprecated: "override_json is deprecated. This argument is retained only for " +
-						"backward compatibility with previous versions of this data source.",
+					Deprecated:   names.DataSourceDeprecationMessage("aws_iam_policy_document", "override_json"),
 				},
 				"override_policy_documents": {
 					Type:     schema.TypeList,
@@ -95,8 +94,7 @@ func dataSourcePolicyDocument() *schema.Resource {
 					Type:         schema.TypeString,
 					Optional:     true,
 					ValidateFunc: validation.StringIsEmpty,
-					Deprecated: "source_json is deprecated. This argument is retained only for " +
-						"backward compatibility with previous versions of this data source.",
+					Deprecated:   names.DataSourceDeprecationMessage("aws_iam_policy_document", "source_json"),
 				},
 				"source_policy_documents": {
 					Type:     schema.TypeList,
@@ -109,14 +109,10 @@ func resourceRole() *schema.Resource {
 				Default:  false,
 			},
 			"inline_policy": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Computed: true,
-				Deprecated: "inline_policy is deprecated. " +
-					"Use the aws_iam_role_policy resource instead. If Terraform should " +
-					"exclusively manage all inline policy associations (the current " +
-					"behavior of this argument), use the aws_iam_role_policies_exclusive " +
-					"resource as well.",
+				Type:       schema.TypeSet,
+				Optional:   true,
+				Computed:   true,
+				Deprecated: names.ResourceDeprecationMessage("aws_iam_role", "inline_policy"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrName: {
@@ -149,14 +145,10 @@ func resourceRole() *schema.Resource {
 				},
 			},
 			"managed_policy_arns": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Computed: true,
-				Deprecated: "managed_policy_arns is deprecated. " +
-					"Use the aws_iam_role_policy_attachment resource instead. If Terraform should " +
-					"exclusively manage all managed policy attachments (the current " +
-					"behavior of this argument), use the aws_iam_role_policy_attachments_exclusive " +
-					"resource as well.",
+				Type:       schema.TypeSet,
+				Optional:   true,
+				Computed:   true,
+				Deprecated: names.ResourceDeprecationMessage("aws_iam_role", "managed_policy_arns"),
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: verify.ValidARN,
@@ -52,6 +52,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.Service
 			Name:     "Registration Code",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  dataSourceTopicRuleEvaluation,
+			TypeName: "aws_iot_topic_rule_evaluation",
+			Name:     "Topic Rule Evaluation",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
@@ -7,12 +7,15 @@ package iot
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"reflect"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iot"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iot/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -38,6 +41,11 @@ func resourceTopicRule() *schema.Resource {
 		UpdateWithoutTimeout: resourceTopicRuleUpdate,
 		DeleteWithoutTimeout: resourceTopicRuleDelete,
 
+		CustomizeDiff: resourceTopicRuleCustomizeDiff,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validateTopicRuleSQL,
+		},
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -1148,8 +1156,9 @@ func resourceTopicRule() *schema.Resource {
 					Required: true,
 				},
 				"sql_version": {
-					Type:     schema.TypeString,
-					Required: true,
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(topicRuleSQLVersion_Values(), false),
 				},
 				"sqs": {
 					Type:     schema.TypeSet,
@@ -1249,6 +1258,118 @@ func resourceTopicRule() *schema.Resource {
 	}
 }
 
+// topicRuleActionKeys are the arguments that configure topic rule actions.
+var topicRuleActionKeys = []string{
+	"cloudwatch_alarm",
+	names.AttrCloudWatchLogs,
+	"cloudwatch_metric",
+	"dynamodb",
+	"dynamodbv2",
+	"elasticsearch",
+	"error_action",
+	"firehose",
+	"http",
+	"iot_analytics",
+	"iot_events",
+	"kafka",
+	"kinesis",
+	"lambda",
+	"republish",
+	"s3",
+	"sns",
+	"sqs",
+	"step_functions",
+	"timestream",
+}
+
+// resourceTopicRuleCustomizeDiff validates the substitution templates in the rule's actions.
+// Only new rules and changed arguments are validated, so that existing rules can be updated regardless.
+func resourceTopicRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	if diff.Id() != "" && !diff.HasChanges(append([]string{"sql", "sql_version"}, topicRuleActionKeys...)...) {
+		return nil
+	}
+
+	if !diff.NewValueKnown("sql_version") {
+		return nil
+	}
+
+	version := diff.Get("sql_version").(string)
+	if !slices.Contains(topicRuleSQLVersion_Values(), version) {
+		return nil
+	}
+
+	for _, k := range topicRuleActionKeys {
+		if err := walkTopicRuleActionStrings(diff.Get(k), func(s string) error {
+			return validateTopicRuleSubstitutionTemplates(s, version)
+		}); err != nil {
+			return fmt.Errorf("%s: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+// validateTopicRuleSQL returns a warning if the rule's SQL statement can't be parsed for its SQL version,
+// and for each function called in the statement that is not known to this provider version.
+// AWS IoT is the authority on which statements are valid, so these are not errors.
+func validateTopicRuleSQL(_ context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	sql, version := req.RawConfig.GetAttr("sql"), req.RawConfig.GetAttr("sql_version")
+	if !sql.IsKnown() || sql.IsNull() || !version.IsKnown() || version.IsNull() {
+		return
+	}
+
+	if !slices.Contains(topicRuleSQLVersion_Values(), version.AsString()) {
+		return
+	}
+
+	path := cty.GetAttrPath("sql")
+
+	stmt, err := parseTopicRuleSQL(sql.AsString(), version.AsString())
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Unable to parse IoT SQL statement",
+			Detail:        fmt.Sprintf("%s. If the statement is not supported by AWS IoT, creating or updating the rule will fail.", err),
+			AttributePath: path,
+		})
+		return
+	}
+
+	for _, name := range unknownSQLFunctions(stmt) {
+		resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Unknown IoT SQL function",
+			Detail: fmt.Sprintf("The function %s is not known to this version of the provider, so its arguments could not be validated. ", name) +
+				"If the function is not supported by AWS IoT, creating or updating the rule will fail.",
+			AttributePath: path,
+		})
+	}
+}
+
+// walkTopicRuleActionStrings calls f for each string value in an action argument.
+func walkTopicRuleActionStrings(v any, f func(string) error) error {
+	switch v := v.(type) {
+	case string:
+		return f(v)
+	case []any:
+		for _, v := range v {
+			if err := walkTopicRuleActionStrings(v, f); err != nil {
+				return err
+			}
+		}
+	case *schema.Set:
+		return walkTopicRuleActionStrings(v.List(), f)
+	case map[string]any:
+		for _, v := range v {
+			if err := walkTopicRuleActionStrings(v, f); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceTopicRuleCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IoTClient(ctx)
@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_iot_topic_rule_evaluation", name="Topic Rule Evaluation")
+func dataSourceTopicRuleEvaluation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTopicRuleEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrClientID: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"matched": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"payload": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			names.AttrPrincipal: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rendered_templates": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sql": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sql_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      topicRuleSQLVersion20160323,
+				ValidateFunc: validation.StringInSlice(topicRuleSQLVersion_Values(), false),
+			},
+			"templates": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"timestamp": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"topic": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceTopicRuleEvaluationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sql, version := d.Get("sql").(string), d.Get("sql_version").(string)
+	stmt, err := parseTopicRuleSQL(sql, version)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "parsing IoT Topic Rule SQL: %s", err)
+	}
+
+	payload, err := decodeSQLJSON(d.Get("payload").(string))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "decoding payload: %s", err)
+	}
+
+	message := &sqlMessage{
+		accountID: meta.(*conns.AWSClient).AccountID(ctx),
+		clientID:  d.Get(names.AttrClientID).(string),
+		payload:   payload,
+		principal: d.Get(names.AttrPrincipal).(string),
+		timestamp: time.Now(),
+		topic:     d.Get("topic").(string),
+	}
+	if v, ok := d.GetOk("timestamp"); ok {
+		message.timestamp = time.UnixMilli(int64(v.(int)))
+	}
+
+	matched, v, err := evaluateTopicRuleSQL(stmt, message)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "evaluating IoT Topic Rule SQL: %s", err)
+	}
+
+	var result string
+	if matched && v != sqlUndefined {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "encoding IoT Topic Rule SQL result: %s", err)
+		}
+		result = string(b)
+	}
+
+	// Substitution templates are evaluated against the incoming message, not the SELECT result.
+	renderedTemplates := make(map[string]any)
+	for k, v := range d.Get("templates").(map[string]any) {
+		rendered, err := evaluateTopicRuleSubstitutionTemplates(v.(string), version, message)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "evaluating templates (%s): %s", k, err)
+		}
+		renderedTemplates[k] = rendered
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(strings.Join([]string{sql, version, message.topic, d.Get("payload").(string)}, "\n"))))
+	d.Set("matched", matched)
+	d.Set("rendered_templates", renderedTemplates)
+	d.Set("result", result)
+
+	return diags
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iot_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTTopicRuleEvaluationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iot_topic_rule_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicRuleEvaluationDataSourceConfig_basic("things/sensor-1/telemetry"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "matched", acctest.CtTrue),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "result", `{"thing": "sensor-1", "temp": 60, "color": "red"}`),
+					resource.TestCheckResourceAttr(dataSourceName, "rendered_templates.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "rendered_templates.topic", "alerts/sensor-1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rendered_templates.timestamp", "1700000000000"),
+				),
+			},
+			{
+				Config: testAccTopicRuleEvaluationDataSourceConfig_basic("things/sensor-1/status"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "matched", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "result", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTTopicRuleEvaluationDataSource_unsupportedFunction(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTopicRuleEvaluationDataSourceConfig_unsupportedFunction,
+				ExpectError: regexache.MustCompile(`function get_thing_shadow is not supported for local evaluation`),
+			},
+		},
+	})
+}
+
+func testAccTopicRuleEvaluationDataSourceConfig_basic(topic string) string {
+	return fmt.Sprintf(`
+data "aws_iot_topic_rule_evaluation" "test" {
+  sql       = "SELECT topic(2) AS thing, temperature AS temp, state.color FROM 'things/+/telemetry' WHERE temperature > 50"
+  topic     = %[1]q
+  timestamp = 1700000000000
+
+  payload = jsonencode({
+    temperature = 60
+    state = {
+      color = "red"
+    }
+  })
+
+  templates = {
+    topic     = "alerts/$${topic(2)}"
+    timestamp = "$${timestamp()}"
+  }
+}
+`, topic)
+}
+
+const testAccTopicRuleEvaluationDataSourceConfig_unsupportedFunction = `
+data "aws_iot_topic_rule_evaluation" "test" {
+  sql     = "SELECT get_thing_shadow('thing', 'arn:aws:iam::123456789012:role/test') AS shadow FROM 'things/#'"
+  topic   = "things/sensor-1"
+  payload = jsonencode({})
+}
+`
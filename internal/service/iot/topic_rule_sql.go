@@ -0,0 +1,1162 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Parser for the AWS IoT SQL dialect used by topic rules.
+// See https://docs.aws.amazon.com/iot/latest/developerguide/iot-sql-reference.html.
+
+const (
+	topicRuleSQLVersion20151008 = "2015-10-08"
+	topicRuleSQLVersion20160323 = "2016-03-23"
+	topicRuleSQLVersionBeta     = "beta"
+)
+
+func topicRuleSQLVersion_Values() []string {
+	return []string{
+		topicRuleSQLVersion20151008,
+		topicRuleSQLVersion20160323,
+		topicRuleSQLVersionBeta,
+	}
+}
+
+type sqlTokenType int
+
+const (
+	sqlTokenEOF sqlTokenType = iota
+	sqlTokenIdent
+	sqlTokenQuotedIdent
+	sqlTokenNumber
+	sqlTokenString
+	sqlTokenPunct
+)
+
+type sqlToken struct {
+	typ   sqlTokenType
+	value string
+	pos   int
+}
+
+func (t sqlToken) String() string {
+	switch t.typ {
+	case sqlTokenEOF:
+		return "end of input"
+	case sqlTokenString:
+		return fmt.Sprintf("'%s'", t.value)
+	default:
+		return fmt.Sprintf("%q", t.value)
+	}
+}
+
+// isKeyword returns whether the token is the specified (case-insensitive) keyword.
+func (t sqlToken) isKeyword(keyword string) bool {
+	return t.typ == sqlTokenIdent && strings.EqualFold(t.value, keyword)
+}
+
+func (t sqlToken) isPunct(punct string) bool {
+	return t.typ == sqlTokenPunct && t.value == punct
+}
+
+var sqlReservedWords = []string{
+	"and", "as", "case", "else", "end", "from", "not", "or", "select", "then", "when", "where",
+}
+
+func tokenizeTopicRuleSQL(s string) ([]sqlToken, error) {
+	var tokens []sqlToken
+	runes := []rune(s)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '\'' || r == '"' || r == '`':
+			start := i
+			var sb strings.Builder
+			for i++; ; i++ {
+				if i >= len(runes) {
+					return nil, fmt.Errorf("unterminated %c at position %d", r, start)
+				}
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+					sb.WriteRune(runes[i])
+					continue
+				}
+				if runes[i] == r {
+					// A doubled quote is an escaped quote.
+					if i+1 < len(runes) && runes[i+1] == r {
+						i++
+						sb.WriteRune(r)
+						continue
+					}
+					i++
+					break
+				}
+				sb.WriteRune(runes[i])
+			}
+			typ := sqlTokenQuotedIdent
+			if r == '\'' {
+				typ = sqlTokenString
+			}
+			tokens = append(tokens, sqlToken{typ: typ, value: sb.String(), pos: start})
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+				j := i + 1
+				if j < len(runes) && (runes[j] == '+' || runes[j] == '-') {
+					j++
+				}
+				if j < len(runes) && unicode.IsDigit(runes[j]) {
+					for i = j; i < len(runes) && unicode.IsDigit(runes[i]); i++ {
+					}
+				}
+			}
+			tokens = append(tokens, sqlToken{typ: sqlTokenNumber, value: string(runes[start:i]), pos: start})
+		case unicode.IsLetter(r) || r == '_' || r == '$':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_' || runes[i] == '$') {
+				i++
+			}
+			tokens = append(tokens, sqlToken{typ: sqlTokenIdent, value: string(runes[start:i]), pos: start})
+		default:
+			start := i
+			if i+1 < len(runes) {
+				switch op := string(runes[i : i+2]); op {
+				case "<>", "!=", "<=", ">=":
+					tokens = append(tokens, sqlToken{typ: sqlTokenPunct, value: op, pos: start})
+					i += 2
+					continue
+				}
+			}
+			if !strings.ContainsRune("()[]{},.:*+-/%=<>", r) {
+				return nil, fmt.Errorf("unexpected character %q at position %d", r, start)
+			}
+			tokens = append(tokens, sqlToken{typ: sqlTokenPunct, value: string(r), pos: start})
+			i++
+		}
+	}
+
+	return append(tokens, sqlToken{typ: sqlTokenEOF, pos: len(runes)}), nil
+}
+
+// AST.
+
+type sqlExpr interface {
+	sqlExpr()
+}
+
+type sqlLiteral struct {
+	value any
+}
+
+type sqlArrayLiteral struct {
+	elements []sqlExpr
+}
+
+type sqlObjectLiteral struct {
+	keys   []string
+	values []sqlExpr
+}
+
+// sqlWildcard is "*", the whole message or the current element of a nested object query.
+type sqlWildcard struct{}
+
+type sqlIdent struct {
+	name string
+}
+
+type sqlField struct {
+	target sqlExpr
+	name   string
+}
+
+// sqlFieldWildcard is "target.*", which is only valid in a SELECT clause.
+type sqlFieldWildcard struct {
+	target sqlExpr
+}
+
+type sqlIndex struct {
+	target sqlExpr
+	index  sqlExpr
+}
+
+type sqlUnary struct {
+	op      string
+	operand sqlExpr
+}
+
+type sqlBinary struct {
+	op          string
+	left, right sqlExpr
+}
+
+type sqlCall struct {
+	name string
+	args []sqlExpr
+}
+
+type sqlCast struct {
+	operand sqlExpr
+	typ     string
+}
+
+type sqlCaseWhen struct {
+	condition, result sqlExpr
+}
+
+type sqlCase struct {
+	operand   sqlExpr
+	whens     []sqlCaseWhen
+	otherwise sqlExpr
+}
+
+type sqlColumn struct {
+	expr  sqlExpr
+	alias string
+}
+
+// sqlSelect is a SELECT statement or a nested object query.
+type sqlSelect struct {
+	value   bool
+	columns []sqlColumn
+	// topic is the topic filter of a SELECT statement.
+	topic string
+	// from is the array queried by a nested object query, and fromAlias the optional name of its elements.
+	from      sqlExpr
+	fromAlias string
+	where     sqlExpr
+}
+
+func (sqlLiteral) sqlExpr()       {}
+func (sqlArrayLiteral) sqlExpr()  {}
+func (sqlObjectLiteral) sqlExpr() {}
+func (sqlWildcard) sqlExpr()      {}
+func (sqlIdent) sqlExpr()         {}
+func (sqlField) sqlExpr()         {}
+func (sqlFieldWildcard) sqlExpr() {}
+func (sqlIndex) sqlExpr()         {}
+func (sqlUnary) sqlExpr()         {}
+func (sqlBinary) sqlExpr()        {}
+func (sqlCall) sqlExpr()          {}
+func (sqlCast) sqlExpr()          {}
+func (sqlCase) sqlExpr()          {}
+func (*sqlSelect) sqlExpr()       {}
+
+// sqlUndefinedValue is the type of the value of expressions that reference missing attributes or have invalid operands.
+type sqlUndefinedValue struct{}
+
+var sqlUndefined = sqlUndefinedValue{}
+
+var sqlCastTypes = []string{"Bool", "Boolean", "Decimal", "Int", "String"}
+
+type sqlParser struct {
+	tokens  []sqlToken
+	pos     int
+	version string
+}
+
+// parseTopicRuleSQL parses an IoT SQL statement for the specified SQL version.
+func parseTopicRuleSQL(sql, version string) (*sqlSelect, error) {
+	p, err := newSQLParser(sql, version)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt, err := p.parseSelect(false)
+	if err != nil {
+		return nil, err
+	}
+
+	if t := p.peek(); t.typ != sqlTokenEOF {
+		return nil, p.errorf(t, "unexpected %s", t)
+	}
+
+	if err := validateSQLFieldWildcards(stmt); err != nil {
+		return nil, err
+	}
+
+	return stmt, nil
+}
+
+// parseTopicRuleSQLExpression parses an IoT SQL expression, such as the expression of a substitution template.
+func parseTopicRuleSQLExpression(expr, version string) (sqlExpr, error) {
+	p, err := newSQLParser(expr, version)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+
+	if t := p.peek(); t.typ != sqlTokenEOF {
+		return nil, p.errorf(t, "unexpected %s", t)
+	}
+
+	if err := validateSQLFieldWildcards(e); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func newSQLParser(s, version string) (*sqlParser, error) {
+	tokens, err := tokenizeTopicRuleSQL(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlParser{
+		tokens:  tokens,
+		version: version,
+	}, nil
+}
+
+func (p *sqlParser) peek() sqlToken {
+	return p.tokens[p.pos]
+}
+
+func (p *sqlParser) next() sqlToken {
+	t := p.tokens[p.pos]
+	if t.typ != sqlTokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *sqlParser) acceptKeyword(keyword string) bool {
+	if p.peek().isKeyword(keyword) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *sqlParser) acceptPunct(punct string) bool {
+	if p.peek().isPunct(punct) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *sqlParser) expectKeyword(keyword string) error {
+	if t := p.peek(); !p.acceptKeyword(keyword) {
+		return p.errorf(t, "expected %s, got %s", strings.ToUpper(keyword), t)
+	}
+	return nil
+}
+
+func (p *sqlParser) expectPunct(punct string) error {
+	if t := p.peek(); !p.acceptPunct(punct) {
+		return p.errorf(t, "expected %q, got %s", punct, t)
+	}
+	return nil
+}
+
+func (p *sqlParser) errorf(t sqlToken, format string, a ...any) error {
+	return fmt.Errorf("position %d: %s", t.pos+1, fmt.Sprintf(format, a...))
+}
+
+func (p *sqlParser) requireVersion(t sqlToken, feature string) error {
+	if p.version == topicRuleSQLVersion20151008 {
+		return p.errorf(t, "%s requires SQL version %s or later", feature, topicRuleSQLVersion20160323)
+	}
+	return nil
+}
+
+// parseSelect parses "SELECT [VALUE] columns FROM topic|expr [AS alias] [WHERE expr]".
+func (p *sqlParser) parseSelect(nested bool) (*sqlSelect, error) {
+	stmt := &sqlSelect{}
+
+	if err := p.expectKeyword("select"); err != nil {
+		return nil, err
+	}
+
+	// VALUE is also a valid attribute name.
+	if t := p.peek(); t.isKeyword("value") && p.startsSelectValue() {
+		if err := p.requireVersion(t, "SELECT VALUE"); err != nil {
+			return nil, err
+		}
+		p.next()
+		stmt.value = true
+	}
+
+	for {
+		column, err := p.parseColumn(stmt.value)
+		if err != nil {
+			return nil, err
+		}
+		stmt.columns = append(stmt.columns, column)
+
+		if !p.acceptPunct(",") {
+			break
+		}
+	}
+
+	if stmt.value && len(stmt.columns) > 1 {
+		return nil, p.errorf(p.peek(), "SELECT VALUE requires a single expression")
+	}
+
+	if err := p.expectKeyword("from"); err != nil {
+		return nil, err
+	}
+
+	if nested {
+		from, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		stmt.from = from
+
+		if p.acceptKeyword("as") {
+			t := p.next()
+			if t.typ != sqlTokenIdent && t.typ != sqlTokenQuotedIdent {
+				return nil, p.errorf(t, "expected an alias, got %s", t)
+			}
+			stmt.fromAlias = t.value
+		}
+	} else {
+		t := p.next()
+		if t.typ != sqlTokenString {
+			return nil, p.errorf(t, "expected a quoted topic filter, got %s", t)
+		}
+		if err := validateTopicFilter(t.value); err != nil {
+			return nil, p.errorf(t, "invalid topic filter '%s': %s", t.value, err)
+		}
+		stmt.topic = t.value
+	}
+
+	if p.acceptKeyword("where") {
+		where, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		stmt.where = where
+	}
+
+	return stmt, nil
+}
+
+// startsSelectValue returns whether the next token, VALUE, is followed by an expression.
+func (p *sqlParser) startsSelectValue() bool {
+	t := p.tokens[p.pos+1]
+
+	switch {
+	case t.typ == sqlTokenEOF, t.isKeyword("as"), t.isKeyword("from"):
+		return false
+	case t.typ == sqlTokenPunct:
+		return !slices.Contains([]string{",", ".", "[", "+", "/", "%", "=", "<>", "!=", "<", "<=", ">", ">="}, t.value)
+	}
+
+	return true
+}
+
+func (p *sqlParser) parseColumn(value bool) (sqlColumn, error) {
+	var column sqlColumn
+
+	expr, err := p.parseExpr()
+	if err != nil {
+		return column, err
+	}
+	column.expr = expr
+
+	if p.acceptKeyword("as") {
+		t := p.next()
+		if t.typ != sqlTokenIdent && t.typ != sqlTokenQuotedIdent {
+			return column, p.errorf(t, "expected an alias, got %s", t)
+		}
+		if value {
+			return column, p.errorf(t, "SELECT VALUE does not support aliases")
+		}
+		column.alias = t.value
+	}
+
+	return column, nil
+}
+
+func (p *sqlParser) parseExpr() (sqlExpr, error) {
+	return p.parseOr()
+}
+
+func (p *sqlParser) parseOr() (sqlExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.acceptKeyword("or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = sqlBinary{op: "or", left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *sqlParser) parseAnd() (sqlExpr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.acceptKeyword("and") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = sqlBinary{op: "and", left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *sqlParser) parseNot() (sqlExpr, error) {
+	if p.acceptKeyword("not") {
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return sqlUnary{op: "not", operand: operand}, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *sqlParser) parseComparison() (sqlExpr, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		t := p.peek()
+		if t.typ != sqlTokenPunct {
+			return left, nil
+		}
+
+		switch t.value {
+		case "=", "<>", "!=", "<", "<=", ">", ">=":
+			p.next()
+			right, err := p.parseAdditive()
+			if err != nil {
+				return nil, err
+			}
+			op := t.value
+			if op == "!=" {
+				op = "<>"
+			}
+			left = sqlBinary{op: op, left: left, right: right}
+		default:
+			return left, nil
+		}
+	}
+}
+
+func (p *sqlParser) parseAdditive() (sqlExpr, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		t := p.peek()
+		if !t.isPunct("+") && !t.isPunct("-") {
+			return left, nil
+		}
+		p.next()
+
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		left = sqlBinary{op: t.value, left: left, right: right}
+	}
+}
+
+func (p *sqlParser) parseMultiplicative() (sqlExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		t := p.peek()
+		if !t.isPunct("*") && !t.isPunct("/") && !t.isPunct("%") {
+			return left, nil
+		}
+		p.next()
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = sqlBinary{op: t.value, left: left, right: right}
+	}
+}
+
+func (p *sqlParser) parseUnary() (sqlExpr, error) {
+	if p.acceptPunct("-") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return sqlUnary{op: "-", operand: operand}, nil
+	}
+
+	if p.acceptPunct("+") {
+		return p.parseUnary()
+	}
+
+	return p.parsePostfix()
+}
+
+func (p *sqlParser) parsePostfix() (sqlExpr, error) {
+	expr, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		switch t := p.peek(); {
+		case t.isPunct("."):
+			p.next()
+			if p.acceptPunct("*") {
+				// "target.*" can't be followed by other operators.
+				return sqlFieldWildcard{target: expr}, nil
+			}
+			name := p.next()
+			if name.typ != sqlTokenIdent && name.typ != sqlTokenQuotedIdent {
+				return nil, p.errorf(name, "expected an attribute name, got %s", name)
+			}
+			expr = sqlField{target: expr, name: name.value}
+		case t.isPunct("["):
+			p.next()
+			index, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectPunct("]"); err != nil {
+				return nil, err
+			}
+			expr = sqlIndex{target: expr, index: index}
+		default:
+			return expr, nil
+		}
+	}
+}
+
+func (p *sqlParser) parsePrimary() (sqlExpr, error) {
+	t := p.next()
+
+	switch t.typ {
+	case sqlTokenEOF:
+		return nil, p.errorf(t, "unexpected end of input")
+	case sqlTokenNumber:
+		if v, err := strconv.ParseInt(t.value, 10, 64); err == nil {
+			return sqlLiteral{value: v}, nil
+		}
+		v, err := strconv.ParseFloat(t.value, 64)
+		if err != nil {
+			return nil, p.errorf(t, "invalid number %s", t)
+		}
+		return sqlLiteral{value: v}, nil
+	case sqlTokenString:
+		return sqlLiteral{value: t.value}, nil
+	case sqlTokenQuotedIdent:
+		return sqlIdent{name: t.value}, nil
+	case sqlTokenPunct:
+		switch t.value {
+		case "*":
+			return sqlWildcard{}, nil
+		case "(":
+			if p.peek().isKeyword("select") {
+				if err := p.requireVersion(p.peek(), "nested object query"); err != nil {
+					return nil, err
+				}
+				stmt, err := p.parseSelect(true)
+				if err != nil {
+					return nil, err
+				}
+				if err := p.expectPunct(")"); err != nil {
+					return nil, err
+				}
+				return stmt, nil
+			}
+			expr, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectPunct(")"); err != nil {
+				return nil, err
+			}
+			return expr, nil
+		case "[":
+			return p.parseArrayLiteral()
+		case "{":
+			return p.parseObjectLiteral()
+		}
+	case sqlTokenIdent:
+		switch strings.ToLower(t.value) {
+		case "true":
+			return sqlLiteral{value: true}, nil
+		case "false":
+			return sqlLiteral{value: false}, nil
+		case "null":
+			return sqlLiteral{value: nil}, nil
+		case "undefined":
+			return sqlLiteral{value: sqlUndefined}, nil
+		case "case":
+			return p.parseCase()
+		}
+
+		if p.peek().isPunct("(") {
+			p.next()
+			if strings.EqualFold(t.value, "cast") {
+				return p.parseCast()
+			}
+			return p.parseCall(t)
+		}
+
+		for _, v := range sqlReservedWords {
+			if strings.EqualFold(t.value, v) {
+				return nil, p.errorf(t, "unexpected %s", strings.ToUpper(t.value))
+			}
+		}
+
+		return sqlIdent{name: t.value}, nil
+	}
+
+	return nil, p.errorf(t, "unexpected %s", t)
+}
+
+func (p *sqlParser) parseArrayLiteral() (sqlExpr, error) {
+	var expr sqlArrayLiteral
+
+	if p.acceptPunct("]") {
+		return expr, nil
+	}
+
+	for {
+		element, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		expr.elements = append(expr.elements, element)
+
+		if p.acceptPunct("]") {
+			return expr, nil
+		}
+		if err := p.expectPunct(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *sqlParser) parseObjectLiteral() (sqlExpr, error) {
+	var expr sqlObjectLiteral
+
+	if p.acceptPunct("}") {
+		return expr, nil
+	}
+
+	for {
+		t := p.next()
+		if t.typ != sqlTokenString && t.typ != sqlTokenQuotedIdent && t.typ != sqlTokenIdent {
+			return nil, p.errorf(t, "expected an object key, got %s", t)
+		}
+		if err := p.expectPunct(":"); err != nil {
+			return nil, err
+		}
+		value, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		expr.keys = append(expr.keys, t.value)
+		expr.values = append(expr.values, value)
+
+		if p.acceptPunct("}") {
+			return expr, nil
+		}
+		if err := p.expectPunct(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *sqlParser) parseCall(name sqlToken) (sqlExpr, error) {
+	expr := sqlCall{name: name.value}
+
+	if !p.acceptPunct(")") {
+		for {
+			arg, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			expr.args = append(expr.args, arg)
+
+			if p.acceptPunct(")") {
+				break
+			}
+			if err := p.expectPunct(","); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Functions added to IoT SQL after this provider version are allowed. See unknownSQLFunctions.
+	f, ok := findSQLFunction(expr.name)
+	if !ok {
+		return expr, nil
+	}
+
+	if n := len(expr.args); n < f.minArgs || (f.maxArgs >= 0 && n > f.maxArgs) {
+		return nil, p.errorf(name, "function %s called with %d arguments, %s", name.value, n, f.arity())
+	}
+
+	return expr, nil
+}
+
+// parseCast parses the remainder of "cast(expr AS type)".
+func (p *sqlParser) parseCast() (sqlExpr, error) {
+	operand, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.expectKeyword("as"); err != nil {
+		return nil, err
+	}
+
+	t := p.next()
+	typ := ""
+	for _, v := range sqlCastTypes {
+		if t.typ == sqlTokenIdent && strings.EqualFold(t.value, v) {
+			typ = v
+		}
+	}
+	if typ == "" {
+		return nil, p.errorf(t, "invalid cast type %s, expected one of %s", t, strings.Join(sqlCastTypes, ", "))
+	}
+
+	if err := p.expectPunct(")"); err != nil {
+		return nil, err
+	}
+
+	return sqlCast{operand: operand, typ: typ}, nil
+}
+
+// parseCase parses the remainder of "CASE [expr] WHEN expr THEN expr ... [ELSE expr] END".
+func (p *sqlParser) parseCase() (sqlExpr, error) {
+	var expr sqlCase
+
+	if !p.peek().isKeyword("when") {
+		operand, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		expr.operand = operand
+	}
+
+	for p.acceptKeyword("when") {
+		condition, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectKeyword("then"); err != nil {
+			return nil, err
+		}
+		result, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		expr.whens = append(expr.whens, sqlCaseWhen{condition: condition, result: result})
+	}
+
+	if len(expr.whens) == 0 {
+		return nil, p.errorf(p.peek(), "expected WHEN, got %s", p.peek())
+	}
+
+	if p.acceptKeyword("else") {
+		otherwise, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		expr.otherwise = otherwise
+	}
+
+	if err := p.expectKeyword("end"); err != nil {
+		return nil, err
+	}
+
+	return expr, nil
+}
+
+// validateSQLFieldWildcards checks that "target.*" is only used as a column of a SELECT clause.
+func validateSQLFieldWildcards(expr sqlExpr) error {
+	var walk func(sqlExpr, bool) error
+	walk = func(expr sqlExpr, column bool) error {
+		switch e := expr.(type) {
+		case sqlFieldWildcard:
+			if !column {
+				return fmt.Errorf("%s.* can only be used in a SELECT clause", formatSQLExpr(e.target))
+			}
+			return walk(e.target, false)
+		case sqlArrayLiteral:
+			for _, v := range e.elements {
+				if err := walk(v, false); err != nil {
+					return err
+				}
+			}
+		case sqlObjectLiteral:
+			for _, v := range e.values {
+				if err := walk(v, false); err != nil {
+					return err
+				}
+			}
+		case sqlField:
+			return walk(e.target, false)
+		case sqlIndex:
+			if err := walk(e.target, false); err != nil {
+				return err
+			}
+			return walk(e.index, false)
+		case sqlUnary:
+			return walk(e.operand, false)
+		case sqlBinary:
+			if err := walk(e.left, false); err != nil {
+				return err
+			}
+			return walk(e.right, false)
+		case sqlCall:
+			for _, v := range e.args {
+				if err := walk(v, false); err != nil {
+					return err
+				}
+			}
+		case sqlCast:
+			return walk(e.operand, false)
+		case sqlCase:
+			if e.operand != nil {
+				if err := walk(e.operand, false); err != nil {
+					return err
+				}
+			}
+			for _, v := range e.whens {
+				if err := walk(v.condition, false); err != nil {
+					return err
+				}
+				if err := walk(v.result, false); err != nil {
+					return err
+				}
+			}
+			if e.otherwise != nil {
+				return walk(e.otherwise, false)
+			}
+		case *sqlSelect:
+			for _, v := range e.columns {
+				if err := walk(v.expr, true); err != nil {
+					return err
+				}
+			}
+			if e.from != nil {
+				if err := walk(e.from, false); err != nil {
+					return err
+				}
+			}
+			if e.where != nil {
+				return walk(e.where, false)
+			}
+		}
+		return nil
+	}
+
+	return walk(expr, false)
+}
+
+// unknownSQLFunctions returns the names of the functions called in an expression that are not known to this provider version.
+func unknownSQLFunctions(expr sqlExpr) []string {
+	var names []string
+
+	var walk func(sqlExpr)
+	walk = func(expr sqlExpr) {
+		switch e := expr.(type) {
+		case sqlFieldWildcard:
+			walk(e.target)
+		case sqlArrayLiteral:
+			for _, v := range e.elements {
+				walk(v)
+			}
+		case sqlObjectLiteral:
+			for _, v := range e.values {
+				walk(v)
+			}
+		case sqlField:
+			walk(e.target)
+		case sqlIndex:
+			walk(e.target)
+			walk(e.index)
+		case sqlUnary:
+			walk(e.operand)
+		case sqlBinary:
+			walk(e.left)
+			walk(e.right)
+		case sqlCall:
+			if _, ok := findSQLFunction(e.name); !ok && !slices.Contains(names, e.name) {
+				names = append(names, e.name)
+			}
+			for _, v := range e.args {
+				walk(v)
+			}
+		case sqlCast:
+			walk(e.operand)
+		case sqlCase:
+			if e.operand != nil {
+				walk(e.operand)
+			}
+			for _, v := range e.whens {
+				walk(v.condition)
+				walk(v.result)
+			}
+			if e.otherwise != nil {
+				walk(e.otherwise)
+			}
+		case *sqlSelect:
+			for _, v := range e.columns {
+				walk(v.expr)
+			}
+			if e.from != nil {
+				walk(e.from)
+			}
+			if e.where != nil {
+				walk(e.where)
+			}
+		}
+	}
+
+	walk(expr)
+
+	return names
+}
+
+// formatSQLExpr returns a short description of an expression for use in error messages.
+func formatSQLExpr(expr sqlExpr) string {
+	switch e := expr.(type) {
+	case sqlIdent:
+		return e.name
+	case sqlField:
+		return formatSQLExpr(e.target) + "." + e.name
+	case sqlWildcard:
+		return "*"
+	case sqlCall:
+		return e.name + "()"
+	default:
+		return "expression"
+	}
+}
+
+// validateTopicFilter validates an MQTT topic filter.
+func validateTopicFilter(filter string) error {
+	if filter == "" {
+		return fmt.Errorf("must not be empty")
+	}
+
+	levels := strings.Split(filter, "/")
+	for i, level := range levels {
+		switch {
+		case level == "#":
+			if i != len(levels)-1 {
+				return fmt.Errorf("# must be the last level")
+			}
+		case level == "+":
+		case strings.ContainsAny(level, "#+"):
+			return fmt.Errorf("wildcards must occupy an entire level")
+		}
+	}
+
+	return nil
+}
+
+// validateTopicRuleSubstitutionTemplates validates the "${expression}" substitution templates in an action argument.
+func validateTopicRuleSubstitutionTemplates(s, version string) error {
+	_, err := expandTopicRuleSubstitutionTemplates(s, version)
+	return err
+}
+
+// expandTopicRuleSubstitutionTemplates splits a string into literal text and the expressions of its substitution templates.
+func expandTopicRuleSubstitutionTemplates(s, version string) ([]any, error) {
+	var parts []any
+
+	for {
+		i := strings.Index(s, "${")
+		if i == -1 {
+			if s != "" {
+				parts = append(parts, s)
+			}
+			return parts, nil
+		}
+
+		if i > 0 {
+			parts = append(parts, s[:i])
+		}
+
+		end := findSubstitutionTemplateEnd(s, i+2)
+		if end == -1 {
+			return nil, fmt.Errorf("unterminated substitution template %q", s[i:])
+		}
+
+		expr, err := parseTopicRuleSQLExpression(s[i+2:end], version)
+		if err != nil {
+			return nil, fmt.Errorf("substitution template %q: %w", s[i:end+1], err)
+		}
+		parts = append(parts, expr)
+
+		s = s[end+1:]
+	}
+}
+
+// findSubstitutionTemplateEnd returns the index of the "}" that closes the substitution template starting at start,
+// skipping quoted strings and nested braces, or -1.
+func findSubstitutionTemplateEnd(s string, start int) int {
+	depth := 0
+	var quote byte
+
+	for i := start; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '{':
+			depth++
+		case c == '}':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,1130 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"maps"
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hashicorp/go-uuid"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// Local evaluation of AWS IoT SQL statements.
+// Values are represented as decoded JSON, with numbers as int64 or float64, and sqlUndefined.
+
+// sqlMessage is an MQTT message that a topic rule is evaluated against.
+type sqlMessage struct {
+	accountID string
+	clientID  string
+	payload   any
+	principal string
+	timestamp time.Time
+	topic     string
+}
+
+type sqlEvalContext struct {
+	message *sqlMessage
+	// current is the value that attributes are resolved against:
+	// the message payload, or the current element of a nested object query.
+	current any
+	// aliases are the names of the current elements of enclosing nested object queries.
+	aliases map[string]any
+}
+
+type sqlFunction struct {
+	minArgs, maxArgs int
+	// acceptsUndefined indicates that the function is called with Undefined arguments.
+	// Other functions return Undefined if any argument is Undefined.
+	acceptsUndefined bool
+	// eval is nil for functions that can't be evaluated locally, such as functions that call other AWS services.
+	eval func(ctx *sqlEvalContext, args []any) (any, error)
+}
+
+func (f sqlFunction) arity() string {
+	switch {
+	case f.maxArgs < 0:
+		return fmt.Sprintf("expected at least %d", f.minArgs)
+	case f.minArgs == f.maxArgs:
+		return fmt.Sprintf("expected %d", f.minArgs)
+	default:
+		return fmt.Sprintf("expected %d to %d", f.minArgs, f.maxArgs)
+	}
+}
+
+// sqlFunctions are the functions supported by AWS IoT SQL, keyed by lower-case name.
+// See https://docs.aws.amazon.com/iot/latest/developerguide/iot-sql-functions.html.
+var sqlFunctions map[string]sqlFunction
+
+func init() {
+	sqlFunctions = map[string]sqlFunction{
+		"abs":                     {minArgs: 1, maxArgs: 1, eval: sqlMathFunc(math.Abs, true)},
+		"accountid":               {eval: func(ctx *sqlEvalContext, _ []any) (any, error) { return ctx.message.accountID, nil }},
+		"acos":                    {minArgs: 1, maxArgs: 1, eval: sqlMathFunc(math.Acos, false)},
+		"asin":                    {minArgs: 1, maxArgs: 1, eval: sqlMathFunc(math.Asin, false)},
+		"atan":                    {minArgs: 1, maxArgs: 1, eval: sqlMathFunc(math.Atan, false)},
+		"atan2":                   {minArgs: 2, maxArgs: 2, eval: sqlMathFunc2(math.Atan2)},
+		"aws_lambda":              {minArgs: 2, maxArgs: 2},
+		"bitand":                  {minArgs: 2, maxArgs: 2, eval: sqlBitFunc(func(a, b int64) int64 { return a & b })},
+		"bitnot":                  {minArgs: 1, maxArgs: 1, eval: sqlBitNot},
+		"bitor":                   {minArgs: 2, maxArgs: 2, eval: sqlBitFunc(func(a, b int64) int64 { return a | b })},
+		"bitxor":                  {minArgs: 2, maxArgs: 2, eval: sqlBitFunc(func(a, b int64) int64 { return a ^ b })},
+		"ceil":                    {minArgs: 1, maxArgs: 1, eval: sqlRoundFunc(math.Ceil)},
+		"chr":                     {minArgs: 1, maxArgs: 1, eval: sqlChr},
+		"clientid":                {eval: func(ctx *sqlEvalContext, _ []any) (any, error) { return ctx.message.clientID, nil }},
+		"concat":                  {minArgs: 1, maxArgs: -1, eval: sqlConcat},
+		"cos":                     {minArgs: 1, maxArgs: 1, eval: sqlMathFunc(math.Cos, false)},
+		"cosh":                    {minArgs: 1, maxArgs: 1, eval: sqlMathFunc(math.Cosh, false)},
+		"crc32":                   {minArgs: 1, maxArgs: 1, eval: sqlCRC32},
+		"decode":                  {minArgs: 2, maxArgs: 2, eval: sqlDecode},
+		"encode":                  {minArgs: 2, maxArgs: 2, eval: sqlEncode},
+		"endswith":                {minArgs: 2, maxArgs: 2, eval: sqlStringFunc2(func(a, b string) any { return strings.HasSuffix(a, b) })},
+		"exp":                     {minArgs: 1, maxArgs: 1, eval: sqlMathFunc(math.Exp, false)},
+		"floor":                   {minArgs: 1, maxArgs: 1, eval: sqlRoundFunc(math.Floor)},
+		"get":                     {minArgs: 2, maxArgs: 2, eval: sqlGet},
+		"get_dynamodb":            {minArgs: 4, maxArgs: 6},
+		"get_mqtt_property":       {minArgs: 1, maxArgs: 1, eval: sqlUndefinedFunc},
+		"get_or_default":          {minArgs: 2, maxArgs: 2, acceptsUndefined: true, eval: sqlGetOrDefault},
+		"get_registry_data":       {minArgs: 3, maxArgs: 3},
+		"get_secret":              {minArgs: 3, maxArgs: 4},
+		"get_thing_shadow":        {minArgs: 2, maxArgs: 3},
+		"get_user_properties":     {minArgs: 1, maxArgs: 1, eval: sqlUndefinedFunc},
+		"indexof":                 {minArgs: 2, maxArgs: 2, eval: sqlStringFunc2(func(a, b string) any { return int64(strings.Index(a, b)) })},
+		"isarray":                 {minArgs: 1, maxArgs: 1, acceptsUndefined: true, eval: sqlIsType(func(v any) bool { _, ok := v.([]any); return ok })},
+		"isboolean":               {minArgs: 1, maxArgs: 1, acceptsUndefined: true, eval: sqlIsType(func(v any) bool { _, ok := v.(bool); return ok })},
+		"isempty":                 {minArgs: 1, maxArgs: 1, acceptsUndefined: true, eval: sqlIsType(isSQLEmpty)},
+		"isnull":                  {minArgs: 1, maxArgs: 1, acceptsUndefined: true, eval: sqlIsType(func(v any) bool { return v == nil })},
+		"isnumber":                {minArgs: 1, maxArgs: 1, acceptsUndefined: true, eval: sqlIsType(isSQLNumber)},
+		"isobject":                {minArgs: 1, maxArgs: 1, acceptsUndefined: true, eval: sqlIsType(func(v any) bool { _, ok := v.(map[string]any); return ok })},
+		"isstring":                {minArgs: 1, maxArgs: 1, acceptsUndefined: true, eval: sqlIsType(func(v any) bool { _, ok := v.(string); return ok })},
+		"isundefined":             {minArgs: 1, maxArgs: 1, acceptsUndefined: true, eval: sqlIsType(func(v any) bool { return v == sqlUndefined })},
+		"ln":                      {minArgs: 1, maxArgs: 1, eval: sqlMathFunc(math.Log, false)},
+		"log":                     {minArgs: 1, maxArgs: 1, eval: sqlMathFunc(math.Log10, false)},
+		"lower":                   {minArgs: 1, maxArgs: 1, eval: sqlStringFunc(strings.ToLower)},
+		"lpad":                    {minArgs: 2, maxArgs: 2, eval: sqlPad(true)},
+		"ltrim":                   {minArgs: 1, maxArgs: 1, eval: sqlStringFunc(func(s string) string { return strings.TrimLeft(s, " \t\n\r") })},
+		"machinelearning_predict": {minArgs: 3, maxArgs: 3},
+		"md2":                     {minArgs: 1, maxArgs: 1},
+		"md5":                     {minArgs: 1, maxArgs: 1, eval: sqlHash(md5.New)},
+		"mod":                     {minArgs: 2, maxArgs: 2, eval: sqlMod},
+		"nanvl":                   {minArgs: 2, maxArgs: 2, eval: sqlNaNVL},
+		"newuuid":                 {eval: func(*sqlEvalContext, []any) (any, error) { return uuid.GenerateUUID() }},
+		"numbytes":                {minArgs: 1, maxArgs: 1, eval: sqlStringFunc1(func(s string) any { return int64(len(s)) })},
+		"parse_time":              {minArgs: 2, maxArgs: 3},
+		"power":                   {minArgs: 2, maxArgs: 2, eval: sqlMathFunc2(math.Pow)},
+		"principal":               {eval: func(ctx *sqlEvalContext, _ []any) (any, error) { return ctx.message.principal, nil }},
+		"rand":                    {eval: func(*sqlEvalContext, []any) (any, error) { return rand.Float64(), nil }},
+		"regexp_matches":          {minArgs: 2, maxArgs: 2, eval: sqlRegexp(func(re *regexp.Regexp, s string, _ []string) any { return re.MatchString(s) })},
+		"regexp_replace":          {minArgs: 3, maxArgs: 3, eval: sqlRegexp(func(re *regexp.Regexp, s string, args []string) any { return re.ReplaceAllString(s, args[0]) })},
+		"regexp_substr":           {minArgs: 2, maxArgs: 2, eval: sqlRegexp(func(re *regexp.Regexp, s string, _ []string) any { return re.FindString(s) })},
+		"remainder":               {minArgs: 2, maxArgs: 2, eval: sqlMod},
+		"replace":                 {minArgs: 3, maxArgs: 3, eval: sqlReplace},
+		"round":                   {minArgs: 1, maxArgs: 1, eval: sqlRoundFunc(math.Round)},
+		"rpad":                    {minArgs: 2, maxArgs: 2, eval: sqlPad(false)},
+		"rtrim":                   {minArgs: 1, maxArgs: 1, eval: sqlStringFunc(func(s string) string { return strings.TrimRight(s, " \t\n\r") })},
+		"sha1":                    {minArgs: 1, maxArgs: 1, eval: sqlHash(sha1.New)},
+		"sha224":                  {minArgs: 1, maxArgs: 1, eval: sqlHash(sha256.New224)},
+		"sha256":                  {minArgs: 1, maxArgs: 1, eval: sqlHash(sha256.New)},
+		"sha384":                  {minArgs: 1, maxArgs: 1, eval: sqlHash(sha512.New384)},
+		"sha512":                  {minArgs: 1, maxArgs: 1, eval: sqlHash(sha512.New)},
+		"sign":                    {minArgs: 1, maxArgs: 1, eval: sqlSign},
+		"sin":                     {minArgs: 1, maxArgs: 1, eval: sqlMathFunc(math.Sin, false)},
+		"sinh":                    {minArgs: 1, maxArgs: 1, eval: sqlMathFunc(math.Sinh, false)},
+		"sourceip":                {eval: sqlUndefinedFunc},
+		"sqrt":                    {minArgs: 1, maxArgs: 1, eval: sqlMathFunc(math.Sqrt, false)},
+		"startswith":              {minArgs: 2, maxArgs: 2, eval: sqlStringFunc2(func(a, b string) any { return strings.HasPrefix(a, b) })},
+		"substring":               {minArgs: 2, maxArgs: 3, eval: sqlSubstring},
+		"tan":                     {minArgs: 1, maxArgs: 1, eval: sqlMathFunc(math.Tan, false)},
+		"tanh":                    {minArgs: 1, maxArgs: 1, eval: sqlMathFunc(math.Tanh, false)},
+		"time_to_epoch":           {minArgs: 2, maxArgs: 2},
+		"timestamp":               {eval: func(ctx *sqlEvalContext, _ []any) (any, error) { return ctx.message.timestamp.UnixMilli(), nil }},
+		"topic":                   {maxArgs: 1, eval: sqlTopic},
+		"traceid":                 {eval: sqlUndefinedFunc},
+		"transform":               {minArgs: 3, maxArgs: 3},
+		"trim":                    {minArgs: 1, maxArgs: 1, eval: sqlStringFunc(func(s string) string { return strings.Trim(s, " \t\n\r") })},
+		"trunc":                   {minArgs: 2, maxArgs: 2, eval: sqlTrunc},
+		"upper":                   {minArgs: 1, maxArgs: 1, eval: sqlStringFunc(strings.ToUpper)},
+	}
+}
+
+func findSQLFunction(name string) (sqlFunction, bool) {
+	f, ok := sqlFunctions[strings.ToLower(name)]
+	return f, ok
+}
+
+// decodeSQLJSON decodes a JSON document, keeping integers as int64.
+func decodeSQLJSON(s string) (any, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return normalizeSQLJSON(v), nil
+}
+
+func normalizeSQLJSON(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case []any:
+		for i, e := range v {
+			v[i] = normalizeSQLJSON(e)
+		}
+	case map[string]any:
+		for k, e := range v {
+			v[k] = normalizeSQLJSON(e)
+		}
+	}
+
+	return v
+}
+
+// evaluateTopicRuleSQL evaluates a SELECT statement against a message.
+// It returns whether the message matches the statement's topic filter and WHERE clause, and the resulting payload.
+func evaluateTopicRuleSQL(stmt *sqlSelect, message *sqlMessage) (bool, any, error) {
+	if !topicMatchesFilter(message.topic, stmt.topic) {
+		return false, nil, nil
+	}
+
+	ctx := &sqlEvalContext{
+		message: message,
+		current: message.payload,
+	}
+
+	return ctx.evalSelect(stmt)
+}
+
+// evaluateTopicRuleSubstitutionTemplates evaluates the substitution templates in a string against a message.
+// Templates that evaluate to Undefined are replaced by an empty string.
+func evaluateTopicRuleSubstitutionTemplates(s, version string, message *sqlMessage) (string, error) {
+	parts, err := expandTopicRuleSubstitutionTemplates(s, version)
+	if err != nil {
+		return "", err
+	}
+
+	ctx := &sqlEvalContext{
+		message: message,
+		current: message.payload,
+	}
+
+	var sb strings.Builder
+	for _, part := range parts {
+		switch part := part.(type) {
+		case string:
+			sb.WriteString(part)
+		case sqlExpr:
+			v, err := ctx.eval(part)
+			if err != nil {
+				return "", err
+			}
+			if str, ok := sqlToString(v); ok {
+				sb.WriteString(str)
+			}
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// topicMatchesFilter returns whether an MQTT topic matches a topic filter.
+func topicMatchesFilter(topic, filter string) bool {
+	topicLevels, filterLevels := strings.Split(topic, "/"), strings.Split(filter, "/")
+
+	for i, level := range filterLevels {
+		switch {
+		case level == "#":
+			return true
+		case i >= len(topicLevels):
+			return false
+		case level == "+":
+		case level != topicLevels[i]:
+			return false
+		}
+	}
+
+	return len(topicLevels) == len(filterLevels)
+}
+
+func (ctx *sqlEvalContext) evalSelect(stmt *sqlSelect) (bool, any, error) {
+	if stmt.where != nil {
+		v, err := ctx.eval(stmt.where)
+		if err != nil {
+			return false, nil, err
+		}
+		if v != true {
+			return false, nil, nil
+		}
+	}
+
+	if stmt.value {
+		v, err := ctx.eval(stmt.columns[0].expr)
+		return true, v, err
+	}
+
+	result := make(map[string]any)
+	for i, column := range stmt.columns {
+		switch e := column.expr.(type) {
+		case sqlWildcard:
+			if m, ok := ctx.current.(map[string]any); ok {
+				for k, v := range m {
+					result[k] = v
+				}
+			} else if len(stmt.columns) == 1 {
+				return true, ctx.current, nil
+			}
+		case sqlFieldWildcard:
+			v, err := ctx.eval(e.target)
+			if err != nil {
+				return false, nil, err
+			}
+			if m, ok := v.(map[string]any); ok {
+				for k, v := range m {
+					result[k] = v
+				}
+			}
+		default:
+			v, err := ctx.eval(column.expr)
+			if err != nil {
+				return false, nil, err
+			}
+			if v == sqlUndefined {
+				continue
+			}
+			name := column.alias
+			if name == "" {
+				name = sqlColumnName(column.expr, i)
+			}
+			result[name] = v
+		}
+	}
+
+	return true, result, nil
+}
+
+// sqlColumnName returns the name of a SELECT clause column without an alias.
+func sqlColumnName(expr sqlExpr, i int) string {
+	switch e := expr.(type) {
+	case sqlIdent:
+		return e.name
+	case sqlField:
+		return e.name
+	case sqlIndex:
+		return sqlColumnName(e.target, i)
+	case sqlCall:
+		return e.name
+	default:
+		return "_" + strconv.Itoa(i+1)
+	}
+}
+
+func (ctx *sqlEvalContext) eval(expr sqlExpr) (any, error) {
+	switch e := expr.(type) {
+	case sqlLiteral:
+		return e.value, nil
+	case sqlArrayLiteral:
+		result := make([]any, 0, len(e.elements))
+		for _, element := range e.elements {
+			v, err := ctx.eval(element)
+			if err != nil {
+				return nil, err
+			}
+			if v != sqlUndefined {
+				result = append(result, v)
+			}
+		}
+		return result, nil
+	case sqlObjectLiteral:
+		result := make(map[string]any, len(e.keys))
+		for i, k := range e.keys {
+			v, err := ctx.eval(e.values[i])
+			if err != nil {
+				return nil, err
+			}
+			if v != sqlUndefined {
+				result[k] = v
+			}
+		}
+		return result, nil
+	case sqlWildcard:
+		return ctx.current, nil
+	case sqlIdent:
+		if v, ok := ctx.aliases[e.name]; ok {
+			return v, nil
+		}
+		return sqlAttribute(ctx.current, e.name), nil
+	case sqlField:
+		v, err := ctx.eval(e.target)
+		if err != nil {
+			return nil, err
+		}
+		return sqlAttribute(v, e.name), nil
+	case sqlFieldWildcard:
+		return ctx.eval(e.target)
+	case sqlIndex:
+		v, err := ctx.eval(e.target)
+		if err != nil {
+			return nil, err
+		}
+		index, err := ctx.eval(e.index)
+		if err != nil {
+			return nil, err
+		}
+		return sqlElement(v, index), nil
+	case sqlUnary:
+		v, err := ctx.eval(e.operand)
+		if err != nil {
+			return nil, err
+		}
+		return sqlEvalUnary(e.op, v), nil
+	case sqlBinary:
+		return ctx.evalBinary(e)
+	case sqlCall:
+		return ctx.evalCall(e)
+	case sqlCast:
+		v, err := ctx.eval(e.operand)
+		if err != nil {
+			return nil, err
+		}
+		return sqlCastValue(v, e.typ), nil
+	case sqlCase:
+		return ctx.evalCase(e)
+	case *sqlSelect:
+		return ctx.evalNestedSelect(e)
+	}
+
+	return nil, fmt.Errorf("unsupported expression %T", expr)
+}
+
+func (ctx *sqlEvalContext) evalBinary(e sqlBinary) (any, error) {
+	left, err := ctx.eval(e.left)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := ctx.eval(e.right)
+	if err != nil {
+		return nil, err
+	}
+
+	if left == sqlUndefined || right == sqlUndefined {
+		return sqlUndefined, nil
+	}
+
+	switch e.op {
+	case "and", "or":
+		l, lok := left.(bool)
+		r, rok := right.(bool)
+		if !lok || !rok {
+			return sqlUndefined, nil
+		}
+		if e.op == "and" {
+			return l && r, nil
+		}
+		return l || r, nil
+	case "=":
+		return sqlEqual(left, right), nil
+	case "<>":
+		return !sqlEqual(left, right), nil
+	case "<", "<=", ">", ">=":
+		return sqlCompare(e.op, left, right), nil
+	default:
+		return sqlArithmetic(e.op, left, right), nil
+	}
+}
+
+func (ctx *sqlEvalContext) evalCall(e sqlCall) (any, error) {
+	f, ok := findSQLFunction(e.name)
+	if !ok {
+		return nil, fmt.Errorf("unknown function %s", e.name)
+	}
+
+	if f.eval == nil {
+		return nil, fmt.Errorf("function %s is not supported for local evaluation", e.name)
+	}
+
+	args := make([]any, 0, len(e.args))
+	for _, arg := range e.args {
+		v, err := ctx.eval(arg)
+		if err != nil {
+			return nil, err
+		}
+		if v == sqlUndefined && !f.acceptsUndefined {
+			return sqlUndefined, nil
+		}
+		args = append(args, v)
+	}
+
+	return f.eval(ctx, args)
+}
+
+func (ctx *sqlEvalContext) evalCase(e sqlCase) (any, error) {
+	var operand any
+	if e.operand != nil {
+		v, err := ctx.eval(e.operand)
+		if err != nil {
+			return nil, err
+		}
+		operand = v
+	}
+
+	for _, when := range e.whens {
+		condition, err := ctx.eval(when.condition)
+		if err != nil {
+			return nil, err
+		}
+
+		var matched bool
+		if e.operand != nil {
+			matched = operand != sqlUndefined && condition != sqlUndefined && sqlEqual(operand, condition)
+		} else {
+			matched = condition == true
+		}
+
+		if matched {
+			return ctx.eval(when.result)
+		}
+	}
+
+	if e.otherwise != nil {
+		return ctx.eval(e.otherwise)
+	}
+
+	return sqlUndefined, nil
+}
+
+func (ctx *sqlEvalContext) evalNestedSelect(stmt *sqlSelect) (any, error) {
+	from, err := ctx.eval(stmt.from)
+	if err != nil {
+		return nil, err
+	}
+
+	elements, ok := from.([]any)
+	if !ok {
+		return sqlUndefined, nil
+	}
+
+	result := make([]any, 0, len(elements))
+	for _, element := range elements {
+		nested := &sqlEvalContext{
+			message: ctx.message,
+			current: element,
+			aliases: ctx.aliases,
+		}
+		if stmt.fromAlias != "" {
+			nested.aliases = maps.Clone(ctx.aliases)
+			if nested.aliases == nil {
+				nested.aliases = make(map[string]any)
+			}
+			nested.aliases[stmt.fromAlias] = element
+		}
+
+		matched, v, err := nested.evalSelect(stmt)
+		if err != nil {
+			return nil, err
+		}
+		if matched && v != sqlUndefined {
+			result = append(result, v)
+		}
+	}
+
+	return result, nil
+}
+
+func sqlAttribute(v any, name string) any {
+	if m, ok := v.(map[string]any); ok {
+		if v, ok := m[name]; ok {
+			return v
+		}
+	}
+
+	return sqlUndefined
+}
+
+func sqlElement(v, index any) any {
+	switch v := v.(type) {
+	case []any:
+		if i, ok := index.(int64); ok && i >= 0 && i < int64(len(v)) {
+			return v[i]
+		}
+	case map[string]any:
+		if k, ok := index.(string); ok {
+			return sqlAttribute(v, k)
+		}
+	}
+
+	return sqlUndefined
+}
+
+func sqlEvalUnary(op string, v any) any {
+	switch op {
+	case "not":
+		if b, ok := v.(bool); ok {
+			return !b
+		}
+	case "-":
+		switch n := sqlToNumber(v).(type) {
+		case int64:
+			return -n
+		case float64:
+			return -n
+		}
+	}
+
+	return sqlUndefined
+}
+
+func isSQLNumber(v any) bool {
+	switch v.(type) {
+	case int64, float64:
+		return true
+	}
+	return false
+}
+
+func isSQLEmpty(v any) bool {
+	switch v := v.(type) {
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+	return false
+}
+
+// sqlToNumber converts a value to int64 or float64, or returns Undefined.
+// Strings are converted if they contain a valid number.
+func sqlToNumber(v any) any {
+	switch v := v.(type) {
+	case int64, float64:
+		return v
+	case string:
+		if i, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64); err == nil {
+			return i
+		}
+		if f, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+			return f
+		}
+	}
+
+	return sqlUndefined
+}
+
+func sqlToFloat(v any) (float64, bool) {
+	switch n := sqlToNumber(v).(type) {
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+func sqlToInt(v any) (int64, bool) {
+	switch n := sqlToNumber(v).(type) {
+	case int64:
+		return n, true
+	case float64:
+		if n == math.Trunc(n) {
+			return int64(n), true
+		}
+	}
+	return 0, false
+}
+
+// sqlToString converts a scalar value to a string. Arrays and objects are JSON encoded.
+func sqlToString(v any) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case nil:
+		return "null", true
+	case []any, map[string]any:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		return string(b), true
+	}
+
+	return "", false
+}
+
+func sqlEqual(left, right any) bool {
+	if isSQLNumber(left) && isSQLNumber(right) {
+		l, _ := sqlToFloat(left)
+		r, _ := sqlToFloat(right)
+		return l == r
+	}
+
+	return reflect.DeepEqual(left, right)
+}
+
+func sqlCompare(op string, left, right any) any {
+	var c int
+
+	if l, ok := left.(string); ok {
+		r, ok := right.(string)
+		if !ok {
+			return sqlUndefined
+		}
+		c = strings.Compare(l, r)
+	} else {
+		l, lok := sqlToFloat(left)
+		r, rok := sqlToFloat(right)
+		if !lok || !rok {
+			return sqlUndefined
+		}
+		switch {
+		case l < r:
+			c = -1
+		case l > r:
+			c = 1
+		}
+	}
+
+	switch op {
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	default:
+		return c >= 0
+	}
+}
+
+func sqlArithmetic(op string, left, right any) any {
+	l, r := sqlToNumber(left), sqlToNumber(right)
+	if l == sqlUndefined || r == sqlUndefined {
+		return sqlUndefined
+	}
+
+	li, lok := l.(int64)
+	ri, rok := r.(int64)
+	if lok && rok {
+		switch op {
+		case "+":
+			return li + ri
+		case "-":
+			return li - ri
+		case "*":
+			return li * ri
+		case "/":
+			if ri == 0 {
+				return sqlUndefined
+			}
+			return li / ri
+		case "%":
+			if ri == 0 {
+				return sqlUndefined
+			}
+			return li % ri
+		}
+	}
+
+	lf, _ := sqlToFloat(l)
+	rf, _ := sqlToFloat(r)
+	switch op {
+	case "+":
+		return lf + rf
+	case "-":
+		return lf - rf
+	case "*":
+		return lf * rf
+	case "/":
+		if rf == 0 {
+			return sqlUndefined
+		}
+		return lf / rf
+	case "%":
+		if rf == 0 {
+			return sqlUndefined
+		}
+		return math.Mod(lf, rf)
+	}
+
+	return sqlUndefined
+}
+
+func sqlCastValue(v any, typ string) any {
+	switch typ {
+	case "Int":
+		switch v := v.(type) {
+		case bool:
+			if v {
+				return int64(1)
+			}
+			return int64(0)
+		default:
+			if f, ok := sqlToFloat(v); ok {
+				return int64(f)
+			}
+		}
+	case "Decimal":
+		switch v := v.(type) {
+		case bool:
+			if v {
+				return float64(1)
+			}
+			return float64(0)
+		default:
+			if f, ok := sqlToFloat(v); ok {
+				return f
+			}
+		}
+	case "String":
+		if s, ok := sqlToString(v); ok {
+			return s
+		}
+	case "Bool", "Boolean":
+		switch v := v.(type) {
+		case bool:
+			return v
+		case string:
+			if b, err := strconv.ParseBool(strings.ToLower(v)); err == nil {
+				return b
+			}
+		default:
+			if f, ok := sqlToFloat(v); ok {
+				return f != 0
+			}
+		}
+	}
+
+	return sqlUndefined
+}
+
+func sqlUndefinedFunc(*sqlEvalContext, []any) (any, error) {
+	return sqlUndefined, nil
+}
+
+func sqlMathFunc(f func(float64) float64, keepInt bool) func(*sqlEvalContext, []any) (any, error) {
+	return func(_ *sqlEvalContext, args []any) (any, error) {
+		if i, ok := args[0].(int64); ok && keepInt {
+			return int64(f(float64(i))), nil
+		}
+		x, ok := sqlToFloat(args[0])
+		if !ok {
+			return sqlUndefined, nil
+		}
+		return f(x), nil
+	}
+}
+
+func sqlMathFunc2(f func(float64, float64) float64) func(*sqlEvalContext, []any) (any, error) {
+	return func(_ *sqlEvalContext, args []any) (any, error) {
+		x, xok := sqlToFloat(args[0])
+		y, yok := sqlToFloat(args[1])
+		if !xok || !yok {
+			return sqlUndefined, nil
+		}
+		return f(x, y), nil
+	}
+}
+
+func sqlRoundFunc(f func(float64) float64) func(*sqlEvalContext, []any) (any, error) {
+	return func(_ *sqlEvalContext, args []any) (any, error) {
+		x, ok := sqlToFloat(args[0])
+		if !ok {
+			return sqlUndefined, nil
+		}
+		return int64(f(x)), nil
+	}
+}
+
+func sqlTrunc(_ *sqlEvalContext, args []any) (any, error) {
+	x, xok := sqlToFloat(args[0])
+	places, pok := sqlToInt(args[1])
+	if !xok || !pok {
+		return sqlUndefined, nil
+	}
+	scale := math.Pow10(int(places))
+	return math.Trunc(x*scale) / scale, nil
+}
+
+func sqlSign(_ *sqlEvalContext, args []any) (any, error) {
+	x, ok := sqlToFloat(args[0])
+	if !ok {
+		return sqlUndefined, nil
+	}
+	switch {
+	case x > 0:
+		return int64(1), nil
+	case x < 0:
+		return int64(-1), nil
+	}
+	return int64(0), nil
+}
+
+func sqlMod(_ *sqlEvalContext, args []any) (any, error) {
+	return sqlArithmetic("%", args[0], args[1]), nil
+}
+
+func sqlNaNVL(_ *sqlEvalContext, args []any) (any, error) {
+	if f, ok := args[0].(float64); ok && math.IsNaN(f) {
+		return args[1], nil
+	}
+	return args[0], nil
+}
+
+func sqlBitFunc(f func(int64, int64) int64) func(*sqlEvalContext, []any) (any, error) {
+	return func(_ *sqlEvalContext, args []any) (any, error) {
+		a, aok := sqlToInt(args[0])
+		b, bok := sqlToInt(args[1])
+		if !aok || !bok {
+			return sqlUndefined, nil
+		}
+		return f(a, b), nil
+	}
+}
+
+func sqlBitNot(_ *sqlEvalContext, args []any) (any, error) {
+	a, ok := sqlToInt(args[0])
+	if !ok {
+		return sqlUndefined, nil
+	}
+	return ^a, nil
+}
+
+func sqlChr(_ *sqlEvalContext, args []any) (any, error) {
+	i, ok := sqlToInt(args[0])
+	if !ok || !utf8.ValidRune(rune(i)) {
+		return sqlUndefined, nil
+	}
+	return string(rune(i)), nil
+}
+
+func sqlStringFunc(f func(string) string) func(*sqlEvalContext, []any) (any, error) {
+	return sqlStringFunc1(func(s string) any { return f(s) })
+}
+
+func sqlStringFunc1(f func(string) any) func(*sqlEvalContext, []any) (any, error) {
+	return func(_ *sqlEvalContext, args []any) (any, error) {
+		s, ok := sqlToString(args[0])
+		if !ok {
+			return sqlUndefined, nil
+		}
+		return f(s), nil
+	}
+}
+
+func sqlStringFunc2(f func(string, string) any) func(*sqlEvalContext, []any) (any, error) {
+	return func(_ *sqlEvalContext, args []any) (any, error) {
+		a, aok := sqlToString(args[0])
+		b, bok := sqlToString(args[1])
+		if !aok || !bok {
+			return sqlUndefined, nil
+		}
+		return f(a, b), nil
+	}
+}
+
+func sqlConcat(_ *sqlEvalContext, args []any) (any, error) {
+	var array []any
+	var sb strings.Builder
+	arrays := true
+
+	for _, arg := range args {
+		if v, ok := arg.([]any); ok {
+			array = append(array, v...)
+			continue
+		}
+		arrays = false
+	}
+
+	if arrays {
+		return array, nil
+	}
+
+	for _, arg := range args {
+		s, ok := sqlToString(arg)
+		if !ok {
+			return sqlUndefined, nil
+		}
+		sb.WriteString(s)
+	}
+
+	return sb.String(), nil
+}
+
+func sqlReplace(_ *sqlEvalContext, args []any) (any, error) {
+	var s [3]string
+	for i := range s {
+		v, ok := sqlToString(args[i])
+		if !ok {
+			return sqlUndefined, nil
+		}
+		s[i] = v
+	}
+	return strings.ReplaceAll(s[0], s[1], s[2]), nil
+}
+
+func sqlSubstring(_ *sqlEvalContext, args []any) (any, error) {
+	s, ok := sqlToString(args[0])
+	if !ok {
+		return sqlUndefined, nil
+	}
+	runes := []rune(s)
+
+	start, ok := sqlToInt(args[1])
+	if !ok {
+		return sqlUndefined, nil
+	}
+	end := int64(len(runes))
+	if len(args) > 2 {
+		if end, ok = sqlToInt(args[2]); !ok {
+			return sqlUndefined, nil
+		}
+	}
+
+	start = min(max(start, 0), int64(len(runes)))
+	end = min(max(end, start), int64(len(runes)))
+
+	return string(runes[start:end]), nil
+}
+
+func sqlPad(left bool) func(*sqlEvalContext, []any) (any, error) {
+	return func(_ *sqlEvalContext, args []any) (any, error) {
+		s, sok := sqlToString(args[0])
+		n, nok := sqlToInt(args[1])
+		if !sok || !nok {
+			return sqlUndefined, nil
+		}
+		padding := strings.Repeat(" ", int(max(n, 0)))
+		if left {
+			return padding + s, nil
+		}
+		return s + padding, nil
+	}
+}
+
+func sqlRegexp(f func(*regexp.Regexp, string, []string) any) func(*sqlEvalContext, []any) (any, error) {
+	return func(_ *sqlEvalContext, args []any) (any, error) {
+		var s []string
+		for _, arg := range args {
+			v, ok := sqlToString(arg)
+			if !ok {
+				return sqlUndefined, nil
+			}
+			s = append(s, v)
+		}
+
+		re, err := regexp.Compile(s[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %w", s[1], err)
+		}
+
+		return f(re, s[0], s[2:]), nil
+	}
+}
+
+func sqlHash(h func() hash.Hash) func(*sqlEvalContext, []any) (any, error) {
+	return sqlStringFunc1(func(s string) any {
+		hash := h()
+		hash.Write([]byte(s))
+		return hex.EncodeToString(hash.Sum(nil))
+	})
+}
+
+func sqlCRC32(_ *sqlEvalContext, args []any) (any, error) {
+	s, ok := sqlToString(args[0])
+	if !ok {
+		return sqlUndefined, nil
+	}
+	return strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(s))), 16), nil
+}
+
+func sqlEncode(_ *sqlEvalContext, args []any) (any, error) {
+	if encoding, ok := args[1].(string); !ok || encoding != "base64" {
+		return nil, fmt.Errorf("encode: unsupported encoding %v", args[1])
+	}
+	s, ok := sqlToString(args[0])
+	if !ok {
+		return sqlUndefined, nil
+	}
+	return itypes.Base64Encode([]byte(s)), nil
+}
+
+func sqlDecode(_ *sqlEvalContext, args []any) (any, error) {
+	if encoding, ok := args[1].(string); !ok || encoding != "base64" {
+		return nil, fmt.Errorf("decode: unsupported encoding %v", args[1])
+	}
+	s, ok := args[0].(string)
+	if !ok {
+		return sqlUndefined, nil
+	}
+	b, err := itypes.Base64Decode(s)
+	if err != nil {
+		return sqlUndefined, nil
+	}
+	// Decoded JSON documents are returned as values.
+	if v, err := decodeSQLJSON(string(b)); err == nil && json.Valid(bytes.TrimSpace(b)) {
+		return v, nil
+	}
+	return string(b), nil
+}
+
+func sqlGet(_ *sqlEvalContext, args []any) (any, error) {
+	return sqlElement(args[0], args[1]), nil
+}
+
+func sqlGetOrDefault(_ *sqlEvalContext, args []any) (any, error) {
+	if args[0] == sqlUndefined {
+		return args[1], nil
+	}
+	return args[0], nil
+}
+
+func sqlIsType(f func(any) bool) func(*sqlEvalContext, []any) (any, error) {
+	return func(_ *sqlEvalContext, args []any) (any, error) {
+		return f(args[0]), nil
+	}
+}
+
+func sqlTopic(ctx *sqlEvalContext, args []any) (any, error) {
+	if len(args) == 0 {
+		return ctx.message.topic, nil
+	}
+
+	i, ok := sqlToInt(args[0])
+	levels := strings.Split(ctx.message.topic, "/")
+	if !ok || i < 1 || i > int64(len(levels)) {
+		return sqlUndefined, nil
+	}
+
+	return levels[i-1], nil
+}
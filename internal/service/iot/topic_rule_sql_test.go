@@ -0,0 +1,424 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestParseTopicRuleSQL(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sql         string
+		version     string
+		expectedErr string
+	}{
+		"wildcard": {
+			sql:     "SELECT * FROM 'topic/test'",
+			version: topicRuleSQLVersion20151008,
+		},
+		"columns": {
+			sql:     "SELECT temperature AS temp, state.reported.color, upper(name) FROM 'things/+/telemetry' WHERE temperature > 50 AND NOT isUndefined(name)",
+			version: topicRuleSQLVersion20160323,
+		},
+		"field wildcard": {
+			sql:     "SELECT state.*, topic(2) AS thing FROM 'things/#'",
+			version: topicRuleSQLVersion20160323,
+		},
+		"case and cast": {
+			sql:     "SELECT CASE color WHEN 'red' THEN 1 ELSE 0 END AS red, cast(value AS Decimal) FROM '#'",
+			version: topicRuleSQLVersion20160323,
+		},
+		"array index and literals": {
+			sql:     "SELECT readings[0] AS first, [1, 2, 3] AS list, {'a': 1} AS obj FROM 'a/b'",
+			version: topicRuleSQLVersion20160323,
+		},
+		"select value": {
+			sql:     "SELECT VALUE state FROM 'a/b'",
+			version: topicRuleSQLVersion20160323,
+		},
+		"select value 2015-10-08": {
+			sql:         "SELECT VALUE state FROM 'a/b'",
+			version:     topicRuleSQLVersion20151008,
+			expectedErr: `SELECT VALUE`,
+		},
+		"value attribute": {
+			sql:     "SELECT value FROM 'a/b'",
+			version: topicRuleSQLVersion20151008,
+		},
+		"nested object query": {
+			sql:     "SELECT (SELECT v.id FROM v WHERE v.ok = true) AS ids FROM 'a/b'",
+			version: topicRuleSQLVersionBeta,
+		},
+		"nested object query 2015-10-08": {
+			sql:         "SELECT (SELECT id FROM items) AS ids FROM 'a/b'",
+			version:     topicRuleSQLVersion20151008,
+			expectedErr: `nested object query requires`,
+		},
+		"missing FROM": {
+			sql:         "SELECT *",
+			version:     topicRuleSQLVersion20160323,
+			expectedErr: `FROM`,
+		},
+		"unknown function": {
+			sql:     "SELECT nosuchfunc(a, b, c) FROM 'a/b'",
+			version: topicRuleSQLVersion20160323,
+		},
+		"wrong number of arguments": {
+			sql:         "SELECT substring(a) FROM 'a/b'",
+			version:     topicRuleSQLVersion20160323,
+			expectedErr: `expected 2 to 3`,
+		},
+		"unterminated string": {
+			sql:         "SELECT * FROM 'a/b",
+			version:     topicRuleSQLVersion20160323,
+			expectedErr: `unterminated`,
+		},
+		"invalid topic filter": {
+			sql:         "SELECT * FROM 'a/#/b'",
+			version:     topicRuleSQLVersion20160323,
+			expectedErr: `#`,
+		},
+		"trailing input": {
+			sql:         "SELECT * FROM 'a/b' WHERE a = 1 b",
+			version:     topicRuleSQLVersion20160323,
+			expectedErr: `unexpected`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseTopicRuleSQL(testCase.sql, testCase.version)
+
+			if testCase.expectedErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error matching %q, got none", testCase.expectedErr)
+			}
+			if !strings.Contains(err.Error(), testCase.expectedErr) {
+				t.Fatalf("expected error matching %q, got %q", testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateTopicRuleSubstitutionTemplates(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value      string
+		expectedOK bool
+	}{
+		"no templates":     {value: "arn:aws:sns:us-west-2:123456789012:test", expectedOK: true},
+		"attribute":        {value: "${topic()}/${device.id}", expectedOK: true},
+		"nested braces":    {value: "${get({'a': 1}, 'a')}", expectedOK: true},
+		"unterminated":     {value: "prefix-${device", expectedOK: false},
+		"unknown function": {value: "${nosuchfunc()}", expectedOK: true},
+		"syntax error":     {value: "${a +}", expectedOK: false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateTopicRuleSubstitutionTemplates(testCase.value, topicRuleSQLVersion20160323)
+
+			if got := err == nil; got != testCase.expectedOK {
+				t.Fatalf("validateTopicRuleSubstitutionTemplates(%q) error = %v", testCase.value, err)
+			}
+		})
+	}
+}
+
+func TestUnknownSQLFunctions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sql      string
+		expected []string
+	}{
+		"known functions": {
+			sql: "SELECT TOPIC(2) AS t, substring(a, 1) FROM 'a/b' WHERE startswith(b, 'x')",
+		},
+		"unknown functions": {
+			sql:      "SELECT newfunc(a), CASE newfunc(b) WHEN 1 THEN otherfunc() END FROM 'a/b' WHERE upper(newfunc(c)) = 'X'",
+			expected: []string{"newfunc", "otherfunc"},
+		},
+		"nested object query": {
+			sql:      "SELECT (SELECT newfunc(v.id) FROM v) AS ids FROM 'a/b'",
+			expected: []string{"newfunc"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			stmt, err := parseTopicRuleSQL(testCase.sql, topicRuleSQLVersionBeta)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := unknownSQLFunctions(stmt); !slices.Equal(got, testCase.expected) {
+				t.Fatalf("unknownSQLFunctions(%q) = %v, expected %v", testCase.sql, got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestValidateTopicRuleSQL(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sql             string
+		version         string
+		expectedSummary []string
+	}{
+		"valid": {
+			sql:     "SELECT * FROM 'a/b'",
+			version: topicRuleSQLVersion20160323,
+		},
+		"syntax error": {
+			sql:             "SELECT * FORM 'a/b'",
+			version:         topicRuleSQLVersion20160323,
+			expectedSummary: []string{"Unable to parse IoT SQL statement"},
+		},
+		"unsupported by version": {
+			sql:             "SELECT VALUE state FROM 'a/b'",
+			version:         topicRuleSQLVersion20151008,
+			expectedSummary: []string{"Unable to parse IoT SQL statement"},
+		},
+		"unknown functions": {
+			sql:             "SELECT newfunc(a), otherfunc(b) FROM 'a/b'",
+			version:         topicRuleSQLVersion20160323,
+			expectedSummary: []string{"Unknown IoT SQL function", "Unknown IoT SQL function"},
+		},
+		"unknown version": {
+			sql:     "SELECT * FORM 'a/b'",
+			version: "2099-01-01",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := schema.ValidateResourceConfigFuncRequest{
+				RawConfig: cty.ObjectVal(map[string]cty.Value{
+					"sql":         cty.StringVal(testCase.sql),
+					"sql_version": cty.StringVal(testCase.version),
+				}),
+			}
+			var resp schema.ValidateResourceConfigFuncResponse
+
+			validateTopicRuleSQL(context.Background(), req, &resp)
+
+			var got []string
+			for _, d := range resp.Diagnostics {
+				if d.Severity != diag.Warning {
+					t.Errorf("unexpected diagnostic severity: %v", d.Severity)
+				}
+				got = append(got, d.Summary)
+			}
+
+			if !slices.Equal(got, testCase.expectedSummary) {
+				t.Fatalf("validateTopicRuleSQL(%q, %q) = %v, expected %v", testCase.sql, testCase.version, got, testCase.expectedSummary)
+			}
+		})
+	}
+}
+
+func TestEvaluateTopicRuleSQL(t *testing.T) {
+	t.Parallel()
+
+	const payload = `{
+  "temperature": 60,
+  "humidity": 20.5,
+  "name": "sensor",
+  "state": {"color": "red", "on": true},
+  "readings": [{"id": 1, "ok": true}, {"id": 2, "ok": false}, {"id": 3, "ok": true}]
+}`
+
+	testCases := map[string]struct {
+		sql             string
+		topic           string
+		expectedMatched bool
+		expectedResult  string
+	}{
+		"wildcard": {
+			sql:             "SELECT * FROM 'things/+/telemetry'",
+			topic:           "things/t1/telemetry",
+			expectedMatched: true,
+			expectedResult:  payload,
+		},
+		"topic mismatch": {
+			sql:   "SELECT * FROM 'things/+/status'",
+			topic: "things/t1/telemetry",
+		},
+		"where false": {
+			sql:   "SELECT * FROM '#' WHERE temperature < 50",
+			topic: "things/t1/telemetry",
+		},
+		"where undefined": {
+			sql:   "SELECT * FROM '#' WHERE missing > 50",
+			topic: "things/t1/telemetry",
+		},
+		"columns": {
+			sql:             "SELECT temperature * 2 AS double, upper(name) AS name, state.color, topic(2) AS thing, missing FROM '#' WHERE temperature > 50",
+			topic:           "things/t1/telemetry",
+			expectedMatched: true,
+			expectedResult:  `{"double": 120, "name": "SENSOR", "color": "red", "thing": "t1"}`,
+		},
+		"field wildcard": {
+			sql:             "SELECT state.*, humidity FROM '#'",
+			topic:           "a",
+			expectedMatched: true,
+			expectedResult:  `{"color": "red", "on": true, "humidity": 20.5}`,
+		},
+		"select value": {
+			sql:             "SELECT VALUE readings[1].id FROM '#'",
+			topic:           "a",
+			expectedMatched: true,
+			expectedResult:  `2`,
+		},
+		"case": {
+			sql:             "SELECT CASE state.color WHEN 'red' THEN 'stop' ELSE 'go' END AS signal FROM '#'",
+			topic:           "a",
+			expectedMatched: true,
+			expectedResult:  `{"signal": "stop"}`,
+		},
+		"nested object query": {
+			sql:             "SELECT (SELECT VALUE r.id FROM readings AS r WHERE r.ok = true) AS ids FROM '#'",
+			topic:           "a",
+			expectedMatched: true,
+			expectedResult:  `{"ids": [1, 3]}`,
+		},
+		"string conversion": {
+			sql:             "SELECT '5' + 1 AS sum, cast(humidity AS Int) AS h FROM '#'",
+			topic:           "a",
+			expectedMatched: true,
+			expectedResult:  `{"sum": 6, "h": 20}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			stmt, err := parseTopicRuleSQL(testCase.sql, topicRuleSQLVersion20160323)
+			if err != nil {
+				t.Fatalf("parsing SQL: %s", err)
+			}
+
+			v, err := decodeSQLJSON(payload)
+			if err != nil {
+				t.Fatalf("decoding payload: %s", err)
+			}
+
+			matched, result, err := evaluateTopicRuleSQL(stmt, &sqlMessage{
+				payload:   v,
+				timestamp: time.UnixMilli(1700000000000),
+				topic:     testCase.topic,
+			})
+			if err != nil {
+				t.Fatalf("evaluating SQL: %s", err)
+			}
+
+			if matched != testCase.expectedMatched {
+				t.Fatalf("matched = %t, expected %t", matched, testCase.expectedMatched)
+			}
+
+			if !matched {
+				return
+			}
+
+			expected, err := decodeSQLJSON(testCase.expectedResult)
+			if err != nil {
+				t.Fatalf("decoding expected result: %s", err)
+			}
+
+			if got, want := mustMarshalJSON(t, result), mustMarshalJSON(t, expected); got != want {
+				t.Errorf("result = %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestEvaluateTopicRuleSubstitutionTemplates(t *testing.T) {
+	t.Parallel()
+
+	v, err := decodeSQLJSON(`{"device": {"id": "d-1"}, "value": 7}`)
+	if err != nil {
+		t.Fatalf("decoding payload: %s", err)
+	}
+
+	message := &sqlMessage{
+		clientID:  "client-1",
+		payload:   v,
+		timestamp: time.UnixMilli(1700000000000),
+		topic:     "things/t1/telemetry",
+	}
+
+	got, err := evaluateTopicRuleSubstitutionTemplates("${topic(2)}/${device.id}/${value + 1}/${clientid()}/${timestamp()}${missing}", topicRuleSQLVersion20160323, message)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := "t1/d-1/8/client-1/1700000000000"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+}
+
+func TestTopicMatchesFilter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		topic, filter string
+		expected      bool
+	}{
+		{"a/b/c", "a/b/c", true},
+		{"a/b/c", "a/+/c", true},
+		{"a/b/c", "a/#", true},
+		{"a", "a/#", true},
+		{"a/b/c", "#", true},
+		{"a/b", "a/b/c", false},
+		{"a/b/c", "a/b", false},
+		{"a/b/c", "a/+", false},
+		{"a/x/c", "a/b/c", false},
+	}
+
+	for _, testCase := range testCases {
+		if got := topicMatchesFilter(testCase.topic, testCase.filter); got != testCase.expected {
+			t.Errorf("topicMatchesFilter(%q, %q) = %t, expected %t", testCase.topic, testCase.filter, got, testCase.expected)
+		}
+	}
+}
+
+func mustMarshalJSON(t *testing.T, v any) string {
+	t.Helper()
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("encoding JSON: %s", err)
+	}
+
+	return string(b)
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -104,6 +105,32 @@ func TestAccIoTTopicRule_disappears(t *testing.T) {
 	})
 }
 
+func TestAccIoTTopicRule_sqlValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccTopicRuleName()
+	resourceName := "aws_iot_topic_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicRuleConfig_sql(rName, "SELECT VALUE state FROM 'topic/test'", "2016-03-23"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sql", "SELECT VALUE state FROM 'topic/test'"),
+				),
+			},
+			{
+				Config:      testAccTopicRuleConfig_republishTemplate(rName, "$${substring(topic())}/out"),
+				ExpectError: regexache.MustCompile(`republish: substitution template`),
+			},
+		},
+	})
+}
+
 func TestAccIoTTopicRule_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := testAccTopicRuleName()
@@ -2524,6 +2551,33 @@ resource "aws_iot_topic_rule" "test" {
 `, rName)
 }
 
+func testAccTopicRuleConfig_sql(rName, sql, sqlVersion string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_topic_rule" "test" {
+  name        = %[1]q
+  enabled     = true
+  sql         = %[2]q
+  sql_version = %[3]q
+}
+`, rName, sql, sqlVersion)
+}
+
+func testAccTopicRuleConfig_republishTemplate(rName, topic string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_topic_rule" "test" {
+  name        = %[1]q
+  enabled     = true
+  sql         = "SELECT * FROM 'topic/test'"
+  sql_version = "2016-03-23"
+
+  republish {
+    role_arn = "arn:aws:iam::123456789012:role/test"
+    topic    = %[2]q
+  }
+}
+`, rName, topic)
+}
+
 func testAccTopicRuleConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_iot_topic_rule" "test" {
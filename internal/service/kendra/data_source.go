@@ -92,7 +92,7 @@ func ResourceDataSource() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"s3_configuration": {
 							Type:       schema.TypeList,
-							Deprecated: "s3_configuration is deprecated. Use template_configuration instead.",
+							Deprecated: names.ResourceDeprecationMessage("aws_kendra_data_source", "configuration.s3_configuration"),
 							Optional:   true,
 							MaxItems:   1,
 							Elem: &schema.Resource{
@@ -181,7 +181,7 @@ func ResourceDataSource() *schema.Resource {
 						},
 						"web_crawler_configuration": {
 							Type:       schema.TypeList,
-							Deprecated: "web_crawler_configuration is deprecated. Use template_configuration instead.",
+							Deprecated: names.ResourceDeprecationMessage("aws_kendra_data_source", "configuration.web_crawler_configuration"),
 							Optional:   true,
 							MaxItems:   1,
 							Elem: &schema.Resource{
@@ -41,7 +41,7 @@ func resourceApplication() *schema.Resource {
 		UpdateWithoutTimeout: resourceApplicationUpdate,
 		DeleteWithoutTimeout: resourceApplicationDelete,
 
-		DeprecationMessage: "use aws_kinesisanalyticsv2_application resource instead",
+		DeprecationMessage: names.ResourceDeprecationMessage("aws_kinesis_analytics_application", ""),
 
 		CustomizeDiff: customdiff.Sequence(
 			customdiff.ForceNewIfChange("inputs", func(_ context.Context, old, new, meta any) bool {
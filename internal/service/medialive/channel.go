@@ -153,6 +153,13 @@ func resourceChannel() *schema.Resource {
 				"encoder_settings": func() *schema.Schema {
 					return channelEncoderSettingsSchema()
 				}(),
+				"encoder_settings_json": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateFunc:     validation.StringIsJSON,
+					DiffSuppressFunc: suppressEquivalentChannelEncoderSettingsJSON,
+					ExactlyOneOf:     []string{"encoder_settings", "encoder_settings_json"},
+				},
 				"input_attachments": {
 					Type:     schema.TypeSet,
 					Required: true,
@@ -765,6 +772,13 @@ func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, meta any
 	if v, ok := d.GetOk("encoder_settings"); ok && len(v.([]any)) > 0 {
 		in.EncoderSettings = expandChannelEncoderSettings(v.([]any))
 	}
+	if v, ok := d.GetOk("encoder_settings_json"); ok {
+		encoderSettings, err := expandChannelEncoderSettingsJSON(v.(string))
+		if err != nil {
+			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionCreating, ResNameChannel, d.Get(names.AttrName).(string), err)
+		}
+		in.EncoderSettings = encoderSettings
+	}
 	if v, ok := d.GetOk("input_attachments"); ok && v.(*schema.Set).Len() > 0 {
 		in.InputAttachments = expandChannelInputAttachments(v.(*schema.Set).List())
 	}
@@ -841,6 +855,22 @@ func resourceChannelRead(ctx context.Context, d *schema.ResourceData, meta any)
 	if err := d.Set("encoder_settings", flattenChannelEncoderSettings(out.EncoderSettings)); err != nil {
 		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionSetting, ResNameChannel, d.Id(), err)
 	}
+	// Only track encoder_settings_json if configured. The configured JSON is kept unless the channel's
+	// encoder settings differ from it in more than the defaults added by MediaLive.
+	if v, ok := d.GetOk("encoder_settings_json"); ok {
+		contains, err := channelEncoderSettingsJSONContains(v.(string), out.EncoderSettings)
+		if err != nil {
+			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionSetting, ResNameChannel, d.Id(), err)
+		}
+
+		if !contains {
+			encoderSettings, err := flattenChannelEncoderSettingsJSON(out.EncoderSettings)
+			if err != nil {
+				return create.AppendDiagError(diags, names.MediaLive, create.ErrActionSetting, ResNameChannel, d.Id(), err)
+			}
+			d.Set("encoder_settings_json", encoderSettings)
+		}
+	}
 	if err := d.Set("input_specification", flattenChannelInputSpecification(out.InputSpecification)); err != nil {
 		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionSetting, ResNameChannel, d.Id(), err)
 	}
@@ -876,7 +906,15 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta any
 			in.Destinations = expandChannelDestinations(d.Get("destinations").(*schema.Set).List())
 		}
 
-		if d.HasChange("encoder_settings") {
+		if v := d.Get("encoder_settings_json").(string); v != "" {
+			if d.HasChange("encoder_settings_json") {
+				encoderSettings, err := expandChannelEncoderSettingsJSON(v)
+				if err != nil {
+					return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
+				}
+				in.EncoderSettings = encoderSettings
+			}
+		} else if d.HasChange("encoder_settings") {
 			in.EncoderSettings = expandChannelEncoderSettings(d.Get("encoder_settings").([]any))
 		}
 
@@ -0,0 +1,277 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	tfsmithy "github.com/hashicorp/terraform-provider-aws/internal/smithy"
+)
+
+// Encoder settings can be configured as JSON in the format of the MediaLive API, for example
+// exported from the MediaLive console. Field names are matched case-insensitively, so both the
+// API's camelCase field names and the SDK's field names are accepted.
+//
+// SDK types can't be (un)marshalled as Smithy documents, so the configured JSON is decoded as a document
+// and then converted to the SDK type. Settings that the SDK type doesn't support are reported as errors
+// rather than silently dropped.
+
+func expandChannelEncoderSettingsJSON(s string) (*types.EncoderSettings, error) {
+	v, err := tfsmithy.DocumentFromJSONString(s, removeEmptyJSONValues)
+	if err != nil {
+		return nil, fmt.Errorf("decoding encoder_settings_json: %w", err)
+	}
+
+	b, err := tfjson.EncodeToBytes(v)
+	if err != nil {
+		return nil, fmt.Errorf("decoding encoder_settings_json: %w", err)
+	}
+
+	var apiObject types.EncoderSettings
+	if err := tfjson.DecodeFromBytes(b, &apiObject); err != nil {
+		return nil, fmt.Errorf("decoding encoder_settings_json: %w", err)
+	}
+
+	decoded, err := channelEncoderSettingsJSONValue(&apiObject)
+	if err != nil {
+		return nil, err
+	}
+
+	if fields := unsupportedJSONFields(v, decoded, ""); len(fields) > 0 {
+		return nil, fmt.Errorf("decoding encoder_settings_json: unsupported settings: %s", strings.Join(fields, ", "))
+	}
+
+	return &apiObject, nil
+}
+
+func flattenChannelEncoderSettingsJSON(apiObject *types.EncoderSettings) (string, error) {
+	if apiObject == nil {
+		return "", nil
+	}
+
+	v, err := channelEncoderSettingsJSONValue(apiObject)
+	if err != nil {
+		return "", err
+	}
+
+	s, err := tfjson.EncodeToString(v)
+	if err != nil {
+		return "", fmt.Errorf("encoding encoder_settings_json: %w", err)
+	}
+
+	return strings.TrimSpace(s), nil
+}
+
+// channelEncoderSettingsJSONValue returns the decoded JSON value of encoder settings without unset fields.
+func channelEncoderSettingsJSONValue(apiObject *types.EncoderSettings) (any, error) {
+	b, err := tfjson.EncodeToBytes(apiObject)
+	if err != nil {
+		return nil, fmt.Errorf("encoding encoder_settings_json: %w", err)
+	}
+
+	var v any
+	if err := tfjson.DecodeFromBytes(b, &v); err != nil {
+		return nil, err
+	}
+
+	return removeEmptyJSONValues(v), nil
+}
+
+// normalizeChannelEncoderSettingsJSON returns the decoded value of encoder settings JSON,
+// with field names in a consistent case and unset fields removed.
+func normalizeChannelEncoderSettingsJSON(s string) (any, error) {
+	apiObject, err := expandChannelEncoderSettingsJSON(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return channelEncoderSettingsJSONValue(apiObject)
+}
+
+// removeEmptyJSONValues removes null values, empty strings, and empty arrays and objects from a decoded JSON value.
+// The SDK encodes unset enumeration fields as empty strings.
+func removeEmptyJSONValues(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if e = removeEmptyJSONValues(e); e == nil {
+				delete(v, k)
+			} else {
+				v[k] = e
+			}
+		}
+		if len(v) == 0 {
+			return nil
+		}
+	case []any:
+		// Array elements are kept to preserve indexes.
+		for i, e := range v {
+			v[i] = removeEmptyJSONValues(e)
+		}
+		if len(v) == 0 {
+			return nil
+		}
+	case string:
+		if v == "" {
+			return nil
+		}
+	}
+
+	return v
+}
+
+func suppressEquivalentChannelEncoderSettingsJSON(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+
+	if old == "" || new == "" {
+		return false
+	}
+
+	o, err := normalizeChannelEncoderSettingsJSON(old)
+	if err != nil {
+		return false
+	}
+
+	n, err := normalizeChannelEncoderSettingsJSON(new)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(o, n)
+}
+
+// channelEncoderSettingsJSONContains returns whether the channel's encoder settings contain all of the configured settings.
+// Settings that are only present in the channel's encoder settings are defaults added by MediaLive.
+func channelEncoderSettingsJSONContains(configured string, apiObject *types.EncoderSettings) (bool, error) {
+	c, err := normalizeChannelEncoderSettingsJSON(configured)
+	if err != nil {
+		return false, err
+	}
+
+	a, err := channelEncoderSettingsJSONValue(apiObject)
+	if err != nil {
+		return false, err
+	}
+
+	return jsonValueContains(a, c), nil
+}
+
+// jsonValueContains returns whether the decoded JSON value a contains b.
+// Objects contain another object if they contain each of its fields. Arrays must have the same length.
+func jsonValueContains(a, b any) bool {
+	switch b := b.(type) {
+	case map[string]any:
+		a, ok := a.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		for k, v := range b {
+			if !jsonValueContains(a[k], v) {
+				return false
+			}
+		}
+
+		return true
+	case []any:
+		a, ok := a.([]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+
+		for i := range b {
+			if !jsonValueContains(a[i], b[i]) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		// MediaLive omits some settings that are configured with their zero value.
+		if a == nil {
+			return isZeroJSONValue(b)
+		}
+
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+// isZeroJSONValue returns whether the decoded JSON value v is false, zero or an empty string.
+func isZeroJSONValue(v any) bool {
+	switch v := v.(type) {
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case string:
+		return v == ""
+	default:
+		return false
+	}
+}
+
+// unsupportedJSONFields returns the paths of fields in the decoded JSON value configured that are missing from decoded.
+// Field names are matched case-insensitively.
+func unsupportedJSONFields(configured, decoded any, path string) []string {
+	var fields []string
+
+	switch configured := configured.(type) {
+	case map[string]any:
+		decoded, _ := decoded.(map[string]any)
+
+		for k, v := range configured {
+			p := k
+			if path != "" {
+				p = path + "." + k
+			}
+
+			e, ok := jsonObjectField(decoded, k)
+			if !ok {
+				fields = append(fields, p)
+				continue
+			}
+
+			fields = append(fields, unsupportedJSONFields(v, e, p)...)
+		}
+	case []any:
+		decoded, _ := decoded.([]any)
+
+		for i, v := range configured {
+			var e any
+			if i < len(decoded) {
+				e = decoded[i]
+			}
+
+			fields = append(fields, unsupportedJSONFields(v, e, path+"["+strconv.Itoa(i)+"]")...)
+		}
+	}
+
+	slices.Sort(fields)
+
+	return fields
+}
+
+// jsonObjectField returns the value of the field in the decoded JSON object m whose name case-insensitively matches k.
+func jsonObjectField(m map[string]any, k string) (any, bool) {
+	if v, ok := m[k]; ok {
+		return v, true
+	}
+
+	for key, v := range m {
+		if strings.EqualFold(key, k) {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+func TestRemoveEmptyJSONValues(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected string
+	}{
+		"null": {
+			input:    `null`,
+			expected: `null`,
+		},
+		"empty object": {
+			input:    `{}`,
+			expected: `null`,
+		},
+		"unset fields": {
+			input:    `{"a": null, "b": "", "c": [], "d": {}, "e": "x"}`,
+			expected: `{"e": "x"}`,
+		},
+		"zero and false values": {
+			input:    `{"a": 0, "b": false, "c": "x"}`,
+			expected: `{"a": 0, "b": false, "c": "x"}`,
+		},
+		"nested unset fields": {
+			input:    `{"a": {"b": {"c": ""}}, "d": {"e": 1, "f": null}}`,
+			expected: `{"d": {"e": 1}}`,
+		},
+		"array elements kept": {
+			input:    `{"a": [{"b": ""}, {"c": 1}]}`,
+			expected: `{"a": [null, {"c": 1}]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := removeEmptyJSONValues(decodeJSONValue(t, testCase.input))
+
+			if diff := cmp.Diff(got, decodeJSONValue(t, testCase.expected)); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestJSONValueContains(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a        string
+		b        string
+		expected bool
+	}{
+		"equal": {
+			a:        `{"a": 1, "b": {"c": "x"}}`,
+			b:        `{"a": 1, "b": {"c": "x"}}`,
+			expected: true,
+		},
+		"server-added defaults": {
+			a:        `{"a": 1, "b": {"c": "x", "d": "DEFAULT"}, "e": [{"f": 1, "g": true}]}`,
+			b:        `{"a": 1, "b": {"c": "x"}, "e": [{"f": 1}]}`,
+			expected: true,
+		},
+		"changed value": {
+			a:        `{"a": 1, "b": {"c": "y"}}`,
+			b:        `{"a": 1, "b": {"c": "x"}}`,
+			expected: false,
+		},
+		"missing field": {
+			a:        `{"a": 1}`,
+			b:        `{"a": 1, "b": "x"}`,
+			expected: false,
+		},
+		"zero and false values omitted": {
+			a:        `{"a": {"b": 1}}`,
+			b:        `{"a": {"b": 1, "c": 0, "d": false}}`,
+			expected: true,
+		},
+		"non-zero value omitted": {
+			a:        `{"a": {"b": 1}}`,
+			b:        `{"a": {"b": 1, "c": 2}}`,
+			expected: false,
+		},
+		"true value omitted": {
+			a:        `{"a": {"b": 1}}`,
+			b:        `{"a": {"b": 1, "d": true}}`,
+			expected: false,
+		},
+		"zero value changed": {
+			a:        `{"a": 1}`,
+			b:        `{"a": 0}`,
+			expected: false,
+		},
+		"array length changed": {
+			a:        `{"a": [{"b": 1}, {"b": 2}]}`,
+			b:        `{"a": [{"b": 1}]}`,
+			expected: false,
+		},
+		"array order changed": {
+			a:        `{"a": [{"b": 2}, {"b": 1}]}`,
+			b:        `{"a": [{"b": 1}, {"b": 2}]}`,
+			expected: false,
+		},
+		"type changed": {
+			a:        `{"a": [1]}`,
+			b:        `{"a": {"b": 1}}`,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := jsonValueContains(decodeJSONValue(t, testCase.a), decodeJSONValue(t, testCase.b))
+
+			if got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestUnsupportedJSONFields(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		configured string
+		decoded    string
+		expected   []string
+	}{
+		"supported": {
+			configured: `{"a": 1, "b": {"c": "x"}}`,
+			decoded:    `{"a": 1, "b": {"c": "x"}}`,
+		},
+		"case-insensitive names": {
+			configured: `{"audioDescriptions": [{"audioSelectorName": "x"}]}`,
+			decoded:    `{"AudioDescriptions": [{"AudioSelectorName": "x"}]}`,
+		},
+		"zero and false values": {
+			configured: `{"a": 0, "b": false}`,
+			decoded:    `{"A": 0, "B": false}`,
+		},
+		"unsupported fields": {
+			configured: `{"a": 1, "newSetting": "x", "b": {"c": "x", "d": {"e": 1}}}`,
+			decoded:    `{"A": 1, "B": {"C": "x"}}`,
+			expected:   []string{"b.d", "newSetting"},
+		},
+		"unsupported fields in array": {
+			configured: `{"a": [{"b": 1}, {"b": 2, "c": 3}]}`,
+			decoded:    `{"A": [{"B": 1}, {"B": 2}]}`,
+			expected:   []string{"a[1].c"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := unsupportedJSONFields(decodeJSONValue(t, testCase.configured), decodeJSONValue(t, testCase.decoded), "")
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func decodeJSONValue(t *testing.T, s string) any {
+	t.Helper()
+
+	var v any
+	if err := tfjson.DecodeFromString(s, &v); err != nil {
+		t.Fatal(err)
+	}
+
+	return v
+}
@@ -15,9 +15,11 @@ import (
 
 func channelEncoderSettingsSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Required: true,
-		MaxItems: 1,
+		Type:         schema.TypeList,
+		Optional:     true,
+		Computed:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"encoder_settings", "encoder_settings_json"},
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"audio_descriptions": {
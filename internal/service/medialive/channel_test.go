@@ -79,6 +79,53 @@ func TestAccMediaLiveChannel_basic(t *testing.T) {
 	})
 }
 
+func TestAccMediaLiveChannel_encoderSettingsJSON(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var channel medialive.DescribeChannelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+			testAccChannelsPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_encoderSettingsJSON(rName, "_1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "encoder_settings_json"),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.0.timecode_config.0.source", "EMBEDDED"),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.0.output_groups.0.outputs.0.output_settings.0.archive_output_settings.0.name_modifier", "_1"),
+				),
+			},
+			{
+				Config: testAccChannelConfig_encoderSettingsJSON(rName, "_2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.0.output_groups.0.outputs.0.output_settings.0.archive_output_settings.0.name_modifier", "_2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"encoder_settings_json", "start_channel"},
+			},
+		},
+	})
+}
+
 func TestAccMediaLiveChannel_captionDescriptions(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1108,6 +1155,83 @@ resource "aws_medialive_channel" "test" {
 `, rName))
 }
 
+func testAccChannelConfig_encoderSettingsJSON(rName, nameModifier string) string {
+	return acctest.ConfigCompose(
+		testAccChannelConfig_base(rName),
+		testAccChannelConfig_baseS3(rName),
+		testAccChannelConfig_baseMultiplex(rName),
+		fmt.Sprintf(`
+resource "aws_medialive_channel" "test" {
+  name          = %[1]q
+  channel_class = "STANDARD"
+  role_arn      = aws_iam_role.test.arn
+
+  input_specification {
+    codec            = "AVC"
+    input_resolution = "HD"
+    maximum_bitrate  = "MAX_20_MBPS"
+  }
+
+  input_attachments {
+    input_attachment_name = "example-input1"
+    input_id              = aws_medialive_input.test.id
+  }
+
+  destinations {
+    id = %[1]q
+
+    settings {
+      url = "s3://${aws_s3_bucket.test1.id}/test1"
+    }
+
+    settings {
+      url = "s3://${aws_s3_bucket.test2.id}/test2"
+    }
+  }
+
+  encoder_settings_json = jsonencode({
+    timecodeConfig = {
+      source = "EMBEDDED"
+    }
+    audioDescriptions = [{
+      audioSelectorName = %[1]q
+      name              = %[1]q
+    }]
+    videoDescriptions = [{
+      name = "test-video-name"
+    }]
+    outputGroups = [{
+      outputGroupSettings = {
+        archiveGroupSettings = {
+          destination = {
+            destinationRefId = %[1]q
+          }
+        }
+      }
+      outputs = [{
+        outputName            = "test-output-name"
+        videoDescriptionName  = "test-video-name"
+        audioDescriptionNames = [%[1]q]
+        outputSettings = {
+          archiveOutputSettings = {
+            nameModifier = %[2]q
+            extension    = "m2ts"
+            containerSettings = {
+              m2tsSettings = {
+                audioBufferModel = "ATSC"
+                bufferModel      = "MULTIPLEX"
+                rateMode         = "CBR"
+              }
+            }
+          }
+        }
+      }]
+    }]
+  })
+}
+`, rName, nameModifier))
+}
+
 func testAccChannelConfig_udpOutputSettings(rName string) string {
 	return acctest.ConfigCompose(
 		testAccChannelConfig_base(rName),
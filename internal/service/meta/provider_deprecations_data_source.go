@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_provider_deprecations", name="Provider Deprecations")
+// @Region(overrideEnabled=false)
+func newProviderDeprecationsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &providerDeprecationsDataSource{}
+
+	return d, nil
+}
+
+type providerDeprecationsDataSource struct {
+	framework.DataSourceWithModel[providerDeprecationsDataSourceModel]
+}
+
+func (d *providerDeprecationsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Lists the deprecations that the provider has registered for the specified resource and data source types. " +
+			"The configuration is not inspected, so deprecations are listed whether or not the configuration uses the deprecated items.",
+		Attributes: map[string]schema.Attribute{
+			"data_source_types": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Data source types to list the registered deprecations of.",
+			},
+			"deprecations": framework.DataSourceComputedListOfObjectAttribute[deprecationModel](ctx),
+			"removal_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list deprecated items scheduled for removal in this provider version.",
+			},
+			"resource_types": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Resource types to list the registered deprecations of.",
+			},
+		},
+	}
+}
+
+func (d *providerDeprecationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data providerDeprecationsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resourceTypes := fwflex.ExpandFrameworkStringValueSet(ctx, data.ResourceTypes)
+	dataSourceTypes := fwflex.ExpandFrameworkStringValueSet(ctx, data.DataSourceTypes)
+	all := data.ResourceTypes.IsNull() && data.DataSourceTypes.IsNull()
+	removalVersion := data.RemovalVersion.ValueString()
+
+	deprecations := make([]deprecationModel, 0)
+	for _, v := range names.Deprecations() {
+		switch {
+		case all:
+		case v.Kind == names.DeprecationKindResource && slices.Contains(resourceTypes, v.TypeName):
+		case v.Kind == names.DeprecationKindDataSource && slices.Contains(dataSourceTypes, v.TypeName):
+		default:
+			continue
+		}
+
+		if removalVersion != "" && v.RemovalVersion != removalVersion {
+			continue
+		}
+
+		deprecations = append(deprecations, deprecationModel{
+			Address:        types.StringValue(v.Address()),
+			Attribute:      types.StringValue(v.Attribute),
+			Kind:           types.StringValue(string(v.Kind)),
+			Message:        types.StringValue(v.Message()),
+			RemovalVersion: types.StringValue(v.RemovalVersion),
+			Replacement:    types.StringValue(v.Replacement),
+			TypeName:       types.StringValue(v.TypeName),
+		})
+	}
+
+	data.Deprecations = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, deprecations)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type providerDeprecationsDataSourceModel struct {
+	DataSourceTypes fwtypes.SetOfString                               `tfsdk:"data_source_types"`
+	Deprecations    fwtypes.ListNestedObjectValueOf[deprecationModel] `tfsdk:"deprecations"`
+	RemovalVersion  types.String                                      `tfsdk:"removal_version"`
+	ResourceTypes   fwtypes.SetOfString                               `tfsdk:"resource_types"`
+}
+
+type deprecationModel struct {
+	Address        types.String `tfsdk:"address"`
+	Attribute      types.String `tfsdk:"attribute"`
+	Kind           types.String `tfsdk:"kind"`
+	Message        types.String `tfsdk:"message"`
+	RemovalVersion types.String `tfsdk:"removal_version"`
+	Replacement    types.String `tfsdk:"replacement"`
+	TypeName       types.String `tfsdk:"type_name"`
+}
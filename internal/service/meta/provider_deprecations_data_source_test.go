@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package meta_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMetaProviderDeprecationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_provider_deprecations.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderDeprecationsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "deprecations.#", strconv.Itoa(len(names.Deprecations()))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaProviderDeprecationsDataSource_types(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_provider_deprecations.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderDeprecationsDataSourceConfig_types,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "deprecations.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "deprecations.*", map[string]string{
+						"address":   "aws_iam_role.inline_policy",
+						"attribute": "inline_policy",
+						"kind":      "resource",
+						"type_name": "aws_iam_role",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "deprecations.*", map[string]string{
+						"address":     "data.aws_s3_bucket_object",
+						"attribute":   "",
+						"kind":        "data_source",
+						"replacement": "the aws_s3_object data source",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaProviderDeprecationsDataSource_removalVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_provider_deprecations.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderDeprecationsDataSourceConfig_removalVersion,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "deprecations.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "deprecations.0.address", "aws_redshift_cluster.aqua_configuration_status"),
+					resource.TestCheckResourceAttr(dataSourceName, "deprecations.0.removal_version", "7.0.0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccProviderDeprecationsDataSourceConfig_basic = `
+data "aws_provider_deprecations" "test" {}
+`
+
+const testAccProviderDeprecationsDataSourceConfig_types = `
+data "aws_provider_deprecations" "test" {
+  resource_types    = ["aws_iam_role", "aws_vpc"]
+  data_source_types = ["aws_s3_bucket_object"]
+}
+`
+
+const testAccProviderDeprecationsDataSourceConfig_removalVersion = `
+data "aws_provider_deprecations" "test" {
+  resource_types  = ["aws_iam_role", "aws_redshift_cluster"]
+  removal_version = "7.0.0"
+}
+`
@@ -53,7 +53,7 @@ func (d *regionDataSource) Schema(ctx context.Context, request datasource.Schema
 			names.AttrName: schema.StringAttribute{
 				Optional:           true,
 				Computed:           true,
-				DeprecationMessage: "name is deprecated. Use region instead.",
+				DeprecationMessage: names.DataSourceDeprecationMessage("aws_region", names.AttrName),
 			},
 		},
 	}
@@ -41,6 +41,12 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 			Name:     "Partition",
 			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
 		},
+		{
+			Factory:  newProviderDeprecationsDataSource,
+			TypeName: "aws_provider_deprecations",
+			Name:     "Provider Deprecations",
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+		},
 		{
 			Factory:  newRegionDataSource,
 			TypeName: "aws_region",
@@ -283,7 +283,7 @@ func dataSourceCoreNetworkPolicyDocument() *schema.Resource {
 												"use_edge": {
 													Type:       schema.TypeString,
 													Optional:   true,
-													Deprecated: "use_edge is deprecated. Use use_edge_location instead.",
+													Deprecated: names.DataSourceDeprecationMessage("aws_networkmanager_core_network_policy_document", "segment_actions.via.with_edge_override.use_edge"),
 												},
 											},
 										},
@@ -63,10 +63,13 @@ func (o *blueGreenOrchestrator) waitForDeploymentAvailable(ctx context.Context,
 	return dep, nil
 }
 
-func (o *blueGreenOrchestrator) Switchover(ctx context.Context, identifier string, timeout time.Duration) (*types.BlueGreenDeployment, error) {
+func (o *blueGreenOrchestrator) Switchover(ctx context.Context, identifier string, timeout time.Duration, optFns ...func(*rds.SwitchoverBlueGreenDeploymentInput)) (*types.BlueGreenDeployment, error) {
 	input := &rds.SwitchoverBlueGreenDeploymentInput{
 		BlueGreenDeploymentIdentifier: aws.String(identifier),
 	}
+	for _, fn := range optFns {
+		fn(input)
+	}
 	_, err := tfresource.RetryWhen(ctx, 10*time.Minute,
 		func(ctx context.Context) (any, error) {
 			return o.conn.SwitchoverBlueGreenDeployment(ctx, input)
@@ -166,3 +169,155 @@ func (h *instanceHandler) modifyTarget(ctx context.Context, identifier string, d
 
 	return nil
 }
+
+type clusterHandler struct {
+	conn *rds.Client
+}
+
+func newClusterHandler(conn *rds.Client) *clusterHandler {
+	return &clusterHandler{
+		conn: conn,
+	}
+}
+
+func (h *clusterHandler) precondition(ctx context.Context, d *schema.ResourceData, timeout time.Duration) error {
+	// The Green environment inherits deletion protection from the Blue environment.
+	if d.HasChange(names.AttrDeletionProtection) {
+		input := &rds.ModifyDBClusterInput{
+			ApplyImmediately:    aws.Bool(true),
+			DBClusterIdentifier: aws.String(d.Id()),
+			DeletionProtection:  aws.Bool(d.Get(names.AttrDeletionProtection).(bool)),
+		}
+
+		if err := dbClusterModify(ctx, h.conn, input); err != nil {
+			return fmt.Errorf("setting pre-conditions: %w", err)
+		}
+
+		if _, err := waitDBClusterUpdated(ctx, h.conn, d.Id(), true, timeout); err != nil {
+			return fmt.Errorf("setting pre-conditions: waiting for completion: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (h *clusterHandler) createBlueGreenInput(d *schema.ResourceData) *rds.CreateBlueGreenDeploymentInput {
+	input := &rds.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(d.Id()),
+		Source:                  aws.String(d.Get(names.AttrARN).(string)),
+	}
+
+	if d.HasChange(names.AttrEngineVersion) {
+		input.TargetEngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+	}
+	if d.HasChange("db_cluster_parameter_group_name") {
+		input.TargetDBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
+	}
+	if d.HasChange("db_instance_parameter_group_name") {
+		input.TargetDBParameterGroupName = aws.String(d.Get("db_instance_parameter_group_name").(string))
+	}
+
+	return input
+}
+
+func (h *clusterHandler) modifyTarget(ctx context.Context, identifier string, d *schema.ResourceData, timeout time.Duration, operation string) error {
+	if !d.HasChangesExcept(clusterBlueGreenIgnoredAttributes()...) {
+		return nil
+	}
+
+	input := &rds.ModifyDBClusterInput{
+		ApplyImmediately:    aws.Bool(true),
+		DBClusterIdentifier: aws.String(identifier),
+	}
+
+	if diags := dbClusterPopulateModify(input, d); diags.HasError() {
+		return fmt.Errorf("populating modify input: %s", sdkdiag.DiagnosticsString(diags))
+	}
+
+	// Already applied by the Blue/Green Deployment or to the Blue environment.
+	input.AllowMajorVersionUpgrade = nil
+	input.DBClusterParameterGroupName = nil
+	input.DBInstanceParameterGroupName = nil
+	input.DeletionProtection = nil
+	input.EngineVersion = nil
+
+	log.Printf("[DEBUG] %s: Updating Green environment", operation)
+
+	if err := dbClusterModify(ctx, h.conn, input); err != nil {
+		return fmt.Errorf("updating Green environment: %w", err)
+	}
+
+	if _, err := waitDBClusterUpdated(ctx, h.conn, identifier, true, timeout); err != nil {
+		return fmt.Errorf("updating Green environment: waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
+// deleteSource deletes the former Blue environment's DB instances and then its DB cluster, returning the DB cluster's identifier.
+// The caller is responsible for waiting for the DB cluster to be deleted.
+func (h *clusterHandler) deleteSource(ctx context.Context, sourceARN string, timeout time.Duration) (string, error) {
+	source, err := findDBClusterByID(ctx, h.conn, sourceARN)
+	if err != nil {
+		return "", err
+	}
+
+	identifier := aws.ToString(source.DBClusterIdentifier)
+
+	for _, v := range source.DBClusterMembers {
+		instanceID := aws.ToString(v.DBInstanceIdentifier)
+		input := &rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String(instanceID),
+		}
+
+		_, err := tfresource.RetryWhenIsA[any, *types.InvalidDBInstanceStateFault](ctx, timeout, func(ctx context.Context) (any, error) {
+			return h.conn.DeleteDBInstance(ctx, input)
+		})
+
+		if errs.IsA[*types.DBInstanceNotFoundFault](err) {
+			continue
+		}
+
+		if err != nil {
+			return identifier, fmt.Errorf("deleting RDS Cluster Instance (%s): %w", instanceID, err)
+		}
+	}
+
+	for _, v := range source.DBClusterMembers {
+		instanceID := aws.ToString(v.DBInstanceIdentifier)
+		if _, err := waitDBClusterInstanceDeleted(ctx, h.conn, instanceID, timeout); err != nil {
+			return identifier, fmt.Errorf("waiting for RDS Cluster Instance (%s) delete: %w", instanceID, err)
+		}
+	}
+
+	if aws.ToBool(source.DeletionProtection) {
+		input := &rds.ModifyDBClusterInput{
+			ApplyImmediately:    aws.Bool(true),
+			DBClusterIdentifier: aws.String(identifier),
+			DeletionProtection:  aws.Bool(false),
+		}
+
+		if err := dbClusterModify(ctx, h.conn, input); err != nil {
+			return identifier, fmt.Errorf("disabling deletion protection: %w", err)
+		}
+
+		if _, err := waitDBClusterUpdated(ctx, h.conn, identifier, true, timeout); err != nil {
+			return identifier, fmt.Errorf("disabling deletion protection: waiting for completion: %w", err)
+		}
+	}
+
+	input := &rds.DeleteDBClusterInput{
+		DBClusterIdentifier: aws.String(identifier),
+		SkipFinalSnapshot:   aws.Bool(true),
+	}
+
+	_, err = tfresource.RetryWhenIsA[any, *types.InvalidDBClusterStateFault](ctx, timeout, func(ctx context.Context) (any, error) {
+		return h.conn.DeleteDBCluster(ctx, input)
+	})
+
+	if err != nil {
+		return identifier, err
+	}
+
+	return identifier, nil
+}
@@ -0,0 +1,490 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_rds_blue_green_deployment", name="Blue/Green Deployment")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newBlueGreenDeploymentResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &blueGreenDeploymentResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	blueGreenDeploymentStatusSwitchoverCompleted = "SWITCHOVER_COMPLETED"
+)
+
+type blueGreenDeploymentResource struct {
+	framework.ResourceWithModel[blueGreenDeploymentResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *blueGreenDeploymentResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"blue_green_deployment_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 60),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrSource: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+			"switchover": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"switchover_timeout": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrTarget: schema.StringAttribute{
+				Computed: true,
+			},
+			"target_db_cluster_parameter_group_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_db_instance_class": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_db_parameter_group_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+			"target_engine_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_port": schema.Int64Attribute{
+				Computed: true,
+			},
+			"target_reader_endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *blueGreenDeploymentResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data blueGreenDeploymentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	name := data.BlueGreenDeploymentName.ValueString()
+	var input rds.CreateBlueGreenDeploymentInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateBlueGreenDeployment(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating RDS Blue/Green Deployment (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	id := aws.ToString(output.BlueGreenDeployment.BlueGreenDeploymentIdentifier)
+	data.ID = types.StringValue(id)
+	data.ARN = types.StringValue(r.blueGreenDeploymentARN(ctx, id))
+
+	deadline := inttypes.NewDeadline(r.CreateTimeout(ctx, data.Timeouts))
+	deployment, err := waitBlueGreenDeploymentAvailable(ctx, conn, id, deadline.Remaining())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for RDS Blue/Green Deployment (%s) create", id), err.Error())
+
+		return
+	}
+
+	if data.Switchover.ValueBool() {
+		deployment, err = r.switchover(ctx, conn, &data, deadline.Remaining())
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("creating RDS Blue/Green Deployment (%s)", id), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	data.Status = fwflex.StringToFramework(ctx, deployment.Status)
+	data.Target = fwflex.StringToFramework(ctx, deployment.Target)
+
+	if err := flattenBlueGreenDeploymentTarget(ctx, conn, &data); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Blue/Green Deployment (%s) target", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *blueGreenDeploymentResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data blueGreenDeploymentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	id := data.ID.ValueString()
+	output, err := findBlueGreenDeploymentByID(ctx, conn, id)
+
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Blue/Green Deployment (%s)", id), err.Error())
+
+		return
+	}
+
+	source := data.Source
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// After switchover, the deployment's source is the renamed former Blue environment.
+	if !source.IsNull() {
+		data.Source = source
+	}
+
+	data.ARN = types.StringValue(r.blueGreenDeploymentARN(ctx, id))
+	data.Switchover = types.BoolValue(aws.ToString(output.Status) == blueGreenDeploymentStatusSwitchoverCompleted)
+
+	if err := flattenBlueGreenDeploymentTarget(ctx, conn, &data); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Blue/Green Deployment (%s) target", id), err.Error())
+
+		return
+	}
+
+	setTagsOut(ctx, output.TagList)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *blueGreenDeploymentResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new blueGreenDeploymentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	id := new.ID.ValueString()
+
+	if old.Switchover.ValueBool() && !new.Switchover.ValueBool() {
+		response.Diagnostics.AddError(fmt.Sprintf("updating RDS Blue/Green Deployment (%s)", id), "a completed switchover cannot be reverted")
+
+		return
+	}
+
+	deployment, err := findBlueGreenDeploymentByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Blue/Green Deployment (%s)", id), err.Error())
+
+		return
+	}
+
+	if !old.Switchover.ValueBool() && new.Switchover.ValueBool() {
+		deployment, err = r.switchover(ctx, conn, &new, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating RDS Blue/Green Deployment (%s)", id), err.Error())
+
+			return
+		}
+	}
+
+	new.Status = fwflex.StringToFramework(ctx, deployment.Status)
+	new.Target = fwflex.StringToFramework(ctx, deployment.Target)
+
+	if err := flattenBlueGreenDeploymentTarget(ctx, conn, &new); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Blue/Green Deployment (%s) target", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *blueGreenDeploymentResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data blueGreenDeploymentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	id := data.ID.ValueString()
+	input := rds.DeleteBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(id),
+	}
+	// The Green environment is deleted along with the deployment unless it has been switched over to.
+	if data.Status.ValueString() != blueGreenDeploymentStatusSwitchoverCompleted {
+		input.DeleteTarget = aws.Bool(true)
+	}
+
+	_, err := conn.DeleteBlueGreenDeployment(ctx, &input)
+
+	if errs.IsA[*awstypes.BlueGreenDeploymentNotFoundFault](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting RDS Blue/Green Deployment (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitBlueGreenDeploymentDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for RDS Blue/Green Deployment (%s) delete", id), err.Error())
+
+		return
+	}
+
+	if input.DeleteTarget == nil {
+		response.Diagnostics.AddWarning(
+			fmt.Sprintf("RDS Blue/Green Deployment (%s) source retained", id),
+			"The Blue/Green Deployment was deleted after switchover. The former Blue environment, renamed with an \"-old1\" suffix, "+
+				"is not deleted and continues to incur charges. Delete it separately when it is no longer needed.",
+		)
+	}
+}
+
+func (r *blueGreenDeploymentResource) switchover(ctx context.Context, conn *rds.Client, data *blueGreenDeploymentResourceModel, timeout time.Duration) (*awstypes.BlueGreenDeployment, error) {
+	return newBlueGreenOrchestrator(conn).Switchover(ctx, data.ID.ValueString(), timeout, func(input *rds.SwitchoverBlueGreenDeploymentInput) {
+		input.SwitchoverTimeout = fwflex.Int32FromFrameworkInt64(ctx, data.SwitchoverTimeout)
+	})
+}
+
+func (r *blueGreenDeploymentResource) blueGreenDeploymentARN(ctx context.Context, id string) string {
+	return r.Meta().RegionalARN(ctx, names.RDS, "deployment:"+id)
+}
+
+// flattenBlueGreenDeploymentTarget sets the endpoint attributes of the deployment's target DB instance or DB cluster.
+// The target_* arguments aren't returned by the API. Those that aren't configured or already in state, for example
+// after import, are set from the target DB instance or DB cluster.
+func flattenBlueGreenDeploymentTarget(ctx context.Context, conn *rds.Client, data *blueGreenDeploymentResourceModel) error {
+	data.TargetEndpoint = types.StringNull()
+	data.TargetPort = types.Int64Null()
+	data.TargetReaderEndpoint = types.StringNull()
+
+	defer func() {
+		for _, v := range []*types.String{&data.TargetDBClusterParameterGroupName, &data.TargetDBInstanceClass, &data.TargetDBParameterGroupName, &data.TargetEngineVersion} {
+			if v.IsUnknown() {
+				*v = types.StringNull()
+			}
+		}
+	}()
+
+	target := data.Target.ValueString()
+	parsed, err := arn.Parse(target)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case strings.HasPrefix(parsed.Resource, "db:"):
+		instance, err := findDBInstanceByID(ctx, conn, target)
+
+		if retry.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if v := instance.Endpoint; v != nil {
+			data.TargetEndpoint = fwflex.StringToFramework(ctx, v.Address)
+			data.TargetPort = fwflex.Int32ToFrameworkInt64(ctx, v.Port)
+		}
+
+		flattenBlueGreenDeploymentTargetDBInstance(ctx, instance, data)
+		setBlueGreenDeploymentTargetArgument(ctx, &data.TargetEngineVersion, instance.EngineVersion)
+	case strings.HasPrefix(parsed.Resource, "cluster:"):
+		cluster, err := findDBClusterByID(ctx, conn, target)
+
+		if retry.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		data.TargetEndpoint = fwflex.StringToFramework(ctx, cluster.Endpoint)
+		data.TargetPort = fwflex.Int32ToFrameworkInt64(ctx, cluster.Port)
+		data.TargetReaderEndpoint = fwflex.StringToFramework(ctx, cluster.ReaderEndpoint)
+
+		setBlueGreenDeploymentTargetArgument(ctx, &data.TargetDBClusterParameterGroupName, cluster.DBClusterParameterGroup)
+		setBlueGreenDeploymentTargetArgument(ctx, &data.TargetEngineVersion, cluster.EngineVersion)
+
+		// The DB instance class and DB parameter group apply to the DB cluster's DB instances.
+		if len(cluster.DBClusterMembers) > 0 {
+			instance, err := findDBInstanceByID(ctx, conn, aws.ToString(cluster.DBClusterMembers[0].DBInstanceIdentifier))
+
+			if retry.NotFound(err) {
+				return nil
+			}
+
+			if err != nil {
+				return err
+			}
+
+			flattenBlueGreenDeploymentTargetDBInstance(ctx, instance, data)
+		}
+	}
+
+	return nil
+}
+
+// flattenBlueGreenDeploymentTargetDBInstance sets the DB instance class and DB parameter group arguments from a target DB instance.
+func flattenBlueGreenDeploymentTargetDBInstance(ctx context.Context, instance *awstypes.DBInstance, data *blueGreenDeploymentResourceModel) {
+	setBlueGreenDeploymentTargetArgument(ctx, &data.TargetDBInstanceClass, instance.DBInstanceClass)
+	if len(instance.DBParameterGroups) > 0 {
+		setBlueGreenDeploymentTargetArgument(ctx, &data.TargetDBParameterGroupName, instance.DBParameterGroups[0].DBParameterGroupName)
+	}
+}
+
+// setBlueGreenDeploymentTargetArgument sets a target_* argument that isn't configured or in state.
+func setBlueGreenDeploymentTargetArgument(ctx context.Context, v *types.String, target *string) {
+	if v.IsNull() || v.IsUnknown() {
+		*v = fwflex.StringToFramework(ctx, target)
+	}
+}
+
+type blueGreenDeploymentResourceModel struct {
+	framework.WithRegionModel
+	ARN                               types.String   `tfsdk:"arn"`
+	BlueGreenDeploymentName           types.String   `tfsdk:"blue_green_deployment_name"`
+	ID                                types.String   `tfsdk:"id"`
+	Source                            fwtypes.ARN    `tfsdk:"source"`
+	Status                            types.String   `tfsdk:"status"`
+	Switchover                        types.Bool     `tfsdk:"switchover"`
+	SwitchoverTimeout                 types.Int64    `tfsdk:"switchover_timeout"`
+	Tags                              tftags.Map     `tfsdk:"tags"`
+	TagsAll                           tftags.Map     `tfsdk:"tags_all"`
+	Target                            types.String   `tfsdk:"target"`
+	TargetDBClusterParameterGroupName types.String   `tfsdk:"target_db_cluster_parameter_group_name"`
+	TargetDBInstanceClass             types.String   `tfsdk:"target_db_instance_class"`
+	TargetDBParameterGroupName        types.String   `tfsdk:"target_db_parameter_group_name"`
+	TargetEndpoint                    types.String   `tfsdk:"target_endpoint"`
+	TargetEngineVersion               types.String   `tfsdk:"target_engine_version"`
+	TargetPort                        types.Int64    `tfsdk:"target_port"`
+	TargetReaderEndpoint              types.String   `tfsdk:"target_reader_endpoint"`
+	Timeouts                          timeouts.Value `tfsdk:"timeouts"`
+}
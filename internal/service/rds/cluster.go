@@ -7,8 +7,10 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -112,6 +114,24 @@ func resourceCluster() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(0, 259200),
 			},
+			"blue_green_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"switchover_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(30),
+						},
+					},
+				},
+			},
 			"ca_certificate_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -678,6 +698,25 @@ func resourceCluster() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, d *schema.ResourceDiff, meta any) error {
+				if !d.Get("blue_green_update.0.enabled").(bool) {
+					return nil
+				}
+
+				if engine := d.Get(names.AttrEngine).(string); !slices.Contains(clusterValidBlueGreenEngines(), engine) {
+					return fmt.Errorf(`"blue_green_update.enabled" cannot be set when "engine" is %q.`, engine)
+				}
+				if engineMode := d.Get("engine_mode").(string); engineMode != "" && engineMode != engineModeProvisioned {
+					return fmt.Errorf(`"blue_green_update.enabled" cannot be set when "engine_mode" is %q.`, engineMode)
+				}
+				if d.Get("global_cluster_identifier").(string) != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "global_cluster_identifier" is set.`)
+				}
+				if d.Get("replication_source_identifier").(string) != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "replication_source_identifier" is set.`)
+				}
+				return nil
+			},
 			customdiff.ForceNewIf(names.AttrStorageType, func(_ context.Context, d *schema.ResourceDiff, meta any) bool {
 				// Aurora supports mutation of the storage_type parameter, other engines do not
 				return !strings.HasPrefix(d.Get(names.AttrEngine).(string), "aurora")
@@ -1594,6 +1633,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta any
 
 	if d.HasChangesExcept(
 		names.AttrAllowMajorVersionUpgrade,
+		"blue_green_update",
 		"delete_automated_backups",
 		names.AttrFinalSnapshotIdentifier,
 		"global_cluster_identifier",
@@ -1601,292 +1641,412 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta any
 		"replication_source_identifier",
 		"skip_final_snapshot",
 		names.AttrTags, names.AttrTagsAll) {
-		applyImmediately := d.Get(names.AttrApplyImmediately).(bool)
-		input := &rds.ModifyDBClusterInput{
-			ApplyImmediately:    aws.Bool(applyImmediately),
-			DBClusterIdentifier: aws.String(d.Id()),
-		}
+		if d.Get("blue_green_update.0.enabled").(bool) && d.HasChanges(clusterBlueGreenUpdateAttributes()...) {
+			diags = append(diags, resourceClusterBlueGreenUpdate(ctx, conn, d)...)
+			if diags.HasError() {
+				return diags
+			}
+		} else {
+			applyImmediately := d.Get(names.AttrApplyImmediately).(bool)
+			input := &rds.ModifyDBClusterInput{
+				ApplyImmediately:    aws.Bool(applyImmediately),
+				DBClusterIdentifier: aws.String(d.Id()),
+			}
 
-		storageType := d.Get(names.AttrStorageType).(string)
-		if d.HasChange(names.AttrAllocatedStorage) {
-			input.AllocatedStorage = aws.Int32(int32(d.Get(names.AttrAllocatedStorage).(int)))
-			if isProvisionedIOPSStorageType(storageType) {
-				// When modifying Provisioned IOPS storage, a value for both allocated storage and iops must be specified.
-				input.Iops = aws.Int32(int32(d.Get(names.AttrIOPS).(int)))
+			diags = append(diags, dbClusterPopulateModify(input, d)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if err := dbClusterModify(ctx, conn, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+			}
+
+			if _, err := waitDBClusterUpdated(ctx, conn, d.Id(), applyImmediately, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) update: %s", d.Id(), err)
 			}
 		}
+	}
 
-		if v, ok := d.GetOk(names.AttrAllowMajorVersionUpgrade); ok {
-			input.AllowMajorVersionUpgrade = aws.Bool(v.(bool))
+	if d.HasChange("global_cluster_identifier") {
+		o, n := d.GetChange("global_cluster_identifier")
+		os, ns := o.(string), n.(string)
+
+		if os == "" {
+			return sdkdiag.AppendErrorf(diags, "existing RDS Clusters cannot be added to an existing RDS Global Cluster")
 		}
 
-		if d.HasChange("backtrack_window") {
-			input.BacktrackWindow = aws.Int64(int64(d.Get("backtrack_window").(int)))
+		if ns != "" {
+			return sdkdiag.AppendErrorf(diags, "existing RDS Clusters cannot be migrated between existing RDS Global Clusters")
 		}
 
-		if d.HasChange("backup_retention_period") {
-			input.BackupRetentionPeriod = aws.Int32(int32(d.Get("backup_retention_period").(int)))
+		clusterARN := d.Get(names.AttrARN).(string)
+		input := &rds.RemoveFromGlobalClusterInput{
+			DbClusterIdentifier:     aws.String(clusterARN),
+			GlobalClusterIdentifier: aws.String(os),
 		}
 
-		if d.HasChange("ca_certificate_identifier") {
-			input.CACertificateIdentifier = aws.String(d.Get("ca_certificate_identifier").(string))
+		_, err := conn.RemoveFromGlobalCluster(ctx, input)
+
+		if err != nil && !errs.IsA[*types.GlobalClusterNotFoundFault](err) && !tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "is not found in global cluster") {
+			return sdkdiag.AppendErrorf(diags, "removing RDS Cluster (%s) from RDS Global Cluster: %s", d.Id(), err)
 		}
 
-		if d.HasChange("copy_tags_to_snapshot") {
-			input.CopyTagsToSnapshot = aws.Bool(d.Get("copy_tags_to_snapshot").(bool))
+		// Removal from a global cluster puts the cluster into 'promoting' state. Wait for it to become available again.
+		if _, err := waitDBClusterAvailable(ctx, conn, d.Id(), true, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) available: %s", d.Id(), err)
 		}
+	}
 
-		if d.HasChange("database_insights_mode") {
-			input.DatabaseInsightsMode = types.DatabaseInsightsMode(d.Get("database_insights_mode").(string))
-			input.EnablePerformanceInsights = aws.Bool(d.Get("performance_insights_enabled").(bool))
-			if v, ok := d.Get("performance_insights_kms_key_id").(string); ok && v != "" {
-				input.PerformanceInsightsKMSKeyId = aws.String(v)
+	if d.HasChange("iam_roles") {
+		o, n := d.GetChange("iam_roles")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		for _, v := range ns.Difference(os).List() {
+			if err := addIAMRoleToCluster(ctx, conn, d.Id(), v.(string)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
 			}
-			input.PerformanceInsightsRetentionPeriod = aws.Int32(int32(d.Get("performance_insights_retention_period").(int)))
 		}
 
-		if d.HasChange("db_cluster_instance_class") {
-			input.DBClusterInstanceClass = aws.String(d.Get("db_cluster_instance_class").(string))
+		for _, v := range os.Difference(ns).List() {
+			if err := removeIAMRoleFromCluster(ctx, conn, d.Id(), v.(string)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
 		}
+	}
 
-		if d.HasChange("db_cluster_parameter_group_name") {
-			input.DBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
-		}
+	return append(diags, resourceClusterRead(ctx, d, meta)...)
+}
 
-		// DB instance parameter group name is not currently returned from the
-		// DescribeDBClusters API. This means there is no drift detection, so when
-		// set, the configured attribute should always be sent on modify.
-		// Except, this causes an error on a minor version upgrade, so it is
-		// removed during update retry, if necessary.
-		if v, ok := d.GetOk("db_instance_parameter_group_name"); ok || d.HasChange("db_instance_parameter_group_name") {
-			input.DBInstanceParameterGroupName = aws.String(v.(string))
-		}
+func resourceClusterBlueGreenUpdate(ctx context.Context, conn *rds.Client, d *schema.ResourceData) (diags diag.Diagnostics) {
+	deadline := inttypes.NewDeadline(d.Timeout(schema.TimeoutUpdate))
 
-		if d.HasChange(names.AttrDeletionProtection) {
-			input.DeletionProtection = aws.Bool(d.Get(names.AttrDeletionProtection).(bool))
-		}
+	orchestrator := newBlueGreenOrchestrator(conn)
+	defer orchestrator.CleanUp(ctx)
 
-		if d.HasChanges(names.AttrDomain, "domain_iam_role_name") {
-			input.Domain = aws.String(d.Get(names.AttrDomain).(string))
-			input.DomainIAMRoleName = aws.String(d.Get("domain_iam_role_name").(string))
-		}
+	handler := newClusterHandler(conn)
 
-		if d.HasChange("enable_global_write_forwarding") {
-			input.EnableGlobalWriteForwarding = aws.Bool(d.Get("enable_global_write_forwarding").(bool))
-		}
+	if err := handler.precondition(ctx, d, deadline.Remaining()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
 
-		// for provisioned and serverlessv2 (also "provisioned"), data api must be enabled using conn.EnableHttpEndpoint() as below
-		if d.HasChange("enable_http_endpoint") && d.Get("engine_mode").(string) != engineModeProvisioned {
-			input.EnableHttpEndpoint = aws.Bool(d.Get("enable_http_endpoint").(bool))
-		}
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Creating Blue/Green Deployment", d.Id())
 
-		if d.HasChange("enable_local_write_forwarding") {
-			input.EnableLocalWriteForwarding = aws.Bool(d.Get("enable_local_write_forwarding").(bool))
-		}
+	dep, err := orchestrator.CreateDeployment(ctx, handler.createBlueGreenInput(d))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
 
-		if d.HasChange("enabled_cloudwatch_logs_exports") {
-			o, n := d.GetChange("enabled_cloudwatch_logs_exports")
-			os, ns := o.(*schema.Set), n.(*schema.Set)
+	// The identifier and last known status are tracked separately from dep, which is nil after a failed wait or switchover.
+	deploymentIdentifier, deploymentStatus := aws.ToString(dep.BlueGreenDeploymentIdentifier), aws.ToString(dep.Status)
+	defer func() {
+		log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment", d.Id())
 
-			input.CloudwatchLogsExportConfiguration = &types.CloudwatchLogsExportConfiguration{
-				DisableLogTypes: flex.ExpandStringValueSet(os.Difference(ns)),
-				EnableLogTypes:  flex.ExpandStringValueSet(ns.Difference(os)),
-			}
+		// Ensure that the Blue/Green Deployment is always cleaned up, along with the Green environment if switchover did not complete.
+		input := &rds.DeleteBlueGreenDeploymentInput{
+			BlueGreenDeploymentIdentifier: aws.String(deploymentIdentifier),
 		}
-
-		if d.HasChange(names.AttrEngineVersion) {
-			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+		if deploymentStatus != "SWITCHOVER_COMPLETED" {
+			input.DeleteTarget = aws.Bool(true)
 		}
 
-		// This can happen when updates are deferred (apply_immediately = false), and
-		// multiple applies occur before the maintenance window. In this case,
-		// continue sending the desired engine_version as part of the modify request.
-		if d.Get(names.AttrEngineVersion).(string) != d.Get("engine_version_actual").(string) {
-			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+		_, err := conn.DeleteBlueGreenDeployment(ctx, input)
+
+		if errs.IsA[*types.BlueGreenDeploymentNotFoundFault](err) {
+			return
 		}
 
-		if d.HasChange("iam_database_authentication_enabled") {
-			input.EnableIAMDatabaseAuthentication = aws.Bool(d.Get("iam_database_authentication_enabled").(bool))
+		if err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment: %s", d.Id(), err)
+			return
 		}
 
-		if d.HasChange(names.AttrIOPS) {
-			input.Iops = aws.Int32(int32(d.Get(names.AttrIOPS).(int)))
-			if isProvisionedIOPSStorageType(storageType) {
-				// When modifying Provisioned IOPS storage, a value for both allocated storage and iops must be specified.
-				input.AllocatedStorage = aws.Int32(int32(d.Get(names.AttrAllocatedStorage).(int)))
+		orchestrator.AddCleanupWaiter(func(ctx context.Context, conn *rds.Client, optFns ...tfresource.OptionsFunc) {
+			if _, err := waitBlueGreenDeploymentDeleted(ctx, conn, deploymentIdentifier, deadline.Remaining(), optFns...); err != nil {
+				diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment: waiting for completion: %s", d.Id(), err)
 			}
-		}
+		})
+	}()
 
-		if d.HasChange("manage_master_user_password") {
-			input.ManageMasterUserPassword = aws.Bool(d.Get("manage_master_user_password").(bool))
-		}
+	dep, err = orchestrator.waitForDeploymentAvailable(ctx, deploymentIdentifier, deadline.Remaining())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
+	deploymentStatus = aws.ToString(dep.Status)
 
-		if d.HasChange("master_password") {
-			if v, ok := d.GetOk("master_password"); ok {
-				input.MasterUserPassword = aws.String(v.(string))
-			}
-		}
+	target, err := findDBClusterByID(ctx, conn, aws.ToString(dep.Target))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): creating Blue/Green Deployment: reading Green environment: %s", d.Id(), err)
+	}
 
-		if d.HasChange("master_password_wo_version") {
-			masterPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_password_wo"))
-			diags = append(diags, di...)
-			if diags.HasError() {
-				return diags
-			}
+	targetID := aws.ToString(target.DBClusterIdentifier)
+	if _, err := waitDBClusterAvailable(ctx, conn, targetID, true, deadline.Remaining()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): creating Blue/Green Deployment: waiting for Green environment: %s", d.Id(), err)
+	}
 
-			if masterPasswordWO != "" {
-				input.MasterUserPassword = aws.String(masterPasswordWO)
-			}
-		}
+	if err := handler.modifyTarget(ctx, targetID, d, deadline.Remaining(), fmt.Sprintf("Updating RDS Cluster (%s)", d.Id())); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
 
-		if d.HasChange("master_user_secret_kms_key_id") {
-			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
-				input.MasterUserSecretKmsKeyId = aws.String(v.(string))
-			}
-		}
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Switching over Blue/Green Deployment", d.Id())
 
-		if d.HasChange("monitoring_interval") {
-			input.MonitoringInterval = aws.Int32(int32(d.Get("monitoring_interval").(int)))
+	dep, err = orchestrator.Switchover(ctx, deploymentIdentifier, deadline.Remaining(), func(input *rds.SwitchoverBlueGreenDeploymentInput) {
+		if v, ok := d.GetOk("blue_green_update.0.switchover_timeout"); ok {
+			input.SwitchoverTimeout = aws.Int32(int32(v.(int)))
 		}
+	})
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
+	deploymentStatus = aws.ToString(dep.Status)
 
-		if d.HasChange("monitoring_role_arn") {
-			input.MonitoringRoleArn = aws.String(d.Get("monitoring_role_arn").(string))
-		}
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment source", d.Id())
 
-		if d.HasChange("network_type") {
-			input.NetworkType = aws.String(d.Get("network_type").(string))
-		}
+	sourceID, err := handler.deleteSource(ctx, aws.ToString(dep.Source), deadline.Remaining())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
+	}
 
-		if d.HasChange("performance_insights_enabled") {
-			input.EnablePerformanceInsights = aws.Bool(d.Get("performance_insights_enabled").(bool))
+	orchestrator.AddCleanupWaiter(func(ctx context.Context, conn *rds.Client, optFns ...tfresource.OptionsFunc) {
+		if _, err := waitDBClusterDeleted(ctx, conn, sourceID, deadline.Remaining()); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: waiting for completion: %s", d.Id(), err)
 		}
+	})
 
-		if d.HasChange("performance_insights_kms_key_id") {
-			input.PerformanceInsightsKMSKeyId = aws.String(d.Get("performance_insights_kms_key_id").(string))
-		}
+	return diags
+}
+
+func dbClusterPopulateModify(input *rds.ModifyDBClusterInput, d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-		if d.HasChange("performance_insights_retention_period") {
-			input.PerformanceInsightsRetentionPeriod = aws.Int32(int32(d.Get("performance_insights_retention_period").(int)))
+	storageType := d.Get(names.AttrStorageType).(string)
+	if d.HasChange(names.AttrAllocatedStorage) {
+		input.AllocatedStorage = aws.Int32(int32(d.Get(names.AttrAllocatedStorage).(int)))
+		if isProvisionedIOPSStorageType(storageType) {
+			// When modifying Provisioned IOPS storage, a value for both allocated storage and iops must be specified.
+			input.Iops = aws.Int32(int32(d.Get(names.AttrIOPS).(int)))
 		}
+	}
+
+	if v, ok := d.GetOk(names.AttrAllowMajorVersionUpgrade); ok {
+		input.AllowMajorVersionUpgrade = aws.Bool(v.(bool))
+	}
+
+	if d.HasChange("backtrack_window") {
+		input.BacktrackWindow = aws.Int64(int64(d.Get("backtrack_window").(int)))
+	}
+
+	if d.HasChange("backup_retention_period") {
+		input.BackupRetentionPeriod = aws.Int32(int32(d.Get("backup_retention_period").(int)))
+	}
+
+	if d.HasChange("ca_certificate_identifier") {
+		input.CACertificateIdentifier = aws.String(d.Get("ca_certificate_identifier").(string))
+	}
 
-		if d.HasChange(names.AttrPort) {
-			input.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
+	if d.HasChange("copy_tags_to_snapshot") {
+		input.CopyTagsToSnapshot = aws.Bool(d.Get("copy_tags_to_snapshot").(bool))
+	}
+
+	if d.HasChange("database_insights_mode") {
+		input.DatabaseInsightsMode = types.DatabaseInsightsMode(d.Get("database_insights_mode").(string))
+		input.EnablePerformanceInsights = aws.Bool(d.Get("performance_insights_enabled").(bool))
+		if v, ok := d.Get("performance_insights_kms_key_id").(string); ok && v != "" {
+			input.PerformanceInsightsKMSKeyId = aws.String(v)
 		}
+		input.PerformanceInsightsRetentionPeriod = aws.Int32(int32(d.Get("performance_insights_retention_period").(int)))
+	}
+
+	if d.HasChange("db_cluster_instance_class") {
+		input.DBClusterInstanceClass = aws.String(d.Get("db_cluster_instance_class").(string))
+	}
+
+	if d.HasChange("db_cluster_parameter_group_name") {
+		input.DBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
+	}
+
+	// DB instance parameter group name is not currently returned from the
+	// DescribeDBClusters API. This means there is no drift detection, so when
+	// set, the configured attribute should always be sent on modify.
+	// Except, this causes an error on a minor version upgrade, so it is
+	// removed during update retry, if necessary.
+	if v, ok := d.GetOk("db_instance_parameter_group_name"); ok || d.HasChange("db_instance_parameter_group_name") {
+		input.DBInstanceParameterGroupName = aws.String(v.(string))
+	}
+
+	if d.HasChange(names.AttrDeletionProtection) {
+		input.DeletionProtection = aws.Bool(d.Get(names.AttrDeletionProtection).(bool))
+	}
+
+	if d.HasChanges(names.AttrDomain, "domain_iam_role_name") {
+		input.Domain = aws.String(d.Get(names.AttrDomain).(string))
+		input.DomainIAMRoleName = aws.String(d.Get("domain_iam_role_name").(string))
+	}
+
+	if d.HasChange("enable_global_write_forwarding") {
+		input.EnableGlobalWriteForwarding = aws.Bool(d.Get("enable_global_write_forwarding").(bool))
+	}
+
+	// for provisioned and serverlessv2 (also "provisioned"), data api must be enabled using conn.EnableHttpEndpoint() as below
+	if d.HasChange("enable_http_endpoint") && d.Get("engine_mode").(string) != engineModeProvisioned {
+		input.EnableHttpEndpoint = aws.Bool(d.Get("enable_http_endpoint").(bool))
+	}
+
+	if d.HasChange("enable_local_write_forwarding") {
+		input.EnableLocalWriteForwarding = aws.Bool(d.Get("enable_local_write_forwarding").(bool))
+	}
+
+	if d.HasChange("enabled_cloudwatch_logs_exports") {
+		o, n := d.GetChange("enabled_cloudwatch_logs_exports")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
 
-		if d.HasChange("preferred_backup_window") {
-			input.PreferredBackupWindow = aws.String(d.Get("preferred_backup_window").(string))
+		input.CloudwatchLogsExportConfiguration = &types.CloudwatchLogsExportConfiguration{
+			DisableLogTypes: flex.ExpandStringValueSet(os.Difference(ns)),
+			EnableLogTypes:  flex.ExpandStringValueSet(ns.Difference(os)),
 		}
+	}
+
+	if d.HasChange(names.AttrEngineVersion) {
+		input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+	}
+
+	// This can happen when updates are deferred (apply_immediately = false), and
+	// multiple applies occur before the maintenance window. In this case,
+	// continue sending the desired engine_version as part of the modify request.
+	if d.Get(names.AttrEngineVersion).(string) != d.Get("engine_version_actual").(string) {
+		input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+	}
 
-		if d.HasChange(names.AttrPreferredMaintenanceWindow) {
-			input.PreferredMaintenanceWindow = aws.String(d.Get(names.AttrPreferredMaintenanceWindow).(string))
+	if d.HasChange("iam_database_authentication_enabled") {
+		input.EnableIAMDatabaseAuthentication = aws.Bool(d.Get("iam_database_authentication_enabled").(bool))
+	}
+
+	if d.HasChange(names.AttrIOPS) {
+		input.Iops = aws.Int32(int32(d.Get(names.AttrIOPS).(int)))
+		if isProvisionedIOPSStorageType(storageType) {
+			// When modifying Provisioned IOPS storage, a value for both allocated storage and iops must be specified.
+			input.AllocatedStorage = aws.Int32(int32(d.Get(names.AttrAllocatedStorage).(int)))
 		}
+	}
 
-		if d.HasChange("scaling_configuration") {
-			if v, ok := d.GetOk("scaling_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-				input.ScalingConfiguration = expandScalingConfiguration(v.([]any)[0].(map[string]any))
-			}
+	if d.HasChange("manage_master_user_password") {
+		input.ManageMasterUserPassword = aws.Bool(d.Get("manage_master_user_password").(bool))
+	}
+
+	if d.HasChange("master_password") {
+		if v, ok := d.GetOk("master_password"); ok {
+			input.MasterUserPassword = aws.String(v.(string))
 		}
+	}
 
-		if d.HasChange("serverlessv2_scaling_configuration") {
-			if v, ok := d.GetOk("serverlessv2_scaling_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-				input.ServerlessV2ScalingConfiguration = expandServerlessV2ScalingConfiguration(v.([]any)[0].(map[string]any))
-			}
+	if d.HasChange("master_password_wo_version") {
+		masterPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
 		}
 
-		if d.HasChange(names.AttrStorageType) {
-			input.StorageType = aws.String(d.Get(names.AttrStorageType).(string))
+		if masterPasswordWO != "" {
+			input.MasterUserPassword = aws.String(masterPasswordWO)
 		}
+	}
 
-		if d.HasChange(names.AttrVPCSecurityGroupIDs) {
-			if v, ok := d.GetOk(names.AttrVPCSecurityGroupIDs); ok && v.(*schema.Set).Len() > 0 {
-				input.VpcSecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
-			} else {
-				input.VpcSecurityGroupIds = []string{}
-			}
+	if d.HasChange("master_user_secret_kms_key_id") {
+		if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+			input.MasterUserSecretKmsKeyId = aws.String(v.(string))
 		}
+	}
 
-		const (
-			timeout = 5 * time.Minute
-		)
-		_, err := tfresource.RetryWhen(ctx, timeout,
-			func(ctx context.Context) (any, error) {
-				return conn.ModifyDBCluster(ctx, input)
-			},
-			func(err error) (bool, error) {
-				if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "IAM role ARN value is invalid or does not include the required permissions") {
-					return true, err
-				}
+	if d.HasChange("monitoring_interval") {
+		input.MonitoringInterval = aws.Int32(int32(d.Get("monitoring_interval").(int)))
+	}
 
-				if errs.IsA[*types.InvalidDBClusterStateFault](err) {
-					return true, err
-				}
+	if d.HasChange("monitoring_role_arn") {
+		input.MonitoringRoleArn = aws.String(d.Get("monitoring_role_arn").(string))
+	}
 
-				if tfawserr.ErrMessageContains(err, errCodeInvalidParameterCombination, "db-instance-parameter-group-name can only be specified for a major") {
-					input.DBInstanceParameterGroupName = nil
-					return true, err
-				}
+	if d.HasChange("network_type") {
+		input.NetworkType = aws.String(d.Get("network_type").(string))
+	}
 
-				return false, err
-			},
-		)
+	if d.HasChange("performance_insights_enabled") {
+		input.EnablePerformanceInsights = aws.Bool(d.Get("performance_insights_enabled").(bool))
+	}
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
-		}
+	if d.HasChange("performance_insights_kms_key_id") {
+		input.PerformanceInsightsKMSKeyId = aws.String(d.Get("performance_insights_kms_key_id").(string))
+	}
 
-		if _, err := waitDBClusterUpdated(ctx, conn, d.Id(), applyImmediately, d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) update: %s", d.Id(), err)
-		}
+	if d.HasChange("performance_insights_retention_period") {
+		input.PerformanceInsightsRetentionPeriod = aws.Int32(int32(d.Get("performance_insights_retention_period").(int)))
 	}
 
-	if d.HasChange("global_cluster_identifier") {
-		o, n := d.GetChange("global_cluster_identifier")
-		os, ns := o.(string), n.(string)
+	if d.HasChange(names.AttrPort) {
+		input.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
+	}
 
-		if os == "" {
-			return sdkdiag.AppendErrorf(diags, "existing RDS Clusters cannot be added to an existing RDS Global Cluster")
-		}
+	if d.HasChange("preferred_backup_window") {
+		input.PreferredBackupWindow = aws.String(d.Get("preferred_backup_window").(string))
+	}
 
-		if ns != "" {
-			return sdkdiag.AppendErrorf(diags, "existing RDS Clusters cannot be migrated between existing RDS Global Clusters")
-		}
+	if d.HasChange(names.AttrPreferredMaintenanceWindow) {
+		input.PreferredMaintenanceWindow = aws.String(d.Get(names.AttrPreferredMaintenanceWindow).(string))
+	}
 
-		clusterARN := d.Get(names.AttrARN).(string)
-		input := &rds.RemoveFromGlobalClusterInput{
-			DbClusterIdentifier:     aws.String(clusterARN),
-			GlobalClusterIdentifier: aws.String(os),
+	if d.HasChange("scaling_configuration") {
+		if v, ok := d.GetOk("scaling_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			input.ScalingConfiguration = expandScalingConfiguration(v.([]any)[0].(map[string]any))
 		}
+	}
 
-		_, err := conn.RemoveFromGlobalCluster(ctx, input)
-
-		if err != nil && !errs.IsA[*types.GlobalClusterNotFoundFault](err) && !tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "is not found in global cluster") {
-			return sdkdiag.AppendErrorf(diags, "removing RDS Cluster (%s) from RDS Global Cluster: %s", d.Id(), err)
+	if d.HasChange("serverlessv2_scaling_configuration") {
+		if v, ok := d.GetOk("serverlessv2_scaling_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			input.ServerlessV2ScalingConfiguration = expandServerlessV2ScalingConfiguration(v.([]any)[0].(map[string]any))
 		}
+	}
 
-		// Removal from a global cluster puts the cluster into 'promoting' state. Wait for it to become available again.
-		if _, err := waitDBClusterAvailable(ctx, conn, d.Id(), true, d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) available: %s", d.Id(), err)
+	if d.HasChange(names.AttrStorageType) {
+		input.StorageType = aws.String(d.Get(names.AttrStorageType).(string))
+	}
+
+	if d.HasChange(names.AttrVPCSecurityGroupIDs) {
+		if v, ok := d.GetOk(names.AttrVPCSecurityGroupIDs); ok && v.(*schema.Set).Len() > 0 {
+			input.VpcSecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
+		} else {
+			input.VpcSecurityGroupIds = []string{}
 		}
 	}
 
-	if d.HasChange("iam_roles") {
-		o, n := d.GetChange("iam_roles")
-		os, ns := o.(*schema.Set), n.(*schema.Set)
+	return diags
+}
 
-		for _, v := range ns.Difference(os).List() {
-			if err := addIAMRoleToCluster(ctx, conn, d.Id(), v.(string)); err != nil {
-				return sdkdiag.AppendFromErr(diags, err)
+func dbClusterModify(ctx context.Context, conn *rds.Client, input *rds.ModifyDBClusterInput) error {
+	const (
+		timeout = 5 * time.Minute
+	)
+	_, err := tfresource.RetryWhen(ctx, timeout,
+		func(ctx context.Context) (any, error) {
+			return conn.ModifyDBCluster(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "IAM role ARN value is invalid or does not include the required permissions") {
+				return true, err
 			}
-		}
 
-		for _, v := range os.Difference(ns).List() {
-			if err := removeIAMRoleFromCluster(ctx, conn, d.Id(), v.(string)); err != nil {
-				return sdkdiag.AppendFromErr(diags, err)
+			if errs.IsA[*types.InvalidDBClusterStateFault](err) {
+				return true, err
 			}
-		}
-	}
 
-	return append(diags, resourceClusterRead(ctx, d, meta)...)
+			if tfawserr.ErrMessageContains(err, errCodeInvalidParameterCombination, "db-instance-parameter-group-name can only be specified for a major") {
+				input.DBInstanceParameterGroupName = nil
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	return err
 }
 
 func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -2286,6 +2446,39 @@ func waitDBClusterDeleted(ctx context.Context, conn *rds.Client, id string, time
 	return nil, err
 }
 
+// clusterBlueGreenUpdateAttributes returns the attributes whose changes are applied using a Blue/Green Deployment when enabled.
+func clusterBlueGreenUpdateAttributes() []string {
+	return []string{
+		"db_cluster_parameter_group_name",
+		"db_instance_parameter_group_name",
+		names.AttrEngineVersion,
+	}
+}
+
+// clusterBlueGreenIgnoredAttributes returns the attributes whose changes are not applied to the Green environment before switchover.
+func clusterBlueGreenIgnoredAttributes() []string {
+	return append(clusterBlueGreenUpdateAttributes(),
+		names.AttrAllowMajorVersionUpgrade,
+		names.AttrApplyImmediately,
+		"blue_green_update",
+		"delete_automated_backups",
+		names.AttrDeletionProtection,
+		names.AttrFinalSnapshotIdentifier,
+		"global_cluster_identifier",
+		"iam_roles",
+		"replication_source_identifier",
+		"skip_final_snapshot",
+		names.AttrTags, names.AttrTagsAll,
+	)
+}
+
+func clusterValidBlueGreenEngines() []string {
+	return []string{
+		ClusterEngineAuroraMySQL,
+		ClusterEngineAuroraPostgreSQL,
+	}
+}
+
 func expandScalingConfiguration(tfMap map[string]any) *types.ScalingConfiguration {
 	if tfMap == nil {
 		return nil
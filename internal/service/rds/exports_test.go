@@ -5,6 +5,7 @@ package rds
 
 // Exports for use in tests only.
 var (
+	ResourceBlueGreenDeployment                 = newBlueGreenDeploymentResource
 	ResourceCertificate                         = resourceCertificate
 	ResourceCluster                             = resourceCluster
 	ResourceClusterActivityStream               = resourceClusterActivityStream
@@ -36,6 +37,7 @@ var (
 	ResourceSubnetGroup                         = resourceSubnetGroup
 
 	ClusterIDAndRegionFromARN                  = clusterIDAndRegionFromARN
+	FindBlueGreenDeploymentByID                = findBlueGreenDeploymentByID
 	FindCustomDBEngineVersionByTwoPartKey      = findCustomDBEngineVersionByTwoPartKey
 	FindDBClusterByID                          = findDBClusterByID
 	FindDBClusterEndpointByID                  = findDBClusterEndpointByID
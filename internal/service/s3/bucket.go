@@ -89,7 +89,7 @@ func resourceBucket() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				Deprecated:       "acceleration_status is deprecated. Use the aws_s3_bucket_accelerate_configuration resource instead.",
+				Deprecated:       names.ResourceDeprecationMessage("aws_s3_bucket", "acceleration_status"),
 				ValidateDiagFunc: enum.Validate[types.BucketAccelerateStatus](),
 			},
 			"acl": {
@@ -98,7 +98,7 @@ func resourceBucket() *schema.Resource {
 				Computed:      true,
 				ConflictsWith: []string{"grant"},
 				ValidateFunc:  validation.StringInSlice(bucketCannedACL_Values(), false),
-				Deprecated:    "acl is deprecated. Use the aws_s3_bucket_acl resource instead.",
+				Deprecated:    names.ResourceDeprecationMessage("aws_s3_bucket", "acl"),
 			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -141,7 +141,7 @@ func resourceBucket() *schema.Resource {
 				Type:       schema.TypeList,
 				Optional:   true,
 				Computed:   true,
-				Deprecated: "cors_rule is deprecated. Use the aws_s3_bucket_cors_configuration resource instead.",
+				Deprecated: names.ResourceDeprecationMessage("aws_s3_bucket", "cors_rule"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"allowed_headers": {
@@ -181,7 +181,7 @@ func resourceBucket() *schema.Resource {
 				Optional:      true,
 				Computed:      true,
 				ConflictsWith: []string{"acl"},
-				Deprecated:    "grant is deprecated. Use the aws_s3_bucket_acl resource instead.",
+				Deprecated:    names.ResourceDeprecationMessage("aws_s3_bucket", "grant"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrID: {
@@ -221,7 +221,7 @@ func resourceBucket() *schema.Resource {
 				Type:       schema.TypeList,
 				Optional:   true,
 				Computed:   true,
-				Deprecated: "lifecycle_rule is deprecated. Use the aws_s3_bucket_lifecycle_configuration resource instead.",
+				Deprecated: names.ResourceDeprecationMessage("aws_s3_bucket", "lifecycle_rule"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"abort_incomplete_multipart_upload_days": {
@@ -329,7 +329,7 @@ func resourceBucket() *schema.Resource {
 				Optional:   true,
 				Computed:   true,
 				MaxItems:   1,
-				Deprecated: "logging is deprecated. Use the aws_s3_bucket_logging resource instead.",
+				Deprecated: names.ResourceDeprecationMessage("aws_s3_bucket", "logging"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"target_bucket": {
@@ -348,7 +348,7 @@ func resourceBucket() *schema.Resource {
 				Optional:   true,
 				Computed:   true,
 				MaxItems:   1,
-				Deprecated: "object_lock_configuration is deprecated. Use the top-level parameter object_lock_enabled and the aws_s3_bucket_object_lock_configuration resource instead.",
+				Deprecated: names.ResourceDeprecationMessage("aws_s3_bucket", "object_lock_configuration"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"object_lock_enabled": {
@@ -357,12 +357,12 @@ func resourceBucket() *schema.Resource {
 							ForceNew:         true,
 							ConflictsWith:    []string{"object_lock_enabled"},
 							ValidateDiagFunc: enum.Validate[types.ObjectLockEnabled](),
-							Deprecated:       "object_lock_enabled is deprecated. Use the top-level parameter object_lock_enabled instead.",
+							Deprecated:       names.ResourceDeprecationMessage("aws_s3_bucket", "object_lock_configuration.object_lock_enabled"),
 						},
 						names.AttrRule: {
 							Type:       schema.TypeList,
 							Optional:   true,
-							Deprecated: "rule is deprecated. Use the aws_s3_bucket_object_lock_configuration resource instead.",
+							Deprecated: names.ResourceDeprecationMessage("aws_s3_bucket", "object_lock_configuration.rule"),
 							MaxItems:   1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -408,7 +408,7 @@ func resourceBucket() *schema.Resource {
 				Type:                  schema.TypeString,
 				Optional:              true,
 				Computed:              true,
-				Deprecated:            "policy is deprecated. Use the aws_s3_bucket_policy resource instead.",
+				Deprecated:            names.ResourceDeprecationMessage("aws_s3_bucket", names.AttrPolicy),
 				ValidateFunc:          validation.StringIsJSON,
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
@@ -422,7 +422,7 @@ func resourceBucket() *schema.Resource {
 				Optional:   true,
 				Computed:   true,
 				MaxItems:   1,
-				Deprecated: "replication_configuration is deprecated. Use the aws_s3_bucket_replication_configuration resource instead.",
+				Deprecated: names.ResourceDeprecationMessage("aws_s3_bucket", "replication_configuration"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrRole: {
@@ -594,7 +594,7 @@ func resourceBucket() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				Deprecated:       "request_payer is deprecated. Use the aws_s3_bucket_request_payment_configuration resource instead.",
+				Deprecated:       names.ResourceDeprecationMessage("aws_s3_bucket", "request_payer"),
 				ValidateDiagFunc: enum.Validate[types.Payer](),
 			},
 			"server_side_encryption_configuration": {
@@ -602,7 +602,7 @@ func resourceBucket() *schema.Resource {
 				MaxItems:   1,
 				Optional:   true,
 				Computed:   true,
-				Deprecated: "server_side_encryption_configuration is deprecated. Use the aws_s3_bucket_server_side_encryption_configuration resource instead.",
+				Deprecated: names.ResourceDeprecationMessage("aws_s3_bucket", "server_side_encryption_configuration"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrRule: {
@@ -646,7 +646,7 @@ func resourceBucket() *schema.Resource {
 				Optional:   true,
 				Computed:   true,
 				MaxItems:   1,
-				Deprecated: "versioning is deprecated. Use the aws_s3_bucket_versioning resource instead.",
+				Deprecated: names.ResourceDeprecationMessage("aws_s3_bucket", "versioning"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrEnabled: {
@@ -667,7 +667,7 @@ func resourceBucket() *schema.Resource {
 				Optional:   true,
 				Computed:   true,
 				MaxItems:   1,
-				Deprecated: "website is deprecated. Use the aws_s3_bucket_website_configuration resource instead.",
+				Deprecated: names.ResourceDeprecationMessage("aws_s3_bucket", "website"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"error_document": {
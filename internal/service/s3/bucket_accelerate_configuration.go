@@ -50,7 +50,7 @@ func resourceBucketAccelerateConfiguration() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: verify.ValidAccountID,
-				Deprecated:   "expected_bucket_owner is deprecated. It will be removed in a future verion of the provider.",
+				Deprecated:   names.ResourceDeprecationMessage("aws_s3_bucket_accelerate_configuration", names.AttrExpectedBucketOwner),
 			},
 			names.AttrStatus: {
 				Type:             schema.TypeString,
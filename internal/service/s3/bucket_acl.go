@@ -110,11 +110,10 @@ func resourceBucketACL() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									names.AttrDisplayName: {
-										Type:     schema.TypeString,
-										Optional: true,
-										Computed: true,
-										Deprecated: "display_name is deprecated. This attribute is no longer returned by " +
-											"AWS and will be removed in a future major version.",
+										Type:       schema.TypeString,
+										Optional:   true,
+										Computed:   true,
+										Deprecated: names.ResourceDeprecationMessage("aws_s3_bucket_acl", "access_control_policy.owner.display_name"),
 									},
 									names.AttrID: {
 										Type:     schema.TypeString,
@@ -142,7 +141,7 @@ func resourceBucketACL() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: verify.ValidAccountID,
-				Deprecated:   "expected_bucket_owner is deprecated. It will be removed in a future verion of the provider.",
+				Deprecated:   names.ResourceDeprecationMessage("aws_s3_bucket_acl", names.AttrExpectedBucketOwner),
 			},
 		},
 
@@ -53,7 +53,7 @@ func resourceBucketCorsConfiguration() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: verify.ValidAccountID,
-				Deprecated:   "expected_bucket_owner is deprecated. It will be removed in a future verion of the provider.",
+				Deprecated:   names.ResourceDeprecationMessage("aws_s3_bucket_cors_configuration", names.AttrExpectedBucketOwner),
 			},
 			"cors_rule": {
 				Type:     schema.TypeSet,
@@ -95,7 +95,7 @@ func (r *bucketLifecycleConfigurationResource) Schema(ctx context.Context, reque
 				Validators: []validator.String{
 					fwvalidators.AWSAccountID(),
 				},
-				DeprecationMessage: "This attribute will be removed in a future verion of the provider.",
+				DeprecationMessage: names.ResourceDeprecationMessage("aws_s3_bucket_lifecycle_configuration", names.AttrExpectedBucketOwner),
 			},
 			names.AttrID: framework.IDAttributeDeprecatedNoReplacement(),
 			"transition_default_minimum_object_size": schema.StringAttribute{
@@ -124,7 +124,7 @@ func (r *bucketLifecycleConfigurationResource) Schema(ctx context.Context, reque
 						names.AttrPrefix: schema.StringAttribute{
 							Optional:           true,
 							Computed:           true, // Because of Legacy value handling
-							DeprecationMessage: "Specify a prefix using 'filter' instead",
+							DeprecationMessage: names.ResourceDeprecationMessage("aws_s3_bucket_lifecycle_configuration", "rule.prefix"),
 							PlanModifiers: []planmodifier.String{
 								tfstringplanmodifier.LegacyValue(),
 							},
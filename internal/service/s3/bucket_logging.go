@@ -51,7 +51,7 @@ func resourceBucketLogging() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: verify.ValidAccountID,
-				Deprecated:   "expected_bucket_owner is deprecated. It will be removed in a future verion of the provider.",
+				Deprecated:   names.ResourceDeprecationMessage("aws_s3_bucket_logging", names.AttrExpectedBucketOwner),
 			},
 			"target_bucket": {
 				Type:     schema.TypeString,
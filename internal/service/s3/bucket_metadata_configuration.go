@@ -67,7 +67,7 @@ func (r *bucketMetadataConfigurationResource) Schema(ctx context.Context, reques
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
-				DeprecationMessage: "This attribute will be removed in a future verion of the provider.",
+				DeprecationMessage: names.ResourceDeprecationMessage("aws_s3_bucket_metadata_configuration", names.AttrExpectedBucketOwner),
 			},
 		},
 		Blocks: map[string]schema.Block{
@@ -193,7 +193,7 @@ func resourceBucketObject() *schema.Resource {
 			},
 		},
 
-		DeprecationMessage: `use the aws_s3_object resource instead`,
+		DeprecationMessage: names.ResourceDeprecationMessage("aws_s3_bucket_object", ""),
 	}
 }
 
@@ -139,7 +139,7 @@ func dataSourceBucketObject() *schema.Resource {
 			},
 		},
 
-		DeprecationMessage: `use the aws_s3_object data source instead`,
+		DeprecationMessage: names.DataSourceDeprecationMessage("aws_s3_bucket_object", ""),
 	}
 }
 
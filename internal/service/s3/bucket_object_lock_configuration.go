@@ -51,7 +51,7 @@ func resourceBucketObjectLockConfiguration() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: verify.ValidAccountID,
-				Deprecated:   "expected_bucket_owner is deprecated. It will be removed in a future verion of the provider.",
+				Deprecated:   names.ResourceDeprecationMessage("aws_s3_bucket_object_lock_configuration", names.AttrExpectedBucketOwner),
 			},
 			"object_lock_enabled": {
 				Type:             schema.TypeString,
@@ -75,7 +75,7 @@ func dataSourceBucketObjects() *schema.Resource {
 			},
 		},
 
-		DeprecationMessage: `use the aws_s3_objects data source instead`,
+		DeprecationMessage: names.DataSourceDeprecationMessage("aws_s3_bucket_objects", ""),
 	}
 }
 
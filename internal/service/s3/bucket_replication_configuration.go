@@ -255,7 +255,7 @@ func resourceBucketReplicationConfiguration() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							ValidateFunc: validation.StringLenBetween(0, 1024),
-							Deprecated:   "prefix is deprecated. Use filter instead.",
+							Deprecated:   names.ResourceDeprecationMessage("aws_s3_bucket_replication_configuration", "rule.prefix"),
 						},
 						names.AttrPriority: {
 							Type:     schema.TypeInt,
@@ -50,7 +50,7 @@ func resourceBucketRequestPaymentConfiguration() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: verify.ValidAccountID,
-				Deprecated:   "expected_bucket_owner is deprecated. It will be removed in a future verion of the provider.",
+				Deprecated:   names.ResourceDeprecationMessage("aws_s3_bucket_request_payment_configuration", names.AttrExpectedBucketOwner),
 			},
 			"payer": {
 				Type:             schema.TypeString,
@@ -54,7 +54,7 @@ func resourceBucketServerSideEncryptionConfiguration() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: verify.ValidAccountID,
-				Deprecated:   "expected_bucket_owner is deprecated. It will be removed in a future verion of the provider.",
+				Deprecated:   names.ResourceDeprecationMessage("aws_s3_bucket_server_side_encryption_configuration", names.AttrExpectedBucketOwner),
 			},
 			names.AttrRule: {
 				Type:     schema.TypeSet,
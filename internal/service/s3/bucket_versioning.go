@@ -55,7 +55,7 @@ func resourceBucketVersioning() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: verify.ValidAccountID,
-				Deprecated:   "expected_bucket_owner is deprecated. It will be removed in a future verion of the provider.",
+				Deprecated:   names.ResourceDeprecationMessage("aws_s3_bucket_versioning", names.AttrExpectedBucketOwner),
 			},
 			"mfa": {
 				Type:     schema.TypeString,
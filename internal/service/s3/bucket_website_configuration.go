@@ -66,7 +66,7 @@ func resourceBucketWebsiteConfiguration() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: verify.ValidAccountID,
-				Deprecated:   "expected_bucket_owner is deprecated. It will be removed in a future verion of the provider.",
+				Deprecated:   names.ResourceDeprecationMessage("aws_s3_bucket_website_configuration", names.AttrExpectedBucketOwner),
 			},
 			"index_document": {
 				Type:     schema.TypeList,
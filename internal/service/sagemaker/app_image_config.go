@@ -30,6 +30,11 @@ import (
 
 // @SDKResource("aws_sagemaker_app_image_config", name="App Image Config")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("app_image_config_name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sagemaker;sagemaker.DescribeAppImageConfigOutput")
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(idAttrDuplicates="app_image_config_name")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceAppImageConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAppImageConfigCreate,
@@ -37,10 +42,6 @@ func resourceAppImageConfig() *schema.Resource {
 		UpdateWithoutTimeout: resourceAppImageConfigUpdate,
 		DeleteWithoutTimeout: resourceAppImageConfigDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
 			n := 0
 			if _, ok := diff.GetOk("code_editor_app_image_config"); ok {
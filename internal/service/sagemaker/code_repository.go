@@ -29,15 +29,17 @@ import (
 
 // @SDKResource("aws_sagemaker_code_repository", name="Code Repository")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("code_repository_name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sagemaker;sagemaker.DescribeCodeRepositoryOutput")
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(idAttrDuplicates="code_repository_name")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceCodeRepository() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCodeRepositoryCreate,
 		ReadWithoutTimeout:   resourceCodeRepositoryRead,
 		UpdateWithoutTimeout: resourceCodeRepositoryUpdate,
 		DeleteWithoutTimeout: resourceCodeRepositoryDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
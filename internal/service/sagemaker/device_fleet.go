@@ -30,15 +30,17 @@ import (
 
 // @SDKResource("aws_sagemaker_device_fleet", name="Device Fleet")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("device_fleet_name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sagemaker;sagemaker.DescribeDeviceFleetOutput")
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(idAttrDuplicates="device_fleet_name")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceDeviceFleet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDeviceFleetCreate,
 		ReadWithoutTimeout:   resourceDeviceFleetRead,
 		UpdateWithoutTimeout: resourceDeviceFleetUpdate,
 		DeleteWithoutTimeout: resourceDeviceFleetDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
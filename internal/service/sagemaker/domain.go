@@ -31,15 +31,18 @@ import (
 
 // @SDKResource("aws_sagemaker_domain", name="Domain")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sagemaker;sagemaker.DescribeDomainOutput")
+// @Testing(serialize=true)
+// @Testing(importIgnore="retention_policy")
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceDomain() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDomainCreate,
 		ReadWithoutTimeout:   resourceDomainRead,
 		UpdateWithoutTimeout: resourceDomainUpdate,
 		DeleteWithoutTimeout: resourceDomainDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
 
 		Schema: map[string]*schema.Schema{
 			"app_network_access_type": {
@@ -36,15 +36,16 @@ import (
 
 // @SDKResource("aws_sagemaker_endpoint_configuration", name="Endpoint Configuration")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(idAttrDuplicates="name")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceEndpointConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEndpointConfigurationCreate,
 		ReadWithoutTimeout:   resourceEndpointConfigurationRead,
 		UpdateWithoutTimeout: resourceEndpointConfigurationUpdate,
 		DeleteWithoutTimeout: resourceEndpointConfigurationDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
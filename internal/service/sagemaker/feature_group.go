@@ -31,15 +31,18 @@ import (
 
 // @SDKResource("aws_sagemaker_feature_group", name="Feature Group")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("feature_group_name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sagemaker;sagemaker.DescribeFeatureGroupOutput")
+// @Testing(serialize=true)
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(idAttrDuplicates="feature_group_name")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceFeatureGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFeatureGroupCreate,
 		ReadWithoutTimeout:   resourceFeatureGroupRead,
 		UpdateWithoutTimeout: resourceFeatureGroupUpdate,
 		DeleteWithoutTimeout: resourceFeatureGroupDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
@@ -40,6 +40,7 @@ func TestAccSageMakerFeatureGroup_serial(t *testing.T) {
 		"onlineConfig_TTLDuration":           testAccFeatureGroup_onlineConfigTTLDuration,
 		"throughputConfig":                   testAccFeatureGroup_throughputConfig,
 		"tags":                               testAccFeatureGroup_tags,
+		"Identity":                           testAccSageMakerFeatureGroup_IdentitySerial,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -33,6 +33,12 @@ import (
 
 // @SDKResource("aws_sagemaker_flow_definition", name="Flow Definition")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("flow_definition_name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sagemaker;sagemaker.DescribeFlowDefinitionOutput")
+// @Testing(serialize=true)
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(idAttrDuplicates="flow_definition_name")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceFlowDefinition() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFlowDefinitionCreate,
@@ -40,10 +46,6 @@ func resourceFlowDefinition() *schema.Resource {
 		UpdateWithoutTimeout: resourceFlowDefinitionUpdate,
 		DeleteWithoutTimeout: resourceFlowDefinitionDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
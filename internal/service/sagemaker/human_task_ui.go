@@ -28,15 +28,18 @@ import (
 
 // @SDKResource("aws_sagemaker_human_task_ui", name="Human Task UI")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("human_task_ui_name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sagemaker;sagemaker.DescribeHumanTaskUiOutput")
+// @Testing(importIgnore="ui_template.0.content;ui_template.0.url")
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(idAttrDuplicates="human_task_ui_name")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceHumanTaskUI() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceHumanTaskUICreate,
 		ReadWithoutTimeout:   resourceHumanTaskUIRead,
 		UpdateWithoutTimeout: resourceHumanTaskUIUpdate,
 		DeleteWithoutTimeout: resourceHumanTaskUIDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
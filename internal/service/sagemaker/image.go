@@ -30,15 +30,17 @@ import (
 
 // @SDKResource("aws_sagemaker_image", name="Image")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("image_name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sagemaker;sagemaker.DescribeImageOutput")
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(idAttrDuplicates="image_name")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceImage() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceImageCreate,
 		ReadWithoutTimeout:   resourceImageRead,
 		UpdateWithoutTimeout: resourceImageUpdate,
 		DeleteWithoutTimeout: resourceImageDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
@@ -29,6 +29,11 @@ import (
 
 // @SDKResource("aws_sagemaker_mlflow_tracking_server", name="Mlflow Tracking Server")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("tracking_server_name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sagemaker;sagemaker.DescribeMlflowTrackingServerOutput")
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(idAttrDuplicates="tracking_server_name")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceMlflowTrackingServer() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceMlflowTrackingServerCreate,
@@ -36,10 +41,6 @@ func resourceMlflowTrackingServer() *schema.Resource {
 		UpdateWithoutTimeout: resourceMlflowTrackingServerUpdate,
 		DeleteWithoutTimeout: resourceMlflowTrackingServerDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
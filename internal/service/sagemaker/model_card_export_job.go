@@ -18,7 +18,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
@@ -40,6 +39,10 @@ import (
 )
 
 // @FrameworkResource("aws_sagemaker_model_card_export_job", name="Model Card Export Job")
+// @IdentityAttribute("model_card_export_job_arn")
+// @Testing(checkDestroyNoop=true)
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(existsTakesT=false)
 func newModelCardExportJobResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &modelCardExportJobResource{}
 	r.SetDefaultCreateTimeout(15 * time.Minute)
@@ -48,6 +51,7 @@ func newModelCardExportJobResource(_ context.Context) (resource.ResourceWithConf
 
 type modelCardExportJobResource struct {
 	framework.ResourceWithModel[modelCardExportJobResourceModel]
+	framework.WithImportByIdentity
 	framework.WithNoUpdate
 	framework.WithNoOpDelete
 	framework.WithTimeouts
@@ -185,10 +189,6 @@ func (r *modelCardExportJobResource) Read(ctx context.Context, request resource.
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
-func (r *modelCardExportJobResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("model_card_export_job_arn"), request, response)
-}
-
 func findModelCardExportJobByARN(ctx context.Context, conn *sagemaker.Client, arn string) (*sagemaker.DescribeModelCardExportJobOutput, error) {
 	input := sagemaker.DescribeModelCardExportJobInput{
 		ModelCardExportJobArn: aws.String(arn),
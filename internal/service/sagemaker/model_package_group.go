@@ -27,15 +27,17 @@ import (
 
 // @SDKResource("aws_sagemaker_model_package_group", name="Model Package Group")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("model_package_group_name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sagemaker;sagemaker.DescribeModelPackageGroupOutput")
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(idAttrDuplicates="model_package_group_name")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceModelPackageGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceModelPackageGroupCreate,
 		ReadWithoutTimeout:   resourceModelPackageGroupRead,
 		UpdateWithoutTimeout: resourceModelPackageGroupUpdate,
 		DeleteWithoutTimeout: resourceModelPackageGroupDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
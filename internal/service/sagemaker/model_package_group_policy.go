@@ -25,15 +25,17 @@ import (
 )
 
 // @SDKResource("aws_sagemaker_model_package_group_policy", name="Model Package Group Policy")
+// @IdentityAttribute("model_package_group_name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sagemaker;sagemaker.GetModelPackageGroupPolicyOutput")
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(idAttrDuplicates="model_package_group_name")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceModelPackageGroupPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceModelPackageGroupPolicyPut,
 		ReadWithoutTimeout:   resourceModelPackageGroupPolicyRead,
 		UpdateWithoutTimeout: resourceModelPackageGroupPolicyPut,
 		DeleteWithoutTimeout: resourceModelPackageGroupPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
 
 		Schema: map[string]*schema.Schema{
 			"model_package_group_name": {
@@ -34,6 +34,11 @@ import (
 
 // @SDKResource("aws_sagemaker_notebook_instance", name="Notebook Instance")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sagemaker;sagemaker.DescribeNotebookInstanceOutput")
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(idAttrDuplicates="name")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceNotebookInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceNotebookInstanceCreate,
@@ -41,10 +46,6 @@ func resourceNotebookInstance() *schema.Resource {
 		UpdateWithoutTimeout: resourceNotebookInstanceUpdate,
 		DeleteWithoutTimeout: resourceNotebookInstanceDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		CustomizeDiff: customdiff.Sequence(
 			customdiff.ForceNewIfChange(names.AttrVolumeSize, func(_ context.Context, old, new, meta any) bool {
 				return new.(int) < old.(int)
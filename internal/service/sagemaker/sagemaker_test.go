@@ -38,16 +38,17 @@ func TestAccSageMaker_serial(t *testing.T) {
 			"resourceSpecLifecycle": testAccApp_resourceSpecLifecycle,
 			"space":                 testAccApp_space,
 			"decodeAppId":           testAccDecodeAppID,
+			"Identity":              testAccSageMakerApp_IdentitySerial,
 		},
 		"Domain": {
-			acctest.CtBasic:                            testAccDomain_basic,
-			acctest.CtDisappears:                       testAccDomain_disappears,
-			"tags":                                     testAccDomain_tags,
-			"tensorboardAppSettings":                   testAccDomain_tensorboardAppSettings,
-			"tensorboardAppSettingsWithImage":          testAccDomain_tensorboardAppSettingsWithImage,
-			"kernelGatewayAppSettings":                 testAccDomain_kernelGatewayAppSettings,
-			"kernelGatewayAppSettings_customImage":     testAccDomain_kernelGatewayAppSettings_customImage,
-			"kernelGatewayAppSettings_lifecycleConfig": testAccDomain_kernelGatewayAppSettings_lifecycleConfig,
+			acctest.CtBasic:                                           testAccDomain_basic,
+			acctest.CtDisappears:                                      testAccDomain_disappears,
+			"tags":                                                    testAccDomain_tags,
+			"tensorboardAppSettings":                                  testAccDomain_tensorboardAppSettings,
+			"tensorboardAppSettingsWithImage":                         testAccDomain_tensorboardAppSettingsWithImage,
+			"kernelGatewayAppSettings":                                testAccDomain_kernelGatewayAppSettings,
+			"kernelGatewayAppSettings_customImage":                    testAccDomain_kernelGatewayAppSettings_customImage,
+			"kernelGatewayAppSettings_lifecycleConfig":                testAccDomain_kernelGatewayAppSettings_lifecycleConfig,
 			"kernelGatewayAppSettings_defaultResourceAndCustomImage":  testAccDomain_kernelGatewayAppSettings_defaultResourceSpecAndCustomImage,
 			"jupyterServerAppSettings":                                testAccDomain_jupyterServerAppSettings,
 			"codeEditorAppSettings":                                   testAccDomain_codeEditorAppSettings,
@@ -87,6 +88,7 @@ func TestAccSageMaker_serial(t *testing.T) {
 			"studioWebPortalSettings_hiddenAppTypes":                  testAccDomain_studioWebPortalSettings_hiddenAppTypes,
 			"studioWebPortalSettings_hiddenInstanceTypes":             testAccDomain_studioWebPortalSettings_hiddenInstanceTypes,
 			"studioWebPortalSettings_hiddenMlTools":                   testAccDomain_studioWebPortalSettings_hiddenMlTools,
+			"Identity":                                                testAccSageMakerDomain_IdentitySerial,
 		},
 		"FlowDefinition": {
 			acctest.CtBasic:                  testAccFlowDefinition_basic,
@@ -94,6 +96,7 @@ func TestAccSageMaker_serial(t *testing.T) {
 			"tags":                           testAccFlowDefinition_tags,
 			"HumanLoopConfigPublicWorkforce": testAccFlowDefinition_humanLoopConfig_publicWorkforce,
 			"HumanLoopRequestSource":         testAccFlowDefinition_humanLoopRequestSource,
+			"Identity":                       testAccSageMakerFlowDefinition_IdentitySerial,
 		},
 		"Space": {
 			acctest.CtBasic:            testAccSpace_basic,
@@ -108,6 +111,7 @@ func TestAccSageMaker_serial(t *testing.T) {
 			"codeEditorAppSettings":                    testAccSpace_codeEditorAppSettings,
 			"storageSettings":                          testAccSpace_storageSettings,
 			"customFileSystem":                         testAccSpace_customFileSystem,
+			"Identity":                                 testAccSageMakerSpace_IdentitySerial,
 		},
 		"UserProfile": {
 			acctest.CtBasic:                            testAccUserProfile_basic,
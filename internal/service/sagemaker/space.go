@@ -34,15 +34,17 @@ import (
 
 // @SDKResource("aws_sagemaker_space", name="Space")
 // @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sagemaker;sagemaker.DescribeSpaceOutput")
+// @Testing(serialize=true)
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceSpace() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSpaceCreate,
 		ReadWithoutTimeout:   resourceSpaceRead,
 		UpdateWithoutTimeout: resourceSpaceUpdate,
 		DeleteWithoutTimeout: resourceSpaceDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
@@ -29,15 +29,17 @@ import (
 
 // @SDKResource("aws_sagemaker_studio_lifecycle_config", name="Studio Lifecycle Config")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("studio_lifecycle_config_name")
+// @Testing(hasExistsFunction=false)
+// @Testing(checkDestroyNoop=true)
+// @Testing(preIdentityVersion="v6.31.1")
+// @Testing(idAttrDuplicates="studio_lifecycle_config_name")
 func resourceStudioLifecycleConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStudioLifecycleConfigCreate,
 		ReadWithoutTimeout:   resourceStudioLifecycleConfigRead,
 		UpdateWithoutTimeout: resourceStudioLifecycleConfigUpdate,
 		DeleteWithoutTimeout: resourceStudioLifecycleConfigDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
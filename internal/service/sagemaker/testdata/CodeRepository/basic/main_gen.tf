@@ -0,0 +1,16 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_sagemaker_code_repository" "test" {
+  code_repository_name = var.rName
+
+  git_config {
+    repository_url = "https://github.com/hashicorp/terraform-provider-aws.git"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,43 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name               = var.rName
+  path               = "/"
+  assume_role_policy = data.aws_iam_policy_document.test.json
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["sagemaker.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_sagemaker_feature_group" "test" {
+  feature_group_name             = var.rName
+  record_identifier_feature_name = var.rName
+  event_time_feature_name        = var.rName
+  role_arn                       = aws_iam_role.test.arn
+
+  feature_definition {
+    feature_name = var.rName
+    feature_type = "String"
+  }
+
+  online_store_config {
+    enable_online_store = true
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,140 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_sagemaker_human_task_ui" "test" {
+  human_task_ui_name = var.rName
+
+  ui_template {
+    content = file("test-fixtures/sagemaker-human-task-ui-tmpl.html")
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = var.rName
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name               = var.rName
+  path               = "/"
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["sagemaker.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = var.rName
+  role = aws_iam_role.test.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:PutObject"
+      ],
+      "Resource": [
+        "${aws_s3_bucket.test.arn}/*"
+      ]
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:GetBucketLocation"
+      ],
+      "Resource": [
+        "*"
+      ]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_cognito_user_pool" "test" {
+  name = var.rName
+}
+
+resource "aws_cognito_user_pool_client" "test" {
+  name            = var.rName
+  generate_secret = true
+  user_pool_id    = aws_cognito_user_pool.test.id
+}
+
+resource "aws_cognito_user_pool_domain" "test" {
+  domain       = var.rName
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+resource "aws_cognito_user_group" "test" {
+  name         = var.rName
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+resource "aws_sagemaker_workforce" "test" {
+  workforce_name = var.rName
+
+  cognito_config {
+    client_id = aws_cognito_user_pool_client.test.id
+    user_pool = aws_cognito_user_pool_domain.test.user_pool_id
+  }
+}
+
+resource "aws_sagemaker_workteam" "test" {
+  workteam_name  = var.rName
+  workforce_name = aws_sagemaker_workforce.test.id
+  description    = var.rName
+
+  member_definition {
+    cognito_member_definition {
+      client_id  = aws_cognito_user_pool_client.test.id
+      user_pool  = aws_cognito_user_pool_domain.test.user_pool_id
+      user_group = aws_cognito_user_group.test.id
+    }
+  }
+}
+
+resource "aws_sagemaker_flow_definition" "test" {
+  flow_definition_name = var.rName
+  role_arn             = aws_iam_role.test.arn
+
+  human_loop_config {
+    human_task_ui_arn                     = aws_sagemaker_human_task_ui.test.arn
+    task_availability_lifetime_in_seconds = 1
+    task_count                            = 1
+    task_description                      = var.rName
+    task_title                            = var.rName
+    workteam_arn                          = aws_sagemaker_workteam.test.arn
+  }
+
+  output_config {
+    s3_output_path = "s3://${aws_s3_bucket.test.bucket}/"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "6.31.1"
+    }
+  }
+}
+
+provider "aws" {}
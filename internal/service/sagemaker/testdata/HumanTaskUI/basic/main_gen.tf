@@ -0,0 +1,16 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_sagemaker_human_task_ui" "test" {
+  human_task_ui_name = var.rName
+
+  ui_template {
+    content = file("test-fixtures/sagemaker-human-task-ui-tmpl.html")
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
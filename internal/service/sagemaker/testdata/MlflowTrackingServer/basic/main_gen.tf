@@ -0,0 +1,60 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name               = var.rName
+  path               = "/"
+  assume_role_policy = data.aws_iam_policy_document.test.json
+  inline_policy {
+    name   = "TrackingServerPolicy"
+    policy = data.aws_iam_policy_document.tracking.json
+  }
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["sagemaker.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+}
+
+data "aws_iam_policy_document" "tracking" {
+  statement {
+    sid    = "Tracking"
+    effect = "Allow"
+    actions = [
+      "s3:Get*",
+      "s3:Put*",
+      "s3:List*",
+      "sagemaker:AddTags",
+      "sagemaker:CreateModelPackageGroup",
+      "sagemaker:CreateModelPackage",
+      "sagemaker:UpdateModelPackage",
+      "sagemaker:DescribeModelPackageGroup"
+    ]
+    resources = ["*"]
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = var.rName
+  force_destroy = true
+}
+
+resource "aws_sagemaker_mlflow_tracking_server" "test" {
+  tracking_server_name = var.rName
+  role_arn             = aws_iam_role.test.arn
+  artifact_store_uri   = "s3://${aws_s3_bucket.test.bucket}/path"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
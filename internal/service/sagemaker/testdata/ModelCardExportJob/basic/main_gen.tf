@@ -0,0 +1,29 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_sagemaker_model_card" "test" {
+  model_card_name   = "${var.rName}-card"
+  model_card_status = "Draft"
+
+  content = "{}"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = var.rName
+  force_destroy = true
+}
+
+resource "aws_sagemaker_model_card_export_job" "test" {
+  model_card_export_job_name = var.rName
+  model_card_name            = aws_sagemaker_model_card.test.model_card_name
+
+  output_config {
+    s3_output_path = "s3://${aws_s3_bucket.test.bucket}/"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,42 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid       = "AddPermModelPackageGroup"
+    actions   = ["sagemaker:DescribeModelPackage", "sagemaker:ListModelPackages"]
+    resources = [aws_sagemaker_model_package_group.test.arn]
+    principals {
+      identifiers = ["arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"]
+      type        = "AWS"
+    }
+  }
+}
+
+resource "aws_sagemaker_model_package_group" "test" {
+  model_package_group_name = var.rName
+}
+
+resource "aws_sagemaker_model_package_group_policy" "test" {
+  model_package_group_name = aws_sagemaker_model_package_group.test.model_package_group_name
+  resource_policy          = jsonencode(jsondecode(data.aws_iam_policy_document.test.json))
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "6.31.1"
+    }
+  }
+}
+
+provider "aws" {}
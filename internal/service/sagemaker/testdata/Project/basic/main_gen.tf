@@ -0,0 +1,151 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_s3_bucket" "test" {
+  bucket        = var.rName
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "${var.rName}.json"
+
+  content = jsonencode({
+    AWSTemplateFormatVersion = "2010-09-09"
+    Parameters = {
+      SageMakerProjectName = {
+        Type        = "String"
+        Description = "Name of the project"
+      }
+      SageMakerProjectId = {
+        Type        = "String"
+        Description = "Service generated Id of the project."
+      }
+    }
+
+    Resources = {
+      MyVPC = {
+        Type = "AWS::EC2::VPC"
+        Properties = {
+          CidrBlock = "10.1.0.0/16"
+        }
+      }
+    }
+
+    Outputs = {
+      VpcID = {
+        Description = "VPC ID"
+        Value = {
+          Ref = "MyVPC"
+        }
+      }
+    }
+  })
+}
+
+resource "aws_servicecatalog_product" "test" {
+  name  = var.rName
+  owner = var.rName
+  type  = "CLOUD_FORMATION_TEMPLATE"
+
+  provisioning_artifact_parameters {
+    disable_template_validation = true
+    name                        = var.rName
+    template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.test.key}"
+    type                        = "CLOUD_FORMATION_TEMPLATE"
+  }
+}
+
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = var.rName
+  description   = var.rName
+  provider_name = var.rName
+}
+
+resource "aws_servicecatalog_product_portfolio_association" "test" {
+  portfolio_id = aws_servicecatalog_portfolio.test.id
+  product_id   = aws_servicecatalog_product.test.id
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = var.rName
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "servicecatalog.${data.aws_partition.current.dns_suffix}"
+      }
+      Sid = ""
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = var.rName
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action = [
+          "cloudformation:CreateStack",
+          "cloudformation:DeleteStack",
+          "cloudformation:DescribeStackEvents",
+          "cloudformation:DescribeStacks",
+          "cloudformation:GetTemplateSummary",
+          "cloudformation:SetStackPolicy",
+          "cloudformation:ValidateTemplate",
+          "cloudformation:UpdateStack",
+          "s3:GetObject",
+          "servicecatalog:*",
+          "ec2:*"
+        ]
+        Effect   = "Allow"
+        Resource = "*"
+      },
+    ]
+  })
+}
+
+resource "aws_servicecatalog_constraint" "test" {
+  portfolio_id = aws_servicecatalog_product_portfolio_association.test.portfolio_id
+  product_id   = aws_servicecatalog_product_portfolio_association.test.product_id
+  type         = "LAUNCH"
+
+  parameters = jsonencode({
+    "RoleArn" : aws_iam_role.test.arn
+  })
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "test" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_servicecatalog_principal_portfolio_association" "test" {
+  portfolio_id  = aws_servicecatalog_product_portfolio_association.test.portfolio_id
+  principal_arn = data.aws_iam_session_context.test.issuer_arn
+}
+
+resource "aws_sagemaker_project" "test" {
+  project_name = var.rName
+
+  service_catalog_provisioning_details {
+    product_id = aws_servicecatalog_constraint.test.product_id
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -38,6 +38,13 @@ import (
 )
 
 // @SDKResource("aws_scheduler_schedule", name="Schedule")
+// @IdentityAttribute("group_name")
+// @IdentityAttribute("name")
+// @IdAttrFormat("{group_name}/{name}")
+// @ImportIDHandler("scheduleImportID")
+// @Testing(preIdentityVersion="v6.31.0")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/scheduler;scheduler.GetScheduleOutput")
 func resourceSchedule() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceScheduleCreate,
@@ -45,10 +52,6 @@ func resourceSchedule() *schema.Resource {
 		UpdateWithoutTimeout: resourceScheduleUpdate,
 		DeleteWithoutTimeout: resourceScheduleDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			"action_after_completion": {
 				Type:             schema.TypeString,
@@ -717,6 +720,26 @@ func scheduleParseResourceID(id string) (string, string, error) {
 	return parts[0], parts[1], nil
 }
 
+type scheduleImportID struct{}
+
+func (scheduleImportID) Create(d *schema.ResourceData) string {
+	return scheduleCreateResourceID(d.Get(names.AttrGroupName).(string), d.Get(names.AttrName).(string))
+}
+
+func (scheduleImportID) Parse(id string) (string, map[string]string, error) {
+	groupName, scheduleName, err := scheduleParseResourceID(id)
+	if err != nil {
+		return id, nil, err
+	}
+
+	results := map[string]string{
+		names.AttrGroupName: groupName,
+		names.AttrName:      scheduleName,
+	}
+
+	return id, results, nil
+}
+
 func expandCapacityProviderStrategyItem(tfMap map[string]any) awstypes.CapacityProviderStrategyItem {
 	if tfMap == nil {
 		return awstypes.CapacityProviderStrategyItem{}
@@ -31,6 +31,10 @@ import (
 
 // @SDKResource("aws_scheduler_schedule_group", name="Schedule Group")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(preIdentityVersion="v6.31.0")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/scheduler;scheduler.GetScheduleGroupOutput")
 func resourceScheduleGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceScheduleGroupCreate,
@@ -38,10 +42,6 @@ func resourceScheduleGroup() *schema.Resource {
 		UpdateWithoutTimeout: resourceScheduleGroupUpdate,
 		DeleteWithoutTimeout: resourceScheduleGroupDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
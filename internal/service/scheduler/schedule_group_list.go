@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_scheduler_schedule_group")
+func newScheduleGroupResourceAsListResource() inttypes.ListResourceForSDK {
+	l := listResourceScheduleGroup{}
+	l.SetResourceSchema(resourceScheduleGroup())
+	return &l
+}
+
+var _ list.ListResource = &listResourceScheduleGroup{}
+
+type listResourceScheduleGroup struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *listResourceScheduleGroup) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	conn := l.Meta().SchedulerClient(ctx)
+
+	var query listScheduleGroupModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing EventBridge Scheduler Schedule Group")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input scheduler.ListScheduleGroupsInput
+		for item, err := range listScheduleGroups(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			id := aws.ToString(item.Name)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			tflog.Info(ctx, "Reading EventBridge Scheduler Schedule Group")
+			diags := resourceScheduleGroupRead(ctx, rd, l.Meta())
+			if diags.HasError() {
+				tflog.Error(ctx, "Reading EventBridge Scheduler Schedule Group", map[string]any{
+					names.AttrID: id,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+			if rd.Id() == "" {
+				// Resource is logically deleted
+				continue
+			}
+
+			result.DisplayName = id
+
+			l.SetResult(ctx, l.Meta(), request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+type listScheduleGroupModel struct {
+	framework.WithRegionModel
+}
+
+func listScheduleGroups(ctx context.Context, conn *scheduler.Client, input *scheduler.ListScheduleGroupsInput) iter.Seq2[awstypes.ScheduleGroupSummary, error] {
+	return func(yield func(awstypes.ScheduleGroupSummary, error) bool) {
+		err := listScheduleGroupsPages(ctx, conn, input, func(page *scheduler.ListScheduleGroupsOutput, lastPage bool) bool {
+			for _, item := range page.ScheduleGroups {
+				if !yield(item, nil) {
+					return false
+				}
+			}
+			return !lastPage
+		})
+		if err != nil {
+			yield(awstypes.ScheduleGroupSummary{}, fmt.Errorf("listing EventBridge Scheduler Schedule Group resources: %w", err))
+			return
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_scheduler_schedule")
+func newScheduleResourceAsListResource() inttypes.ListResourceForSDK { // nosemgrep:ci.scheduler-in-func-name
+	l := listResourceSchedule{}
+	l.SetResourceSchema(resourceSchedule())
+	return &l
+}
+
+var _ list.ListResource = &listResourceSchedule{}
+
+type listResourceSchedule struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *listResourceSchedule) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrGroupName: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the schedule group to list schedules in. Defaults to all schedule groups.",
+			},
+			names.AttrNamePrefix: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Name prefix to filter schedules by.",
+			},
+		},
+	}
+}
+
+func (l *listResourceSchedule) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	conn := l.Meta().SchedulerClient(ctx)
+
+	var query listScheduleModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing EventBridge Scheduler Schedule")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := scheduler.ListSchedulesInput{
+			GroupName:  query.GroupName.ValueStringPointer(),
+			NamePrefix: query.NamePrefix.ValueStringPointer(),
+		}
+
+		for item, err := range listSchedules(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			groupName, scheduleName := aws.ToString(item.GroupName), aws.ToString(item.Name)
+			id := scheduleCreateResourceID(groupName, scheduleName)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(id)
+			rd.Set(names.AttrGroupName, groupName)
+			rd.Set(names.AttrName, scheduleName)
+
+			tflog.Info(ctx, "Reading EventBridge Scheduler Schedule")
+			diags := resourceScheduleRead(ctx, rd, l.Meta())
+			if diags.HasError() {
+				tflog.Error(ctx, "Reading EventBridge Scheduler Schedule", map[string]any{
+					names.AttrID: id,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+			if rd.Id() == "" {
+				// Resource is logically deleted
+				continue
+			}
+
+			result.DisplayName = id
+
+			l.SetResult(ctx, l.Meta(), request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+type listScheduleModel struct {
+	framework.WithRegionModel
+	GroupName  types.String `tfsdk:"group_name"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+}
+
+func listSchedules(ctx context.Context, conn *scheduler.Client, input *scheduler.ListSchedulesInput) iter.Seq2[awstypes.ScheduleSummary, error] {
+	return func(yield func(awstypes.ScheduleSummary, error) bool) {
+		err := listSchedulesPages(ctx, conn, input, func(page *scheduler.ListSchedulesOutput, lastPage bool) bool {
+			for _, item := range page.Schedules {
+				if !yield(item, nil) {
+					return false
+				}
+			}
+			return !lastPage
+		})
+		if err != nil {
+			yield(awstypes.ScheduleSummary{}, fmt.Errorf("listing EventBridge Scheduler Schedule resources: %w", err))
+			return
+		}
+	}
+}
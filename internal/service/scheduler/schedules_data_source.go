@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_scheduler_schedules", name="Schedules")
+func newSchedulesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &schedulesDataSource{}, nil
+}
+
+type schedulesDataSource struct {
+	framework.DataSourceWithModel[schedulesDataSourceModel]
+}
+
+func (d *schedulesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrGroupName: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			names.AttrNamePrefix: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"schedules": framework.DataSourceComputedListOfObjectAttribute[scheduleSummaryModel](ctx),
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ScheduleState](),
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *schedulesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data schedulesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SchedulerClient(ctx)
+
+	var input scheduler.ListSchedulesInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output := scheduler.ListSchedulesOutput{
+		Schedules: make([]awstypes.ScheduleSummary, 0),
+	}
+	for item, err := range listSchedules(ctx, conn, &input) {
+		if err != nil {
+			response.Diagnostics.AddError("reading EventBridge Scheduler Schedules", err.Error())
+			return
+		}
+
+		output.Schedules = append(output.Schedules, item)
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, &output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type schedulesDataSourceModel struct {
+	framework.WithRegionModel
+	GroupName  types.String                                          `tfsdk:"group_name"`
+	NamePrefix types.String                                          `tfsdk:"name_prefix"`
+	Schedules  fwtypes.ListNestedObjectValueOf[scheduleSummaryModel] `tfsdk:"schedules"`
+	State      fwtypes.StringEnum[awstypes.ScheduleState]            `tfsdk:"state"`
+}
+
+type scheduleSummaryModel struct {
+	ARN                  types.String                                        `tfsdk:"arn"`
+	CreationDate         timetypes.RFC3339                                   `tfsdk:"creation_date"`
+	GroupName            types.String                                        `tfsdk:"group_name"`
+	LastModificationDate timetypes.RFC3339                                   `tfsdk:"last_modification_date"`
+	Name                 types.String                                        `tfsdk:"name"`
+	State                fwtypes.StringEnum[awstypes.ScheduleState]          `tfsdk:"state"`
+	Target               fwtypes.ListNestedObjectValueOf[targetSummaryModel] `tfsdk:"target"`
+}
+
+type targetSummaryModel struct {
+	ARN types.String `tfsdk:"arn"`
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSchedulerSchedulesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_scheduler_schedules.test"
+	resourceName := "aws_scheduler_schedule.test.0"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "schedules.#", "3"),
+					resource.TestCheckResourceAttr("data.aws_scheduler_schedules.name_prefix", "schedules.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_scheduler_schedules.state", "schedules.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "schedules.*.arn", resourceName, names.AttrARN),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "schedules.*", map[string]string{
+						names.AttrGroupName: rName,
+						names.AttrName:      rName + "-a-0",
+						names.AttrState:     "ENABLED",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "schedules.*.target.0.arn", "aws_sqs_queue.test", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair("data.aws_scheduler_schedules.state", "schedules.*.arn", "aws_scheduler_schedule.disabled", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccSchedulesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_scheduler_schedule_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule" "test" {
+  count = 2
+
+  name       = "%[1]s-a-${count.index}"
+  group_name = aws_scheduler_schedule_group.test.name
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+
+resource "aws_scheduler_schedule" "disabled" {
+  name       = "%[1]s-b"
+  group_name = aws_scheduler_schedule_group.test.name
+  state      = "DISABLED"
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+
+data "aws_scheduler_schedules" "test" {
+  group_name = aws_scheduler_schedule_group.test.name
+
+  depends_on = [aws_scheduler_schedule.test, aws_scheduler_schedule.disabled]
+}
+
+data "aws_scheduler_schedules" "name_prefix" {
+  group_name  = aws_scheduler_schedule_group.test.name
+  name_prefix = "%[1]s-a-"
+
+  depends_on = [aws_scheduler_schedule.test, aws_scheduler_schedule.disabled]
+}
+
+data "aws_scheduler_schedules" "state" {
+  group_name = aws_scheduler_schedule_group.test.name
+  state      = "DISABLED"
+
+  depends_on = [aws_scheduler_schedule.test, aws_scheduler_schedule.disabled]
+}
+`, rName))
+}
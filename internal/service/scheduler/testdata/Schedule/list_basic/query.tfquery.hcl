@@ -0,0 +1,9 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_scheduler_schedule" "test" {
+  provider = aws
+  config {
+    group_name = var.rName
+  }
+}
@@ -126,11 +126,10 @@ func resourceService() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"failure_threshold": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							ForceNew: true,
-							Deprecated: "failure_threshold is deprecated. The argument is no longer supported by AWS and the " +
-								"value is always set to 1. The attribute will be removed in a future major version.",
+							Type:       schema.TypeInt,
+							Optional:   true,
+							ForceNew:   true,
+							Deprecated: names.ResourceDeprecationMessage("aws_service_discovery_service", "health_check_custom_config.failure_threshold"),
 						},
 					},
 				},
@@ -82,7 +82,7 @@ func (r *templateResource) Schema(ctx context.Context, request resource.SchemaRe
 					stringplanmodifier.RequiresReplace(),
 					stringplanmodifier.UseStateForUnknown(),
 				},
-				DeprecationMessage: "region is deprecated. Use aws_region instead.",
+				DeprecationMessage: names.ResourceDeprecationMessage("aws_servicequotas_template", names.AttrRegion),
 			},
 			"service_code": schema.StringAttribute{
 				Required: true,
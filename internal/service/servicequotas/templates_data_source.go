@@ -41,7 +41,7 @@ func (d *dataSourceTemplates) Schema(ctx context.Context, request datasource.Sch
 			names.AttrID: framework.IDAttribute(),
 			names.AttrRegion: schema.StringAttribute{
 				Optional:           true,
-				DeprecationMessage: "region is deprecated. Use aws_region instead.",
+				DeprecationMessage: names.DataSourceDeprecationMessage("aws_servicequotas_templates", names.AttrRegion),
 			},
 			"templates": framework.DataSourceComputedListOfObjectAttribute[serviceQuotaIncreaseRequestInTemplateModel](ctx),
 		},
@@ -89,7 +89,7 @@ func resourceReplicationSet() *schema.Resource {
 						},
 					},
 				},
-				Deprecated: "region is deprecated. Use regions instead.",
+				Deprecated: names.ResourceDeprecationMessage("aws_ssmincidents_replication_set", names.AttrRegion),
 			},
 			"regions": {
 				Type:     schema.TypeSet,
@@ -1113,6 +1113,104 @@ func expandRuleGroupRulesJSON(rawRules string) ([]awstypes.Rule, error) {
 	return rules, nil
 }
 
+// expandWebACLStatementJSON decodes a single statement in the JSON format accepted by expandWebACLRulesJSON.
+func expandWebACLStatementJSON(rawStatement string) (*awstypes.Statement, error) {
+	var temp any
+	err := tfjson.DecodeFromBytes([]byte(rawStatement), &temp)
+	if err != nil {
+		return nil, fmt.Errorf("decoding JSON: %w", err)
+	}
+
+	walkWebACLJSON(reflect.ValueOf(temp))
+
+	out, err := tfjson.EncodeToBytes(temp)
+	if err != nil {
+		return nil, err
+	}
+
+	var statement awstypes.Statement
+	err = tfjson.DecodeFromBytes(out, &statement)
+	if err != nil {
+		return nil, err
+	}
+
+	if reflect.ValueOf(statement).IsZero() {
+		return nil, errors.New("invalid Statement supplied")
+	}
+	return &statement, nil
+}
+
+// flattenWebACLRulesJSON encodes rules in the JSON format accepted by expandWebACLRulesJSON.
+// Null values are omitted and byte match search strings are not base64 encoded.
+func flattenWebACLRulesJSON(rules []awstypes.Rule) (string, error) {
+	out, err := tfjson.EncodeToBytes(rules)
+	if err != nil {
+		return "", err
+	}
+
+	var temp any
+	err = tfjson.DecodeFromBytes(out, &temp)
+	if err != nil {
+		return "", err
+	}
+
+	temp, err = walkFlattenWebACLJSON(temp)
+	if err != nil {
+		return "", err
+	}
+
+	if temp == nil {
+		temp = []any{}
+	}
+
+	json, err := tfjson.EncodeToString(temp)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(json), nil
+}
+
+func walkFlattenWebACLJSON(v any) (any, error) {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if val == nil {
+				delete(v, k)
+				continue
+			}
+
+			if k == "ByteMatchStatement" {
+				if st, ok := val.(map[string]any); ok {
+					if str, ok := st["SearchString"].(string); ok {
+						b, err := inttypes.Base64Decode(str)
+						if err != nil {
+							return nil, fmt.Errorf("decoding SearchString: %w", err)
+						}
+						st["SearchString"] = string(b)
+					}
+				}
+			}
+
+			val, err := walkFlattenWebACLJSON(val)
+			if err != nil {
+				return nil, err
+			}
+			v[k] = val
+		}
+	case []any:
+		for i, val := range v {
+			val, err := walkFlattenWebACLJSON(val)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = val
+		}
+	}
+
+	return v, nil
+}
+
 func walkWebACLJSON(v reflect.Value) {
 	m := map[string][]struct {
 		key        string
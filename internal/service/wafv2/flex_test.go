@@ -157,3 +157,90 @@ func Test_expandWebACLRulesJSON(t *testing.T) {
 		})
 	}
 }
+
+func Test_flattenWebACLRulesJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rules []awstypes.Rule
+		want  string
+	}{
+		"nil": {
+			want: `[]`,
+		},
+		"empty": {
+			rules: []awstypes.Rule{},
+			want:  `[]`,
+		},
+		"SearchString": {
+			rules: []awstypes.Rule{
+				{
+					Name:     aws.String("test_rule0"),
+					Priority: 0,
+					Action: &awstypes.RuleAction{
+						Block: &awstypes.BlockAction{},
+					},
+					VisibilityConfig: &awstypes.VisibilityConfig{
+						SampledRequestsEnabled:   true,
+						CloudWatchMetricsEnabled: true,
+						MetricName:               aws.String("test_rule0"),
+					},
+					Statement: &awstypes.Statement{
+						NotStatement: &awstypes.NotStatement{
+							Statement: &awstypes.Statement{
+								ByteMatchStatement: &awstypes.ByteMatchStatement{
+									SearchString: []byte("test"),
+									FieldToMatch: &awstypes.FieldToMatch{
+										UriPath: &awstypes.UriPath{},
+									},
+									TextTransformations: []awstypes.TextTransformation{
+										{
+											Priority: 0,
+											Type:     awstypes.TextTransformationTypeNone,
+										},
+									},
+									PositionalConstraint: awstypes.PositionalConstraintExactly,
+								},
+							},
+						},
+					},
+				},
+			},
+			want: `[{"Action":{"Block":{}},"Name":"test_rule0","Priority":0,"Statement":{"NotStatement":{"Statement":{"ByteMatchStatement":{"FieldToMatch":{"UriPath":{}},"PositionalConstraint":"EXACTLY","SearchString":"test","TextTransformations":[{"Priority":0,"Type":"NONE"}]}}}},"VisibilityConfig":{"CloudWatchMetricsEnabled":true,"MetricName":"test_rule0","SampledRequestsEnabled":true}}]`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := flattenWebACLRulesJSON(tc.rules)
+			if err != nil {
+				t.Fatalf("flattenWebACLRulesJSON() error = %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("flattenWebACLRulesJSON() = %s, want %s", got, tc.want)
+			}
+
+			// The output must be accepted by expandWebACLRulesJSON.
+			rules, err := expandWebACLRulesJSON(got)
+			if err != nil {
+				t.Fatalf("expandWebACLRulesJSON() error = %v", err)
+			}
+			if diff := cmp.Diff(rules, tc.rules, cmpopts.EquateEmpty(), cmpopts.IgnoreUnexported(
+				awstypes.Rule{},
+				awstypes.RuleAction{},
+				awstypes.BlockAction{},
+				awstypes.VisibilityConfig{},
+				awstypes.Statement{},
+				awstypes.NotStatement{},
+				awstypes.ByteMatchStatement{},
+				awstypes.FieldToMatch{},
+				awstypes.UriPath{},
+				awstypes.TextTransformation{},
+			)); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
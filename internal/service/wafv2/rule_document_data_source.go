@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Maximum number of web ACL capacity units (WCUs) that a web ACL can use.
+	webACLCapacityMax = 5000
+)
+
+// @SDKDataSource("aws_wafv2_rule_document", name="Rule Document")
+func dataSourceRuleDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRuleDocumentRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			ruleSchema := webACLRuleResourceSchema()
+			ruleSchema.Schema["statement"].Required = false
+			ruleSchema.Schema["statement"].Optional = true
+			ruleSchema.Schema["statement_json"] = &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			}
+
+			return map[string]*schema.Schema{
+				"capacity": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"capacity_limit": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      webACLCapacityMax,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				names.AttrJSON: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"override_rules_json": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsJSON,
+					},
+				},
+				names.AttrRule: {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     ruleSchema,
+				},
+				names.AttrScope: {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.Scope](),
+				},
+				"source_rules_json": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsJSON,
+					},
+				},
+			}
+		},
+	}
+}
+
+func dataSourceRuleDocumentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	var rules ruleDocument
+
+	// Rules from source documents must have unique names.
+	for i, v := range d.Get("source_rules_json").([]any) {
+		if v == nil {
+			continue
+		}
+
+		sourceRules, err := expandWebACLRulesJSON(v.(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "source_rules_json (%d): %s", i, err)
+		}
+
+		for _, rule := range sourceRules {
+			if !rules.add(rule) {
+				return sdkdiag.AppendErrorf(diags, "source_rules_json (%d): duplicate rule name (%s)", i, aws.ToString(rule.Name))
+			}
+		}
+	}
+
+	// Rules configured in the data source replace source rules with the same name.
+	for i, v := range d.Get(names.AttrRule).([]any) {
+		if v == nil {
+			continue
+		}
+
+		tfMap := v.(map[string]any)
+		statementJSON := tfMap["statement_json"].(string)
+		hasStatement := len(tfMap["statement"].([]any)) > 0
+
+		if hasStatement == (statementJSON != "") {
+			return sdkdiag.AppendErrorf(diags, "rule (%d): exactly one of statement or statement_json must be set", i)
+		}
+
+		if hasStatement {
+			rules.put(expandWebACLRule(tfMap))
+			continue
+		}
+
+		tfMap["statement"] = []any{}
+		rule := expandWebACLRule(tfMap)
+		statement, err := expandWebACLStatementJSON(statementJSON)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "rule (%d) statement_json: %s", i, err)
+		}
+		rule.Statement = statement
+
+		rules.put(rule)
+	}
+
+	// Rules from override documents replace all other rules with the same name.
+	for i, v := range d.Get("override_rules_json").([]any) {
+		if v == nil {
+			continue
+		}
+
+		overrideRules, err := expandWebACLRulesJSON(v.(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "override_rules_json (%d): %s", i, err)
+		}
+
+		for _, rule := range overrideRules {
+			rules.put(rule)
+		}
+	}
+
+	slices.SortStableFunc(rules, func(a, b awstypes.Rule) int {
+		return cmp.Compare(a.Priority, b.Priority)
+	})
+
+	for i := 1; i < len(rules); i++ {
+		if rules[i].Priority == rules[i-1].Priority {
+			return sdkdiag.AppendErrorf(diags, "rules %s and %s have the same priority (%d)", aws.ToString(rules[i-1].Name), aws.ToString(rules[i].Name), rules[i].Priority)
+		}
+	}
+
+	json, err := flattenWebACLRulesJSON(rules)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "encoding WAFv2 rules JSON: %s", err)
+	}
+
+	var capacity int64
+	if len(rules) > 0 {
+		input := wafv2.CheckCapacityInput{
+			Rules: rules,
+			Scope: awstypes.Scope(d.Get(names.AttrScope).(string)),
+		}
+
+		output, err := conn.CheckCapacity(ctx, &input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "checking WAFv2 rules capacity: %s", err)
+		}
+
+		capacity = output.Capacity
+	}
+
+	if limit := int64(d.Get("capacity_limit").(int)); capacity > limit {
+		return sdkdiag.AppendErrorf(diags, "WAFv2 rules capacity (%d WCUs) exceeds capacity_limit (%d WCUs)", capacity, limit)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(json)))
+	d.Set("capacity", capacity)
+	d.Set(names.AttrJSON, json)
+
+	return diags
+}
+
+// ruleDocument is a list of rules with unique names.
+type ruleDocument []awstypes.Rule
+
+// add appends the rule if no rule with the same name exists.
+func (r *ruleDocument) add(rule awstypes.Rule) bool {
+	if slices.ContainsFunc(*r, func(v awstypes.Rule) bool { return aws.ToString(v.Name) == aws.ToString(rule.Name) }) {
+		return false
+	}
+
+	*r = append(*r, rule)
+
+	return true
+}
+
+// put replaces the rule with the same name or appends the rule.
+func (r *ruleDocument) put(rule awstypes.Rule) {
+	if i := slices.IndexFunc(*r, func(v awstypes.Rule) bool { return aws.ToString(v.Name) == aws.ToString(rule.Name) }); i != -1 {
+		(*r)[i] = rule
+		return
+	}
+
+	*r = append(*r, rule)
+}
@@ -0,0 +1,304 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWAFV2RuleDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_wafv2_rule_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleDocumentDataSourceConfig_basic(5000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "capacity"),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccRuleDocumentDataSourceJSON_basic),
+				),
+			},
+			{
+				Config:      testAccRuleDocumentDataSourceConfig_basic(1),
+				ExpectError: regexache.MustCompile(`exceeds capacity_limit \(1 WCUs\)`),
+			},
+		},
+	})
+}
+
+func TestAccWAFV2RuleDocumentDataSource_duplicatePriority(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleDocumentDataSourceConfig_duplicatePriority,
+				ExpectError: regexache.MustCompile(`have the same priority \(1\)`),
+			},
+		},
+	})
+}
+
+func TestAccWAFV2RuleDocumentDataSource_webACL(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.WebACL
+	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleDocumentDataSourceConfig_webACL(webACLName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "capacity", "data.aws_wafv2_rule_document.test", "capacity"),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "rule_json", testAccRuleDocumentDataSourceJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+const testAccRuleDocumentDataSourceJSON_basic = `[
+  {
+    "Action": {"Block": {}},
+    "Name": "geo",
+    "Priority": 1,
+    "Statement": {"GeoMatchStatement": {"CountryCodes": ["NL", "US"]}},
+    "VisibilityConfig": {"CloudWatchMetricsEnabled": false, "MetricName": "geo", "SampledRequestsEnabled": false}
+  },
+  {
+    "Action": {"Count": {}},
+    "Name": "admin",
+    "Priority": 2,
+    "Statement": {
+      "ByteMatchStatement": {
+        "FieldToMatch": {"UriPath": {}},
+        "PositionalConstraint": "STARTS_WITH",
+        "SearchString": "/admin",
+        "TextTransformations": [{"Priority": 0, "Type": "NONE"}]
+      }
+    },
+    "VisibilityConfig": {"CloudWatchMetricsEnabled": false, "MetricName": "admin", "SampledRequestsEnabled": false}
+  },
+  {
+    "Action": {"Allow": {}},
+    "Name": "source",
+    "Priority": 3,
+    "Statement": {"LabelMatchStatement": {"Key": "awswaf:managed:test", "Scope": "LABEL"}},
+    "VisibilityConfig": {"CloudWatchMetricsEnabled": false, "MetricName": "source", "SampledRequestsEnabled": false}
+  }
+]`
+
+const testAccRuleDocumentDataSourceConfig_base = `
+data "aws_wafv2_rule_document" "source" {
+  scope = "REGIONAL"
+
+  rule {
+    name     = "source"
+    priority = 3
+
+    action {
+      allow {}
+    }
+
+    statement {
+      label_match_statement {
+        key   = "awswaf:managed:test"
+        scope = "LABEL"
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "source"
+      sampled_requests_enabled   = false
+    }
+  }
+
+  # Replaced by the rule with the same name in the test document.
+  rule {
+    name     = "geo"
+    priority = 10
+
+    action {
+      count {}
+    }
+
+    statement {
+      geo_match_statement {
+        country_codes = ["DE"]
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "geo"
+      sampled_requests_enabled   = false
+    }
+  }
+}
+`
+
+func testAccRuleDocumentDataSourceConfig_basic(capacityLimit int) string {
+	return acctest.ConfigCompose(testAccRuleDocumentDataSourceConfig_base, fmt.Sprintf(`
+data "aws_wafv2_rule_document" "test" {
+  scope          = "REGIONAL"
+  capacity_limit = %[1]d
+
+  source_rules_json = [data.aws_wafv2_rule_document.source.json]
+
+  rule {
+    name     = "geo"
+    priority = 1
+
+    action {
+      block {}
+    }
+
+    statement {
+      geo_match_statement {
+        country_codes = ["NL", "US"]
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "geo"
+      sampled_requests_enabled   = false
+    }
+  }
+
+  rule {
+    name     = "admin"
+    priority = 2
+
+    statement_json = jsonencode({
+      ByteMatchStatement = {
+        FieldToMatch         = { UriPath = {} }
+        PositionalConstraint = "STARTS_WITH"
+        SearchString         = "/admin"
+        TextTransformations  = [{ Priority = 0, Type = "NONE" }]
+      }
+    })
+
+    action {
+      block {}
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "admin"
+      sampled_requests_enabled   = false
+    }
+  }
+
+  override_rules_json = [jsonencode([{
+    Name     = "admin"
+    Priority = 2
+    Action   = { Count = {} }
+    Statement = {
+      ByteMatchStatement = {
+        FieldToMatch         = { UriPath = {} }
+        PositionalConstraint = "STARTS_WITH"
+        SearchString         = "/admin"
+        TextTransformations  = [{ Priority = 0, Type = "NONE" }]
+      }
+    }
+    VisibilityConfig = {
+      CloudWatchMetricsEnabled = false
+      MetricName               = "admin"
+      SampledRequestsEnabled   = false
+    }
+  }])]
+}
+`, capacityLimit))
+}
+
+const testAccRuleDocumentDataSourceConfig_duplicatePriority = `
+data "aws_wafv2_rule_document" "test" {
+  scope = "REGIONAL"
+
+  rule {
+    name     = "rule-1"
+    priority = 1
+
+    action {
+      block {}
+    }
+
+    statement {
+      geo_match_statement {
+        country_codes = ["NL"]
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "rule-1"
+      sampled_requests_enabled   = false
+    }
+  }
+
+  rule {
+    name     = "rule-2"
+    priority = 1
+
+    action {
+      block {}
+    }
+
+    statement {
+      geo_match_statement {
+        country_codes = ["US"]
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "rule-2"
+      sampled_requests_enabled   = false
+    }
+  }
+}
+`
+
+func testAccRuleDocumentDataSourceConfig_webACL(webACLName string) string {
+	return acctest.ConfigCompose(testAccRuleDocumentDataSourceConfig_basic(5000), fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name      = %[1]q
+  scope     = "REGIONAL"
+  rule_json = data.aws_wafv2_rule_document.test.json
+
+  default_action {
+    allow {}
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, webACLName))
+}
@@ -192,49 +192,7 @@ func resourceWebACL() *schema.Resource {
 					Type:          schema.TypeSet,
 					Optional:      true,
 					ConflictsWith: []string{"rule_json"},
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							names.AttrAction: {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"allow":     allowConfigSchema(),
-										"block":     blockConfigSchema(),
-										"captcha":   captchaConfigSchema(),
-										"challenge": challengeConfigSchema(),
-										"count":     countConfigSchema(),
-									},
-								},
-							},
-							"captcha_config":   outerCaptchaConfigSchema(),
-							"challenge_config": outerChallengeConfigSchema(),
-							names.AttrName: {
-								Type:         schema.TypeString,
-								Required:     true,
-								ValidateFunc: validation.StringLenBetween(1, 128),
-							},
-							"override_action": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"count": emptySchema(),
-										"none":  emptySchema(),
-									},
-								},
-							},
-							names.AttrPriority: {
-								Type:     schema.TypeInt,
-								Required: true,
-							},
-							"rule_label":        ruleLabelsSchema(),
-							"statement":         webACLRootStatementSchema(webACLRootStatementSchemaLevel),
-							"visibility_config": visibilityConfigSchema(),
-						},
-					},
+					Elem:          webACLRuleResourceSchema(),
 				},
 				names.AttrScope: {
 					Type:             schema.TypeString,
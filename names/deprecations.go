@@ -0,0 +1,228 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package names
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DeprecationKind is the kind of provider type that a deprecation applies to.
+type DeprecationKind string
+
+const (
+	DeprecationKindResource   DeprecationKind = "resource"
+	DeprecationKindDataSource DeprecationKind = "data_source"
+)
+
+// Deprecation records a deprecated resource, data source, or argument.
+//
+// Every deprecated resource, data source, and configurable attribute or block in the
+// provider's schemas must be recorded here so that practitioners can plan upgrades
+// across major versions. Computed-only attributes are not recorded as they cannot be
+// used in configuration.
+type Deprecation struct {
+	Kind     DeprecationKind
+	TypeName string
+	// Attribute is the dot-separated path of the deprecated attribute or block, for example "rule.prefix".
+	// An empty Attribute means that the resource or data source itself is deprecated.
+	Attribute string
+	// Replacement is the resource, data source, attribute, or service that replaces the deprecated item, if any.
+	Replacement string
+	// Detail is additional information about the deprecation, if any, as one or more sentences.
+	Detail string
+	// RemovalVersion is the provider version in which the deprecated item will be removed, if scheduled.
+	RemovalVersion string
+}
+
+// Address returns the item's address as shown to practitioners, for example "aws_s3_bucket.acl".
+func (d Deprecation) Address() string {
+	var sb strings.Builder
+
+	if d.Kind == DeprecationKindDataSource {
+		sb.WriteString("data.")
+	}
+	sb.WriteString(d.TypeName)
+	if d.Attribute != "" {
+		sb.WriteString(".")
+		sb.WriteString(d.Attribute)
+	}
+
+	return sb.String()
+}
+
+// Message returns a human-readable summary of the deprecation.
+func (d Deprecation) Message() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s is deprecated.", d.Address())
+	if d.Replacement != "" {
+		fmt.Fprintf(&sb, " Use %s instead.", d.Replacement)
+	}
+	if d.Detail != "" {
+		fmt.Fprintf(&sb, " %s", d.Detail)
+	}
+	if d.RemovalVersion != "" {
+		fmt.Fprintf(&sb, " It will be removed in version %s of the provider.", d.RemovalVersion)
+	} else {
+		sb.WriteString(" It will be removed in a future major version of the provider.")
+	}
+
+	return sb.String()
+}
+
+// Deprecations returns all registered deprecations, ordered by kind, type name, and attribute.
+func Deprecations() []Deprecation {
+	return slices.Clone(deprecations)
+}
+
+// DeprecationsForType returns the registered deprecations of the specified resource or data source type.
+func DeprecationsForType(kind DeprecationKind, typeName string) []Deprecation {
+	var s []Deprecation
+
+	for _, d := range deprecations {
+		if d.Kind == kind && d.TypeName == typeName {
+			s = append(s, d)
+		}
+	}
+
+	return s
+}
+
+// LookupDeprecation returns the registered deprecation of the specified resource, data source, or attribute.
+func LookupDeprecation(kind DeprecationKind, typeName, attribute string) (Deprecation, bool) {
+	i, ok := slices.BinarySearchFunc(deprecations, Deprecation{Kind: kind, TypeName: typeName, Attribute: attribute}, compareDeprecations)
+	if !ok {
+		return Deprecation{}, false
+	}
+
+	return deprecations[i], true
+}
+
+// ResourceDeprecationMessage returns the message of the registered deprecation of the specified resource or resource attribute,
+// for use as the deprecation message in the resource's schema.
+// It panics if the deprecation is not registered.
+func ResourceDeprecationMessage(typeName, attribute string) string {
+	return mustLookupDeprecation(DeprecationKindResource, typeName, attribute).Message()
+}
+
+// DataSourceDeprecationMessage returns the message of the registered deprecation of the specified data source or data source attribute,
+// for use as the deprecation message in the data source's schema.
+// It panics if the deprecation is not registered.
+func DataSourceDeprecationMessage(typeName, attribute string) string {
+	return mustLookupDeprecation(DeprecationKindDataSource, typeName, attribute).Message()
+}
+
+func mustLookupDeprecation(kind DeprecationKind, typeName, attribute string) Deprecation {
+	d, ok := LookupDeprecation(kind, typeName, attribute)
+	if !ok {
+		panic(fmt.Sprintf("deprecation of %s not registered", Deprecation{Kind: kind, TypeName: typeName, Attribute: attribute}.Address()))
+	}
+
+	return d
+}
+
+func compareDeprecations(a, b Deprecation) int {
+	return cmp.Or(
+		cmp.Compare(a.Kind, b.Kind),
+		cmp.Compare(a.TypeName, b.TypeName),
+		cmp.Compare(a.Attribute, b.Attribute),
+	)
+}
+
+func init() {
+	slices.SortFunc(deprecations, compareDeprecations)
+}
+
+const (
+	// removalVersionNextMajor is the next major version of the provider.
+	// Items are scheduled for removal in the next major version once AWS no longer supports them.
+	removalVersionNextMajor = "7.0.0"
+)
+
+const (
+	detailBackwardCompatibility = "This argument is retained only for backward compatibility with previous versions of this data source."
+	detailServiceDiscontinued   = "AWS has discontinued the service."
+)
+
+var deprecations = []Deprecation{
+	// Resources.
+	{Kind: DeprecationKindResource, TypeName: "aws_elastictranscoder_pipeline", Replacement: "AWS Elemental MediaConvert", Detail: detailServiceDiscontinued, RemovalVersion: removalVersionNextMajor},
+	{Kind: DeprecationKindResource, TypeName: "aws_elastictranscoder_preset", Replacement: "AWS Elemental MediaConvert", Detail: detailServiceDiscontinued, RemovalVersion: removalVersionNextMajor},
+	{Kind: DeprecationKindResource, TypeName: "aws_evidently_feature", Replacement: "AWS AppConfig feature flags", Detail: detailServiceDiscontinued, RemovalVersion: removalVersionNextMajor},
+	{Kind: DeprecationKindResource, TypeName: "aws_evidently_launch", Replacement: "AWS AppConfig feature flags", Detail: detailServiceDiscontinued, RemovalVersion: removalVersionNextMajor},
+	{Kind: DeprecationKindResource, TypeName: "aws_evidently_project", Replacement: "AWS AppConfig feature flags", Detail: detailServiceDiscontinued, RemovalVersion: removalVersionNextMajor},
+	{Kind: DeprecationKindResource, TypeName: "aws_evidently_segment", Replacement: "AWS AppConfig feature flags", Detail: detailServiceDiscontinued, RemovalVersion: removalVersionNextMajor},
+	{Kind: DeprecationKindResource, TypeName: "aws_kinesis_analytics_application", Replacement: "the aws_kinesisanalyticsv2_application resource", Detail: detailServiceDiscontinued, RemovalVersion: removalVersionNextMajor},
+	{Kind: DeprecationKindResource, TypeName: "aws_media_store_container", Replacement: "S3, AWS MediaPackage, or other storage solution", Detail: detailServiceDiscontinued, RemovalVersion: removalVersionNextMajor},
+	{Kind: DeprecationKindResource, TypeName: "aws_media_store_container_policy", Replacement: "S3, AWS MediaPackage, or other storage solution", Detail: detailServiceDiscontinued, RemovalVersion: removalVersionNextMajor},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket_object", Replacement: "the aws_s3_object resource"},
+
+	// Resource attributes.
+	{Kind: DeprecationKindResource, TypeName: "aws_arcregionswitch_plan", Attribute: AttrRegion},
+	{Kind: DeprecationKindResource, TypeName: "aws_cloudformation_stack_set_instance", Attribute: AttrRegion, Replacement: "stack_set_instance_region"},
+	{Kind: DeprecationKindResource, TypeName: "aws_cloudwatch_event_rule", Attribute: "is_enabled", Replacement: AttrState},
+	{Kind: DeprecationKindResource, TypeName: "aws_config_aggregate_authorization", Attribute: AttrRegion, Replacement: "authorized_aws_region"},
+	{Kind: DeprecationKindResource, TypeName: "aws_dynamodb_table", Attribute: "global_secondary_index.hash_key", Replacement: "global_secondary_index.key_schema"},
+	{Kind: DeprecationKindResource, TypeName: "aws_dynamodb_table", Attribute: "global_secondary_index.range_key", Replacement: "global_secondary_index.key_schema"},
+	{Kind: DeprecationKindResource, TypeName: "aws_guardduty_detector", Attribute: "datasources", Replacement: "aws_guardduty_detector_feature resources"},
+	{Kind: DeprecationKindResource, TypeName: "aws_guardduty_organization_configuration", Attribute: "datasources", Replacement: "aws_guardduty_organization_configuration_feature resources"},
+	{Kind: DeprecationKindResource, TypeName: "aws_iam_role", Attribute: "inline_policy", Replacement: "the aws_iam_role_policy resource", Detail: "If Terraform should exclusively manage all inline policy associations (the current behavior of this argument), use the aws_iam_role_policies_exclusive resource as well."},
+	{Kind: DeprecationKindResource, TypeName: "aws_iam_role", Attribute: "managed_policy_arns", Replacement: "the aws_iam_role_policy_attachment resource", Detail: "If Terraform should exclusively manage all managed policy attachments (the current behavior of this argument), use the aws_iam_role_policy_attachments_exclusive resource as well."},
+	{Kind: DeprecationKindResource, TypeName: "aws_instance", Attribute: "network_interface", Replacement: "primary_network_interface or the aws_network_interface_attachment resource"},
+	{Kind: DeprecationKindResource, TypeName: "aws_invoicing_invoice_unit", Attribute: AttrRegion},
+	{Kind: DeprecationKindResource, TypeName: "aws_kendra_data_source", Attribute: "configuration.s3_configuration", Replacement: "configuration.template_configuration"},
+	{Kind: DeprecationKindResource, TypeName: "aws_kendra_data_source", Attribute: "configuration.web_crawler_configuration", Replacement: "configuration.template_configuration"},
+	{Kind: DeprecationKindResource, TypeName: "aws_redshift_cluster", Attribute: "aqua_configuration_status", Detail: "This parameter is no longer supported by the AWS API.", RemovalVersion: removalVersionNextMajor},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket", Attribute: "acceleration_status", Replacement: "the aws_s3_bucket_accelerate_configuration resource"},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket", Attribute: "acl", Replacement: "the aws_s3_bucket_acl resource"},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket", Attribute: "cors_rule", Replacement: "the aws_s3_bucket_cors_configuration resource"},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket", Attribute: "grant", Replacement: "the aws_s3_bucket_acl resource"},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket", Attribute: "lifecycle_rule", Replacement: "the aws_s3_bucket_lifecycle_configuration resource"},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket", Attribute: "logging", Replacement: "the aws_s3_bucket_logging resource"},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket", Attribute: "object_lock_configuration", Replacement: "object_lock_enabled and the aws_s3_bucket_object_lock_configuration resource"},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket", Attribute: "object_lock_configuration.object_lock_enabled", Replacement: "object_lock_enabled"},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket", Attribute: "object_lock_configuration.rule", Replacement: "the aws_s3_bucket_object_lock_configuration resource"},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket", Attribute: AttrPolicy, Replacement: "the aws_s3_bucket_policy resource"},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket", Attribute: "replication_configuration", Replacement: "the aws_s3_bucket_replication_configuration resource"},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket", Attribute: "request_payer", Replacement: "the aws_s3_bucket_request_payment_configuration resource"},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket", Attribute: "server_side_encryption_configuration", Replacement: "the aws_s3_bucket_server_side_encryption_configuration resource"},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket", Attribute: "versioning", Replacement: "the aws_s3_bucket_versioning resource"},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket", Attribute: "website", Replacement: "the aws_s3_bucket_website_configuration resource"},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket_abac", Attribute: AttrExpectedBucketOwner},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket_accelerate_configuration", Attribute: AttrExpectedBucketOwner},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket_acl", Attribute: "access_control_policy.owner.display_name", Detail: "This attribute is no longer returned by AWS.", RemovalVersion: removalVersionNextMajor},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket_acl", Attribute: AttrExpectedBucketOwner},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket_cors_configuration", Attribute: AttrExpectedBucketOwner},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket_lifecycle_configuration", Attribute: AttrExpectedBucketOwner},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket_lifecycle_configuration", Attribute: "rule.prefix", Replacement: "rule.filter"},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket_logging", Attribute: AttrExpectedBucketOwner},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket_metadata_configuration", Attribute: AttrExpectedBucketOwner},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket_object_lock_configuration", Attribute: AttrExpectedBucketOwner},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket_replication_configuration", Attribute: "rule.prefix", Replacement: "rule.filter"},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket_request_payment_configuration", Attribute: AttrExpectedBucketOwner},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket_server_side_encryption_configuration", Attribute: AttrExpectedBucketOwner},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket_versioning", Attribute: AttrExpectedBucketOwner},
+	{Kind: DeprecationKindResource, TypeName: "aws_s3_bucket_website_configuration", Attribute: AttrExpectedBucketOwner},
+	{Kind: DeprecationKindResource, TypeName: "aws_service_discovery_service", Attribute: "health_check_custom_config.failure_threshold", Detail: "The argument is no longer supported by AWS and the value is always set to 1.", RemovalVersion: removalVersionNextMajor},
+	{Kind: DeprecationKindResource, TypeName: "aws_servicequotas_template", Attribute: AttrRegion, Replacement: "aws_region"},
+	{Kind: DeprecationKindResource, TypeName: "aws_ssmincidents_replication_set", Attribute: AttrRegion, Replacement: "regions"},
+
+	// Data sources.
+	{Kind: DeprecationKindDataSource, TypeName: "aws_cloudtrail_service_account", Replacement: "a service principal name in IAM policies", Detail: "AWS recommends using a service principal name instead of an AWS account ID in any relevant IAM policy."},
+	{Kind: DeprecationKindDataSource, TypeName: "aws_s3_bucket_object", Replacement: "the aws_s3_object data source"},
+	{Kind: DeprecationKindDataSource, TypeName: "aws_s3_bucket_objects", Replacement: "the aws_s3_objects data source"},
+
+	// Data source attributes.
+	{Kind: DeprecationKindDataSource, TypeName: "aws_arcregionswitch_plan", Attribute: AttrRegion},
+	{Kind: DeprecationKindDataSource, TypeName: "aws_arcregionswitch_route53_health_checks", Attribute: AttrRegion},
+	{Kind: DeprecationKindDataSource, TypeName: "aws_iam_policy_document", Attribute: "override_json", Replacement: "override_policy_documents", Detail: detailBackwardCompatibility},
+	{Kind: DeprecationKindDataSource, TypeName: "aws_iam_policy_document", Attribute: "source_json", Replacement: "source_policy_documents", Detail: detailBackwardCompatibility},
+	{Kind: DeprecationKindDataSource, TypeName: "aws_networkmanager_core_network_policy_document", Attribute: "segment_actions.via.with_edge_override.use_edge", Replacement: "segment_actions.via.with_edge_override.use_edge_location"},
+	{Kind: DeprecationKindDataSource, TypeName: "aws_region", Attribute: AttrName, Replacement: AttrRegion},
+	{Kind: DeprecationKindDataSource, TypeName: "aws_servicequotas_templates", Attribute: AttrRegion, Replacement: "aws_region"},
+	{Kind: DeprecationKindDataSource, TypeName: "aws_vpc_peering_connection", Attribute: AttrRegion, Replacement: "requester_region"},
+}
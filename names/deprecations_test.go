@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package names
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDeprecations(t *testing.T) {
+	t.Parallel()
+
+	s := Deprecations()
+
+	if !slices.IsSortedFunc(s, compareDeprecations) {
+		t.Error("deprecations are not sorted")
+	}
+
+	for i, d := range s {
+		if d.Kind != DeprecationKindResource && d.Kind != DeprecationKindDataSource {
+			t.Errorf("%s: invalid kind %q", d.Address(), d.Kind)
+		}
+		if !strings.HasPrefix(d.TypeName, "aws_") {
+			t.Errorf("%s: invalid type name", d.Address())
+		}
+		if i > 0 && compareDeprecations(s[i-1], d) == 0 {
+			t.Errorf("%s: duplicate deprecation", d.Address())
+		}
+	}
+}
+
+func TestDeprecationMessage(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    Deprecation
+		expected string
+	}{
+		{
+			name:     "resource",
+			input:    Deprecation{Kind: DeprecationKindResource, TypeName: "aws_example_thing", Replacement: "the aws_example_widget resource"},
+			expected: "aws_example_thing is deprecated. Use the aws_example_widget resource instead. It will be removed in a future major version of the provider.",
+		},
+		{
+			name:     "data source attribute",
+			input:    Deprecation{Kind: DeprecationKindDataSource, TypeName: "aws_example_thing", Attribute: "rule.prefix", Replacement: "rule.filter", RemovalVersion: "7.0.0"},
+			expected: "data.aws_example_thing.rule.prefix is deprecated. Use rule.filter instead. It will be removed in version 7.0.0 of the provider.",
+		},
+		{
+			name:     "detail",
+			input:    Deprecation{Kind: DeprecationKindResource, TypeName: "aws_example_thing", Attribute: "status", Replacement: "state", Detail: "The value is always empty.", RemovalVersion: "7.0.0"},
+			expected: "aws_example_thing.status is deprecated. Use state instead. The value is always empty. It will be removed in version 7.0.0 of the provider.",
+		},
+		{
+			name:     "no replacement",
+			input:    Deprecation{Kind: DeprecationKindResource, TypeName: "aws_example_thing", Attribute: "status"},
+			expected: "aws_example_thing.status is deprecated. It will be removed in a future major version of the provider.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.input.Message(), testCase.expected; got != want {
+				t.Errorf("Message() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestLookupDeprecation(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := LookupDeprecation(DeprecationKindResource, "aws_s3_bucket", "acl"); !ok {
+		t.Error("expected aws_s3_bucket.acl to be deprecated")
+	}
+	if _, ok := LookupDeprecation(DeprecationKindDataSource, "aws_s3_bucket", "acl"); ok {
+		t.Error("expected data.aws_s3_bucket.acl not to be deprecated")
+	}
+	if _, ok := LookupDeprecation(DeprecationKindResource, "aws_s3_bucket", ""); ok {
+		t.Error("expected aws_s3_bucket not to be deprecated")
+	}
+
+	if got, want := len(DeprecationsForType(DeprecationKindResource, "aws_iam_role")), 2; got != want {
+		t.Errorf("DeprecationsForType(aws_iam_role) returned %d deprecations, want %d", got, want)
+	}
+}
+
+func TestDeprecationMessageFunctions(t *testing.T) {
+	t.Parallel()
+
+	if got, want := ResourceDeprecationMessage("aws_s3_bucket", "acl"), "aws_s3_bucket.acl is deprecated. Use the aws_s3_bucket_acl resource instead. It will be removed in a future major version of the provider."; got != want {
+		t.Errorf("ResourceDeprecationMessage(aws_s3_bucket, acl) = %q, want %q", got, want)
+	}
+	if got, want := DataSourceDeprecationMessage("aws_region", AttrName), "data.aws_region.name is deprecated. Use region instead. It will be removed in a future major version of the provider."; got != want {
+		t.Errorf("DataSourceDeprecationMessage(aws_region, name) = %q, want %q", got, want)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected DataSourceDeprecationMessage(aws_s3_bucket, acl) to panic")
+		}
+	}()
+	DataSourceDeprecationMessage("aws_s3_bucket", "acl")
+}
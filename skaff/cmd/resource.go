@@ -5,6 +5,7 @@ package cmd
 
 import (
 	"github.com/hashicorp/terraform-provider-aws/skaff/resource"
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdkmodel"
 	"github.com/spf13/cobra"
 )
 
@@ -16,12 +17,16 @@ var (
 	pluginSDKV2   bool
 	includeTags   bool
 	framework     bool
+	sdkOperations sdkmodel.Operations
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if sdkOperations != (sdkmodel.Operations{}) {
+			return resource.CreateFromSDK(name, snakeName, !clearComments, force, includeTags, sdkOperations)
+		}
 		return resource.Create(name, snakeName, !clearComments, force, includeTags)
 	},
 }
@@ -33,4 +38,9 @@ func init() {
 	resourceCmd.Flags().StringVarP(&name, "name", "n", "", "name of the entity")
 	resourceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVar(&sdkOperations.Create, "sdk-create", "", "generate the resource from the AWS SDK for Go v2 model using this Create operation (e.g., CreateWidget)")
+	resourceCmd.Flags().StringVar(&sdkOperations.Read, "sdk-read", "", "AWS SDK for Go v2 operation that describes the resource (e.g., GetWidget)")
+	resourceCmd.Flags().StringVar(&sdkOperations.Update, "sdk-update", "", "AWS SDK for Go v2 operation that updates the resource, if any (e.g., UpdateWidget)")
+	resourceCmd.Flags().StringVar(&sdkOperations.Delete, "sdk-delete", "", "AWS SDK for Go v2 operation that deletes the resource (e.g., DeleteWidget)")
+	resourceCmd.Flags().StringVar(&sdkOperations.List, "sdk-list", "", "AWS SDK for Go v2 operation that lists the resources, used by the sweeper (e.g., ListWidgets)")
 }
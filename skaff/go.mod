@@ -4,14 +4,15 @@ go 1.25.6
 
 require (
 	github.com/YakDriver/regexache v0.25.0
+	github.com/google/go-cmp v0.7.0
 	github.com/hashicorp/terraform-provider-aws v1.60.1-0.20220322001452-8f7a597d0c24
 	github.com/spf13/cobra v1.10.2
+	golang.org/x/tools v0.41.0
 )
 
 require (
 	github.com/agext/levenshtein v1.2.3 // indirect
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
-	github.com/google/go-cmp v0.7.0 // indirect
 	github.com/google/uuid v1.6.0 // indirect
 	github.com/hashicorp/aws-sdk-go-base/v2 v2.0.0-beta.70 // indirect
 	github.com/hashicorp/hcl/v2 v2.24.0 // indirect
@@ -22,7 +23,6 @@ require (
 	golang.org/x/mod v0.32.0 // indirect
 	golang.org/x/sync v0.19.0 // indirect
 	golang.org/x/text v0.33.0 // indirect
-	golang.org/x/tools v0.41.0 // indirect
 )
 
 replace github.com/hashicorp/terraform-provider-aws => ../
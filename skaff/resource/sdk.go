@@ -0,0 +1,676 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	namesgen "github.com/hashicorp/terraform-provider-aws/names/generate"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdkmodel"
+	"golang.org/x/tools/imports"
+)
+
+//go:embed resourcesdk.gtpl
+var resourceSDKTmpl string
+
+//go:embed resourcesdktest.gtpl
+var resourceSDKTestTmpl string
+
+//go:embed websitedocsdk.gtpl
+var websiteSDKTmpl string
+
+type SDKTemplateData struct {
+	TemplateData
+
+	Model *sdkmodel.Resource
+
+	// Generated source fragments.
+	SchemaAttributes string
+	SchemaBlocks     string
+	ModelFields      string
+	NestedModels     string
+	TestConfigArgs   string
+
+	TestConfigUsesName bool
+	ExampleConfigArgs  string
+	ExampleImportID    string
+
+	Identity            []string
+	IdentifierFields    []identifierField
+	IdentityAnnotations []string
+	TagsIdentifier      string
+	FinderName          string
+	FinderParams        string
+	ObjectType          string
+	// DeleteByIdentifiers is whether the Delete input takes exactly the resource identifiers.
+	DeleteByIdentifiers bool
+	// ImportIDParser is the name of the import ID parser for multi-attribute identities.
+	ImportIDParser string
+	ImportIDFormat string
+
+	HasID bool
+
+	Arguments          []docAttribute
+	ComputedAttributes []docAttribute
+}
+
+// IdentityDescription returns the documentation of the specified identity attribute.
+func (sd SDKTemplateData) IdentityDescription(name string) string {
+	for _, a := range sd.Model.Identifiers {
+		if a.Name == name && a.Description != "" {
+			return a.Description
+		}
+	}
+	return fmt.Sprintf("Identifier of the %s.", sd.HumanResourceName)
+}
+
+// identifierField pairs a Read input member with the model field it is set from.
+type identifierField struct {
+	Member string
+	GoName string
+	Param  string
+}
+
+type docAttribute struct {
+	Name        string
+	Description string
+	Required    bool
+	Block       bool
+}
+
+// CreateFromSDK generates a working Plugin Framework resource from the specified
+// AWS SDK for Go v2 operations of the current service package's SDK package.
+func CreateFromSDK(resName, snakeName string, comments, force, tags bool, ops sdkmodel.Operations) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if resName == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if resName == strings.ToLower(resName) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+	}
+
+	if snakeName == "" {
+		snakeName = names.ToSnakeCase(resName)
+	}
+
+	service, err := data.LookupService(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
+	}
+
+	model, err := sdkmodel.Load(service.GoV2Package())
+	if err != nil {
+		return err
+	}
+
+	res, err := model.Resource(capitalizeForAWS(resName), ops)
+	if err != nil {
+		return fmt.Errorf("introspecting %s operations: %w", service.GoV2Package(), err)
+	}
+
+	templateData := TemplateData{
+		Resource:             resName,
+		ResourceAWS:          capitalizeForAWS(resName),
+		ResourceLower:        strings.ToLower(resName),
+		ResourceLowerCamel:   convert.ToLowercasePrefix(resName),
+		ResourceSnake:        snakeName,
+		HumanFriendlyService: service.HumanFriendly(),
+		IncludeComments:      comments,
+		IncludeTags:          tags && res.HasTags,
+		SDKPackage:           service.GoV2Package(),
+		ServicePackage:       servicePackage,
+		Service:              service.ProviderNameUpper(),
+		ServiceLower:         strings.ToLower(service.ProviderNameUpper()),
+		AWSServiceName:       service.FullHumanFriendly(),
+		HumanResourceName:    convert.ToHumanResName(resName),
+		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
+	}
+
+	td := newSDKTemplateData(templateData, model, res)
+
+	f := fmt.Sprintf("%s.go", snakeName)
+	if err = writeSDKTemplate("newres", f, resourceSDKTmpl, force, td, true); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", snakeName)
+	if err = writeSDKTemplate("restest", tf, resourceSDKTestTmpl, force, td, true); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeSDKTemplate("webdoc", wf, websiteSDKTmpl, force, td, false); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	for _, a := range res.Unsupported {
+		fmt.Fprintf(os.Stderr, "skipped %s (%s): not supported by the generator, add it by hand\n", a.AWSName, a.GoType)
+	}
+	fmt.Printf("Add the following to exports_test.go:\n\tResource%[1]s = new%[1]sResource\n\tFind%[1]s%[2]s = %[3]s\n", resName, strings.TrimPrefix(td.FinderName, "find"+resName), td.FinderName)
+	if res.List != "" {
+		fmt.Printf("Register the sweeper in sweep.go:\n\tawsv2.Register(%q, sweep%ss)\n", templateData.ProviderResourceName, resName)
+	}
+
+	return nil
+}
+
+func newSDKTemplateData(td TemplateData, model *sdkmodel.Model, r *sdkmodel.Resource) SDKTemplateData {
+	sd := SDKTemplateData{
+		TemplateData: td,
+		Model:        r,
+		ObjectType:   r.ObjectType,
+	}
+
+	g := &sdkGenerator{
+		model:  model,
+		lower:  td.ResourceLowerCamel,
+		nested: make(map[string]bool),
+	}
+
+	var attrs, blocks, fields strings.Builder
+	for _, a := range r.Attributes {
+		if a.Name == names.AttrID {
+			sd.HasID = true
+		}
+		if a.IsBlock() {
+			blocks.WriteString(g.block(a, 3))
+		} else {
+			attrs.WriteString(g.attribute(a, 3))
+		}
+	}
+	if sd.IncludeTags {
+		attrs.WriteString("\t\t\tnames.AttrTags:    tftags.TagsAttribute(),\n")
+		attrs.WriteString("\t\t\tnames.AttrTagsAll: tftags.TagsAttributeComputedOnly(),\n")
+	}
+	sd.SchemaAttributes = attrs.String()
+	sd.SchemaBlocks = blocks.String()
+
+	fields.WriteString(g.fields(r.Attributes, sd.IncludeTags, true))
+	sd.ModelFields = fields.String()
+	sd.NestedModels = g.models.String()
+
+	var params []string
+	for i, a := range r.Identifiers {
+		param := convert.ToLowercasePrefix(a.GoName)
+		params = append(params, param)
+		sd.Identity = append(sd.Identity, a.Name)
+		sd.IdentifierFields = append(sd.IdentifierFields, identifierField{
+			Member: r.IdentifierMembers[i],
+			GoName: a.GoName,
+			Param:  param,
+		})
+	}
+	sd.FinderParams = strings.Join(params, ", ") + " string"
+
+	switch id := r.Identifiers[0]; len(r.Identifiers) {
+	case 1:
+		sd.FinderName = fmt.Sprintf("find%sBy%s", td.Resource, id.GoName)
+		switch {
+		case id.Name == names.AttrARN:
+			sd.IdentityAnnotations = []string{"@ArnIdentity"}
+		case strings.HasSuffix(id.Name, "_arn"):
+			sd.IdentityAnnotations = []string{fmt.Sprintf("@ArnIdentity(%q)", id.Name)}
+		default:
+			sd.IdentityAnnotations = []string{fmt.Sprintf("@IdentityAttribute(%q)", id.Name)}
+		}
+	default:
+		sd.FinderName = fmt.Sprintf("find%sBy%sPartKey", td.Resource, numberWords[len(r.Identifiers)])
+		sd.ImportIDParser = td.ResourceLowerCamel + "ImportID"
+		var format []string
+		for _, a := range r.Identifiers {
+			format = append(format, "<"+strings.ReplaceAll(a.Name, "_", "-")+">")
+		}
+		sd.ImportIDFormat = strings.Join(format, ",")
+		for _, a := range r.Identifiers {
+			sd.IdentityAnnotations = append(sd.IdentityAnnotations, fmt.Sprintf("@IdentityAttribute(%q)", a.Name))
+		}
+		sd.IdentityAnnotations = append(sd.IdentityAnnotations, fmt.Sprintf("@ImportIDHandler(%q)", sd.ImportIDParser))
+	}
+
+	sd.TagsIdentifier = r.Identifiers[0].Name
+	for _, a := range r.Attributes {
+		if a.Name == names.AttrARN {
+			sd.TagsIdentifier = a.Name
+		}
+	}
+
+	sd.DeleteByIdentifiers = true
+	for _, member := range r.IdentifierMembers {
+		if !model.HasMember(r.Delete+"Input", member) {
+			sd.DeleteByIdentifiers = false
+		}
+	}
+
+	sd.TestConfigArgs = g.testValues(r.Attributes, 1)
+	sd.TestConfigUsesName = strings.Contains(sd.TestConfigArgs, "%[1]q")
+	sd.ExampleConfigArgs = strings.NewReplacer("%[1]q", `"example"`, `"test"`, `"example"`).Replace(sd.TestConfigArgs)
+
+	var example []string
+	for _, a := range r.Identifiers {
+		example = append(example, "example-"+strings.ReplaceAll(a.Name, "_", "-"))
+	}
+	sd.ExampleImportID = strings.Join(example, ",")
+
+	for _, a := range r.Attributes {
+		doc := docAttribute{
+			Name:        a.Name,
+			Description: a.Description,
+			Required:    a.Required,
+			Block:       a.IsBlock(),
+		}
+		if doc.Description == "" {
+			doc.Description = "TODO: Describe this attribute."
+		}
+		if a.ComputedOnly() {
+			sd.ComputedAttributes = append(sd.ComputedAttributes, doc)
+		} else {
+			sd.Arguments = append(sd.Arguments, doc)
+		}
+	}
+	slices.SortStableFunc(sd.Arguments, func(a, b docAttribute) int {
+		switch {
+		case a.Required && !b.Required:
+			return -1
+		case !a.Required && b.Required:
+			return 1
+		}
+		return 0
+	})
+
+	return sd
+}
+
+var numberWords = map[int]string{
+	2: "Two",
+	3: "Three",
+	4: "Four",
+	5: "Five",
+}
+
+type sdkGenerator struct {
+	model  *sdkmodel.Model
+	lower  string
+	nested map[string]bool
+	models strings.Builder
+}
+
+// attribute renders a schema.Attribute map entry.
+func (g *sdkGenerator) attribute(a *sdkmodel.Attribute, indent int) string {
+	key := namesgen.ConstOrQuote(a.Name)
+
+	if a.ComputedOnly() {
+		switch {
+		case a.Name == names.AttrARN && a.Kind == sdkmodel.KindString:
+			return fmt.Sprintf("%s%s: framework.ARNAttributeComputedOnly(),\n", tabs(indent), key)
+		case a.Name == names.AttrID && a.Kind == sdkmodel.KindString:
+			return fmt.Sprintf("%s%s: framework.IDAttribute(),\n", tabs(indent), key)
+		case a.Kind == sdkmodel.KindStruct || a.Kind == sdkmodel.KindListOfStruct:
+			return fmt.Sprintf("%s%s: framework.ResourceComputedListOfObjectsAttribute[%s](ctx, listplanmodifier.UseStateForUnknown()),\n", tabs(indent), key, g.nestedModel(a))
+		}
+	}
+
+	var (
+		attrType, customType, elemType, modifierPkg string
+	)
+	switch a.Kind {
+	case sdkmodel.KindString:
+		attrType, modifierPkg = "StringAttribute", "string"
+	case sdkmodel.KindBool:
+		attrType, modifierPkg = "BoolAttribute", "bool"
+	case sdkmodel.KindInt32:
+		attrType, modifierPkg = "Int32Attribute", "int32"
+	case sdkmodel.KindInt64:
+		attrType, modifierPkg = "Int64Attribute", "int64"
+	case sdkmodel.KindFloat32:
+		attrType, modifierPkg = "Float32Attribute", "float32"
+	case sdkmodel.KindFloat64:
+		attrType, modifierPkg = "Float64Attribute", "float64"
+	case sdkmodel.KindTime:
+		attrType, modifierPkg, customType = "StringAttribute", "string", "timetypes.RFC3339Type{}"
+	case sdkmodel.KindEnum:
+		attrType, modifierPkg, customType = "StringAttribute", "string", fmt.Sprintf("fwtypes.StringEnumType[awstypes.%s]()", a.TypeName)
+	case sdkmodel.KindListOfString:
+		attrType, modifierPkg, customType, elemType = "ListAttribute", "list", "fwtypes.ListOfStringType", "types.StringType"
+	case sdkmodel.KindListOfEnum:
+		attrType, modifierPkg, customType, elemType = "ListAttribute", "list", fmt.Sprintf("fwtypes.ListOfStringEnumType[awstypes.%s]()", a.TypeName), "types.StringType"
+	case sdkmodel.KindMapOfString:
+		attrType, modifierPkg, customType, elemType = "MapAttribute", "map", "fwtypes.MapOfStringType", "types.StringType"
+	case sdkmodel.KindStruct, sdkmodel.KindListOfStruct:
+		// Configurable structures are rendered as blocks.
+		return g.block(a, indent)
+	}
+
+	var modifiers []string
+	if a.RequiresReplace {
+		modifiers = append(modifiers, modifierPkg+"planmodifier.RequiresReplace()")
+	}
+	if a.Computed {
+		modifiers = append(modifiers, modifierPkg+"planmodifier.UseStateForUnknown()")
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s%s: schema.%s{\n", tabs(indent), key, attrType)
+	if customType != "" {
+		fmt.Fprintf(&b, "%sCustomType: %s,\n", tabs(indent+1), customType)
+	}
+	if elemType != "" {
+		fmt.Fprintf(&b, "%sElementType: %s,\n", tabs(indent+1), elemType)
+	}
+	if a.Required {
+		fmt.Fprintf(&b, "%sRequired: true,\n", tabs(indent+1))
+	}
+	if a.Optional {
+		fmt.Fprintf(&b, "%sOptional: true,\n", tabs(indent+1))
+	}
+	if a.Computed {
+		fmt.Fprintf(&b, "%sComputed: true,\n", tabs(indent+1))
+	}
+	if len(modifiers) > 0 {
+		fmt.Fprintf(&b, "%sPlanModifiers: []planmodifier.%s{\n", tabs(indent+1), planModifierType(modifierPkg))
+		for _, m := range modifiers {
+			fmt.Fprintf(&b, "%s%s,\n", tabs(indent+2), m)
+		}
+		fmt.Fprintf(&b, "%s},\n", tabs(indent+1))
+	}
+	fmt.Fprintf(&b, "%s},\n", tabs(indent))
+
+	return b.String()
+}
+
+// block renders a schema.ListNestedBlock map entry.
+func (g *sdkGenerator) block(a *sdkmodel.Attribute, indent int) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s%s: schema.ListNestedBlock{\n", tabs(indent), namesgen.ConstOrQuote(a.Name))
+	fmt.Fprintf(&b, "%sCustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", tabs(indent+1), g.nestedModel(a))
+
+	var validators []string
+	if a.Required {
+		validators = append(validators, "listvalidator.IsRequired()")
+	}
+	if a.Kind == sdkmodel.KindStruct {
+		validators = append(validators, "listvalidator.SizeAtMost(1)")
+	}
+	if len(validators) > 0 {
+		fmt.Fprintf(&b, "%sValidators: []validator.List{\n", tabs(indent+1))
+		for _, v := range validators {
+			fmt.Fprintf(&b, "%s%s,\n", tabs(indent+2), v)
+		}
+		fmt.Fprintf(&b, "%s},\n", tabs(indent+1))
+	}
+	if a.RequiresReplace {
+		fmt.Fprintf(&b, "%sPlanModifiers: []planmodifier.List{\n", tabs(indent+1))
+		fmt.Fprintf(&b, "%slistplanmodifier.RequiresReplace(),\n", tabs(indent+2))
+		fmt.Fprintf(&b, "%s},\n", tabs(indent+1))
+	}
+
+	var attrs, blocks strings.Builder
+	for _, n := range a.Nested {
+		if n.IsBlock() {
+			blocks.WriteString(g.block(n, indent+4))
+		} else {
+			attrs.WriteString(g.attribute(n, indent+4))
+		}
+	}
+
+	fmt.Fprintf(&b, "%sNestedObject: schema.NestedBlockObject{\n", tabs(indent+1))
+	if attrs.Len() > 0 {
+		fmt.Fprintf(&b, "%sAttributes: map[string]schema.Attribute{\n", tabs(indent+2))
+		b.WriteString(attrs.String())
+		fmt.Fprintf(&b, "%s},\n", tabs(indent+2))
+	}
+	if blocks.Len() > 0 {
+		fmt.Fprintf(&b, "%sBlocks: map[string]schema.Block{\n", tabs(indent+2))
+		b.WriteString(blocks.String())
+		fmt.Fprintf(&b, "%s},\n", tabs(indent+2))
+	}
+	fmt.Fprintf(&b, "%s},\n", tabs(indent+1))
+	fmt.Fprintf(&b, "%s},\n", tabs(indent))
+
+	return b.String()
+}
+
+// nestedModel returns the name of the model of a structure attribute, generating it on first use.
+func (g *sdkGenerator) nestedModel(a *sdkmodel.Attribute) string {
+	name := convert.ToLowercasePrefix(a.TypeName) + "Model"
+	if name == g.lower+"ResourceModel" {
+		name = convert.ToLowercasePrefix(a.TypeName) + "NestedModel"
+	}
+	if g.nested[name] {
+		return name
+	}
+	g.nested[name] = true
+
+	// Generate the fields first so that nested models are emitted in dependency order.
+	fields := g.fields(a.Nested, false, false)
+	fmt.Fprintf(&g.models, "\ntype %s struct {\n%s}\n", name, fields)
+
+	return name
+}
+
+// fields renders model struct fields.
+func (g *sdkGenerator) fields(attrs []*sdkmodel.Attribute, tags, timeouts bool) string {
+	type field struct {
+		name, typ, tag string
+	}
+	var fields []field
+	for _, a := range attrs {
+		fields = append(fields, field{a.GoName, g.fieldType(a), a.Name})
+	}
+	if tags {
+		fields = append(fields, field{"Tags", "tftags.Map", names.AttrTags}, field{"TagsAll", "tftags.Map", names.AttrTagsAll})
+	}
+	if timeouts {
+		fields = append(fields, field{"Timeouts", "timeouts.Value", names.AttrTimeouts})
+	}
+	slices.SortFunc(fields, func(a, b field) int {
+		return strings.Compare(a.name, b.name)
+	})
+
+	var b strings.Builder
+	for _, f := range fields {
+		fmt.Fprintf(&b, "\t%s %s `tfsdk:\"%s\"`\n", f.name, f.typ, f.tag)
+	}
+	return b.String()
+}
+
+func (g *sdkGenerator) fieldType(a *sdkmodel.Attribute) string {
+	switch a.Kind {
+	case sdkmodel.KindString:
+		return "types.String"
+	case sdkmodel.KindBool:
+		return "types.Bool"
+	case sdkmodel.KindInt32:
+		return "types.Int32"
+	case sdkmodel.KindInt64:
+		return "types.Int64"
+	case sdkmodel.KindFloat32:
+		return "types.Float32"
+	case sdkmodel.KindFloat64:
+		return "types.Float64"
+	case sdkmodel.KindTime:
+		return "timetypes.RFC3339"
+	case sdkmodel.KindEnum:
+		return fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", a.TypeName)
+	case sdkmodel.KindListOfString:
+		return "fwtypes.ListOfString"
+	case sdkmodel.KindListOfEnum:
+		return fmt.Sprintf("fwtypes.ListOfStringEnum[awstypes.%s]", a.TypeName)
+	case sdkmodel.KindMapOfString:
+		return "fwtypes.MapOfString"
+	case sdkmodel.KindStruct, sdkmodel.KindListOfStruct:
+		return fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", g.nestedModel(a))
+	}
+	return "types.String"
+}
+
+// testValues renders the required HCL arguments with sample values for acceptance test configurations.
+func (g *sdkGenerator) testValues(attrs []*sdkmodel.Attribute, indent int) string {
+	var width int
+	for _, a := range attrs {
+		if a.Required && !a.IsBlock() {
+			width = max(width, len(a.Name))
+		}
+	}
+
+	var b strings.Builder
+	for _, a := range attrs {
+		if !a.Required {
+			continue
+		}
+		if a.IsBlock() {
+			fmt.Fprintf(&b, "\n%s%s {\n", indent2(indent), a.Name)
+			b.WriteString(g.testValues(a.Nested, indent+1))
+			fmt.Fprintf(&b, "%s}\n", indent2(indent))
+			continue
+		}
+		fmt.Fprintf(&b, "%s%-*s = %s\n", indent2(indent), width, a.Name, g.testValue(a))
+	}
+	return b.String()
+}
+
+func (g *sdkGenerator) testValue(a *sdkmodel.Attribute) string {
+	switch a.Kind {
+	case sdkmodel.KindString:
+		if strings.HasSuffix(a.Name, "name") {
+			return "%[1]q"
+		}
+	case sdkmodel.KindBool:
+		return "false"
+	case sdkmodel.KindInt32, sdkmodel.KindInt64, sdkmodel.KindFloat32, sdkmodel.KindFloat64:
+		return "1"
+	case sdkmodel.KindTime:
+		return `"2030-01-01T00:00:00Z"`
+	case sdkmodel.KindEnum:
+		return fmt.Sprintf("%q", g.enumValue(a.TypeName))
+	case sdkmodel.KindListOfString:
+		return `["test"]`
+	case sdkmodel.KindListOfEnum:
+		return fmt.Sprintf("[%q]", g.enumValue(a.TypeName))
+	case sdkmodel.KindMapOfString:
+		return `{ key1 = "value1" }`
+	}
+	return `"test"`
+}
+
+func (g *sdkGenerator) enumValue(typeName string) string {
+	if values := g.model.EnumStringValues(typeName); len(values) > 0 {
+		return values[0]
+	}
+	return "TODO"
+}
+
+func planModifierType(pkg string) string {
+	return strings.ToUpper(pkg[:1]) + pkg[1:]
+}
+
+func tabs(n int) string {
+	return strings.Repeat("\t", n)
+}
+
+func indent2(n int) string {
+	return strings.Repeat("  ", n)
+}
+
+func writeSDKTemplate(templateName, filename, tmpl string, force bool, td SDKTemplateData, format bool) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	contents, err := executeSDKTemplate(templateName, filename, tmpl, td, format)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filename, contents, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
+
+func executeSDKTemplate(templateName, filename, tmpl string, td SDKTemplateData, format bool) ([]byte, error) {
+	funcs := template.FuncMap{
+		"constOrQuote": namesgen.ConstOrQuote,
+		// identArgs renders the identifier values of a model variable, e.g. "state".
+		"identArgs": func(v string) string {
+			var args []string
+			for _, a := range td.Model.Identifiers {
+				args = append(args, fmt.Sprintf("%s.%s.ValueString()", v, a.GoName))
+			}
+			return strings.Join(args, ", ")
+		},
+		// identID renders a single identifying value of a model variable for diagnostics.
+		"identID": func(v string) string {
+			var args []string
+			for _, a := range td.Model.Identifiers {
+				args = append(args, fmt.Sprintf("%s.%s.ValueString()", v, a.GoName))
+			}
+			if len(args) == 1 {
+				return args[0]
+			}
+			return fmt.Sprintf("strings.Join([]string{%s}, intflex.ResourceIdSeparator)", strings.Join(args, ", "))
+		},
+		// identStateArgs renders the identifier values of a test resource's state.
+		"identStateArgs": func(v string) string {
+			var args []string
+			for _, a := range td.Model.Identifiers {
+				args = append(args, fmt.Sprintf("%s.Primary.Attributes[%s]", v, namesgen.ConstOrQuote(a.Name)))
+			}
+			return strings.Join(args, ", ")
+		},
+		"identParams": func() string {
+			var args []string
+			for _, a := range td.Model.Identifiers {
+				args = append(args, convert.ToLowercasePrefix(a.GoName))
+			}
+			return strings.Join(args, ", ")
+		},
+	}
+
+	tplate, err := template.New(templateName).Funcs(funcs).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tplate.Execute(&buffer, td); err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+
+	if !format {
+		return buffer.Bytes(), nil
+	}
+
+	// Unused imports are removed.
+	contents, err := imports.Process(filename, buffer.Bytes(), &imports.Options{Comments: true, TabIndent: true, TabWidth: 8})
+	if err != nil {
+		return nil, fmt.Errorf("error formatting generated file (%s): %s", filename, err)
+	}
+
+	return contents, nil
+}
@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdkmodel"
+)
+
+const testSDKSource = `
+package widgets
+
+type WidgetState string
+
+const (
+	WidgetStateCreating WidgetState = "CREATING"
+	WidgetStateReady    WidgetState = "READY"
+	WidgetStateDeleting WidgetState = "DELETING"
+)
+
+type Setting struct {
+	// This member is required.
+	Key *string
+
+	Value *string
+}
+
+type CreateWidgetInput struct {
+	// This member is required.
+	ClusterName *string
+
+	// This member is required.
+	WidgetName *string
+
+	Enabled *bool
+
+	Settings []Setting
+}
+
+type CreateWidgetOutput struct {
+	WidgetArn *string
+}
+
+type DescribeWidgetInput struct {
+	// This member is required.
+	ClusterName *string
+
+	// This member is required.
+	WidgetName *string
+}
+
+type DescribeWidgetOutput struct {
+	ClusterName *string
+
+	WidgetName *string
+
+	WidgetArn *string
+
+	Enabled *bool
+
+	Settings []Setting
+
+	State WidgetState
+}
+
+type DeleteWidgetInput struct {
+	// This member is required.
+	ClusterName *string
+
+	// This member is required.
+	WidgetName *string
+}
+`
+
+func TestCreateFromSDKTemplates(t *testing.T) {
+	t.Parallel()
+
+	m, err := sdkmodel.FromSource("widgets", testSDKSource)
+	if err != nil {
+		t.Fatalf("loading source: %s", err)
+	}
+
+	r, err := m.Resource("Widget", sdkmodel.Operations{
+		Create: "CreateWidget",
+		Read:   "DescribeWidget",
+		Delete: "DeleteWidget",
+	})
+	if err != nil {
+		t.Fatalf("deriving resource: %s", err)
+	}
+
+	td := newSDKTemplateData(TemplateData{
+		Resource:             "Widget",
+		ResourceAWS:          "Widget",
+		ResourceLower:        "widget",
+		ResourceLowerCamel:   "widget",
+		ResourceSnake:        "widget",
+		HumanFriendlyService: "Widgets",
+		SDKPackage:           "widgets",
+		ServicePackage:       "widgets",
+		Service:              "Widgets",
+		ServiceLower:         "widgets",
+		HumanResourceName:    "Widget",
+		ProviderResourceName: "aws_widgets_widget",
+	}, m, r)
+
+	testCases := []struct {
+		name, filename, tmpl string
+		format               bool
+		want                 []string
+	}{
+		{
+			name:     "resource",
+			filename: "widget.go",
+			tmpl:     resourceSDKTmpl,
+			format:   true,
+			want: []string{
+				`// @IdentityAttribute("cluster_name")`,
+				`// @ImportIDHandler("widgetImportID")`,
+				"framework.WithNoUpdate",
+				"func findWidgetByTwoPartKey(ctx context.Context, conn *widgets.Client, clusterName, widgetName string) (*widgets.DescribeWidgetOutput, error) {",
+				"Pending:                   enum.Slice(awstypes.WidgetStateCreating),",
+				"CustomType: fwtypes.NewListNestedObjectTypeOf[settingModel](ctx),",
+				"Settings    fwtypes.ListNestedObjectValueOf[settingModel] `tfsdk:\"settings\"`",
+				"names.AttrEnabled: schema.BoolAttribute{",
+			},
+		},
+		{
+			name:     "test",
+			filename: "widget_test.go",
+			tmpl:     resourceSDKTestTmpl,
+			format:   true,
+			want: []string{
+				`acctest.AttrsImportStateIdFunc(resourceName, ",", names.AttrClusterName, "widget_name")`,
+				"tfwidgets.FindWidgetByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrClusterName], rs.Primary.Attributes[\"widget_name\"])",
+				"cluster_name = %[1]q\n  widget_name  = %[1]q",
+			},
+		},
+		{
+			name:     "website",
+			filename: "widgets_widget.html.markdown",
+			tmpl:     websiteSDKTmpl,
+			want: []string{
+				"* `cluster_name` - (Required)",
+				"* `enabled` - (Optional)",
+				"* `widget_arn` - ",
+				"% terraform import aws_widgets_widget.example example-cluster-name,example-widget-name",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := executeSDKTemplate(testCase.name, testCase.filename, testCase.tmpl, td, testCase.format)
+			if err != nil {
+				t.Fatalf("executing template: %s", err)
+			}
+
+			for _, want := range testCase.want {
+				if !strings.Contains(string(got), want) {
+					t.Errorf("output does not contain %q:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,815 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package sdkmodel introspects the Smithy-generated input and output types of
+// an AWS SDK for Go v2 service package and derives a Terraform resource model
+// from them.
+package sdkmodel
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	sdkPackagePathPrefix = "github.com/aws/aws-sdk-go-v2/service/"
+
+	// Smithy-generated documentation marks required members with this sentence.
+	requiredMemberDoc = "This member is required."
+
+	// Nested structures deeper than this are not modeled.
+	maxNestingDepth = 6
+)
+
+// Kind is the shape of an SDK structure member.
+type Kind int
+
+const (
+	KindUnsupported Kind = iota
+	KindString
+	KindBool
+	KindInt32
+	KindInt64
+	KindFloat32
+	KindFloat64
+	KindTime
+	KindEnum
+	KindListOfString
+	KindListOfEnum
+	KindMapOfString
+	KindStruct
+	KindListOfStruct
+)
+
+// Operations names the SDK operations backing a resource's CRUD methods.
+type Operations struct {
+	Create string
+	Read   string
+	Update string
+	Delete string
+	List   string
+}
+
+// Attribute is a resource attribute derived from an SDK structure member.
+type Attribute struct {
+	// AWSName is the SDK structure member name, e.g. KmsKeyId.
+	AWSName string
+	// GoName is the provider model field name, e.g. KMSKeyID.
+	GoName string
+	// Name is the Terraform attribute name, e.g. kms_key_id.
+	Name string
+	// Description is the first sentence of the member's documentation.
+	Description string
+
+	Kind Kind
+	// TypeName is the SDK type name for enum and structure kinds.
+	TypeName string
+	// GoType is the member's Go type as written in the SDK, for unsupported kinds.
+	GoType string
+
+	Required        bool
+	Optional        bool
+	Computed        bool
+	RequiresReplace bool
+
+	// Nested are the attributes of structure kinds.
+	Nested []*Attribute
+}
+
+// IsBlock returns whether the attribute is a nested structure that users configure.
+func (a *Attribute) IsBlock() bool {
+	return (a.Kind == KindStruct || a.Kind == KindListOfStruct) && !a.ComputedOnly()
+}
+
+// ComputedOnly returns whether the attribute cannot be configured.
+func (a *Attribute) ComputedOnly() bool {
+	return a.Computed && !a.Required && !a.Optional
+}
+
+// Waiter holds the enum values used to wait on a resource's status.
+type Waiter struct {
+	// Pending and Target are Go constant names, e.g. WidgetStatusCreating.
+	Pending []string
+	Target  []string
+}
+
+// Resource is a resource model derived from SDK operations.
+type Resource struct {
+	Operations
+
+	// SDKPackage is the SDK service package name, e.g. "workspacesweb".
+	SDKPackage string
+
+	Attributes  []*Attribute
+	Identifiers []*Attribute
+	// IdentifierMembers are the Read input members set from Identifiers.
+	IdentifierMembers []string
+	Unsupported       []*Attribute
+
+	// CreateOutputField is the Create output member holding the resource, if any.
+	CreateOutputField string
+	// ReadOutputField is the Read output member holding the resource, if any.
+	// When empty, the Read output itself describes the resource.
+	ReadOutputField string
+	// ObjectType is the SDK type returned by the finder, e.g. "awstypes.Widget"
+	// or "widgets.DescribeWidgetOutput".
+	ObjectType string
+
+	// Status is the enum attribute reporting the resource's lifecycle state, if any.
+	Status       *Attribute
+	CreateWaiter *Waiter
+	UpdateWaiter *Waiter
+	DeleteWaiter *Waiter
+
+	// ListPaginated is whether the List operation has a paginator.
+	ListPaginated bool
+	// ListItemsField is the List output member holding the resource summaries.
+	ListItemsField string
+	// ListIdentifiers are the AWS names of the summary members that populate Identifiers.
+	ListIdentifiers []string
+
+	HasTags bool
+	// ObjectHasTags is whether the Read output reports the resource's tags.
+	ObjectHasTags bool
+}
+
+// Model is a loaded SDK service package.
+type Model struct {
+	name     string
+	scopes   []*types.Scope
+	required map[string]bool
+	docs     map[string]string
+}
+
+// Load loads the named AWS SDK for Go v2 service package (e.g. "workspacesweb")
+// and its types package.
+func Load(sdkPackage string) (*Model, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedImports | packages.NeedDeps | packages.NeedTypes | packages.NeedSyntax,
+	}
+	path := sdkPackagePathPrefix + sdkPackage
+	pkgs, err := packages.Load(cfg, path, path+"/types")
+	if err != nil {
+		return nil, fmt.Errorf("loading %s: %w", path, err)
+	}
+
+	var (
+		tpkgs []*types.Package
+		files []*ast.File
+	)
+	for _, pkg := range pkgs {
+		for _, err := range pkg.Errors {
+			return nil, fmt.Errorf("loading %s: %w", pkg.PkgPath, err)
+		}
+		tpkgs = append(tpkgs, pkg.Types)
+		files = append(files, pkg.Syntax...)
+	}
+
+	return New(sdkPackage, tpkgs, files), nil
+}
+
+// FromSource returns a model of a single-file package combining a service's
+// operations and types. It is intended for testing.
+func FromSource(sdkPackage, src string) (*Model, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, sdkPackage+".go", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	pkg, err := cfg.Check(sdkPackage, fset, []*ast.File{file}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(sdkPackage, []*types.Package{pkg}, []*ast.File{file}), nil
+}
+
+// New returns a model of the specified type-checked packages and their syntax.
+func New(sdkPackage string, pkgs []*types.Package, files []*ast.File) *Model {
+	m := &Model{
+		name:     sdkPackage,
+		required: make(map[string]bool),
+		docs:     make(map[string]string),
+	}
+
+	for _, pkg := range pkgs {
+		m.scopes = append(m.scopes, pkg.Scope())
+	}
+
+	for _, file := range files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			spec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			st, ok := spec.Type.(*ast.StructType)
+			if !ok {
+				return false
+			}
+			for _, field := range st.Fields.List {
+				doc := field.Doc.Text()
+				for _, ident := range field.Names {
+					key := spec.Name.Name + "." + ident.Name
+					m.required[key] = strings.Contains(doc, requiredMemberDoc)
+					m.docs[key] = firstSentence(doc)
+				}
+			}
+			return false
+		})
+	}
+
+	return m
+}
+
+// Resource derives the model of the resource named resourceName from the specified operations.
+func (m *Model) Resource(resourceName string, ops Operations) (*Resource, error) {
+	if ops.Create == "" || ops.Read == "" || ops.Delete == "" {
+		return nil, fmt.Errorf("Create, Read and Delete operations are required")
+	}
+
+	createInput, err := m.operationStruct(ops.Create, "Input")
+	if err != nil {
+		return nil, err
+	}
+	createOutput, err := m.operationStruct(ops.Create, "Output")
+	if err != nil {
+		return nil, err
+	}
+	readInput, err := m.operationStruct(ops.Read, "Input")
+	if err != nil {
+		return nil, err
+	}
+	readOutput, err := m.operationStruct(ops.Read, "Output")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := m.operationStruct(ops.Delete, "Input"); err != nil {
+		return nil, err
+	}
+
+	var updateInput *namedStruct
+	if ops.Update != "" {
+		if updateInput, err = m.operationStruct(ops.Update, "Input"); err != nil {
+			return nil, err
+		}
+	}
+
+	r := &Resource{
+		Operations: ops,
+		SDKPackage: m.name,
+	}
+
+	// The Read output either wraps the resource in a single structure member
+	// (e.g. DescribeWidgetOutput.Widget) or describes it directly.
+	object := readOutput
+	r.ObjectType = fmt.Sprintf("%s.%sOutput", m.name, ops.Read)
+	if field, obj := wrappedStruct(readOutput, resourceName); obj != nil {
+		object = obj
+		r.ReadOutputField = field
+		r.ObjectType = "awstypes." + obj.name
+	}
+	if field, obj := wrappedStruct(createOutput, resourceName); obj != nil && obj.name == object.name {
+		r.CreateOutputField = field
+	}
+
+	attrs := make(map[string]*Attribute)
+	var order []string
+	add := func(a *Attribute) *Attribute {
+		if v, ok := attrs[a.AWSName]; ok {
+			return v
+		}
+		attrs[a.AWSName] = a
+		order = append(order, a.AWSName)
+		return a
+	}
+
+	for _, f := range createInput.fields() {
+		if skipMember(f.Name()) {
+			continue
+		}
+		if f.Name() == "Tags" {
+			r.HasTags = true
+			continue
+		}
+		a := m.attribute(createInput.name, f, 0, make(map[string]bool))
+		if a.Kind == KindUnsupported {
+			r.Unsupported = append(r.Unsupported, a)
+			continue
+		}
+		a.Required = m.required[createInput.name+"."+f.Name()]
+		a.Optional = !a.Required
+		if updateInput == nil || updateInput.field(f.Name()) == nil {
+			a.RequiresReplace = true
+		}
+		add(a)
+	}
+
+	for _, f := range object.fields() {
+		if f.Name() == "Tags" {
+			r.ObjectHasTags = true
+			continue
+		}
+		if skipMember(f.Name()) {
+			continue
+		}
+		if a, ok := attrs[f.Name()]; ok {
+			// Optional arguments reported by the service are defaulted by it.
+			if a.Optional && !a.IsBlock() {
+				a.Computed = true
+			}
+			continue
+		}
+		a := m.attribute(object.name, f, 0, make(map[string]bool))
+		if a.Kind == KindUnsupported {
+			if !slices.ContainsFunc(r.Unsupported, func(v *Attribute) bool { return v.AWSName == a.AWSName }) {
+				r.Unsupported = append(r.Unsupported, a)
+			}
+			continue
+		}
+		setComputed(a)
+		add(a)
+	}
+
+	// Read input required members identify the resource.
+	for _, f := range readInput.fields() {
+		if !m.required[readInput.name+"."+f.Name()] {
+			continue
+		}
+		a, ok := attrs[f.Name()]
+		if !ok {
+			a = identifierAlias(attrs, f.Name())
+		}
+		if a == nil {
+			a = m.attribute(readInput.name, f, 0, make(map[string]bool))
+			setComputed(a)
+			a = add(a)
+		}
+		if a.Kind != KindString {
+			return nil, fmt.Errorf("%sInput.%s: identifier must be a string", ops.Read, f.Name())
+		}
+		// Update operations take identifiers to locate the resource, not to change them.
+		a.RequiresReplace = true
+		r.Identifiers = append(r.Identifiers, a)
+		r.IdentifierMembers = append(r.IdentifierMembers, f.Name())
+	}
+	if len(r.Identifiers) == 0 {
+		return nil, fmt.Errorf("%sInput has no required members to identify the resource", ops.Read)
+	}
+
+	for _, k := range order {
+		r.Attributes = append(r.Attributes, attrs[k])
+	}
+	sortAttributes(r.Attributes)
+
+	m.statusWaiters(r, object, resourceName)
+
+	if ops.List != "" {
+		if err := m.listOperation(r, resourceName); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// identifierAlias returns the attribute that a Read input member accepting
+// either an ARN or an ID (e.g. WidgetIdentifier) is set from.
+func identifierAlias(attrs map[string]*Attribute, member string) *Attribute {
+	base, ok := strings.CutSuffix(member, "Identifier")
+	if !ok {
+		return nil
+	}
+	for _, name := range []string{base + "Arn", "Arn", base + "Id", "Id"} {
+		if a, ok := attrs[name]; ok {
+			return a
+		}
+	}
+	return nil
+}
+
+func (m *Model) listOperation(r *Resource, resourceName string) error {
+	listOutput, err := m.operationStruct(r.List, "Output")
+	if err != nil {
+		return err
+	}
+
+	r.ListPaginated = m.lookup(fmt.Sprintf("New%sPaginator", r.List)) != nil
+
+	for _, f := range listOutput.fields() {
+		s, ok := f.Type().(*types.Slice)
+		if !ok {
+			continue
+		}
+		item := namedStructOf(s.Elem())
+		if item == nil {
+			continue
+		}
+		r.ListItemsField = f.Name()
+		for i, id := range r.Identifiers {
+			// e.g. WidgetId in the Read input and Id in the summary.
+			candidates := []string{id.AWSName, r.IdentifierMembers[i], strings.TrimPrefix(id.AWSName, resourceName)}
+			j := slices.IndexFunc(candidates, func(name string) bool {
+				return item.field(name) != nil
+			})
+			if j == -1 {
+				return fmt.Errorf("%s has no member matching identifier %s", item.name, r.IdentifierMembers[i])
+			}
+			r.ListIdentifiers = append(r.ListIdentifiers, candidates[j])
+		}
+		return nil
+	}
+
+	return fmt.Errorf("%sOutput has no list of structures", r.List)
+}
+
+// statusWaiters finds the resource's status enum and classifies its values.
+func (m *Model) statusWaiters(r *Resource, object *namedStruct, resourceName string) {
+	var status *Attribute
+	for _, name := range []string{"Status", resourceName + "Status", "State", resourceName + "State"} {
+		for _, a := range r.Attributes {
+			if a.AWSName == name && a.Kind == KindEnum {
+				status = a
+				break
+			}
+		}
+		if status != nil {
+			break
+		}
+	}
+	if status == nil {
+		return
+	}
+
+	values := m.enumConstants(status.TypeName)
+	var creating, updating, deleting, target []string
+	for _, v := range values {
+		switch value := strings.ToUpper(v.value); {
+		case strings.Contains(value, "FAIL"), strings.Contains(value, "ERROR"):
+		case strings.Contains(value, "DELET"):
+			deleting = append(deleting, v.name)
+		case strings.Contains(value, "UPDAT"), strings.Contains(value, "MODIF"):
+			updating = append(updating, v.name)
+		case strings.Contains(value, "CREAT") && !strings.Contains(value, "CREATED"),
+			strings.Contains(value, "PENDING"), strings.Contains(value, "PROVISIONING"),
+			strings.Contains(value, "STARTING"), strings.Contains(value, "IN_PROGRESS"), strings.Contains(value, "INPROGRESS"):
+			creating = append(creating, v.name)
+		case slices.Contains([]string{"ACTIVE", "AVAILABLE", "CREATED", "READY", "RUNNING", "ENABLED", "SUCCEEDED", "COMPLETE", "COMPLETED", "DEPLOYED", "IN_SERVICE", "INSERVICE"}, strings.ReplaceAll(value, "-", "_")):
+			target = append(target, v.name)
+		}
+	}
+	if len(target) == 0 {
+		return
+	}
+
+	r.Status = status
+	r.CreateWaiter = &Waiter{Pending: creating, Target: target}
+	if len(updating) > 0 {
+		r.UpdateWaiter = &Waiter{Pending: updating, Target: target}
+	}
+	r.DeleteWaiter = &Waiter{Pending: slices.Concat(deleting, target)}
+}
+
+type enumConstant struct {
+	name, value string
+	pos         token.Pos
+}
+
+func (m *Model) enumConstants(typeName string) []enumConstant {
+	var output []enumConstant
+	for _, scope := range m.scopes {
+		for _, name := range scope.Names() {
+			c, ok := scope.Lookup(name).(*types.Const)
+			if !ok {
+				continue
+			}
+			named, ok := c.Type().(*types.Named)
+			if !ok || named.Obj().Name() != typeName || c.Val().Kind() != constant.String {
+				continue
+			}
+			output = append(output, enumConstant{name: name, value: constant.StringVal(c.Val()), pos: c.Pos()})
+		}
+	}
+	// Keep the declaration order.
+	slices.SortFunc(output, func(a, b enumConstant) int {
+		return int(a.pos - b.pos)
+	})
+	return output
+}
+
+// EnumValues returns the Go constant names of the specified enum type.
+func (m *Model) EnumValues(typeName string) []string {
+	var output []string
+	for _, v := range m.enumConstants(typeName) {
+		output = append(output, v.name)
+	}
+	return output
+}
+
+// EnumStringValues returns the string values of the specified enum type.
+func (m *Model) EnumStringValues(typeName string) []string {
+	var output []string
+	for _, v := range m.enumConstants(typeName) {
+		output = append(output, v.value)
+	}
+	return output
+}
+
+func (m *Model) attribute(parent string, f *types.Var, depth int, seen map[string]bool) *Attribute {
+	key := parent + "." + f.Name()
+	a := &Attribute{
+		AWSName:     f.Name(),
+		GoName:      goName(f.Name()),
+		Name:        names.ToSnakeCase(f.Name()),
+		Description: m.docs[key],
+		GoType:      types.TypeString(f.Type(), func(p *types.Package) string { return p.Name() }),
+	}
+
+	t := f.Type()
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+
+	switch t := t.(type) {
+	case *types.Basic:
+		a.Kind = basicKind(t)
+	case *types.Named:
+		switch {
+		case t.Obj().Pkg() != nil && t.Obj().Pkg().Path() == "time" && t.Obj().Name() == "Time":
+			a.Kind = KindTime
+		case isEnum(t):
+			a.Kind = KindEnum
+			a.TypeName = t.Obj().Name()
+		default:
+			if s := namedStructOf(t); s != nil {
+				a.Kind = KindStruct
+				a.TypeName = s.name
+				a.Nested = m.nested(s, depth, seen)
+				if a.Nested == nil {
+					a.Kind = KindUnsupported
+				}
+			}
+		}
+	case *types.Slice:
+		switch elem := t.Elem().(type) {
+		case *types.Basic:
+			if basicKind(elem) == KindString {
+				a.Kind = KindListOfString
+			}
+		case *types.Named:
+			if isEnum(elem) {
+				a.Kind = KindListOfEnum
+				a.TypeName = elem.Obj().Name()
+			} else if s := namedStructOf(elem); s != nil {
+				a.Kind = KindListOfStruct
+				a.TypeName = s.name
+				a.Nested = m.nested(s, depth, seen)
+				if a.Nested == nil {
+					a.Kind = KindUnsupported
+				}
+			}
+		}
+	case *types.Map:
+		if k, ok := t.Key().(*types.Basic); ok && basicKind(k) == KindString {
+			if v, ok := t.Elem().(*types.Basic); ok && basicKind(v) == KindString {
+				a.Kind = KindMapOfString
+			}
+		}
+	}
+
+	return a
+}
+
+func (m *Model) nested(s *namedStruct, depth int, seen map[string]bool) []*Attribute {
+	if depth >= maxNestingDepth || seen[s.name] {
+		return nil
+	}
+	seen[s.name] = true
+	defer delete(seen, s.name)
+
+	var output []*Attribute
+	for _, f := range s.fields() {
+		a := m.attribute(s.name, f, depth+1, seen)
+		if a.Kind == KindUnsupported {
+			continue
+		}
+		a.Required = m.required[s.name+"."+f.Name()]
+		a.Optional = !a.Required
+		output = append(output, a)
+	}
+	sortAttributes(output)
+
+	return output
+}
+
+// HasMember returns whether the specified structure type has the specified member.
+func (m *Model) HasMember(typeName, member string) bool {
+	obj := m.lookup(typeName)
+	if obj == nil {
+		return false
+	}
+	s := namedStructOf(obj.Type())
+	return s != nil && s.field(member) != nil
+}
+
+func (m *Model) lookup(name string) types.Object {
+	for _, scope := range m.scopes {
+		if obj := scope.Lookup(name); obj != nil {
+			return obj
+		}
+	}
+	return nil
+}
+
+func (m *Model) operationStruct(operation, suffix string) (*namedStruct, error) {
+	name := operation + suffix
+	obj := m.lookup(name)
+	if obj == nil {
+		return nil, fmt.Errorf("type %s.%s not found", m.name, name)
+	}
+	s := namedStructOf(obj.Type())
+	if s == nil {
+		return nil, fmt.Errorf("type %s.%s is not a structure", m.name, name)
+	}
+	return s, nil
+}
+
+type namedStruct struct {
+	name string
+	st   *types.Struct
+}
+
+func namedStructOf(t types.Type) *namedStruct {
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok {
+		return nil
+	}
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return nil
+	}
+	return &namedStruct{name: named.Obj().Name(), st: st}
+}
+
+// fields returns the exported members of the structure.
+func (s *namedStruct) fields() []*types.Var {
+	var output []*types.Var
+	for i := range s.st.NumFields() {
+		if f := s.st.Field(i); f.Exported() && !f.Embedded() {
+			output = append(output, f)
+		}
+	}
+	return output
+}
+
+func (s *namedStruct) field(name string) *types.Var {
+	for _, f := range s.fields() {
+		if f.Name() == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// wrappedStruct returns the structure member of an operation output that holds the resource.
+func wrappedStruct(output *namedStruct, resourceName string) (string, *namedStruct) {
+	var candidates []*types.Var
+	for _, f := range output.fields() {
+		if f.Name() == "ResultMetadata" {
+			continue
+		}
+		if _, ok := f.Type().(*types.Pointer); ok && namedStructOf(f.Type()) != nil {
+			if f.Name() == resourceName {
+				return f.Name(), namedStructOf(f.Type())
+			}
+			candidates = append(candidates, f)
+		}
+	}
+	if len(candidates) == 1 && len(output.fields()) <= 2 {
+		return candidates[0].Name(), namedStructOf(candidates[0].Type())
+	}
+	return "", nil
+}
+
+func isEnum(t *types.Named) bool {
+	b, ok := t.Underlying().(*types.Basic)
+	return ok && b.Kind() == types.String
+}
+
+func basicKind(t *types.Basic) Kind {
+	switch t.Kind() {
+	case types.String:
+		return KindString
+	case types.Bool:
+		return KindBool
+	case types.Int32:
+		return KindInt32
+	case types.Int64, types.Int:
+		return KindInt64
+	case types.Float32:
+		return KindFloat32
+	case types.Float64:
+		return KindFloat64
+	default:
+		return KindUnsupported
+	}
+}
+
+// skipMember returns whether an operation input or output member is not resource data.
+func skipMember(name string) bool {
+	switch name {
+	case "ClientToken", "DryRun", "MaxResults", "NextToken", "ResultMetadata":
+		return true
+	}
+	return false
+}
+
+func setComputed(a *Attribute) {
+	a.Computed = true
+	for _, n := range a.Nested {
+		n.Required, n.Optional = false, false
+		setComputed(n)
+	}
+}
+
+func sortAttributes(attrs []*Attribute) {
+	slices.SortFunc(attrs, func(a, b *Attribute) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+func firstSentence(doc string) string {
+	doc = strings.Join(strings.Fields(doc), " ")
+	if doc == "" || strings.HasPrefix(doc, requiredMemberDoc) {
+		return ""
+	}
+	if i := strings.Index(doc, ". "); i != -1 {
+		doc = doc[:i+1]
+	}
+	return doc
+}
+
+// initialisms maps SDK name words to provider Go name words.
+var initialisms = map[string]string{
+	"Acl":   "ACL",
+	"Acls":  "ACLs",
+	"Api":   "API",
+	"Arn":   "ARN",
+	"Arns":  "ARNs",
+	"Cidr":  "CIDR",
+	"Dns":   "DNS",
+	"Http":  "HTTP",
+	"Https": "HTTPS",
+	"Iam":   "IAM",
+	"Id":    "ID",
+	"Ids":   "IDs",
+	"Ip":    "IP",
+	"Json":  "JSON",
+	"Kms":   "KMS",
+	"Sql":   "SQL",
+	"Ssl":   "SSL",
+	"Tls":   "TLS",
+	"Ttl":   "TTL",
+	"Uri":   "URI",
+	"Url":   "URL",
+	"Vpc":   "VPC",
+}
+
+// goName converts an SDK member name to a provider model field name, e.g. KmsKeyId to KMSKeyID.
+func goName(s string) string {
+	var (
+		out  strings.Builder
+		word strings.Builder
+	)
+	flush := func() {
+		w := word.String()
+		if v, ok := initialisms[w]; ok {
+			w = v
+		}
+		out.WriteString(w)
+		word.Reset()
+	}
+	for i, ch := range s {
+		if i > 0 && ch >= 'A' && ch <= 'Z' {
+			flush()
+		}
+		word.WriteRune(ch)
+	}
+	flush()
+
+	return out.String()
+}
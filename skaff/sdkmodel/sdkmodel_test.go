@@ -0,0 +1,378 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkmodel
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testSource = `
+package widgets
+
+import "time"
+
+type WidgetStatus string
+
+const (
+	WidgetStatusCreating WidgetStatus = "CREATING"
+	WidgetStatusActive   WidgetStatus = "ACTIVE"
+	WidgetStatusUpdating WidgetStatus = "UPDATING"
+	WidgetStatusDeleting WidgetStatus = "DELETING"
+	WidgetStatusFailed   WidgetStatus = "FAILED"
+)
+
+type Size string
+
+const (
+	SizeSmall Size = "SMALL"
+	SizeLarge Size = "LARGE"
+)
+
+type Document interface{ isDocument() }
+
+type Network struct {
+	// The subnets to place the widget in.
+	//
+	// This member is required.
+	SubnetIds []string
+
+	SecurityGroupIds []string
+
+	Parent *Network
+}
+
+type Widget struct {
+	// The ARN of the widget.
+	WidgetArn *string
+
+	WidgetId *string
+
+	Name *string
+
+	Size Size
+
+	KmsKeyId *string
+
+	Network *Network
+
+	Status WidgetStatus
+
+	CreatedAt *time.Time
+
+	Tags map[string]string
+
+	Policy Document
+}
+
+type WidgetSummary struct {
+	WidgetId *string
+}
+
+type CreateWidgetInput struct {
+	// The name of the widget. Must be unique.
+	//
+	// This member is required.
+	Name *string
+
+	// This member is required.
+	Size Size
+
+	KmsKeyId *string
+
+	Network *Network
+
+	ClientToken *string
+
+	Tags map[string]string
+
+	Policy Document
+}
+
+type CreateWidgetOutput struct {
+	Widget *Widget
+}
+
+type GetWidgetInput struct {
+	// This member is required.
+	WidgetId *string
+}
+
+type GetWidgetOutput struct {
+	Widget *Widget
+}
+
+type UpdateWidgetInput struct {
+	// This member is required.
+	WidgetId *string
+
+	Size Size
+}
+
+type DeleteWidgetInput struct {
+	// This member is required.
+	WidgetId *string
+}
+
+type ListWidgetsInput struct {
+	NextToken *string
+}
+
+type ListWidgetsOutput struct {
+	Widgets []WidgetSummary
+
+	NextToken *string
+}
+`
+
+func testResource(t *testing.T) (*Model, *Resource) {
+	t.Helper()
+
+	m, err := FromSource("widgets", testSource)
+	if err != nil {
+		t.Fatalf("loading source: %s", err)
+	}
+
+	r, err := m.Resource("Widget", Operations{
+		Create: "CreateWidget",
+		Read:   "GetWidget",
+		Update: "UpdateWidget",
+		Delete: "DeleteWidget",
+		List:   "ListWidgets",
+	})
+	if err != nil {
+		t.Fatalf("deriving resource: %s", err)
+	}
+
+	return m, r
+}
+
+func TestResourceAttributes(t *testing.T) {
+	t.Parallel()
+
+	_, r := testResource(t)
+
+	type attr struct {
+		Name, GoName                                  string
+		Kind                                          Kind
+		Required, Optional, Computed, RequiresReplace bool
+	}
+	var got []attr
+	for _, a := range r.Attributes {
+		got = append(got, attr{a.Name, a.GoName, a.Kind, a.Required, a.Optional, a.Computed, a.RequiresReplace})
+	}
+
+	want := []attr{
+		{"created_at", "CreatedAt", KindTime, false, false, true, false},
+		{"kms_key_id", "KMSKeyID", KindString, false, true, true, true},
+		{"name", "Name", KindString, true, false, false, true},
+		{"network", "Network", KindStruct, false, true, false, true},
+		{"size", "Size", KindEnum, true, false, false, false},
+		{"status", "Status", KindEnum, false, false, true, false},
+		{"widget_arn", "WidgetARN", KindString, false, false, true, false},
+		{"widget_id", "WidgetID", KindString, false, false, true, true},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected attributes (-want +got):\n%s", diff)
+	}
+
+	if got, want := len(r.Unsupported), 1; got != want {
+		t.Errorf("unsupported attributes: got %d, want %d", got, want)
+	}
+	if !r.HasTags || !r.ObjectHasTags {
+		t.Errorf("tags: got %t, %t, want true", r.HasTags, r.ObjectHasTags)
+	}
+	if got, want := r.Attributes[2].Description, "The name of the widget."; got != want {
+		t.Errorf("description: got %q, want %q", got, want)
+	}
+}
+
+func TestResourceIdentifierRequiresReplace(t *testing.T) {
+	t.Parallel()
+
+	const source = `
+package gadgets
+
+type Gadget struct {
+	Name *string
+
+	Description *string
+}
+
+type CreateGadgetInput struct {
+	// This member is required.
+	Name *string
+
+	Description *string
+}
+
+type CreateGadgetOutput struct {
+	Gadget *Gadget
+}
+
+type GetGadgetInput struct {
+	// This member is required.
+	Name *string
+}
+
+type GetGadgetOutput struct {
+	Gadget *Gadget
+}
+
+type UpdateGadgetInput struct {
+	// This member is required.
+	Name *string
+
+	Description *string
+}
+
+type DeleteGadgetInput struct {
+	// This member is required.
+	Name *string
+}
+`
+
+	m, err := FromSource("gadgets", source)
+	if err != nil {
+		t.Fatalf("loading source: %s", err)
+	}
+
+	r, err := m.Resource("Gadget", Operations{
+		Create: "CreateGadget",
+		Read:   "GetGadget",
+		Update: "UpdateGadget",
+		Delete: "DeleteGadget",
+	})
+	if err != nil {
+		t.Fatalf("deriving resource: %s", err)
+	}
+
+	got := make(map[string]bool)
+	for _, a := range r.Attributes {
+		got[a.Name] = a.RequiresReplace
+	}
+
+	// The name is an Update input member but identifies the gadget.
+	want := map[string]bool{
+		"description": false,
+		"name":        true,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected RequiresReplace (-want +got):\n%s", diff)
+	}
+}
+
+func TestResourceNested(t *testing.T) {
+	t.Parallel()
+
+	_, r := testResource(t)
+
+	var network *Attribute
+	for _, a := range r.Attributes {
+		if a.Name == "network" {
+			network = a
+		}
+	}
+
+	var got []string
+	for _, a := range network.Nested {
+		got = append(got, a.Name)
+	}
+	// The recursive parent member is dropped.
+	want := []string{"security_group_ids", "subnet_ids"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected nested attributes (-want +got):\n%s", diff)
+	}
+	if !network.Nested[1].Required {
+		t.Error("subnet_ids: expected Required")
+	}
+}
+
+func TestResourceOperations(t *testing.T) {
+	t.Parallel()
+
+	m, r := testResource(t)
+
+	if got, want := r.ObjectType, "awstypes.Widget"; got != want {
+		t.Errorf("object type: got %q, want %q", got, want)
+	}
+	if got, want := r.ReadOutputField, "Widget"; got != want {
+		t.Errorf("read output field: got %q, want %q", got, want)
+	}
+	if got, want := r.CreateOutputField, "Widget"; got != want {
+		t.Errorf("create output field: got %q, want %q", got, want)
+	}
+	if len(r.Identifiers) != 1 || r.Identifiers[0].Name != "widget_id" {
+		t.Errorf("identifiers: got %v", r.Identifiers)
+	}
+
+	if diff := cmp.Diff(&Waiter{Pending: []string{"WidgetStatusCreating"}, Target: []string{"WidgetStatusActive"}}, r.CreateWaiter); diff != "" {
+		t.Errorf("unexpected create waiter (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(&Waiter{Pending: []string{"WidgetStatusUpdating"}, Target: []string{"WidgetStatusActive"}}, r.UpdateWaiter); diff != "" {
+		t.Errorf("unexpected update waiter (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(&Waiter{Pending: []string{"WidgetStatusDeleting", "WidgetStatusActive"}}, r.DeleteWaiter); diff != "" {
+		t.Errorf("unexpected delete waiter (-want +got):\n%s", diff)
+	}
+
+	if got, want := r.ListItemsField, "Widgets"; got != want {
+		t.Errorf("list items field: got %q, want %q", got, want)
+	}
+	if diff := cmp.Diff([]string{"WidgetId"}, r.ListIdentifiers); diff != "" {
+		t.Errorf("unexpected list identifiers (-want +got):\n%s", diff)
+	}
+
+	if !m.HasMember("DeleteWidgetInput", "WidgetId") {
+		t.Error("DeleteWidgetInput.WidgetId: expected member")
+	}
+	if diff := cmp.Diff([]string{"SMALL", "LARGE"}, m.EnumStringValues("Size")); diff != "" {
+		t.Errorf("unexpected enum values (-want +got):\n%s", diff)
+	}
+}
+
+func TestResourceErrors(t *testing.T) {
+	t.Parallel()
+
+	m, err := FromSource("widgets", testSource)
+	if err != nil {
+		t.Fatalf("loading source: %s", err)
+	}
+
+	testCases := map[string]Operations{
+		"missing delete":    {Create: "CreateWidget", Read: "GetWidget"},
+		"unknown operation": {Create: "CreateWidget", Read: "DescribeWidget", Delete: "DeleteWidget"},
+		"no identifier":     {Create: "CreateWidget", Read: "ListWidgets", Delete: "DeleteWidget"},
+	}
+
+	for name, ops := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := m.Resource("Widget", ops); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestGoName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"Name":             "Name",
+		"KmsKeyId":         "KMSKeyID",
+		"SecurityGroupIds": "SecurityGroupIDs",
+		"WidgetArn":        "WidgetARN",
+		"VpcEndpointUrl":   "VPCEndpointURL",
+		"Identity":         "Identity",
+	}
+
+	for input, want := range testCases {
+		if got := goName(input); got != want {
+			t.Errorf("goName(%q): got %q, want %q", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,309 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type severity int
+
+const (
+	severityAdditive severity = iota
+	severityDeprecating
+	severityBreaking
+)
+
+func (s severity) String() string {
+	switch s {
+	case severityAdditive:
+		return "additive"
+	case severityDeprecating:
+		return "deprecating"
+	case severityBreaking:
+		return "breaking"
+	default:
+		return "unknown"
+	}
+}
+
+// change is a single difference between two provider schemas.
+type change struct {
+	Severity severity
+	Path     string
+	Message  string
+}
+
+func (c change) String() string {
+	return fmt.Sprintf("%s: %s: %s", c.Severity, c.Path, c.Message)
+}
+
+type differ struct {
+	changes []change
+}
+
+func (d *differ) add(sev severity, path, format string, a ...any) {
+	d.changes = append(d.changes, change{
+		Severity: sev,
+		Path:     path,
+		Message:  fmt.Sprintf(format, a...),
+	})
+}
+
+// diffProviderSchemas returns the changes from before to after, ordered by path.
+func diffProviderSchemas(before, after *providerSchema) []change {
+	var d differ
+
+	if before.Provider != nil || after.Provider != nil {
+		d.diffSchema("provider", before.Provider, after.Provider)
+	}
+	d.diffSchemas("resource", before.Resources, after.Resources)
+	d.diffSchemas("data source", before.DataSources, after.DataSources)
+	d.diffSchemas("ephemeral resource", before.EphemeralResources, after.EphemeralResources)
+	d.diffSchemas("list resource", before.ListResources, after.ListResources)
+	d.diffFunctions(before.Functions, after.Functions)
+
+	slices.SortStableFunc(d.changes, func(a, b change) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return d.changes
+}
+
+func (d *differ) diffSchemas(kind string, before, after map[string]*schema) {
+	for _, typeName := range slices.Sorted(maps.Keys(before)) {
+		path := kind + " " + typeName
+		if _, ok := after[typeName]; !ok {
+			d.add(severityBreaking, path, "removed")
+			continue
+		}
+		d.diffSchema(path, before[typeName], after[typeName])
+	}
+
+	for _, typeName := range slices.Sorted(maps.Keys(after)) {
+		if _, ok := before[typeName]; !ok {
+			d.add(severityAdditive, kind+" "+typeName, "added")
+		}
+	}
+}
+
+func (d *differ) diffSchema(path string, before, after *schema) {
+	switch {
+	case before == nil && after == nil:
+		return
+	case before == nil:
+		d.add(severityAdditive, path, "added")
+		return
+	case after == nil:
+		d.add(severityBreaking, path, "removed")
+		return
+	}
+
+	// A schema version bump is safe when a state upgrader is registered, which cannot be checked here.
+	if before.Version != after.Version {
+		d.add(severityAdditive, path, "schema version changed from %d to %d", before.Version, after.Version)
+	}
+
+	d.diffBlock(path, before.Block, after.Block)
+}
+
+func (d *differ) diffBlock(path string, before, after *block) {
+	if before == nil {
+		before = &block{}
+	}
+	if after == nil {
+		after = &block{}
+	}
+
+	if !before.Deprecated && after.Deprecated {
+		d.add(severityDeprecating, path, "deprecated")
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(before.Attributes)) {
+		path := path + "." + name
+		n, ok := after.Attributes[name]
+		if !ok {
+			if _, ok := after.BlockTypes[name]; ok {
+				d.add(severityBreaking, path, "changed from attribute to block")
+			} else {
+				d.add(severityBreaking, path, "attribute removed")
+			}
+			continue
+		}
+		d.diffAttribute(path, before.Attributes[name], n)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(after.Attributes)) {
+		if _, ok := before.Attributes[name]; ok {
+			continue
+		}
+		if _, ok := before.BlockTypes[name]; ok {
+			// Reported as a block change.
+			continue
+		}
+		path := path + "." + name
+		if after.Attributes[name].Required {
+			d.add(severityBreaking, path, "required attribute added")
+		} else {
+			d.add(severityAdditive, path, "attribute added")
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(before.BlockTypes)) {
+		path := path + "." + name
+		n, ok := after.BlockTypes[name]
+		if !ok {
+			if _, ok := after.Attributes[name]; ok {
+				d.add(severityBreaking, path, "changed from block to attribute")
+			} else {
+				d.add(severityBreaking, path, "block removed")
+			}
+			continue
+		}
+		d.diffNestedBlock(path, before.BlockTypes[name], n)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(after.BlockTypes)) {
+		if _, ok := before.BlockTypes[name]; ok {
+			continue
+		}
+		if _, ok := before.Attributes[name]; ok {
+			// Reported as an attribute change.
+			continue
+		}
+		path := path + "." + name
+		if after.BlockTypes[name].MinItems > 0 {
+			d.add(severityBreaking, path, "required block added")
+		} else {
+			d.add(severityAdditive, path, "block added")
+		}
+	}
+}
+
+func (d *differ) diffAttribute(path string, before, after *attribute) {
+	if !sameType(before.Type, after.Type) {
+		d.add(severityBreaking, path, "type changed from %s to %s", before.Type, after.Type)
+	}
+
+	switch {
+	case !before.Required && after.Required:
+		d.add(severityBreaking, path, "now required")
+	case before.Required && !after.Required:
+		d.add(severityAdditive, path, "no longer required")
+	}
+
+	switch {
+	case (before.Optional || before.Required) && !after.Optional && !after.Required:
+		d.add(severityBreaking, path, "now computed only")
+	case !before.Optional && !before.Required && (after.Optional || after.Required):
+		d.add(severityAdditive, path, "now configurable")
+	}
+
+	switch {
+	case before.Computed && !after.Computed:
+		d.add(severityBreaking, path, "no longer computed")
+	case !before.Computed && after.Computed && (after.Optional || after.Required):
+		d.add(severityAdditive, path, "now computed")
+	}
+
+	switch {
+	case !before.Sensitive && after.Sensitive:
+		d.add(severityBreaking, path, "now sensitive")
+	case before.Sensitive && !after.Sensitive:
+		d.add(severityAdditive, path, "no longer sensitive")
+	}
+
+	if !before.Deprecated && after.Deprecated {
+		d.add(severityDeprecating, path, "deprecated")
+	}
+}
+
+func (d *differ) diffNestedBlock(path string, before, after *nestedBlock) {
+	if before.NestingMode != after.NestingMode {
+		d.add(severityBreaking, path, "nesting mode changed from %s to %s", before.NestingMode, after.NestingMode)
+	}
+
+	switch {
+	case after.MinItems > before.MinItems:
+		d.add(severityBreaking, path, "minimum items increased from %d to %d", before.MinItems, after.MinItems)
+	case after.MinItems < before.MinItems:
+		d.add(severityAdditive, path, "minimum items decreased from %d to %d", before.MinItems, after.MinItems)
+	}
+
+	// A MaxItems of 0 means unlimited.
+	switch {
+	case after.MaxItems != 0 && (before.MaxItems == 0 || after.MaxItems < before.MaxItems):
+		d.add(severityBreaking, path, "maximum items reduced from %s to %s", maxItems(before.MaxItems), maxItems(after.MaxItems))
+	case before.MaxItems != 0 && (after.MaxItems == 0 || after.MaxItems > before.MaxItems):
+		d.add(severityAdditive, path, "maximum items raised from %s to %s", maxItems(before.MaxItems), maxItems(after.MaxItems))
+	}
+
+	d.diffBlock(path, before.Block, after.Block)
+}
+
+func (d *differ) diffFunctions(before, after map[string]*function) {
+	for _, name := range slices.Sorted(maps.Keys(before)) {
+		path := "function " + name
+		n, ok := after[name]
+		if !ok {
+			d.add(severityBreaking, path, "removed")
+			continue
+		}
+		d.diffFunction(path, before[name], n)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(after)) {
+		if _, ok := before[name]; !ok {
+			d.add(severityAdditive, "function "+name, "added")
+		}
+	}
+}
+
+// diffFunction compares function signatures. Parameters are positional, so their names are not significant.
+func (d *differ) diffFunction(path string, before, after *function) {
+	if n, m := len(before.Parameters), len(after.Parameters); n != m {
+		d.add(severityBreaking, path, "number of parameters changed from %d to %d", n, m)
+	}
+
+	for i := range min(len(before.Parameters), len(after.Parameters)) {
+		d.diffParameter(fmt.Sprintf("%s parameter %d", path, i+1), before.Parameters[i], after.Parameters[i])
+	}
+
+	switch {
+	case before.VariadicParameter == nil && after.VariadicParameter != nil:
+		d.add(severityAdditive, path, "variadic parameter added")
+	case before.VariadicParameter != nil && after.VariadicParameter == nil:
+		d.add(severityBreaking, path, "variadic parameter removed")
+	case before.VariadicParameter != nil && after.VariadicParameter != nil:
+		d.diffParameter(path+" variadic parameter", before.VariadicParameter, after.VariadicParameter)
+	}
+
+	if !sameType(before.ReturnType, after.ReturnType) {
+		d.add(severityBreaking, path, "return type changed from %s to %s", before.ReturnType, after.ReturnType)
+	}
+}
+
+func (d *differ) diffParameter(path string, before, after *parameter) {
+	if !sameType(before.Type, after.Type) {
+		d.add(severityBreaking, path, "type changed from %s to %s", before.Type, after.Type)
+	}
+
+	switch {
+	case before.AllowsNull && !after.AllowsNull:
+		d.add(severityBreaking, path, "no longer allows null")
+	case !before.AllowsNull && after.AllowsNull:
+		d.add(severityAdditive, path, "now allows null")
+	}
+}
+
+func maxItems(n int64) string {
+	if n == 0 {
+		return "unlimited"
+	}
+	return strconv.FormatInt(n, 10)
+}
@@ -0,0 +1,347 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var (
+	typeString = json.RawMessage(`"string"`)
+	typeNumber = json.RawMessage(`"number"`)
+)
+
+func resources(attrs map[string]*attribute, blocks map[string]*nestedBlock) *providerSchema {
+	return &providerSchema{
+		Resources: map[string]*schema{
+			"aws_example": {
+				Block: &block{
+					Attributes: attrs,
+					BlockTypes: blocks,
+				},
+			},
+		},
+	}
+}
+
+func TestDiffProviderSchemas(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		before, after *providerSchema
+		expected      []change
+	}{
+		"no change": {
+			before: resources(map[string]*attribute{
+				"name": {Type: typeString, Required: true},
+			}, nil),
+			after: resources(map[string]*attribute{
+				"name": {Type: json.RawMessage(` "string" `), Required: true},
+			}, nil),
+		},
+		"resource added and removed": {
+			before: &providerSchema{
+				Resources: map[string]*schema{"aws_old": {Block: &block{}}},
+			},
+			after: &providerSchema{
+				Resources:   map[string]*schema{"aws_new": {Block: &block{}}},
+				DataSources: map[string]*schema{"aws_new": {Block: &block{Deprecated: true}}},
+			},
+			expected: []change{
+				{Severity: severityAdditive, Path: "data source aws_new", Message: "added"},
+				{Severity: severityAdditive, Path: "resource aws_new", Message: "added"},
+				{Severity: severityBreaking, Path: "resource aws_old", Message: "removed"},
+			},
+		},
+		"attributes added": {
+			before: resources(nil, nil),
+			after: resources(map[string]*attribute{
+				"optional": {Type: typeString, Optional: true},
+				"required": {Type: typeString, Required: true},
+			}, nil),
+			expected: []change{
+				{Severity: severityAdditive, Path: "resource aws_example.optional", Message: "attribute added"},
+				{Severity: severityBreaking, Path: "resource aws_example.required", Message: "required attribute added"},
+			},
+		},
+		"attribute changes": {
+			before: resources(map[string]*attribute{
+				"computed":   {Type: typeString, Optional: true, Computed: true},
+				"deprecated": {Type: typeString, Optional: true},
+				"relaxed":    {Type: typeString, Required: true},
+				"removed":    {Type: typeString, Optional: true},
+				"required":   {Type: typeString, Optional: true},
+				"sensitive":  {Type: typeString, Optional: true},
+				"type":       {Type: typeString, Optional: true},
+			}, nil),
+			after: resources(map[string]*attribute{
+				"computed":   {Type: typeString, Optional: true},
+				"deprecated": {Type: typeString, Optional: true, Deprecated: true},
+				"relaxed":    {Type: typeString, Optional: true},
+				"required":   {Type: typeString, Required: true},
+				"sensitive":  {Type: typeString, Optional: true, Sensitive: true},
+				"type":       {Type: typeNumber, Optional: true},
+			}, nil),
+			expected: []change{
+				{Severity: severityBreaking, Path: "resource aws_example.computed", Message: "no longer computed"},
+				{Severity: severityDeprecating, Path: "resource aws_example.deprecated", Message: "deprecated"},
+				{Severity: severityAdditive, Path: "resource aws_example.relaxed", Message: "no longer required"},
+				{Severity: severityBreaking, Path: "resource aws_example.removed", Message: "attribute removed"},
+				{Severity: severityBreaking, Path: "resource aws_example.required", Message: "now required"},
+				{Severity: severityBreaking, Path: "resource aws_example.sensitive", Message: "now sensitive"},
+				{Severity: severityBreaking, Path: "resource aws_example.type", Message: `type changed from "string" to "number"`},
+			},
+		},
+		"computed only": {
+			before: resources(map[string]*attribute{
+				"attr": {Type: typeString, Optional: true},
+			}, nil),
+			after: resources(map[string]*attribute{
+				"attr": {Type: typeString, Computed: true},
+			}, nil),
+			expected: []change{
+				{Severity: severityBreaking, Path: "resource aws_example.attr", Message: "now computed only"},
+			},
+		},
+		"block changes": {
+			before: resources(nil, map[string]*nestedBlock{
+				"max":     {NestingMode: "list", Block: &block{}},
+				"min":     {NestingMode: "list", Block: &block{}},
+				"nesting": {NestingMode: "list", MaxItems: 1, Block: &block{}},
+				"nested": {NestingMode: "list", Block: &block{
+					Attributes: map[string]*attribute{"attr": {Type: typeString, Optional: true}},
+				}},
+			}),
+			after: resources(map[string]*attribute{
+				"nested": {Type: json.RawMessage(`["list",["object",{"attr":"string"}]]`), Optional: true},
+			}, map[string]*nestedBlock{
+				"added":   {NestingMode: "set", Block: &block{}},
+				"max":     {NestingMode: "list", MaxItems: 1, Block: &block{}},
+				"min":     {NestingMode: "list", MinItems: 1, Block: &block{}},
+				"nesting": {NestingMode: "single", Block: &block{}},
+			}),
+			expected: []change{
+				{Severity: severityAdditive, Path: "resource aws_example.added", Message: "block added"},
+				{Severity: severityBreaking, Path: "resource aws_example.max", Message: "maximum items reduced from unlimited to 1"},
+				{Severity: severityBreaking, Path: "resource aws_example.min", Message: "minimum items increased from 0 to 1"},
+				{Severity: severityBreaking, Path: "resource aws_example.nested", Message: "changed from block to attribute"},
+				{Severity: severityBreaking, Path: "resource aws_example.nesting", Message: "nesting mode changed from list to single"},
+				{Severity: severityAdditive, Path: "resource aws_example.nesting", Message: "maximum items raised from 1 to unlimited"},
+			},
+		},
+		"functions": {
+			before: &providerSchema{Functions: map[string]*function{
+				"arn_build": {ReturnType: typeString},
+				"arn_parse": {ReturnType: typeString},
+			}},
+			after: &providerSchema{Functions: map[string]*function{
+				"arn_parse":          {ReturnType: typeString},
+				"trim_iam_role_path": {ReturnType: typeString},
+			}},
+			expected: []change{
+				{Severity: severityBreaking, Path: "function arn_build", Message: "removed"},
+				{Severity: severityAdditive, Path: "function trim_iam_role_path", Message: "added"},
+			},
+		},
+		"function signatures": {
+			before: &providerSchema{Functions: map[string]*function{
+				"nullable": {
+					Parameters: []*parameter{{Name: "arn", Type: typeString, AllowsNull: true}},
+					ReturnType: typeString,
+				},
+				"parameters": {
+					Parameters: []*parameter{{Name: "arn", Type: typeString}},
+					ReturnType: typeString,
+				},
+				"renamed": {
+					Parameters: []*parameter{{Name: "arn", Type: typeString}},
+					ReturnType: typeString,
+				},
+				"returns": {
+					Parameters: []*parameter{{Name: "arn", Type: typeString}},
+					ReturnType: typeString,
+				},
+				"types": {
+					Parameters: []*parameter{{Name: "a", Type: typeString}, {Name: "b", Type: typeString}},
+					ReturnType: typeString,
+				},
+				"variadic": {
+					VariadicParameter: &parameter{Name: "values", Type: typeString},
+					ReturnType:        typeString,
+				},
+			}},
+			after: &providerSchema{Functions: map[string]*function{
+				"nullable": {
+					Parameters: []*parameter{{Name: "arn", Type: typeString}},
+					ReturnType: typeString,
+				},
+				"parameters": {
+					Parameters: []*parameter{{Name: "arn", Type: typeString}, {Name: "partition", Type: typeString}},
+					ReturnType: typeString,
+				},
+				"renamed": {
+					Parameters: []*parameter{{Name: "input", Type: json.RawMessage(` "string" `)}},
+					ReturnType: typeString,
+				},
+				"returns": {
+					Parameters: []*parameter{{Name: "arn", Type: typeString}},
+					ReturnType: json.RawMessage(`["object",{"partition":"string"}]`),
+				},
+				"types": {
+					Parameters: []*parameter{{Name: "a", Type: typeString}, {Name: "b", Type: typeNumber}},
+					ReturnType: typeString,
+				},
+				"variadic": {
+					VariadicParameter: &parameter{Name: "values", Type: typeNumber},
+					ReturnType:        typeString,
+				},
+			}},
+			expected: []change{
+				{Severity: severityBreaking, Path: "function nullable parameter 1", Message: "no longer allows null"},
+				{Severity: severityBreaking, Path: "function parameters", Message: "number of parameters changed from 1 to 2"},
+				{Severity: severityBreaking, Path: "function returns", Message: `return type changed from "string" to ["object",{"partition":"string"}]`},
+				{Severity: severityBreaking, Path: "function types parameter 2", Message: `type changed from "string" to "number"`},
+				{Severity: severityBreaking, Path: "function variadic variadic parameter", Message: `type changed from "string" to "number"`},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diffProviderSchemas(testCase.before, testCase.after)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	response := &tfprotov5.GetProviderSchemaResponse{
+		ResourceSchemas: map[string]*tfprotov5.Schema{
+			"aws_example": {
+				Version: 1,
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{Name: "name", Type: tftypes.String, Required: true},
+						{Name: "tags", Type: tftypes.Map{ElementType: tftypes.String}, Optional: true, Deprecated: true},
+					},
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{
+						{TypeName: "config", Nesting: tfprotov5.SchemaNestedBlockNestingModeList, MaxItems: 1, Block: &tfprotov5.SchemaBlock{}},
+					},
+				},
+			},
+		},
+		Functions: map[string]*tfprotov5.Function{
+			"arn_parse": {
+				Parameters: []*tfprotov5.FunctionParameter{{Name: "arn", Type: tftypes.String}},
+				Return:     &tfprotov5.FunctionReturn{Type: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"partition": tftypes.String}}},
+			},
+		},
+	}
+
+	got, err := newProviderSchema(response)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &providerSchema{
+		Resources: map[string]*schema{
+			"aws_example": {
+				Version: 1,
+				Block: &block{
+					Attributes: map[string]*attribute{
+						"name": {Type: typeString, Required: true},
+						"tags": {Type: json.RawMessage(`["map","string"]`), Optional: true, Deprecated: true},
+					},
+					BlockTypes: map[string]*nestedBlock{
+						"config": {NestingMode: "list", MaxItems: 1, Block: &block{}},
+					},
+				},
+			},
+		},
+		Functions: map[string]*function{
+			"arn_parse": {
+				Parameters: []*parameter{{Name: "arn", Type: typeString}},
+				ReturnType: json.RawMessage(`["object",{"partition":"string"}]`),
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestNewProviderSchemaFromJSON(t *testing.T) {
+	t.Parallel()
+
+	// The same schema as in TestNewProviderSchema, as output by Terraform.
+	in := &tfjson.ProviderSchema{
+		ResourceSchemas: map[string]*tfjson.Schema{
+			"aws_example": {
+				Version: 1,
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"name": {AttributeType: cty.String, Required: true},
+						"tags": {AttributeType: cty.Map(cty.String), Optional: true, Deprecated: true},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"config": {NestingMode: tfjson.SchemaNestingModeList, MaxItems: 1, Block: &tfjson.SchemaBlock{}},
+					},
+				},
+			},
+		},
+		Functions: map[string]*tfjson.FunctionSignature{
+			"arn_parse": {
+				Parameters: []*tfjson.FunctionParameter{{Name: "arn", Type: cty.String}},
+				ReturnType: cty.Object(map[string]cty.Type{"partition": cty.String}),
+			},
+		},
+	}
+
+	got, err := newProviderSchemaFromJSON(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &providerSchema{
+		Resources: map[string]*schema{
+			"aws_example": {
+				Version: 1,
+				Block: &block{
+					Attributes: map[string]*attribute{
+						"name": {Type: typeString, Required: true},
+						"tags": {Type: json.RawMessage(`["map","string"]`), Optional: true, Deprecated: true},
+					},
+					BlockTypes: map[string]*nestedBlock{
+						"config": {NestingMode: "list", MaxItems: 1, Block: &block{}},
+					},
+				},
+			},
+		},
+		Functions: map[string]*function{
+			"arn_parse": {
+				Parameters: []*parameter{{Name: "arn", Type: typeString}},
+				ReturnType: json.RawMessage(`["object",{"partition":"string"}]`),
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
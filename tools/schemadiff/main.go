@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+var (
+	allowBreaking = flag.Bool("allow-breaking", false, "report breaking changes without failing")
+	baseline      = flag.String("baseline", "", "baseline provider schema file to compare against")
+	providerDir   = flag.String("provider-dir", "", "provider source tree to build and load the schema from, instead of this tool's provider")
+	terraform     = flag.String("terraform", "", "path to the Terraform CLI used with -provider-dir, defaults to terraform on PATH")
+	write         = flag.String("write", "", "write the current provider schema to file")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tschemadiff [-provider-dir <dir>] -write <file>\n")
+	fmt.Fprintf(os.Stderr, "\tschemadiff [-provider-dir <dir>] -baseline <file> [-allow-breaking]\n\n")
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if (*baseline == "") == (*write == "") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+
+	var (
+		current *providerSchema
+		err     error
+	)
+	if *providerDir != "" {
+		current, err = treeProviderSchema(ctx, *providerDir, *terraform)
+	} else {
+		current, err = currentProviderSchema(ctx)
+	}
+	if err != nil {
+		fatalf("loading provider schema: %s", err)
+	}
+
+	if *write != "" {
+		if err := writeProviderSchema(*write, current); err != nil {
+			fatalf("writing provider schema (%s): %s", *write, err)
+		}
+		fmt.Printf("Wrote provider schema to %s\n", *write)
+		return
+	}
+
+	before, err := readProviderSchema(*baseline)
+	if err != nil {
+		fatalf("reading provider schema baseline: %s", err)
+	}
+
+	changes := diffProviderSchemas(before, current)
+
+	counts := make(map[severity]int)
+	for _, c := range changes {
+		fmt.Println(c)
+		counts[c.Severity]++
+	}
+
+	fmt.Printf("\n%d breaking, %d deprecating, %d additive change(s) since %s\n", counts[severityBreaking], counts[severityDeprecating], counts[severityAdditive], *baseline)
+
+	if counts[severityBreaking] > 0 && !*allowBreaking {
+		os.Exit(1)
+	}
+}
+
+// currentProviderSchema returns the schema of the muxed SDKv2 and Framework provider.
+func currentProviderSchema(ctx context.Context) (*providerSchema, error) {
+	factory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := factory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range response.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			return nil, fmt.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	return newProviderSchema(response)
+}
+
+func fatalf(format string, a ...any) {
+	fmt.Fprintf(os.Stderr, format+"\n", a...)
+	os.Exit(1)
+}
@@ -0,0 +1,290 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// providerSchema is the subset of the provider's schema used to detect changes.
+// It is saved as the baseline JSON.
+type providerSchema struct {
+	Provider           *schema              `json:"provider,omitempty"`
+	Resources          map[string]*schema   `json:"resources,omitempty"`
+	DataSources        map[string]*schema   `json:"data_sources,omitempty"`
+	EphemeralResources map[string]*schema   `json:"ephemeral_resources,omitempty"`
+	ListResources      map[string]*schema   `json:"list_resources,omitempty"`
+	Functions          map[string]*function `json:"functions,omitempty"`
+}
+
+type schema struct {
+	Version int64  `json:"version"`
+	Block   *block `json:"block"`
+}
+
+type block struct {
+	Attributes map[string]*attribute   `json:"attributes,omitempty"`
+	BlockTypes map[string]*nestedBlock `json:"block_types,omitempty"`
+	Deprecated bool                    `json:"deprecated,omitempty"`
+}
+
+type attribute struct {
+	Type       json.RawMessage `json:"type"`
+	Required   bool            `json:"required,omitempty"`
+	Optional   bool            `json:"optional,omitempty"`
+	Computed   bool            `json:"computed,omitempty"`
+	Sensitive  bool            `json:"sensitive,omitempty"`
+	Deprecated bool            `json:"deprecated,omitempty"`
+}
+
+// function is a provider-defined function's signature.
+type function struct {
+	Parameters        []*parameter    `json:"parameters,omitempty"`
+	VariadicParameter *parameter      `json:"variadic_parameter,omitempty"`
+	ReturnType        json.RawMessage `json:"return_type"`
+}
+
+type parameter struct {
+	Name       string          `json:"name"`
+	Type       json.RawMessage `json:"type"`
+	AllowsNull bool            `json:"allows_null,omitempty"`
+}
+
+type nestedBlock struct {
+	NestingMode string `json:"nesting_mode"`
+	MinItems    int64  `json:"min_items,omitempty"`
+	MaxItems    int64  `json:"max_items,omitempty"`
+	Block       *block `json:"block"`
+}
+
+// newProviderSchema converts a provider server's schema response.
+func newProviderSchema(response *tfprotov5.GetProviderSchemaResponse) (*providerSchema, error) {
+	var (
+		ps  providerSchema
+		err error
+	)
+
+	if ps.Provider, err = newSchema(response.Provider); err != nil {
+		return nil, fmt.Errorf("provider: %w", err)
+	}
+	if ps.Resources, err = newSchemas(response.ResourceSchemas); err != nil {
+		return nil, err
+	}
+	if ps.DataSources, err = newSchemas(response.DataSourceSchemas); err != nil {
+		return nil, err
+	}
+	if ps.EphemeralResources, err = newSchemas(response.EphemeralResourceSchemas); err != nil {
+		return nil, err
+	}
+	if ps.ListResources, err = newSchemas(response.ListResourceSchemas); err != nil {
+		return nil, err
+	}
+	if ps.Functions, err = newFunctions(response.Functions); err != nil {
+		return nil, err
+	}
+
+	return &ps, nil
+}
+
+func newSchemas(in map[string]*tfprotov5.Schema) (map[string]*schema, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	out := make(map[string]*schema, len(in))
+	for typeName, v := range in {
+		s, err := newSchema(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", typeName, err)
+		}
+		out[typeName] = s
+	}
+
+	return out, nil
+}
+
+func newSchema(in *tfprotov5.Schema) (*schema, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	b, err := newBlock(in.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema{
+		Version: in.Version,
+		Block:   b,
+	}, nil
+}
+
+func newBlock(in *tfprotov5.SchemaBlock) (*block, error) {
+	out := &block{}
+	if in == nil {
+		return out, nil
+	}
+
+	out.Deprecated = in.Deprecated
+
+	for _, v := range in.Attributes {
+		typ, err := v.Type.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", v.Name, err)
+		}
+
+		if out.Attributes == nil {
+			out.Attributes = make(map[string]*attribute)
+		}
+		out.Attributes[v.Name] = &attribute{
+			Type:       typ,
+			Required:   v.Required,
+			Optional:   v.Optional,
+			Computed:   v.Computed,
+			Sensitive:  v.Sensitive,
+			Deprecated: v.Deprecated,
+		}
+	}
+
+	for _, v := range in.BlockTypes {
+		b, err := newBlock(v.Block)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", v.TypeName, err)
+		}
+
+		if out.BlockTypes == nil {
+			out.BlockTypes = make(map[string]*nestedBlock)
+		}
+		out.BlockTypes[v.TypeName] = &nestedBlock{
+			NestingMode: nestingMode(v.Nesting),
+			MinItems:    v.MinItems,
+			MaxItems:    v.MaxItems,
+			Block:       b,
+		}
+	}
+
+	return out, nil
+}
+
+func newFunctions(in map[string]*tfprotov5.Function) (map[string]*function, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	out := make(map[string]*function, len(in))
+	for name, v := range in {
+		f, err := newFunction(v)
+		if err != nil {
+			return nil, fmt.Errorf("function %s: %w", name, err)
+		}
+		out[name] = f
+	}
+
+	return out, nil
+}
+
+func newFunction(in *tfprotov5.Function) (*function, error) {
+	out := &function{}
+	if in == nil {
+		return out, nil
+	}
+
+	for _, v := range in.Parameters {
+		p, err := newParameter(v)
+		if err != nil {
+			return nil, err
+		}
+		out.Parameters = append(out.Parameters, p)
+	}
+
+	if in.VariadicParameter != nil {
+		p, err := newParameter(in.VariadicParameter)
+		if err != nil {
+			return nil, err
+		}
+		out.VariadicParameter = p
+	}
+
+	if in.Return != nil && in.Return.Type != nil {
+		typ, err := in.Return.Type.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("return: %w", err)
+		}
+		out.ReturnType = typ
+	}
+
+	return out, nil
+}
+
+func newParameter(in *tfprotov5.FunctionParameter) (*parameter, error) {
+	out := &parameter{
+		Name:       in.Name,
+		AllowsNull: in.AllowNullValue,
+	}
+
+	if in.Type != nil {
+		typ, err := in.Type.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("parameter %s: %w", in.Name, err)
+		}
+		out.Type = typ
+	}
+
+	return out, nil
+}
+
+func nestingMode(mode tfprotov5.SchemaNestedBlockNestingMode) string {
+	switch mode {
+	case tfprotov5.SchemaNestedBlockNestingModeSingle:
+		return "single"
+	case tfprotov5.SchemaNestedBlockNestingModeList:
+		return "list"
+	case tfprotov5.SchemaNestedBlockNestingModeSet:
+		return "set"
+	case tfprotov5.SchemaNestedBlockNestingModeMap:
+		return "map"
+	case tfprotov5.SchemaNestedBlockNestingModeGroup:
+		return "group"
+	default:
+		return "invalid"
+	}
+}
+
+func readProviderSchema(filename string) (*providerSchema, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var ps providerSchema
+	if err := json.Unmarshal(b, &ps); err != nil {
+		return nil, fmt.Errorf("decoding (%s): %w", filename, err)
+	}
+
+	return &ps, nil
+}
+
+func writeProviderSchema(filename string, ps *providerSchema) error {
+	b, err := json.Marshal(ps)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, append(b, '\n'), 0644) //nolint:gosec // Provider schema is not sensitive
+}
+
+// sameType returns whether two attribute types are identical.
+func sameType(a, b json.RawMessage) bool {
+	var x, y bytes.Buffer
+	if json.Compact(&x, a) != nil || json.Compact(&y, b) != nil {
+		return bytes.Equal(a, b)
+	}
+
+	return bytes.Equal(x.Bytes(), y.Bytes())
+}
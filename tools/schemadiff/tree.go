@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-exec/tfexec"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+const providerAddress = "registry.terraform.io/hashicorp/aws"
+
+// treeProviderSchema returns the schema of the provider built from the source tree in the specified directory.
+// The provider is built with the tree's own Go toolchain and module and its schema is read using Terraform,
+// so that this tool can be built once and used with any provider version.
+func treeProviderSchema(ctx context.Context, dir, terraformPath string) (*providerSchema, error) {
+	tmpDir, err := os.MkdirTemp("", "schemadiff")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pluginDir := filepath.Join(tmpDir, "plugins")
+	cmd := exec.CommandContext(ctx, "go", "build", "-o", filepath.Join(pluginDir, "terraform-provider-aws"), ".")
+	cmd.Dir = dir
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("building provider (%s): %w", dir, err)
+	}
+
+	// Development overrides make Terraform use the provider binary without initializing a working directory.
+	cliConfigFile := filepath.Join(tmpDir, "terraform.rc")
+	cliConfig := fmt.Sprintf("provider_installation {\n  dev_overrides {\n    %q = %q\n  }\n  direct {}\n}\n", "hashicorp/aws", pluginDir)
+	if err := os.WriteFile(cliConfigFile, []byte(cliConfig), 0600); err != nil {
+		return nil, err
+	}
+
+	workingDir := filepath.Join(tmpDir, "config")
+	if err := os.Mkdir(workingDir, 0700); err != nil {
+		return nil, err
+	}
+	const config = "terraform {\n  required_providers {\n    aws = {\n      source = \"hashicorp/aws\"\n    }\n  }\n}\n"
+	if err := os.WriteFile(filepath.Join(workingDir, "main.tf"), []byte(config), 0600); err != nil {
+		return nil, err
+	}
+
+	if terraformPath == "" {
+		if terraformPath, err = exec.LookPath("terraform"); err != nil {
+			return nil, err
+		}
+	}
+
+	tf, err := tfexec.NewTerraform(workingDir, terraformPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := tf.SetEnv(map[string]string{"TF_CLI_CONFIG_FILE": cliConfigFile}); err != nil {
+		return nil, err
+	}
+
+	schemas, err := tf.ProvidersSchema(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading provider schema: %w", err)
+	}
+
+	v, ok := schemas.Schemas[providerAddress]
+	if !ok {
+		return nil, fmt.Errorf("provider %s not found", providerAddress)
+	}
+
+	return newProviderSchemaFromJSON(v)
+}
+
+// newProviderSchemaFromJSON converts the schema output by `terraform providers schema -json`.
+func newProviderSchemaFromJSON(in *tfjson.ProviderSchema) (*providerSchema, error) {
+	var (
+		ps  providerSchema
+		err error
+	)
+
+	if ps.Provider, err = newSchemaFromJSON(in.ConfigSchema); err != nil {
+		return nil, fmt.Errorf("provider: %w", err)
+	}
+	if ps.Resources, err = newSchemasFromJSON(in.ResourceSchemas); err != nil {
+		return nil, err
+	}
+	if ps.DataSources, err = newSchemasFromJSON(in.DataSourceSchemas); err != nil {
+		return nil, err
+	}
+	if ps.EphemeralResources, err = newSchemasFromJSON(in.EphemeralResourceSchemas); err != nil {
+		return nil, err
+	}
+	if ps.ListResources, err = newSchemasFromJSON(in.ListResourceSchemas); err != nil {
+		return nil, err
+	}
+
+	for name, v := range in.Functions {
+		f, err := newFunctionFromJSON(v)
+		if err != nil {
+			return nil, fmt.Errorf("function %s: %w", name, err)
+		}
+
+		if ps.Functions == nil {
+			ps.Functions = make(map[string]*function)
+		}
+		ps.Functions[name] = f
+	}
+
+	return &ps, nil
+}
+
+func newSchemasFromJSON(in map[string]*tfjson.Schema) (map[string]*schema, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	out := make(map[string]*schema, len(in))
+	for typeName, v := range in {
+		s, err := newSchemaFromJSON(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", typeName, err)
+		}
+		out[typeName] = s
+	}
+
+	return out, nil
+}
+
+func newSchemaFromJSON(in *tfjson.Schema) (*schema, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	b, err := newBlockFromJSON(in.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema{
+		Version: int64(in.Version), //nolint:gosec // Schema versions are small
+		Block:   b,
+	}, nil
+}
+
+func newBlockFromJSON(in *tfjson.SchemaBlock) (*block, error) {
+	out := &block{}
+	if in == nil {
+		return out, nil
+	}
+
+	out.Deprecated = in.Deprecated
+
+	for name, v := range in.Attributes {
+		typ, err := json.Marshal(v.AttributeType)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		if out.Attributes == nil {
+			out.Attributes = make(map[string]*attribute)
+		}
+		out.Attributes[name] = &attribute{
+			Type:       typ,
+			Required:   v.Required,
+			Optional:   v.Optional,
+			Computed:   v.Computed,
+			Sensitive:  v.Sensitive,
+			Deprecated: v.Deprecated,
+		}
+	}
+
+	for name, v := range in.NestedBlocks {
+		b, err := newBlockFromJSON(v.Block)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		if out.BlockTypes == nil {
+			out.BlockTypes = make(map[string]*nestedBlock)
+		}
+		out.BlockTypes[name] = &nestedBlock{
+			NestingMode: string(v.NestingMode),
+			MinItems:    int64(v.MinItems), //nolint:gosec // Item counts are small
+			MaxItems:    int64(v.MaxItems), //nolint:gosec // Item counts are small
+			Block:       b,
+		}
+	}
+
+	return out, nil
+}
+
+func newFunctionFromJSON(in *tfjson.FunctionSignature) (*function, error) {
+	out := &function{}
+
+	for _, v := range in.Parameters {
+		p, err := newParameterFromJSON(v)
+		if err != nil {
+			return nil, err
+		}
+		out.Parameters = append(out.Parameters, p)
+	}
+
+	if in.VariadicParameter != nil {
+		p, err := newParameterFromJSON(in.VariadicParameter)
+		if err != nil {
+			return nil, err
+		}
+		out.VariadicParameter = p
+	}
+
+	typ, err := json.Marshal(in.ReturnType)
+	if err != nil {
+		return nil, fmt.Errorf("return: %w", err)
+	}
+	out.ReturnType = typ
+
+	return out, nil
+}
+
+func newParameterFromJSON(in *tfjson.FunctionParameter) (*parameter, error) {
+	typ, err := json.Marshal(in.Type)
+	if err != nil {
+		return nil, fmt.Errorf("parameter %s: %w", in.Name, err)
+	}
+
+	return &parameter{
+		Name:       in.Name,
+		Type:       typ,
+		AllowsNull: in.IsNullable,
+	}, nil
+}
@@ -0,0 +1,426 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
+)
+
+// operation describes the AWS API interaction of a Plugin SDK v2 CRUD handler.
+type operation struct {
+	Client    string   // AWSClient method returning the AWS SDK client, e.g. EC2Client
+	Conn      string   // Name of the variable holding the AWS SDK client, e.g. conn
+	APICall   string   // AWS SDK client method, e.g. CreateVpc
+	InputType string   // AWS SDK input type, e.g. ec2.CreateVpcInput
+	Output    string   // Name of the variable holding the AWS SDK output, "_" if unused
+	Input     string   // AWS SDK input composite literal, rewritten for the Plugin Framework. Empty if AutoFlex must be used
+	Finder    string   // Finder call, rewritten for the Plugin Framework
+	SetID     string   // Argument to d.SetId, rewritten for the Plugin Framework
+	Waiters   []string // Waiter calls, rewritten for the Plugin Framework
+	NotFound  []string // Conditions under which an error is ignored
+	Replaced  []string // Hand-written expanders and flatteners replaced by AutoFlex
+	TODO      []string // Plugin SDK v2 code that could not be migrated
+}
+
+// sourceAnalyzer migrates the bodies of Plugin SDK v2 CRUD handlers.
+type sourceAnalyzer struct {
+	attrNames map[string]string // names.Attr... constant name to attribute name
+	files     map[string]*ast.File
+	fset      *token.FileSet
+}
+
+func newSourceAnalyzer() *sourceAnalyzer {
+	return &sourceAnalyzer{
+		attrNames: make(map[string]string),
+		files:     make(map[string]*ast.File),
+		fset:      token.NewFileSet(),
+	}
+}
+
+// funcDecl returns the declaration of the specified package-level function value.
+// nil is returned for function literals or if the source is not available.
+func (a *sourceAnalyzer) funcDecl(fn any) *ast.FuncDecl {
+	name := funcName(fn)
+	if name == "" {
+		return nil
+	}
+
+	f := runtime.FuncForPC(reflect.ValueOf(fn).Pointer())
+	filename, _ := f.FileLine(f.Entry())
+	file, err := a.parseFile(filename)
+	if err != nil {
+		return nil
+	}
+
+	return lookupFuncDecl(file, name)
+}
+
+// funcName returns the unqualified name of the specified package-level function value.
+// An empty string is returned for function literals.
+func funcName(fn any) string {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return ""
+	}
+
+	f := runtime.FuncForPC(v.Pointer())
+	if f == nil {
+		return ""
+	}
+
+	name := f.Name()
+	name = name[strings.LastIndex(name, ".")+1:]
+	if strings.HasPrefix(name, "func") {
+		return ""
+	}
+
+	return name
+}
+
+func (a *sourceAnalyzer) parseFile(filename string) (*ast.File, error) {
+	if file, ok := a.files[filename]; ok {
+		return file, nil
+	}
+
+	file, err := parser.ParseFile(a.fset, filename, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	a.files[filename] = file
+
+	if len(a.attrNames) == 0 {
+		a.loadAttrNames(filename)
+	}
+
+	return file, nil
+}
+
+// loadAttrNames loads the names.Attr... constants from the module containing the specified file.
+func (a *sourceAnalyzer) loadAttrNames(filename string) {
+	for dir := filepath.Dir(filename); dir != filepath.Dir(dir); dir = filepath.Dir(dir) {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err != nil {
+			continue
+		}
+
+		file, err := parser.ParseFile(a.fset, filepath.Join(dir, "names", "attr_consts_gen.go"), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return
+		}
+
+		for _, decl := range file.Decls {
+			decl, ok := decl.(*ast.GenDecl)
+			if !ok || decl.Tok != token.CONST {
+				continue
+			}
+
+			for _, spec := range decl.Specs {
+				spec := spec.(*ast.ValueSpec)
+				for i, name := range spec.Names {
+					if lit, ok := spec.Values[i].(*ast.BasicLit); ok {
+						if v, err := strconv.Unquote(lit.Value); err == nil {
+							a.attrNames[name.Name] = v
+						}
+					}
+				}
+			}
+		}
+
+		return
+	}
+}
+
+func lookupFuncDecl(file *ast.File, name string) *ast.FuncDecl {
+	for _, decl := range file.Decls {
+		if decl, ok := decl.(*ast.FuncDecl); ok && decl.Recv == nil && decl.Name.Name == name {
+			return decl
+		}
+	}
+
+	return nil
+}
+
+// analyze migrates the AWS API interaction of the specified Plugin SDK v2 CRUD handler.
+// data is the name of the Plugin Framework model variable.
+func (a *sourceAnalyzer) analyze(decl *ast.FuncDecl, data string) *operation {
+	if decl == nil || decl.Body == nil {
+		return nil
+	}
+
+	op := &operation{Output: "_"}
+	var conn, inputVar string
+	var apiCall *ast.CallExpr
+	literals := make(map[string]*ast.CompositeLit)
+	results := make(map[*ast.CallExpr]string)
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Rhs) != 1 {
+				break
+			}
+
+			switch rhs := n.Rhs[0].(type) {
+			case *ast.CallExpr:
+				results[rhs] = identName(n.Lhs[0])
+
+				// conn := meta.(*conns.AWSClient).EC2Client(ctx)
+				if sel, ok := rhs.Fun.(*ast.SelectorExpr); ok {
+					if _, ok := sel.X.(*ast.TypeAssertExpr); ok && strings.HasSuffix(sel.Sel.Name, "Client") {
+						op.Client = sel.Sel.Name
+						op.Conn = identName(n.Lhs[0])
+						conn = op.Conn
+					}
+				}
+			case *ast.CompositeLit:
+				literals[identName(n.Lhs[0])] = rhs
+			case *ast.UnaryExpr:
+				if lit, ok := rhs.X.(*ast.CompositeLit); ok && rhs.Op == token.AND {
+					literals[identName(n.Lhs[0])] = lit
+				}
+			}
+
+		case *ast.CallExpr:
+			switch fun := n.Fun.(type) {
+			case *ast.SelectorExpr:
+				x := identName(fun.X)
+
+				switch {
+				case conn != "" && x == conn && len(n.Args) > 1:
+					// Tagging is handled transparently.
+					if strings.Contains(fun.Sel.Name, "Tag") {
+						break
+					}
+					if op.APICall != "" {
+						op.TODO = append(op.TODO, a.summary(n))
+						break
+					}
+					op.APICall = fun.Sel.Name
+					apiCall = n
+					if arg, ok := n.Args[1].(*ast.UnaryExpr); ok && arg.Op == token.AND {
+						inputVar = identName(arg.X)
+						if lit, ok := arg.X.(*ast.CompositeLit); ok {
+							literals[""] = lit
+						}
+					} else {
+						inputVar = identName(n.Args[1])
+					}
+
+				case x == "d" && fun.Sel.Name == "SetId" && len(n.Args) == 1:
+					if v, ok := a.rewrite(n.Args[0], data); ok {
+						op.SetID = v
+					} else {
+						op.TODO = append(op.TODO, a.summary(n))
+					}
+				}
+
+			case *ast.Ident:
+				switch name := fun.Name; {
+				case strings.HasPrefix(name, "find") && op.Finder == "":
+					if v, ok := a.rewrite(n, data); ok {
+						op.Finder = v
+					} else {
+						op.TODO = append(op.TODO, a.summary(n))
+					}
+
+				case strings.HasPrefix(name, "wait"):
+					if v, ok := a.rewrite(n, data); ok {
+						op.Waiters = append(op.Waiters, v)
+					} else {
+						op.TODO = append(op.TODO, a.summary(n))
+					}
+
+				case strings.HasPrefix(name, "expand") || strings.HasPrefix(name, "flatten"):
+					if !slices.Contains(op.Replaced, name) {
+						op.Replaced = append(op.Replaced, name)
+					}
+				}
+			}
+
+		case *ast.IfStmt:
+			// if tfawserr.ErrCodeEquals(err, errCodeInvalidVPCIDNotFound) { return diags }
+			if isNotFoundCondition(n.Cond) && len(n.Body.List) == 1 {
+				if _, ok := n.Body.List[0].(*ast.ReturnStmt); ok {
+					op.NotFound = append(op.NotFound, a.source(n.Cond))
+				}
+			}
+		}
+
+		return true
+	})
+
+	if op.APICall == "" {
+		return op
+	}
+
+	if v := results[apiCall]; v != "" && v != "_" {
+		re := regexp.MustCompile(`\b` + v + `\b`)
+		if re.MatchString(op.SetID) || slices.ContainsFunc(op.Waiters, re.MatchString) {
+			op.Output = v
+		}
+	}
+
+	lit := literals[inputVar]
+	if inputVar == "" {
+		lit = literals[""]
+	}
+	if lit != nil {
+		op.InputType = a.source(lit.Type)
+		if v, ok := a.rewrite(lit, data); ok {
+			op.Input = v
+		}
+	} else {
+		// var input ec2.CreateVpcInput
+		ast.Inspect(decl.Body, func(n ast.Node) bool {
+			if spec, ok := n.(*ast.ValueSpec); ok && spec.Type != nil && len(spec.Names) == 1 && spec.Names[0].Name == inputVar {
+				op.InputType = a.source(spec.Type)
+			}
+			return op.InputType == ""
+		})
+	}
+
+	return op
+}
+
+func identName(expr ast.Expr) string {
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
+
+// isNotFoundCondition returns whether the specified expression tests err for a "not found" condition.
+func isNotFoundCondition(expr ast.Expr) bool {
+	switch expr := expr.(type) {
+	case *ast.BinaryExpr:
+		if expr.Op == token.LOR {
+			return isNotFoundCondition(expr.X) && isNotFoundCondition(expr.Y)
+		}
+	case *ast.CallExpr:
+		for _, arg := range expr.Args {
+			if identName(arg) == "err" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// summary returns a single line summary of the specified call.
+func (a *sourceAnalyzer) summary(call *ast.CallExpr) string {
+	return a.source(call.Fun) + "(...)"
+}
+
+func (a *sourceAnalyzer) source(node ast.Node) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, a.fset, node); err != nil {
+		return ""
+	}
+
+	return buf.String()
+}
+
+var (
+	sdkv2GetRegexp     = regexp.MustCompile(`\bd\.Get\((names\.\w+|"\w+")\)\.\((string|int|bool|float64)\)`)
+	sdkv2IDRegexp      = regexp.MustCompile(`\bd\.Id\(\)`)
+	sdkv2MetaRegexp    = regexp.MustCompile(`\bmeta\.\(\*conns\.AWSClient\)`)
+	sdkv2TimeoutRegexp = regexp.MustCompile(`\bd\.Timeout\(schema\.Timeout(Create|Read|Update|Delete)\)`)
+	sdkv2Regexp        = regexp.MustCompile(`\b(d|meta)\b\.`)
+)
+
+// rewrite rewrites the source of a Plugin SDK v2 expression for the Plugin Framework.
+// data is the name of the Plugin Framework model variable.
+func (a *sourceAnalyzer) rewrite(node ast.Node, data string) (string, bool) {
+	src := a.source(node)
+
+	src = sdkv2IDRegexp.ReplaceAllString(src, data+".ID.ValueString()")
+	src = sdkv2MetaRegexp.ReplaceAllString(src, "r.Meta()")
+	src = sdkv2TimeoutRegexp.ReplaceAllString(src, "r.${1}Timeout(ctx, "+data+".Timeouts)")
+
+	ok := true
+	src = sdkv2GetRegexp.ReplaceAllStringFunc(src, func(s string) string {
+		m := sdkv2GetRegexp.FindStringSubmatch(s)
+
+		name := m[1]
+		if v, found := strings.CutPrefix(name, "names."); found {
+			if name, found = a.attrNames[v]; !found {
+				ok = false
+				return s
+			}
+		} else {
+			name, _ = strconv.Unquote(name)
+		}
+
+		field := data + "." + naming.ToCamelCase(name)
+		switch m[2] {
+		case "bool":
+			return field + ".ValueBool()"
+		case "float64":
+			return field + ".ValueFloat64()"
+		case "int":
+			return "int(" + field + ".ValueInt64())"
+		default:
+			return field + ".ValueString()"
+		}
+	})
+
+	if !ok || sdkv2Regexp.MatchString(src) {
+		return "", false
+	}
+
+	return src, true
+}
+
+// imports returns the imports of the file declaring the specified function.
+// Imports of Plugin SDK v2 packages are omitted.
+func (a *sourceAnalyzer) imports(decl *ast.FuncDecl) []goImport {
+	if decl == nil {
+		return nil
+	}
+
+	file, ok := a.files[a.fset.File(decl.Pos()).Name()]
+	if !ok {
+		return nil
+	}
+
+	var imports []goImport
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || strings.Contains(path, "terraform-plugin-sdk") {
+			continue
+		}
+
+		v := goImport{Path: path}
+		if spec.Name != nil {
+			v.Alias = spec.Name.Name
+		}
+		imports = append(imports, v)
+	}
+
+	return imports
+}
+
+// crudData contains the migrated bodies of a Plugin SDK v2 resource's CRUD handlers.
+type crudData struct {
+	Create, Read, Update, Delete *operation
+	ImportByID                   bool
+	ImportFunc                   string // Plugin SDK v2 importer, if not passthrough
+	Imports                      []goImport
+	UpdateFinder                 string // Read finder call, rewritten for the Update handler
+}
@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"go/parser"
+	"slices"
+	"testing"
+)
+
+const testCRUDSource = `
+package example
+
+func resourceWidgetCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := example.CreateWidgetInput{
+		Configuration: expandConfiguration(d.Get("configuration").([]any)),
+		Name:          aws.String(name),
+	}
+
+	output, err := conn.CreateWidget(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Widget (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Widget.WidgetId))
+
+	if _, err := waitWidgetCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Widget (%s) create: %s", d.Id(), err)
+	}
+
+	if _, err := conn.PutWidgetPolicy(ctx, &example.PutWidgetPolicyInput{}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Widget (%s) policy: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceWidgetRead(ctx, d, meta)...)
+}
+
+func resourceWidgetRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	widget, err := findWidgetByTwoPartKey(ctx, conn, d.Id(), d.Get("cluster_name").(string))
+
+	if !d.IsNewResource() && retry.NotFound(err) {
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("configuration", flattenConfiguration(widget.Configuration))
+
+	return diags
+}
+
+func resourceWidgetDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	_, err := conn.DeleteWidget(ctx, &example.DeleteWidgetInput{
+		WidgetId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) || tfawserr.ErrCodeEquals(err, "NotFound") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Widget (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitWidgetDeleted(ctx, meta.(*conns.AWSClient).ExampleClient(ctx), d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Widget (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+`
+
+func TestSourceAnalyzer(t *testing.T) {
+	t.Parallel()
+
+	a := newSourceAnalyzer()
+	a.attrNames["AttrName"] = "name"
+	file, err := parser.ParseFile(a.fset, "widget.go", testCRUDSource, parser.SkipObjectResolution)
+	if err != nil {
+		t.Fatalf("parsing source: %s", err)
+	}
+
+	create := a.analyze(lookupFuncDecl(file, "resourceWidgetCreate"), "data")
+	if got, want := create.Client, "ExampleClient"; got != want {
+		t.Errorf("client: got %q, want %q", got, want)
+	}
+	if got, want := create.APICall, "CreateWidget"; got != want {
+		t.Errorf("API call: got %q, want %q", got, want)
+	}
+	if got, want := create.InputType, "example.CreateWidgetInput"; got != want {
+		t.Errorf("input type: got %q, want %q", got, want)
+	}
+	if got, want := create.Output, "output"; got != want {
+		t.Errorf("output: got %q, want %q", got, want)
+	}
+	if got, want := create.SetID, "aws.ToString(output.Widget.WidgetId)"; got != want {
+		t.Errorf("SetId: got %q, want %q", got, want)
+	}
+	if got, want := create.Waiters, []string{"waitWidgetCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))"}; !slices.Equal(got, want) {
+		t.Errorf("waiters: got %q, want %q", got, want)
+	}
+	if got, want := create.Replaced, []string{"expandConfiguration"}; !slices.Equal(got, want) {
+		t.Errorf("replaced: got %q, want %q", got, want)
+	}
+	if got, want := create.TODO, []string{"conn.PutWidgetPolicy(...)"}; !slices.Equal(got, want) {
+		t.Errorf("TODO: got %q, want %q", got, want)
+	}
+
+	read := a.analyze(lookupFuncDecl(file, "resourceWidgetRead"), "data")
+	if got, want := read.Finder, "findWidgetByTwoPartKey(ctx, conn, data.ID.ValueString(), data.ClusterName.ValueString())"; got != want {
+		t.Errorf("finder: got %q, want %q", got, want)
+	}
+	if got, want := read.Replaced, []string{"flattenConfiguration"}; !slices.Equal(got, want) {
+		t.Errorf("replaced: got %q, want %q", got, want)
+	}
+
+	del := a.analyze(lookupFuncDecl(file, "resourceWidgetDelete"), "data")
+	if got, want := del.Input, "example.DeleteWidgetInput{\n\tWidgetId: aws.String(data.ID.ValueString()),\n}"; got != want {
+		t.Errorf("input: got %q, want %q", got, want)
+	}
+	if got, want := del.Output, "_"; got != want {
+		t.Errorf("output: got %q, want %q", got, want)
+	}
+	if got, want := del.NotFound, []string{`errs.IsA[*awstypes.ResourceNotFoundException](err) || tfawserr.ErrCodeEquals(err, "NotFound")`}; !slices.Equal(got, want) {
+		t.Errorf("not found: got %q, want %q", got, want)
+	}
+	if got, want := del.Waiters, []string{"waitWidgetDeleted(ctx, r.Meta().ExampleClient(ctx), data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts))"}; !slices.Equal(got, want) {
+		t.Errorf("waiters: got %q, want %q", got, want)
+	}
+}
+
+func TestSourceAnalyzerRewrite(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		source string
+		want   string
+		wantOK bool
+	}{
+		"ID": {
+			source: "d.Id()",
+			want:   "new.ID.ValueString()",
+			wantOK: true,
+		},
+		"Get string": {
+			source: `d.Get("cluster_name").(string)`,
+			want:   "new.ClusterName.ValueString()",
+			wantOK: true,
+		},
+		"Get int": {
+			source: `d.Get("port").(int)`,
+			want:   "int(new.Port.ValueInt64())",
+			wantOK: true,
+		},
+		"Get names constant": {
+			source: `d.Get(names.AttrName).(string)`,
+			want:   "new.Name.ValueString()",
+			wantOK: true,
+		},
+		"Get unknown names constant": {
+			source: `d.Get(names.AttrUnknown).(string)`,
+		},
+		"Get list": {
+			source: `d.Get("subnet_ids").([]any)`,
+		},
+		"HasChange": {
+			source: `d.HasChange("name")`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			a := newSourceAnalyzer()
+			a.attrNames["AttrName"] = "name"
+			expr, err := parser.ParseExpr(testCase.source)
+			if err != nil {
+				t.Fatalf("parsing source: %s", err)
+			}
+
+			got, ok := a.rewrite(expr, "new")
+			if ok != testCase.wantOK {
+				t.Fatalf("ok: got %t, want %t", ok, testCase.wantOK)
+			}
+			if got != testCase.want {
+				t.Errorf("got %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
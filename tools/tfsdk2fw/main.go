@@ -8,11 +8,14 @@ import (
 	_ "embed"
 	"flag"
 	"fmt"
+	"go/ast"
 	"io"
 	"os"
 	"path"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
@@ -131,9 +134,11 @@ func (m *migrator) migrate(outputFilename string) error {
 func (m *migrator) generateTemplateData() (*templateData, error) {
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
+	sbModels := strings.Builder{}
 	emitter := &emitter{
 		Generator:    m.Generator,
 		IsDataSource: m.IsDataSource,
+		ModelWriter:  &sbModels,
 		SchemaWriter: &sbSchema,
 		StructWriter: &sbStruct,
 	}
@@ -145,51 +150,154 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	}
 
 	templateData := &templateData{
-		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
-		DefaultReadTimeout:           emitter.DefaultReadTimeout,
-		DefaultUpdateTimeout:         emitter.DefaultUpdateTimeout,
-		DefaultDeleteTimeout:         emitter.DefaultDeleteTimeout,
-		EmitResourceImportState:      m.Resource.Importer != nil,
-		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
-		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
-		HasTimeouts:                  emitter.HasTimeouts,
-		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
-		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
-		Name:                         m.Name,
-		PackageName:                  m.PackageName,
-		Schema:                       sbSchema.String(),
-		Struct:                       sbStruct.String(),
-		TFTypeName:                   m.TFTypeName,
+		DefaultCreateTimeout:       durationExpr(emitter.DefaultCreateTimeout),
+		DefaultReadTimeout:         durationExpr(emitter.DefaultReadTimeout),
+		DefaultUpdateTimeout:       durationExpr(emitter.DefaultUpdateTimeout),
+		DefaultDeleteTimeout:       durationExpr(emitter.DefaultDeleteTimeout),
+		EmitResourceImportState:    m.Resource.Importer != nil,
+		EmitResourceUpdateSkeleton: m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
+		HasTags:                    !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
+		HasTimeouts:                emitter.HasTimeouts,
+		Models:                     sbModels.String(),
+		Name:                       m.Name,
+		PackageName:                m.PackageName,
+		Schema:                     sbSchema.String(),
+		Struct:                     sbStruct.String(),
+		TagsIdentifierAttribute:    "id",
+		TFTypeName:                 m.TFTypeName,
 	}
 
-	for _, v := range emitter.FrameworkPlanModifierPackages {
-		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
-			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
+	if _, ok := m.Resource.Schema["arn"]; ok {
+		templateData.TagsIdentifierAttribute = "arn"
+	}
+
+	if !m.IsDataSource {
+		templateData.CRUD = m.generateCRUDData()
+
+		// Every Plugin SDK v2 schema version, including the current one, is upgraded.
+		for version := range m.Resource.SchemaVersion + 1 {
+			upgrader := stateUpgrader{Version: version}
+			for _, v := range m.Resource.StateUpgraders {
+				if v.Version < version {
+					continue
+				}
+				if name := funcName(v.Upgrade); name != "" {
+					upgrader.Funcs = append(upgrader.Funcs, name)
+				} else {
+					upgrader.TODO = append(upgrader.TODO, v.Version)
+				}
+			}
+			templateData.StateUpgraders = append(templateData.StateUpgraders, upgrader)
 		}
 	}
+
+	imports := resourceImports
+	if m.IsDataSource {
+		imports = dataSourceImports
+	}
+	for _, v := range imports {
+		templateData.addImport(v)
+	}
+	for _, v := range emitter.FrameworkPlanModifierPackages {
+		templateData.addImport(goImport{Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/" + v})
+	}
 	for _, v := range emitter.FrameworkValidatorsPackages {
-		if !slices.Contains(templateData.FrameworkValidatorsPackages, v) {
-			templateData.FrameworkValidatorsPackages = append(templateData.FrameworkValidatorsPackages, v)
-		}
+		templateData.addImport(goImport{Path: "github.com/hashicorp/terraform-plugin-framework-validators/" + v})
 	}
 	for _, v := range emitter.GoImports {
-		if !slices.Contains(templateData.GoImports, v) {
-			templateData.GoImports = append(templateData.GoImports, v)
+		templateData.addImport(v)
+	}
+	if v := templateData.CRUD; v != nil {
+		for _, v := range v.Imports {
+			templateData.addImport(v)
 		}
 	}
 
 	return templateData, nil
 }
 
+// generateCRUDData migrates the bodies of the resource's CRUD handlers from the Plugin SDK v2 source.
+func (m *migrator) generateCRUDData() *crudData {
+	r := m.Resource
+	analyzer := newSourceAnalyzer()
+	create := analyzer.funcDecl(firstFunc(r.CreateWithoutTimeout, r.CreateContext))
+	read := analyzer.funcDecl(firstFunc(r.ReadWithoutTimeout, r.ReadContext))
+	update := analyzer.funcDecl(firstFunc(r.UpdateWithoutTimeout, r.UpdateContext))
+	del := analyzer.funcDecl(firstFunc(r.DeleteWithoutTimeout, r.DeleteContext))
+	data := &crudData{
+		Create: analyzer.analyze(create, "data"),
+		Read:   analyzer.analyze(read, "data"),
+		Update: analyzer.analyze(update, "new"),
+		Delete: analyzer.analyze(del, "data"),
+	}
+
+	if op := analyzer.analyze(read, "new"); op != nil {
+		data.UpdateFinder = op.Finder
+	}
+
+	for _, decl := range []*ast.FuncDecl{create, read, update, del} {
+		data.Imports = append(data.Imports, analyzer.imports(decl)...)
+	}
+
+	if v := r.Importer; v != nil {
+		if name := funcName(v.StateContext); name == "ImportStatePassthroughContext" {
+			data.ImportByID = true
+		} else {
+			data.ImportFunc = name
+		}
+	}
+
+	for i, op := range []*operation{data.Create, data.Read, data.Update, data.Delete} {
+		name := []string{"Create", "Read", "Update", "Delete"}[i]
+
+		switch {
+		case op == nil && name == "Update":
+		case op != nil && op.APICall != "":
+		case op != nil && name == "Read" && op.Finder != "":
+		default:
+			m.Generator.Warnf("unable to migrate %s handler: manual editing is required", name)
+		}
+	}
+
+	return data
+}
+
+// firstFunc returns the first non-nil function value.
+func firstFunc(fns ...any) any {
+	for _, fn := range fns {
+		if funcName(fn) != "" {
+			return fn
+		}
+	}
+
+	return nil
+}
+
+// durationExpr returns a human-friendly Go expression for the specified time.Duration.
+func durationExpr(d time.Duration) string {
+	switch {
+	case d <= 0:
+		return ""
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%d * time.Hour", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute)
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	default:
+		return fmt.Sprintf("%d * time.Nanosecond", d)
+	}
+}
+
 func (m *migrator) infof(format string, a ...any) {
 	m.Generator.Infof(format, a...)
 }
 
 type emitter struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	DefaultCreateTimeout          time.Duration
+	DefaultReadTimeout            time.Duration
+	DefaultUpdateTimeout          time.Duration
+	DefaultDeleteTimeout          time.Duration
 	Generator                     *common.Generator
 	FrameworkPlanModifierPackages []string // Package names for any terraform-plugin-framework plan modifiers. May contain duplicates.
 	FrameworkValidatorsPackages   []string // Package names for any terraform-plugin-framework-validators validators. May contain duplicates.
@@ -197,11 +305,11 @@ type emitter struct {
 	HasTimeouts                   bool
 	HasTopLevelTagsAllMap         bool
 	HasTopLevelTagsMap            bool
-	ImportFrameworkAttr           bool
-	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
+	ModelWriter                   io.Writer // Nested object models
 	SchemaWriter                  io.Writer
-	StructWriter                  io.Writer
+	StructWriter                  io.Writer // Top-level model fields
+	modelNames                    map[string]string
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
@@ -220,29 +328,30 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 		e.HasTimeouts = true
 
 		if v := v.Create; v != nil {
-			e.DefaultCreateTimeout = int64(*v)
+			e.DefaultCreateTimeout = *v
 		}
 		if v := v.Read; v != nil {
-			e.DefaultReadTimeout = int64(*v)
+			e.DefaultReadTimeout = *v
 		}
 		if v := v.Update; v != nil {
-			e.DefaultUpdateTimeout = int64(*v)
+			e.DefaultUpdateTimeout = *v
 		}
 		if v := v.Delete; v != nil {
-			e.DefaultDeleteTimeout = int64(*v)
+			e.DefaultDeleteTimeout = *v
 		}
 	}
 
 	fprintf(e.SchemaWriter, "schema.Schema{\n")
 
-	err := e.emitAttributesAndBlocks(nil, resource.Schema)
+	err := e.emitAttributesAndBlocks(nil, resource.Schema, e.StructWriter)
 
 	if err != nil {
 		return err
 	}
 
-	if version := resource.SchemaVersion; version > 0 {
-		fprintf(e.SchemaWriter, "Version:%d,\n", version)
+	// State written by every Plugin SDK v2 schema version is upgraded.
+	if !e.IsDataSource {
+		fprintf(e.SchemaWriter, "Version:%d,\n", resource.SchemaVersion+1)
 	}
 
 	if description := resource.Description; description != "" {
@@ -259,9 +368,9 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 }
 
 // emitAttributesAndBlocks generates the Plugin Framework code for a set of Plugin SDK Attributes and Blocks
-// and emits the generated code to the emitter's Writer.
+// and emits the generated code to the emitter's Writer and the model fields to w.
 // Property names are sorted prior to code generation to reduce diffs.
-func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema) error {
+func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema, w io.Writer) error {
 	isTopLevelAttribute := len(path) == 0
 
 	// At this point we are emitting code for a schema.Block or Schema.
@@ -294,22 +403,18 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			}
 		}
 		fprintf(e.SchemaWriter, "%q:", name)
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
+		fprintf(w, "%s ", naming.ToCamelCase(name))
 
 		if name == "id" && isTopLevelAttribute {
 			fprintf(e.SchemaWriter, "framework.IDAttribute()")
+			fprintf(w, "types.String")
 		} else {
-			if err := e.emitAttributeProperty(append(path, name), property); err != nil {
+			if err := e.emitAttributeProperty(append(path, name), property, w); err != nil {
 				return err
 			}
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
+		fprintf(w, " `tfsdk:%q`\n", name)
 
 		fprintf(e.SchemaWriter, ",\n")
 	}
@@ -331,13 +436,16 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
+		fprintf(w, "%s ", naming.ToCamelCase(name))
 
-		err := e.emitBlockProperty(append(path, name), property)
+		err := e.emitBlockProperty(append(path, name), property, w)
 
 		if err != nil {
 			return err
 		}
 
+		fprintf(w, " `tfsdk:%q`\n", name)
+
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -349,7 +457,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 // emitAttributeProperty generates the Plugin Framework code for a Plugin SDK Attribute's property
 // and emits the generated code to the emitter's Writer.
-func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema) error {
+func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema, w io.Writer) error {
 	attributeName := path[len(path)-1]
 	isComputedOnly := property.Computed && !property.Optional
 	isTopLevelAttribute := len(path) == 1
@@ -357,6 +465,26 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	var defaultSpec string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
 
+	// Special handling for 'tags' and 'tags_all'.
+	if isTopLevelAttribute && property.Type == schema.TypeMap {
+		switch {
+		case attributeName == "tags" && property.Optional:
+			e.HasTopLevelTagsMap = true
+			fprintf(e.SchemaWriter, "tftags.TagsAttribute()")
+			fprintf(w, "tftags.Map")
+			return nil
+		case attributeName == "tags" || attributeName == "tags_all":
+			if attributeName == "tags" {
+				e.HasTopLevelTagsMap = true
+			} else {
+				e.HasTopLevelTagsAllMap = true
+			}
+			fprintf(e.SchemaWriter, "tftags.TagsAttributeComputedOnly()")
+			fprintf(w, "tftags.Map")
+			return nil
+		}
+	}
+
 	// At this point we are emitting code for the values of a schema.Schema's Attributes (map[string]schema.Attribute).
 	switch v := property.Type; v {
 	//
@@ -364,30 +492,21 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	//
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fprintf(w, "types.Bool")
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
 
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fprintf(w, "types.Float64")
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
 
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fprintf(w, "types.Int64")
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
@@ -395,20 +514,13 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeString:
 		// Computed-only ARN attributes are easiest handled as strings.
 		if (attributeName == "arn" || strings.HasSuffix(attributeName, "_arn")) && !isComputedOnly {
-			e.ImportProviderFrameworkTypes = true
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fprintf(w, "fwtypes.ARN")
 		} else {
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fprintf(w, "types.String")
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -425,10 +537,6 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
-
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
 			fwValidatorsPackage = "listvalidator"
@@ -438,10 +546,6 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
-
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
 			fwValidatorsPackage = "mapvalidator"
@@ -451,10 +555,6 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
-
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
 			fwValidatorsPackage = "setvalidator"
@@ -463,52 +563,36 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 		switch v := property.Elem.(type) {
 		case *schema.Schema:
-			var elementType string
-
-			switch v := v.Type; v {
-			case schema.TypeBool:
-				elementType = "types.BoolType"
-
-			case schema.TypeFloat:
-				elementType = "types.Float64Type"
-
-			case schema.TypeInt:
-				elementType = "types.Int64Type"
-
-			case schema.TypeString:
-				elementType = "types.StringType"
-				// Special handling for 'tags' and 'tags_all'.
-				if typeName == "map" && isTopLevelAttribute {
-					if attributeName == "tags" {
-						e.HasTopLevelTagsMap = true
-						if property.Optional {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttribute()\n")
-						} else if property.Computed {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
-						}
-					} else if attributeName == "tags_all" {
-						e.HasTopLevelTagsAllMap = true
-						fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
-					}
-				}
+			goType, customType, elementType, err := e.collectionType(path, typeName, v.Type)
 
-			default:
-				return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", typeName, v.String()))
+			if err != nil {
+				return err
 			}
 
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
+			if customType != "" {
+				fprintf(e.SchemaWriter, "CustomType:%s,\n", customType)
+			}
 			fprintf(e.SchemaWriter, "ElementType:%s,\n", elementType)
+			fprintf(w, "%s", goType)
 
 		case *schema.Resource:
 			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeBlock.
-			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
-			fprintf(e.SchemaWriter, "ElementType:")
+			if typeName == "map" {
+				return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
+			}
 
-			if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
+			modelName, err := e.emitComputedOnlyModel(path, v.Schema)
+
+			if err != nil {
 				return err
 			}
 
-			fprintf(e.SchemaWriter, ",\n")
+			nestedObjectType := strings.ToUpper(typeName[:1]) + typeName[1:]
+			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", nestedObjectType, modelName)
+			fprintf(e.SchemaWriter, "ElementType:fwtypes.NewObjectTypeOf[%s](ctx),\n", modelName)
+			fprintf(w, "fwtypes.%sNestedObjectValueOf[%s]", nestedObjectType, modelName)
 
 		default:
 			return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
@@ -616,7 +700,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 // emitBlockProperty generates the Plugin Framework code for a Plugin SDK Block's property
 // and emits the generated code to the emitter's Writer.
-func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) error {
+func (e *emitter) emitBlockProperty(path []string, property *schema.Schema, w io.Writer) error {
 	var planModifiers []string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
 
@@ -633,16 +717,22 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
 
+			modelName := e.modelName(path)
+
 			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			sbModel := strings.Builder{}
+			err := e.emitAttributesAndBlocks(path, v.Schema, &sbModel)
 
 			if err != nil {
 				return err
 			}
 
 			fprintf(e.SchemaWriter, "},\n")
+			e.emitModel(modelName, sbModel.String())
+			fprintf(w, "fwtypes.ListNestedObjectValueOf[%s]", modelName)
 
 		default:
 			return unsupportedTypeError(path, fmt.Sprintf("(Block) list of %T", v))
@@ -656,16 +746,22 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 
+			modelName := e.modelName(path)
+
 			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewSetNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			sbModel := strings.Builder{}
+			err := e.emitAttributesAndBlocks(path, v.Schema, &sbModel)
 
 			if err != nil {
 				return err
 			}
 
 			fprintf(e.SchemaWriter, "},\n")
+			e.emitModel(modelName, sbModel.String())
+			fprintf(w, "fwtypes.SetNestedObjectValueOf[%s]", modelName)
 
 		default:
 			return unsupportedTypeError(path, fmt.Sprintf("(Block) set of %T", v))
@@ -731,127 +827,158 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 	return nil
 }
 
-// emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
-// and emits the generated code to the emitter's Writer.
+// emitComputedOnlyModel generates the model for a Plugin SDK Computed-only nested block
+// and emits the generated code to the emitter's ModelWriter.
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
 // Property names are sorted prior to code generation to reduce diffs.
-func (e *emitter) emitComputedOnlyBlock(path []string, schema map[string]*schema.Schema) error {
+func (e *emitter) emitComputedOnlyModel(path []string, schema map[string]*schema.Schema) (string, error) {
 	names := make([]string, 0)
 	for name := range schema {
 		names = append(names, name)
 	}
 	slices.Sort(names)
 
-	fprintf(e.SchemaWriter, "types.ObjectType{\n")
+	modelName := e.modelName(path)
 
-	emittedFieldName := false
+	sbModel := strings.Builder{}
 	for _, name := range names {
-		property := schema[name]
-
-		if !emittedFieldName {
-			fprintf(e.SchemaWriter, "AttrTypes: map[string]attr.Type{\n")
-			emittedFieldName = true
-			e.ImportFrameworkAttr = true
-		}
-
-		fprintf(e.SchemaWriter, "%q:", name)
-
-		err := e.emitComputedOnlyBlockProperty(append(path, name), property)
+		goType, err := e.computedOnlyModelFieldType(append(path, name), schema[name])
 
 		if err != nil {
-			return err
+			return "", err
 		}
-	}
-	if emittedFieldName {
-		fprintf(e.SchemaWriter, "},\n")
+
+		fprintf(&sbModel, "%s %s `tfsdk:%q`\n", naming.ToCamelCase(name), goType, name)
 	}
 
-	fprintf(e.SchemaWriter, "}")
+	e.emitModel(modelName, sbModel.String())
 
-	return nil
+	return modelName, nil
 }
 
-// emitComputedOnlyBlockProperty generates the Plugin Framework code for a Plugin SDK Computed-only nested block's property
-// and emits the generated code to the emitter's Writer.
-// See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
-func (e *emitter) emitComputedOnlyBlockProperty(path []string, property *schema.Schema) error {
-	// At this point we are emitting code for the values of a types.ObjectType's AttrMap (map[string]attr.Type).
+// computedOnlyModelFieldType returns the model field type for a Plugin SDK Computed-only nested block's property.
+func (e *emitter) computedOnlyModelFieldType(path []string, property *schema.Schema) (string, error) {
 	switch v := property.Type; v {
 	//
 	// Primitive types.
 	//
 	case schema.TypeBool:
-		fprintf(e.SchemaWriter, "types.BoolType,\n")
+		return "types.Bool", nil
 
 	case schema.TypeFloat:
-		fprintf(e.SchemaWriter, "types.Float64Type,\n")
+		return "types.Float64", nil
 
 	case schema.TypeInt:
-		fprintf(e.SchemaWriter, "types.Int64Type,\n")
+		return "types.Int64", nil
 
 	case schema.TypeString:
-		fprintf(e.SchemaWriter, "types.StringType,\n")
+		return "types.String", nil
 
 	//
 	// Complex types.
 	//
 	case schema.TypeList, schema.TypeMap, schema.TypeSet:
-		var aggregateType, typeName string
-
-		switch v {
-		case schema.TypeList:
-			aggregateType = "types.ListType"
-			typeName = "list"
-		case schema.TypeMap:
-			aggregateType = "types.MapType"
-			typeName = "map"
-		case schema.TypeSet:
-			aggregateType = "types.SetType"
-			typeName = "set"
-		}
+		typeName := strings.ToLower(strings.TrimPrefix(v.String(), "Type"))
 
 		switch v := property.Elem.(type) {
 		case *schema.Schema:
-			var elementType string
-
-			switch v := v.Type; v {
-			case schema.TypeBool:
-				elementType = "types.BoolType"
+			goType, _, _, err := e.collectionType(path, typeName, v.Type)
 
-			case schema.TypeFloat:
-				elementType = "types.Float64Type"
+			return goType, err
 
-			case schema.TypeInt:
-				elementType = "types.Int64Type"
+		case *schema.Resource:
+			if typeName == "map" {
+				break
+			}
 
-			case schema.TypeString:
-				elementType = "types.StringType"
+			modelName, err := e.emitComputedOnlyModel(path, v.Schema)
 
-			default:
-				return unsupportedTypeError(path, fmt.Sprintf("(ComputedOnlyBlockProperty) %s of %s", typeName, v.String()))
+			if err != nil {
+				return "", err
 			}
 
-			fprintf(e.SchemaWriter, "%s{ElemType:%s},\n", aggregateType, elementType)
+			return fmt.Sprintf("fwtypes.%s%sNestedObjectValueOf[%s]", strings.ToUpper(typeName[:1]), typeName[1:], modelName), nil
+		}
 
-		case *schema.Resource:
-			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeBlock.
-			fprintf(e.SchemaWriter, "%s{ElemType:", aggregateType)
+		return "", unsupportedTypeError(path, fmt.Sprintf("(ComputedOnlyBlockProperty) %s of %T", typeName, property.Elem))
+	}
 
-			if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
-				return err
-			}
+	return "", unsupportedTypeError(path, property.Type.String())
+}
 
-			fprintf(e.SchemaWriter, "},\n")
+// collectionType returns the model field type, the custom type (if any) and the element type
+// for a Plugin SDK list, map or set of primitive values.
+func (e *emitter) collectionType(path []string, typeName string, elemType schema.ValueType) (string, string, string, error) {
+	var elementType string
 
-		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(ComputedOnlyBlockProperty) %s of %T", typeName, v))
+	switch elemType {
+	case schema.TypeBool:
+		elementType = "types.BoolType"
+
+	case schema.TypeFloat:
+		elementType = "types.Float64Type"
+
+	case schema.TypeInt:
+		elementType = "types.Int64Type"
+
+		if typeName == "list" {
+			return "fwtypes.ListOfInt64", "fwtypes.ListOfInt64Type", elementType, nil
+		}
+
+	case schema.TypeString:
+		elementType = "types.StringType"
+
+		switch typeName {
+		case "list":
+			return "fwtypes.ListOfString", "fwtypes.ListOfStringType", elementType, nil
+		case "map":
+			return "fwtypes.MapOfString", "fwtypes.MapOfStringType", elementType, nil
+		case "set":
+			return "fwtypes.SetOfString", "fwtypes.SetOfStringType", elementType, nil
 		}
 
 	default:
-		return unsupportedTypeError(path, v.String())
+		return "", "", "", unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", typeName, elemType.String()))
 	}
 
-	return nil
+	return "types." + strings.ToUpper(typeName[:1]) + typeName[1:], "", elementType, nil
+}
+
+// modelName returns the name of the model for the nested object at the specified path.
+// The model is named after the property unless that name is already used by a different nested object.
+func (e *emitter) modelName(path []string) string {
+	if e.modelNames == nil {
+		e.modelNames = make(map[string]string)
+	}
+
+	key := strings.Join(path, "/")
+	if name, ok := e.modelNames[key]; ok {
+		return name
+	}
+
+	name := lowerFirst(naming.ToCamelCase(path[len(path)-1])) + "Model"
+	for _, v := range e.modelNames {
+		if v == name {
+			name = lowerFirst(naming.ToCamelCase(strings.Join(path, "_"))) + "Model"
+			break
+		}
+	}
+	e.modelNames[key] = name
+
+	return name
+}
+
+// emitModel emits a nested object model to the emitter's ModelWriter.
+func (e *emitter) emitModel(name, fields string) {
+	fprintf(e.ModelWriter, "type %s struct {\n%s}\n\n", name, fields)
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToLower(s[:1]) + s[1:]
 }
 
 // warnf emits a formatted warning message to the UI.
@@ -902,24 +1029,31 @@ func unsupportedTypeError(path []string, typ string) error {
 }
 
 type templateData struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
-	EmitResourceImportState       bool
-	EmitResourceModifyPlan        bool
-	EmitResourceUpdateSkeleton    bool
-	FrameworkPlanModifierPackages []string
-	FrameworkValidatorsPackages   []string
-	GoImports                     []goImport
-	HasTimeouts                   bool
-	ImportFrameworkAttr           bool
-	ImportProviderFrameworkTypes  bool
-	Name                          string // e.g. Instance
-	PackageName                   string // e.g. ec2
-	Schema                        string
-	Struct                        string
-	TFTypeName                    string // e.g. aws_instance
+	CRUD                       *crudData
+	DefaultCreateTimeout       string // e.g. 10 * time.Minute
+	DefaultReadTimeout         string
+	DefaultUpdateTimeout       string
+	DefaultDeleteTimeout       string
+	EmitResourceImportState    bool
+	EmitResourceUpdateSkeleton bool
+	GoImports                  []goImport // Unused imports are removed by goimports
+	HasTags                    bool
+	HasTimeouts                bool
+	Models                     string
+	Name                       string // e.g. Instance
+	PackageName                string // e.g. ec2
+	Schema                     string
+	StateUpgraders             []stateUpgrader
+	Struct                     string
+	TagsIdentifierAttribute    string
+	TFTypeName                 string // e.g. aws_instance
+}
+
+// stateUpgrader describes the upgrade of state written by a Plugin SDK v2 schema version.
+type stateUpgrader struct {
+	Version int
+	Funcs   []string // Plugin SDK v2 state upgrade functions, in order
+	TODO    []int    // Versions of Plugin SDK v2 state upgrade functions that could not be migrated
 }
 
 //go:embed datasource.gtpl
@@ -932,3 +1066,62 @@ type goImport struct {
 	Path  string
 	Alias string
 }
+
+// name returns the name by which the imported package is referenced.
+func (i goImport) name() string {
+	if i.Alias != "" {
+		return i.Alias
+	}
+
+	name := path.Base(i.Path)
+	if strings.HasPrefix(name, "v") && path.Dir(i.Path) != "." {
+		if _, err := strconv.Atoi(name[1:]); err == nil {
+			name = path.Base(path.Dir(i.Path))
+		}
+	}
+
+	return name
+}
+
+// addImport adds an import unless the path or the name by which it is referenced has already been added.
+func (d *templateData) addImport(v goImport) {
+	for _, i := range d.GoImports {
+		if i.Path == v.Path || i.name() == v.name() {
+			return
+		}
+	}
+
+	d.GoImports = append(d.GoImports, v)
+}
+
+// Imports common to all generated resources and data sources. Unused imports are removed when the generated file is written.
+var (
+	resourceImports = []goImport{
+		{Path: "context"},
+		{Path: "fmt"},
+		{Path: "time"},
+		{Path: "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"},
+		{Path: "github.com/hashicorp/terraform-plugin-framework/path"},
+		{Path: "github.com/hashicorp/terraform-plugin-framework/resource"},
+		{Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema"},
+		{Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"},
+		{Path: "github.com/hashicorp/terraform-plugin-framework/schema/validator"},
+		{Path: "github.com/hashicorp/terraform-plugin-framework/types"},
+		{Path: "github.com/hashicorp/terraform-plugin-log/tflog"},
+		{Path: "github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"},
+		{Path: "github.com/hashicorp/terraform-provider-aws/internal/framework"},
+		{Path: "github.com/hashicorp/terraform-provider-aws/internal/framework/flex", Alias: "fwflex"},
+		{Path: "github.com/hashicorp/terraform-provider-aws/internal/framework/types", Alias: "fwtypes"},
+		{Path: "github.com/hashicorp/terraform-provider-aws/internal/retry"},
+		{Path: "github.com/hashicorp/terraform-provider-aws/internal/tags", Alias: "tftags"},
+	}
+	dataSourceImports = []goImport{
+		{Path: "context"},
+		{Path: "github.com/hashicorp/terraform-plugin-framework/datasource"},
+		{Path: "github.com/hashicorp/terraform-plugin-framework/datasource/schema"},
+		{Path: "github.com/hashicorp/terraform-plugin-framework/types"},
+		{Path: "github.com/hashicorp/terraform-provider-aws/internal/framework"},
+		{Path: "github.com/hashicorp/terraform-provider-aws/internal/framework/types", Alias: "fwtypes"},
+		{Path: "github.com/hashicorp/terraform-provider-aws/internal/tags", Alias: "tftags"},
+	}
+)
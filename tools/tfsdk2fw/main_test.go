@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+func TestEmitSchemaForResource(t *testing.T) {
+	t.Parallel()
+
+	resource := &schema.Resource{
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	sbSchema, sbStruct, sbModels := strings.Builder{}, strings.Builder{}, strings.Builder{}
+	e := &emitter{
+		Generator:    common.NewGenerator(),
+		ModelWriter:  &sbModels,
+		SchemaWriter: &sbSchema,
+		StructWriter: &sbStruct,
+	}
+
+	if err := e.emitSchemaForResource(resource); err != nil {
+		t.Fatalf("emitting schema: %s", err)
+	}
+
+	testCases := map[string]struct {
+		got  string
+		want []string
+	}{
+		"schema": {
+			got: sbSchema.String(),
+			want: []string{
+				"CustomType:fwtypes.NewListNestedObjectTypeOf[configurationModel](ctx),",
+				"CustomType:fwtypes.NewListNestedObjectTypeOf[endpointsModel](ctx),\nElementType:fwtypes.NewObjectTypeOf[endpointsModel](ctx),",
+				"CustomType:fwtypes.SetOfStringType,",
+				`"tags":tftags.TagsAttribute(),`,
+				`"tags_all":tftags.TagsAttributeComputedOnly(),`,
+				"Version:2,",
+			},
+		},
+		"struct": {
+			got: sbStruct.String(),
+			want: []string{
+				"ARN types.String `tfsdk:\"arn\"`",
+				"Configuration fwtypes.ListNestedObjectValueOf[configurationModel] `tfsdk:\"configuration\"`",
+				"Endpoints fwtypes.ListNestedObjectValueOf[endpointsModel] `tfsdk:\"endpoints\"`",
+				"ID types.String `tfsdk:\"id\"`",
+				"Tags tftags.Map `tfsdk:\"tags\"`",
+			},
+		},
+		"models": {
+			got: sbModels.String(),
+			want: []string{
+				"type configurationModel struct {\nSubnetIds fwtypes.SetOfString `tfsdk:\"subnet_ids\"`\n}",
+				"type endpointsModel struct {\nPort types.Int64 `tfsdk:\"port\"`\n}",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, want := range testCase.want {
+				if !strings.Contains(testCase.got, want) {
+					t.Errorf("output does not contain %q:\n%s", want, testCase.got)
+				}
+			}
+		})
+	}
+}
+
+func TestDurationExpr(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[time.Duration]string{
+		0:                       "",
+		2 * time.Hour:           "2 * time.Hour",
+		90 * time.Minute:        "90 * time.Minute",
+		45 * time.Second:        "45 * time.Second",
+		1500 * time.Millisecond: "1500000000 * time.Nanosecond",
+	}
+
+	for d, want := range testCases {
+		if got := durationExpr(d); got != want {
+			t.Errorf("durationExpr(%s): got %q, want %q", d, got, want)
+		}
+	}
+}